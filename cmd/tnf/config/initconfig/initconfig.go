@@ -0,0 +1,267 @@
+package initconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// genericLabel is the label autodiscovery selects the pods under test by when the config file sets no other.
+	genericLabel = "test-network-function.com/generic"
+	// configFilePermissions are the permissions of the written config file.
+	configFilePermissions = 0644
+	podPhaseRunning       = "Running"
+)
+
+var (
+	// OutputPath is the path of the config file to write.
+	OutputPath string
+	// Namespaces restricts the namespaces written to the config file, all the candidate ones when empty.
+	Namespaces []string
+	// Force allows overwriting an existing config file.
+	Force bool
+
+	// systemNamespacePrefixes are the prefixes of the namespaces of the platform, never proposed for testing.
+	systemNamespacePrefixes = []string{"openshift", "kube-"}
+	// systemNamespaces are the other namespaces never proposed for testing.
+	systemNamespaces = map[string]bool{"default": true}
+	// appLabels are the labels a pod selector is derived from, by order of preference.
+	appLabels = []string{"app.kubernetes.io/name", "app"}
+
+	initCmd = &cobra.Command{
+		Use:   "init",
+		Short: "Writes a starter test configuration file for the cluster of the current kubeconfig context.",
+		Long: `init discovers the namespaces of the cluster of the current kubeconfig context running pods, leaving out
+the OpenShift and Kubernetes ones, and writes a commented starter configuration file testing them.  The pods under test
+are selected by the test-network-function.com/generic label when some pods carry it, and otherwise by selectors derived
+from their app.kubernetes.io/name or app labels.`,
+		RunE: runInit,
+	}
+)
+
+// podList holds the data from an `oc get pods -o json` command.
+type podList struct {
+	Items []struct {
+		Metadata struct {
+			Name      string            `json:"name"`
+			Namespace string            `json:"namespace"`
+			Labels    map[string]string `json:"labels"`
+		} `json:"metadata"`
+		Status struct {
+			Phase string `json:"phase"`
+		} `json:"status"`
+	} `json:"items"`
+}
+
+// candidateNamespace is a namespace proposed for testing, with the pods it runs.
+type candidateNamespace struct {
+	name string
+	// selectors are the pod selectors derived from the labels of the pods.
+	selectors []string
+	// unlabeledPods are the pods no selector could be derived for.
+	unlabeledPods []string
+	// genericPods are the pods carrying the generic label.
+	genericPods int
+}
+
+// runOc runs an oc command and returns its output.
+var runOc = func(args ...string) ([]byte, error) {
+	output, err := exec.Command("oc", args...).Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to run oc %s: %w", strings.Join(args, " "), err)
+	}
+	return output, nil
+}
+
+// isSystemNamespace checks whether a namespace belongs to the platform.
+func isSystemNamespace(namespace string) bool {
+	if systemNamespaces[namespace] {
+		return true
+	}
+	for _, prefix := range systemNamespacePrefixes {
+		if strings.HasPrefix(namespace, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// getPodSelector returns the selector derived from the first of appLabels a pod carries, if any.
+func getPodSelector(labels map[string]string) (string, bool) {
+	for _, label := range appLabels {
+		if value, ok := labels[label]; ok && value != "" {
+			return label + "=" + value, true
+		}
+	}
+	return "", false
+}
+
+// discoverNamespaces returns the candidate namespaces running pods, sorted by name.  When namespaces is not empty,
+// those are returned instead, whether they are system namespaces or run pods or not.
+func discoverNamespaces(pods *podList, namespaces []string) []*candidateNamespace {
+	requested := map[string]bool{}
+	candidates := map[string]*candidateNamespace{}
+	selectors := map[string]map[string]bool{}
+	for _, namespace := range namespaces {
+		requested[namespace] = true
+		candidates[namespace] = &candidateNamespace{name: namespace}
+		selectors[namespace] = map[string]bool{}
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		namespace := pod.Metadata.Namespace
+		if pod.Status.Phase != podPhaseRunning {
+			continue
+		}
+		if len(requested) > 0 && !requested[namespace] || len(requested) == 0 && isSystemNamespace(namespace) {
+			continue
+		}
+		candidate, ok := candidates[namespace]
+		if !ok {
+			candidate = &candidateNamespace{name: namespace}
+			candidates[namespace] = candidate
+			selectors[namespace] = map[string]bool{}
+		}
+		if _, ok := pod.Metadata.Labels[genericLabel]; ok {
+			candidate.genericPods++
+		}
+		if selector, ok := getPodSelector(pod.Metadata.Labels); ok {
+			if !selectors[namespace][selector] {
+				selectors[namespace][selector] = true
+				candidate.selectors = append(candidate.selectors, selector)
+			}
+		} else {
+			candidate.unlabeledPods = append(candidate.unlabeledPods, pod.Metadata.Name)
+		}
+	}
+	var result []*candidateNamespace
+	for _, candidate := range candidates {
+		sort.Strings(candidate.selectors)
+		sort.Strings(candidate.unlabeledPods)
+		result = append(result, candidate)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].name < result[j].name })
+	return result
+}
+
+// renderConfig writes the starter config file testing the candidate namespaces.
+func renderConfig(kubeContext, server string, candidates []*candidateNamespace) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Starter test configuration written by \"tnf config init\" on %s\n", time.Now().UTC().Format(time.RFC3339))
+	fmt.Fprintf(&b, "# from the kubeconfig context %s (%s).\n", kubeContext, server)
+	b.WriteString("# Review the namespaces and the pods under test, then run \"tnf run -c <this file>\".\n\n")
+	b.WriteString("schemaVersion: 2\n\n")
+
+	b.WriteString("# The namespaces of the CNF under test.\n")
+	b.WriteString("targetNameSpaces:\n")
+	genericPods := 0
+	for _, candidate := range candidates {
+		fmt.Fprintf(&b, "  - name: %s\n", candidate.name)
+		genericPods += candidate.genericPods
+	}
+	b.WriteString("\n")
+
+	if genericPods > 0 {
+		fmt.Fprintf(&b, "# %d pods carry the %s label, the pods under test are selected by it.\n", genericPods, genericLabel)
+		b.WriteString("targetPodLabels:\n")
+		b.WriteString("  - prefix: test-network-function.com\n    name: generic\n    value: target\n")
+	} else {
+		b.WriteString("# No pod carries the " + genericLabel + " label, the pods under test are selected by the\n")
+		b.WriteString("# label selectors below, derived from their app labels.  Remove those of the pods not to test.\n")
+		selectors := 0
+		for _, candidate := range candidates {
+			selectors += len(candidate.selectors)
+		}
+		if selectors == 0 {
+			b.WriteString("targetPodSelectors: []\n")
+		} else {
+			b.WriteString("targetPodSelectors:\n")
+		}
+		for _, candidate := range candidates {
+			if len(candidate.selectors) == 0 {
+				continue
+			}
+			fmt.Fprintf(&b, "  # namespace %s\n", candidate.name)
+			for _, selector := range candidate.selectors {
+				fmt.Fprintf(&b, "  - %s\n", selector)
+			}
+		}
+	}
+	for _, candidate := range candidates {
+		if len(candidate.unlabeledPods) > 0 {
+			fmt.Fprintf(&b, "# Pods of the namespace %s without an app label, label them to test them: %s\n",
+				candidate.name, strings.Join(candidate.unlabeledPods, ", "))
+		}
+	}
+	b.WriteString("\n")
+
+	b.WriteString("# The operators under test are discovered by the test-network-function.com/operator label of their\n")
+	b.WriteString("# ClusterServiceVersions.  The CRDs under test are those whose name ends with one of these suffixes.\n")
+	b.WriteString("targetCrdFilters: []\n")
+	b.WriteString("#  - nameSuffix: \"example.com\"\n\n")
+
+	b.WriteString("# Limits of the connectivity tests, see the README for the other settings.\n")
+	b.WriteString("# pingThresholds:\n#   count: 5\n#   defaultNetwork:\n#     maxLossPercent: 0\n")
+	return b.String()
+}
+
+// runInit discovers the candidate namespaces of the cluster of the current kubeconfig context and writes the starter
+// config file.
+func runInit(cmd *cobra.Command, args []string) error {
+	if _, err := os.Stat(OutputPath); err == nil && !Force {
+		return fmt.Errorf("%s already exists, use --force to overwrite it", OutputPath)
+	}
+	kubeContext, err := runOc("config", "current-context")
+	if err != nil {
+		return err
+	}
+	server, err := runOc("whoami", "--show-server")
+	if err != nil {
+		return err
+	}
+	output, err := runOc("get", "pods", "-A", "-o", "json")
+	if err != nil {
+		return err
+	}
+	var pods podList
+	if err := json.Unmarshal(output, &pods); err != nil {
+		return fmt.Errorf("failed to parse the pods of the cluster: %w", err)
+	}
+	candidates := discoverNamespaces(&pods, Namespaces)
+	if len(candidates) == 0 {
+		return fmt.Errorf("no namespace running pods was found, use --namespace to name the ones to test")
+	}
+	for _, candidate := range candidates {
+		log.Infof("Found the candidate namespace %s", candidate.name)
+	}
+	config := renderConfig(strings.TrimSpace(string(kubeContext)), strings.TrimSpace(string(server)), candidates)
+	if err := os.WriteFile(OutputPath, []byte(config), configFilePermissions); err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Wrote %s, review it before running the tests\n", OutputPath)
+	return nil
+}
+
+func NewCommand() *cobra.Command {
+	initCmd.Flags().StringVarP(
+		&OutputPath, "output", "o", "tnf_config.yml",
+		"path of the config file to write",
+	)
+	initCmd.Flags().StringSliceVarP(
+		&Namespaces, "namespace", "n", nil,
+		"namespaces to test, all the candidate ones when omitted",
+	)
+	initCmd.Flags().BoolVarP(
+		&Force, "force", "f", false,
+		"overwrite the config file if it exists",
+	)
+	return initCmd
+}
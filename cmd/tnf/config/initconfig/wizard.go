@@ -0,0 +1,443 @@
+package initconfig
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/test-network-function/test-network-function/pkg/config"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// answerAll selects all the choices of a question.
+	answerAll = "all"
+	// numRangeBounds is the number of bounds of a range of choices such as 2-4.
+	numRangeBounds = 2
+)
+
+var (
+	wizardCmd = &cobra.Command{
+		Use:   "wizard",
+		Short: "Writes a test configuration file by asking questions about the cluster of the current kubeconfig context.",
+		Long: `wizard lists the namespaces of the cluster of the current kubeconfig context running pods, and asks which
+namespaces and pods are under test, which node interfaces are checked and the packet loss accepted on the networks of
+the CNF.  The configuration file written is validated as the suite would on load.`,
+		RunE: runWizard,
+	}
+
+	errInputEnded = errors.New("the input ended before the configuration was complete")
+)
+
+// wizard asks the questions of the wizard command and reads the answers.
+type wizard struct {
+	in  *bufio.Scanner
+	out io.Writer
+}
+
+// ask prints a question and returns the trimmed answer, or defaultAnswer when the answer is empty.
+func (w *wizard) ask(question, defaultAnswer string) (string, error) {
+	if defaultAnswer != "" {
+		fmt.Fprintf(w.out, "%s [%s]: ", question, defaultAnswer)
+	} else {
+		fmt.Fprintf(w.out, "%s: ", question)
+	}
+	if !w.in.Scan() {
+		if err := w.in.Err(); err != nil {
+			return "", err
+		}
+		return "", errInputEnded
+	}
+	answer := strings.TrimSpace(w.in.Text())
+	if answer == "" {
+		return defaultAnswer, nil
+	}
+	return answer, nil
+}
+
+// confirm asks a yes or no question.
+func (w *wizard) confirm(question string, defaultAnswer bool) (bool, error) {
+	choices := "y/N"
+	if defaultAnswer {
+		choices = "Y/n"
+	}
+	for {
+		answer, err := w.ask(question+" ("+choices+")", "")
+		if err != nil {
+			return false, err
+		}
+		switch strings.ToLower(answer) {
+		case "":
+			return defaultAnswer, nil
+		case "y", "yes":
+			return true, nil
+		case "n", "no":
+			return false, nil
+		}
+		fmt.Fprintln(w.out, "Please answer y or n.")
+	}
+}
+
+// choose lists numbered choices and returns the indexes of those picked, all of them by default.  The choices are
+// picked by number, by range of numbers such as 2-4, or by name, separated by commas.
+func (w *wizard) choose(question string, names, descriptions []string) ([]int, error) {
+	for i, name := range names {
+		fmt.Fprintf(w.out, "  %d) %s%s\n", i+1, name, descriptions[i])
+	}
+	for {
+		answer, err := w.ask(question+", by number or name", answerAll)
+		if err != nil {
+			return nil, err
+		}
+		picked, err := parseChoices(answer, names)
+		if err == nil {
+			return picked, nil
+		}
+		fmt.Fprintln(w.out, err)
+	}
+}
+
+// askList asks for a comma-separated list of values.
+func (w *wizard) askList(question string) ([]string, error) {
+	answer, err := w.ask(question, "")
+	if err != nil {
+		return nil, err
+	}
+	return splitList(answer), nil
+}
+
+// askPercent asks for a percentage, returning false if the question is skipped.
+func (w *wizard) askPercent(question string) (float64, bool, error) {
+	const maxPercent = 100
+	for {
+		answer, err := w.ask(question+", empty to leave unchecked", "")
+		if err != nil || answer == "" {
+			return 0, false, err
+		}
+		percent, err := strconv.ParseFloat(answer, 64)
+		if err == nil && percent >= 0 && percent <= maxPercent {
+			return percent, true, nil
+		}
+		fmt.Fprintf(w.out, "%s is not a percentage between 0 and 100.\n", answer)
+	}
+}
+
+// splitList splits a comma-separated list, leaving out the empty values.
+func splitList(list string) []string {
+	var values []string
+	for _, value := range strings.Split(list, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// parseChoices returns the sorted indexes of the choices named in an answer.
+func parseChoices(answer string, names []string) ([]int, error) {
+	picked := map[int]bool{}
+	for _, choice := range splitList(answer) {
+		if strings.EqualFold(choice, answerAll) {
+			for i := range names {
+				picked[i] = true
+			}
+			continue
+		}
+		first, last, err := parseChoiceRange(choice, names)
+		if err != nil {
+			return nil, err
+		}
+		for i := first; i <= last; i++ {
+			picked[i] = true
+		}
+	}
+	if len(picked) == 0 {
+		return nil, fmt.Errorf("pick at least one choice")
+	}
+	var indexes []int
+	for i := range picked {
+		indexes = append(indexes, i)
+	}
+	sort.Ints(indexes)
+	return indexes, nil
+}
+
+// parseChoiceRange returns the first and last indexes of a choice given by name, by number or by range of numbers.
+func parseChoiceRange(choice string, names []string) (first, last int, err error) {
+	for i, name := range names {
+		if name == choice {
+			return i, i, nil
+		}
+	}
+	bounds := strings.SplitN(choice, "-", numRangeBounds)
+	first, err = strconv.Atoi(bounds[0])
+	last = first
+	if err == nil && len(bounds) == numRangeBounds {
+		last, err = strconv.Atoi(bounds[1])
+	}
+	if err != nil || first < 1 || last < first || last > len(names) {
+		return 0, 0, fmt.Errorf("%s is neither one of the names nor a number between 1 and %d", choice, len(names))
+	}
+	return first - 1, last - 1, nil
+}
+
+// wizardPod is a running pod proposed for testing.
+type wizardPod struct {
+	name      string
+	selector  string
+	isGeneric bool
+}
+
+// getRunningPods returns the running pods of the cluster by namespace, sorted by name.
+func getRunningPods(pods *podList) map[string][]wizardPod {
+	result := map[string][]wizardPod{}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Status.Phase != podPhaseRunning {
+			continue
+		}
+		selector, _ := getPodSelector(pod.Metadata.Labels)
+		_, isGeneric := pod.Metadata.Labels[genericLabel]
+		namespace := pod.Metadata.Namespace
+		result[namespace] = append(result[namespace], wizardPod{name: pod.Metadata.Name, selector: selector, isGeneric: isGeneric})
+	}
+	for _, namespacePods := range result {
+		sort.Slice(namespacePods, func(i, j int) bool { return namespacePods[i].name < namespacePods[j].name })
+	}
+	return result
+}
+
+// chooseNamespaces asks which of the candidate namespaces are under test.
+func (w *wizard) chooseNamespaces(pods map[string][]wizardPod) ([]string, error) {
+	var names, descriptions []string
+	for namespace := range pods {
+		if !isSystemNamespace(namespace) {
+			names = append(names, namespace)
+		}
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no namespace running pods was found, use \"tnf config init --namespace\" instead")
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		descriptions = append(descriptions, fmt.Sprintf(" (running pods: %d)", len(pods[name])))
+	}
+	fmt.Fprintln(w.out, "\nNamespaces running pods:")
+	picked, err := w.choose("Namespaces of the CNF under test", names, descriptions)
+	if err != nil {
+		return nil, err
+	}
+	var namespaces []string
+	for _, i := range picked {
+		namespaces = append(namespaces, names[i])
+	}
+	return namespaces, nil
+}
+
+// choosePods asks which pods of a namespace are under test, returning the selectors matching them and the names of
+// the picked pods no selector could be derived for.
+func (w *wizard) choosePods(namespace string, pods []wizardPod) (selectors, unlabeledPods []string, err error) {
+	if len(pods) == 0 {
+		return nil, nil, nil
+	}
+	var names, descriptions []string
+	for _, pod := range pods {
+		names = append(names, pod.name)
+		if pod.selector != "" {
+			descriptions = append(descriptions, " ("+pod.selector+")")
+		} else {
+			descriptions = append(descriptions, " (no app label)")
+		}
+	}
+	fmt.Fprintf(w.out, "\nRunning pods of the namespace %s:\n", namespace)
+	picked, err := w.choose("Pods under test", names, descriptions)
+	if err != nil {
+		return nil, nil, err
+	}
+	seen := map[string]bool{}
+	for _, i := range picked {
+		pod := pods[i]
+		switch {
+		case pod.selector == "":
+			unlabeledPods = append(unlabeledPods, pod.name)
+		case !seen[pod.selector]:
+			seen[pod.selector] = true
+			selectors = append(selectors, pod.selector)
+		}
+	}
+	return selectors, unlabeledPods, nil
+}
+
+// askNetworks asks which node interfaces are checked and the packet loss accepted on the networks of the CNF.
+func (w *wizard) askNetworks(settings yaml.MapSlice) (yaml.MapSlice, error) {
+	fmt.Fprintln(w.out, "\nNetworks of the CNF:")
+	interfaces, err := w.askList("Node interfaces whose driver and firmware are checked, separated by commas, e.g. ens1f0")
+	if err != nil {
+		return nil, err
+	}
+	if len(interfaces) > 0 {
+		nics := yaml.MapSlice{{Key: "interfaces", Value: interfaces}}
+		drivers, err := w.askList("Drivers supported on those interfaces, separated by commas, empty to accept any")
+		if err != nil {
+			return nil, err
+		}
+		if len(drivers) > 0 {
+			var supportedDrivers []yaml.MapSlice
+			for _, driver := range drivers {
+				supportedDrivers = append(supportedDrivers, yaml.MapSlice{{Key: "name", Value: driver}})
+			}
+			nics = append(nics, yaml.MapItem{Key: "supportedDrivers", Value: supportedDrivers})
+		}
+		settings = append(settings, yaml.MapItem{Key: "nics", Value: nics})
+	}
+
+	var thresholds yaml.MapSlice
+	for _, network := range []struct{ key, description string }{
+		{"defaultNetwork", "the default network"},
+		{"multusNetwork", "the secondary Multus networks"},
+	} {
+		percent, ok, err := w.askPercent("Highest packet loss percentage accepted on " + network.description)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			thresholds = append(thresholds, yaml.MapItem{Key: network.key, Value: yaml.MapSlice{{Key: "maxLossPercent", Value: percent}}})
+		}
+	}
+	if len(thresholds) > 0 {
+		settings = append(settings, yaml.MapItem{Key: "pingThresholds", Value: thresholds})
+	}
+	return settings, nil
+}
+
+// askConfig asks the questions of the wizard and returns the settings of the config file, and comments on the pods
+// that cannot be selected.
+func (w *wizard) askConfig(pods map[string][]wizardPod) (settings yaml.MapSlice, notes []string, err error) {
+	namespaces, err := w.chooseNamespaces(pods)
+	if err != nil {
+		return nil, nil, err
+	}
+	var targetNamespaces []yaml.MapSlice
+	genericPods := 0
+	for _, namespace := range namespaces {
+		targetNamespaces = append(targetNamespaces, yaml.MapSlice{{Key: "name", Value: namespace}})
+		for _, pod := range pods[namespace] {
+			if pod.isGeneric {
+				genericPods++
+			}
+		}
+	}
+	settings = yaml.MapSlice{
+		{Key: "schemaVersion", Value: 2},
+		{Key: "targetNameSpaces", Value: targetNamespaces},
+	}
+
+	useGenericLabel := false
+	if genericPods > 0 {
+		useGenericLabel, err = w.confirm(fmt.Sprintf("\n%d pods carry the %s label, select the pods under test by it?",
+			genericPods, genericLabel), true)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	if useGenericLabel {
+		settings = append(settings, yaml.MapItem{Key: "targetPodLabels", Value: []yaml.MapSlice{{
+			{Key: "prefix", Value: "test-network-function.com"},
+			{Key: "name", Value: "generic"},
+			{Key: "value", Value: "target"},
+		}}})
+	} else {
+		selectors := []string{}
+		for _, namespace := range namespaces {
+			namespaceSelectors, unlabeledPods, err := w.choosePods(namespace, pods[namespace])
+			if err != nil {
+				return nil, nil, err
+			}
+			selectors = append(selectors, namespaceSelectors...)
+			if len(unlabeledPods) > 0 {
+				notes = append(notes, fmt.Sprintf("Pods of the namespace %s without an app label, label them to test them: %s",
+					namespace, strings.Join(unlabeledPods, ", ")))
+			}
+		}
+		settings = append(settings, yaml.MapItem{Key: "targetPodSelectors", Value: selectors})
+	}
+
+	settings, err = w.askNetworks(settings)
+	if err != nil {
+		return nil, nil, err
+	}
+	return settings, notes, nil
+}
+
+// runWizard asks about the cluster of the current kubeconfig context, then writes the validated config file.
+func runWizard(cmd *cobra.Command, args []string) error {
+	if _, err := os.Stat(OutputPath); err == nil && !Force {
+		return fmt.Errorf("%s already exists, use --force to overwrite it", OutputPath)
+	}
+	kubeContext, err := runOc("config", "current-context")
+	if err != nil {
+		return err
+	}
+	server, err := runOc("whoami", "--show-server")
+	if err != nil {
+		return err
+	}
+	output, err := runOc("get", "pods", "-A", "-o", "json")
+	if err != nil {
+		return err
+	}
+	var pods podList
+	if err := json.Unmarshal(output, &pods); err != nil {
+		return fmt.Errorf("failed to parse the pods of the cluster: %w", err)
+	}
+
+	w := &wizard{in: bufio.NewScanner(cmd.InOrStdin()), out: cmd.OutOrStdout()}
+	fmt.Fprintf(w.out, "Configuring the tests of the cluster of the kubeconfig context %s (%s).\n",
+		strings.TrimSpace(string(kubeContext)), strings.TrimSpace(string(server)))
+	settings, notes, err := w.askConfig(getRunningPods(&pods))
+	if err != nil {
+		return err
+	}
+	contents, err := yaml.Marshal(settings)
+	if err != nil {
+		return err
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Test configuration written by \"tnf config wizard\" on %s\n", time.Now().UTC().Format(time.RFC3339))
+	fmt.Fprintf(&b, "# from the kubeconfig context %s.\n", strings.TrimSpace(string(kubeContext)))
+	for _, note := range notes {
+		fmt.Fprintf(&b, "# %s\n", note)
+	}
+	b.Write(contents)
+	if err := config.ValidateConfigFile(OutputPath, []byte(b.String())); err != nil {
+		return err
+	}
+	if err := os.WriteFile(OutputPath, []byte(b.String()), configFilePermissions); err != nil {
+		return err
+	}
+	for _, note := range notes {
+		fmt.Fprintln(w.out, note)
+	}
+	fmt.Fprintf(w.out, "Wrote %s\n", OutputPath)
+	return nil
+}
+
+func NewWizardCommand() *cobra.Command {
+	wizardCmd.Flags().StringVarP(
+		&OutputPath, "output", "o", "tnf_config.yml",
+		"path of the config file to write",
+	)
+	wizardCmd.Flags().BoolVarP(
+		&Force, "force", "f", false,
+		"overwrite the config file if it exists",
+	)
+	return wizardCmd
+}
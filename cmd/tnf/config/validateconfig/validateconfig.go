@@ -0,0 +1,539 @@
+package validateconfig
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/test-network-function/test-network-function/pkg/config"
+	"github.com/test-network-function/test-network-function/pkg/config/autodiscover"
+	"github.com/test-network-function/test-network-function/pkg/config/configsections"
+)
+
+const (
+	// configurationPathEnvironmentVariable is the variable the suite reads the config file path from.
+	configurationPathEnvironmentVariable = "TNF_CONFIGURATION_PATH"
+	// cnfEnvironmentVariable selects the CNF under test when the config file declares several of them.
+	cnfEnvironmentVariable = "TNF_CNF"
+	// defaultConfigFilePath is the config file validated when no other is given.
+	defaultConfigFilePath = "test-network-function/tnf_config.yml"
+	// cniNetworksStatusAnnotation lists the network attachments of a pod and its IPs on each of them.
+	cniNetworksStatusAnnotation = "k8s.v1.cni.cncf.io/networks-status"
+	resourceTypeSubscription    = "subscriptions.operators.coreos.com"
+	// namespaceGlobChars are the characters making the name of a target namespace a glob pattern.
+	namespaceGlobChars = "*?["
+
+	// The prefixes of the lines of the diff: the resources found, those declared in the config but missing from the
+	// cluster, and those found in the cluster instead.
+	prefixFound      = " "
+	prefixMissing    = "-"
+	prefixUnexpected = "+"
+)
+
+var (
+	// ConfigPaths are the paths to the test configuration file and its overlays.
+	ConfigPaths []string
+	// CNFName selects the CNF to validate when the config file declares several of them.
+	CNFName string
+
+	validateCmd = &cobra.Command{
+		Use:   "validate",
+		Short: "Checks the resources referenced by the test configuration file against the cluster under test.",
+		Long: `validate loads the test configuration file and its overlays as the suite would, then checks against the
+cluster under test, without running any test, that the target namespaces exist and the target pod labels and selectors
+select pods in them, that the pods, deployments, containers and operators referenced exist, that the IPs declared for
+the containers are assigned to their pods, and that the nodes referenced exist.  It prints a diff of the config against
+the cluster: the lines starting with "-" are declared in the config but missing from the cluster, and those starting
+with "+" are found in the cluster instead.  It fails when there is any.`,
+		RunE: runValidate,
+		// The differences found are not a usage error.
+		SilenceUsage: true,
+	}
+)
+
+// pod holds the data from an `oc get pod -o json` command.
+type pod struct {
+	Metadata struct {
+		Annotations map[string]string `json:"annotations"`
+	} `json:"metadata"`
+	Spec struct {
+		NodeName   string `json:"nodeName"`
+		Containers []struct {
+			Name string `json:"name"`
+		} `json:"containers"`
+	} `json:"spec"`
+	Status struct {
+		PodIPs []struct {
+			IP string `json:"ip"`
+		} `json:"podIPs"`
+	} `json:"status"`
+}
+
+// networkStatus is an entry of the "k8s.v1.cni.cncf.io/networks-status" annotation.
+type networkStatus struct {
+	Name    string   `json:"name"`
+	IPs     []string `json:"ips"`
+	Default bool     `json:"default"`
+}
+
+// runOc runs an oc command and returns its output.  The error of a failed command is the message it printed.
+var runOc = func(args ...string) ([]byte, error) {
+	output, err := exec.Command("oc", args...).Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && len(exitErr.Stderr) > 0 {
+			return nil, errors.New(strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return nil, fmt.Errorf("failed to run oc %s: %w", strings.Join(args, " "), err)
+	}
+	return output, nil
+}
+
+// validator compares a test configuration with the cluster under test, printing the diff.
+type validator struct {
+	config *configsections.TestConfiguration
+	out    io.Writer
+	// ocFlags select the kubeconfig file and context of the cluster under test.
+	ocFlags []string
+	// differences counts the lines of the diff starting with "-" or "+".
+	differences int
+	// reported tracks the lines printed, so that a resource referenced several times is reported once.
+	reported map[string]bool
+	// pods caches the pods fetched, and podErrors the failures to fetch them, by "<namespace>/<name>".
+	pods      map[string]*pod
+	podErrors map[string]error
+}
+
+func newValidator(testConfig *configsections.TestConfiguration, out io.Writer) *validator {
+	v := &validator{
+		config:    testConfig,
+		out:       out,
+		reported:  map[string]bool{},
+		pods:      map[string]*pod{},
+		podErrors: map[string]error{},
+	}
+	if testConfig.Kubeconfig != "" {
+		v.ocFlags = append(v.ocFlags, "--kubeconfig", testConfig.Kubeconfig)
+	}
+	if testConfig.KubeconfigContext != "" {
+		v.ocFlags = append(v.ocFlags, "--context", testConfig.KubeconfigContext)
+	}
+	return v
+}
+
+// oc runs an oc command against the cluster under test.
+func (v *validator) oc(args ...string) ([]byte, error) {
+	return runOc(append(append([]string{}, args...), v.ocFlags...)...)
+}
+
+// getNames returns the names of the resources listed by an `oc get -o name` command, without their kind.
+func (v *validator) getNames(args ...string) ([]string, error) {
+	output, err := v.oc(append(args, "-o", "name")...)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, line := range strings.Split(string(output), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			names = append(names, line[strings.Index(line, "/")+1:])
+		}
+	}
+	return names, nil
+}
+
+// exists checks whether a resource exists, returning the error of oc otherwise.
+func (v *validator) exists(resourceType, namespace, name string) error {
+	_, err := v.oc("get", resourceType, "-n", namespace, name, "-o", "name")
+	return err
+}
+
+// getPod returns a pod, fetching it once.
+func (v *validator) getPod(namespace, name string) (*pod, error) {
+	key := namespace + "/" + name
+	if p, ok := v.pods[key]; ok {
+		return p, v.podErrors[key]
+	}
+	var p *pod
+	output, err := v.oc("get", "pod", "-n", namespace, name, "-o", "json")
+	if err == nil {
+		p = &pod{}
+		if err = json.Unmarshal(output, p); err != nil {
+			p, err = nil, fmt.Errorf("failed to parse the pod: %w", err)
+		}
+	}
+	v.pods[key] = p
+	v.podErrors[key] = err
+	return p, err
+}
+
+// report prints a line of the diff, once.
+func (v *validator) report(prefix, format string, args ...interface{}) {
+	line := prefix + " " + fmt.Sprintf(format, args...)
+	if v.reported[line] {
+		return
+	}
+	v.reported[line] = true
+	if prefix != prefixFound {
+		v.differences++
+	}
+	fmt.Fprintln(v.out, line)
+}
+
+// section prints the heading of a group of lines of the diff.
+func (v *validator) section(name string) {
+	fmt.Fprintf(v.out, "@@ %s @@\n", name)
+}
+
+// checkNamespaces checks the target namespaces exist, or that some namespaces match those which are glob patterns or
+// label selectors, and returns the names of the namespaces under test.
+func (v *validator) checkNamespaces() []string {
+	var namespaces []string
+	for _, target := range v.config.TargetNameSpaces {
+		if target.Selector == "" && !strings.ContainsAny(target.Name, namespaceGlobChars) {
+			if _, err := v.oc("get", "namespace", target.Name, "-o", "name"); err != nil {
+				v.report(prefixMissing, "namespace %s: %v", target.Name, err)
+				continue
+			}
+			v.report(prefixFound, "namespace %s", target.Name)
+			namespaces = append(namespaces, target.Name)
+			continue
+		}
+		var filters []string
+		args := []string{"get", "namespaces"}
+		if target.Name != "" {
+			filters = append(filters, target.Name)
+		}
+		if target.Selector != "" {
+			filters = append(filters, target.Selector)
+			args = append(args, "-l", target.Selector)
+		}
+		description := "namespaces matching " + strings.Join(filters, " and ")
+		names, err := v.getNames(args...)
+		if err != nil {
+			v.report(prefixMissing, "%s: %v", description, err)
+			continue
+		}
+		var matched []string
+		for _, name := range names {
+			if ok, _ := path.Match(target.Name, name); ok || target.Name == "" {
+				matched = append(matched, name)
+			}
+		}
+		if len(matched) == 0 {
+			v.report(prefixMissing, "%s: none found", description)
+			continue
+		}
+		v.report(prefixFound, "%s: %s", description, strings.Join(matched, ", "))
+		namespaces = append(namespaces, matched...)
+	}
+	return namespaces
+}
+
+// checkPodSelector checks a label selector selects pods in the namespaces, in any namespace when there is none.  The
+// description names the setting of the selector.
+func (v *validator) checkPodSelector(description, selector string, namespaces []string) {
+	var selected []string
+	var err error
+	if len(namespaces) == 0 {
+		selected, err = v.getNames("get", "pods", "-A", "-l", selector)
+	}
+	for _, namespace := range namespaces {
+		var names []string
+		if names, err = v.getNames("get", "pods", "-n", namespace, "-l", selector); err != nil {
+			break
+		}
+		selected = append(selected, names...)
+	}
+	switch {
+	case err != nil:
+		v.report(prefixMissing, "pods selected by %s: %v", description, err)
+	case len(selected) == 0:
+		v.report(prefixMissing, "pods selected by %s: none found", description)
+	default:
+		v.report(prefixFound, "pods selected by %s: %d pods", description, len(selected))
+	}
+}
+
+// checkPods checks the pod labels and selectors select pods, and the pods and deployments under test exist.
+func (v *validator) checkPods(namespaces []string) {
+	for _, label := range v.config.TargetPodLabels {
+		selector := autodiscover.BuildLabelQuery(label)
+		v.checkPodSelector("label "+selector, selector, namespaces)
+	}
+	for _, selector := range v.config.TargetPodSelectors {
+		v.checkPodSelector("selector "+selector, selector, namespaces)
+	}
+	for i := range v.config.ExpectedTopology {
+		workload := &v.config.ExpectedTopology[i]
+		v.checkPodSelector(fmt.Sprintf("workload %s of the expected topology (%s in namespace %s)", workload.Name,
+			workload.PodSelector, workload.Namespace), workload.PodSelector, []string{workload.Namespace})
+	}
+	for _, p := range v.config.PodsUnderTest {
+		if _, err := v.getPod(p.Namespace, p.Name); err != nil {
+			v.report(prefixMissing, "pod %s/%s: %v", p.Namespace, p.Name, err)
+		} else {
+			v.report(prefixFound, "pod %s/%s", p.Namespace, p.Name)
+		}
+	}
+	for _, deployment := range v.config.DeploymentsUnderTest {
+		if err := v.exists("deployment", deployment.Namespace, deployment.Name); err != nil {
+			v.report(prefixMissing, "deployment %s/%s: %v", deployment.Namespace, deployment.Name, err)
+		} else {
+			v.report(prefixFound, "deployment %s/%s", deployment.Namespace, deployment.Name)
+		}
+	}
+}
+
+// checkOperators checks the ClusterServiceVersions and the Subscriptions of the operators under test exist.
+func (v *validator) checkOperators() {
+	for _, operator := range v.config.Operators {
+		if operator.Name != "" {
+			if err := v.exists("csv", operator.Namespace, operator.Name); err != nil {
+				v.report(prefixMissing, "csv %s/%s: %v", operator.Namespace, operator.Name, err)
+			} else {
+				v.report(prefixFound, "csv %s/%s", operator.Namespace, operator.Name)
+			}
+		}
+		if operator.SubscriptionName != "" {
+			if err := v.exists(resourceTypeSubscription, operator.Namespace, operator.SubscriptionName); err != nil {
+				v.report(prefixMissing, "subscription %s/%s: %v", operator.Namespace, operator.SubscriptionName, err)
+			} else {
+				v.report(prefixFound, "subscription %s/%s", operator.Namespace, operator.SubscriptionName)
+			}
+		}
+	}
+}
+
+// getContainers returns the containers referenced by the config, with their declared IPs when they have any.
+func (v *validator) getContainers() []configsections.ContainerConfig {
+	var containers []configsections.ContainerConfig
+	containers = append(containers, v.config.ContainerConfigList...)
+	containers = append(containers, v.config.Partner.ContainerConfigList...)
+	containers = append(containers, v.config.Partner.ContainersDebugList...)
+	if v.config.Partner.TestOrchestratorID.PodName != "" {
+		containers = append(containers, configsections.ContainerConfig{ContainerIdentifier: v.config.Partner.TestOrchestratorID})
+	}
+	for _, c := range v.config.ExcludeContainersFromConnectivityTests {
+		containers = append(containers, configsections.ContainerConfig{ContainerIdentifier: c})
+	}
+	return containers
+}
+
+// checkContainers checks the containers referenced exist and run on the declared nodes, and that their declared IPs
+// are assigned to their pods.
+func (v *validator) checkContainers() {
+	containers := v.getContainers()
+	for i := range containers {
+		c := &containers[i]
+		name := fmt.Sprintf("%s/%s/%s", c.Namespace, c.PodName, c.ContainerName)
+		p, err := v.getPod(c.Namespace, c.PodName)
+		if err != nil {
+			v.report(prefixMissing, "container %s: %v", name, err)
+			continue
+		}
+		var containerNames []string
+		for _, container := range p.Spec.Containers {
+			containerNames = append(containerNames, container.Name)
+		}
+		if !containsString(containerNames, c.ContainerName) {
+			v.report(prefixMissing, "container %s: not found, the pod runs %s", name, strings.Join(containerNames, ", "))
+			continue
+		}
+		v.report(prefixFound, "container %s", name)
+		if c.NodeName != "" && c.NodeName != p.Spec.NodeName {
+			v.report(prefixMissing, "container %s on node %s", name, c.NodeName)
+			v.report(prefixUnexpected, "container %s on node %s", name, p.Spec.NodeName)
+		}
+		v.checkContainerIPs(name, c, p)
+	}
+}
+
+// getPodNetworks returns the IPs of a pod on each of its network attachments but the default network.
+func getPodNetworks(p *pod) (map[string][]string, error) {
+	networks := map[string][]string{}
+	val, present := p.Metadata.Annotations[cniNetworksStatusAnnotation]
+	if !present {
+		return networks, nil
+	}
+	var statuses []networkStatus
+	if err := json.Unmarshal([]byte(val), &statuses); err != nil {
+		return nil, fmt.Errorf("failed to parse the %s annotation: %w", cniNetworksStatusAnnotation, err)
+	}
+	for _, status := range statuses {
+		if !status.Default {
+			networks[status.Name] = append(networks[status.Name], status.IPs...)
+		}
+	}
+	return networks, nil
+}
+
+// checkContainerIPs checks the IPs declared for a container are assigned to its pod, reporting the IPs of the pod on
+// the Multus networks not declared.  A container declaring no IPs is left to autodiscovery.
+func (v *validator) checkContainerIPs(name string, c *configsections.ContainerConfig, p *pod) {
+	if len(c.MultusIPAddresses) == 0 && len(c.MultusNetworks) == 0 {
+		return
+	}
+	networks, err := getPodNetworks(p)
+	if err != nil {
+		v.report(prefixMissing, "IPs of container %s: %v", name, err)
+		return
+	}
+	var assigned, multusIPs []string
+	for _, ip := range p.Status.PodIPs {
+		assigned = append(assigned, ip.IP)
+	}
+	for _, ips := range networks {
+		assigned = append(assigned, ips...)
+		multusIPs = append(multusIPs, ips...)
+	}
+	declared := map[string]bool{}
+	for _, ip := range c.MultusIPAddresses {
+		declared[ip] = true
+		if containsString(assigned, ip) {
+			v.report(prefixFound, "IP %s of container %s", ip, name)
+		} else {
+			v.report(prefixMissing, "IP %s of container %s", ip, name)
+		}
+	}
+	var networkNames []string
+	for network := range c.MultusNetworks {
+		networkNames = append(networkNames, network)
+	}
+	sort.Strings(networkNames)
+	for _, network := range networkNames {
+		for _, ip := range c.MultusNetworks[network] {
+			declared[ip] = true
+			if containsString(networks[network], ip) {
+				v.report(prefixFound, "IP %s of container %s on network %s", ip, name, network)
+			} else {
+				v.report(prefixMissing, "IP %s of container %s on network %s", ip, name, network)
+			}
+		}
+	}
+	sort.Strings(multusIPs)
+	for _, ip := range multusIPs {
+		if !declared[ip] {
+			v.report(prefixUnexpected, "IP %s of container %s", ip, name)
+		}
+	}
+}
+
+// checkNodes checks the nodes referenced by the containers, the node list and the expected topology exist, and that
+// the node selectors of the expected topology select some nodes.
+func (v *validator) checkNodes() {
+	var referenced []string
+	for _, c := range v.getContainers() {
+		if c.NodeName != "" {
+			referenced = append(referenced, c.NodeName)
+		}
+	}
+	for name := range v.config.Nodes {
+		referenced = append(referenced, name)
+	}
+	for i := range v.config.ExpectedTopology {
+		referenced = append(referenced, v.config.ExpectedTopology[i].Nodes...)
+	}
+	sort.Strings(referenced)
+	if len(referenced) > 0 {
+		nodes, err := v.getNames("get", "nodes")
+		if err != nil {
+			v.report(prefixMissing, "nodes: %v", err)
+			return
+		}
+		for _, name := range referenced {
+			if containsString(nodes, name) {
+				v.report(prefixFound, "node %s", name)
+			} else {
+				v.report(prefixMissing, "node %s", name)
+			}
+		}
+	}
+	for i := range v.config.ExpectedTopology {
+		selector := v.config.ExpectedTopology[i].NodeSelector
+		if selector == "" {
+			continue
+		}
+		nodes, err := v.getNames("get", "nodes", "-l", selector)
+		switch {
+		case err != nil:
+			v.report(prefixMissing, "nodes selected by %s: %v", selector, err)
+		case len(nodes) == 0:
+			v.report(prefixMissing, "nodes selected by %s: none found", selector)
+		default:
+			v.report(prefixFound, "nodes selected by %s: %s", selector, strings.Join(nodes, ", "))
+		}
+	}
+}
+
+// validate prints the diff of the config against the cluster.
+func (v *validator) validate() {
+	v.section("namespaces")
+	namespaces := v.checkNamespaces()
+	v.section("pods")
+	v.checkPods(namespaces)
+	v.section("containers")
+	v.checkContainers()
+	v.section("operators")
+	v.checkOperators()
+	v.section("nodes")
+	v.checkNodes()
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// runValidate loads the config files, then prints their diff against the cluster under test.
+func runValidate(cmd *cobra.Command, args []string) error {
+	configPaths := ConfigPaths
+	if len(configPaths) == 0 {
+		configPaths = config.SplitConfigPaths(os.Getenv(configurationPathEnvironmentVariable))
+	}
+	if len(configPaths) == 0 {
+		configPaths = []string{defaultConfigFilePath}
+	}
+	cnfName := CNFName
+	if cnfName == "" {
+		cnfName = os.Getenv(cnfEnvironmentVariable)
+	}
+	testConfig, err := config.LoadTestConfiguration(cnfName, configPaths...)
+	if err != nil {
+		return err
+	}
+	v := newValidator(testConfig, cmd.OutOrStdout())
+	server, err := v.oc("whoami", "--show-server")
+	if err != nil {
+		return fmt.Errorf("the cluster under test is not reachable: %w", err)
+	}
+	fmt.Fprintf(v.out, "Validating %s against the cluster %s\n", strings.Join(configPaths, ", "), strings.TrimSpace(string(server)))
+	v.validate()
+	if v.differences > 0 {
+		return fmt.Errorf("found %d differences between the config and the cluster", v.differences)
+	}
+	fmt.Fprintln(v.out, "The config matches the cluster")
+	return nil
+}
+
+func NewCommand() *cobra.Command {
+	validateCmd.Flags().StringSliceVarP(
+		&ConfigPaths, "config", "c", nil,
+		"paths, URLs or git references of the test configuration file and its overlays, merged in order, "+defaultConfigFilePath+" when omitted",
+	)
+	validateCmd.Flags().StringVar(
+		&CNFName, "cnf", "",
+		"CNF to validate when the config file declares several of them, the one TNF_CNF names when omitted",
+	)
+	return validateCmd
+}
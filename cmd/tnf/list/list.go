@@ -0,0 +1,100 @@
+package list
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/test-network-function/test-network-function/test-network-function/identifiers"
+)
+
+var (
+	// Suites are the suites whose test cases are listed, all of them when empty.
+	Suites []string
+
+	// Verbose prints the description of each test case.
+	Verbose bool
+
+	list = &cobra.Command{
+		Use:   "list",
+		Short: "Lists the test suites and their test cases.",
+		RunE:  runList,
+	}
+)
+
+// GetSuites returns the sorted names of the test suites, which select them in the focus and skip of a test run.
+func GetSuites() []string {
+	var suites []string
+	seen := map[string]bool{}
+	for identifier := range identifiers.Catalog {
+		suite := path.Base(path.Dir(identifier.Url))
+		if !seen[suite] {
+			seen[suite] = true
+			suites = append(suites, suite)
+		}
+	}
+	sort.Strings(suites)
+	return suites
+}
+
+// ValidateSuites returns an error naming the unknown suites, if any.
+func ValidateSuites(suites []string) error {
+	known := map[string]bool{}
+	for _, suite := range GetSuites() {
+		known[suite] = true
+	}
+	var unknown []string
+	for _, suite := range suites {
+		if !known[suite] {
+			unknown = append(unknown, suite)
+		}
+	}
+	if len(unknown) > 0 {
+		return fmt.Errorf("unknown suites %v, expected some of %v", unknown, GetSuites())
+	}
+	return nil
+}
+
+func runList(cmd *cobra.Command, args []string) error {
+	if err := ValidateSuites(Suites); err != nil {
+		return err
+	}
+	testCases := map[string][]string{}
+	descriptions := map[string]string{}
+	for identifier, testCase := range identifiers.Catalog {
+		suite := path.Base(path.Dir(identifier.Url))
+		testID := identifiers.XformToGinkgoItIdentifier(identifier)
+		testCases[suite] = append(testCases[suite], testID)
+		descriptions[testID] = strings.Join(strings.Fields(strings.TrimPrefix(testCase.Description, identifier.Url)), " ")
+	}
+	suites := Suites
+	if len(suites) == 0 {
+		suites = GetSuites()
+	}
+	for _, suite := range suites {
+		fmt.Fprintln(cmd.OutOrStdout(), suite)
+		sort.Strings(testCases[suite])
+		for _, testID := range testCases[suite] {
+			if Verbose {
+				fmt.Fprintf(cmd.OutOrStdout(), "  %s: %s\n", testID, descriptions[testID])
+			} else {
+				fmt.Fprintf(cmd.OutOrStdout(), "  %s\n", testID)
+			}
+		}
+	}
+	return nil
+}
+
+func NewCommand() *cobra.Command {
+	list.Flags().StringSliceVarP(
+		&Suites, "focus", "f", nil,
+		"suites whose test cases are listed, all of them when omitted",
+	)
+	list.Flags().BoolVarP(
+		&Verbose, "verbose", "v", false,
+		"print the description of each test case",
+	)
+	return list
+}
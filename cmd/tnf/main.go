@@ -5,22 +5,32 @@ import (
 	"github.com/spf13/cobra"
 
 	claim "github.com/test-network-function/test-network-function/cmd/tnf/addclaim"
+	"github.com/test-network-function/test-network-function/cmd/tnf/config/initconfig"
+	"github.com/test-network-function/test-network-function/cmd/tnf/config/validateconfig"
 	"github.com/test-network-function/test-network-function/cmd/tnf/generate/catalog"
 	"github.com/test-network-function/test-network-function/cmd/tnf/generate/handler"
 	"github.com/test-network-function/test-network-function/cmd/tnf/grade"
 	"github.com/test-network-function/test-network-function/cmd/tnf/jsontest"
+	"github.com/test-network-function/test-network-function/cmd/tnf/list"
+	"github.com/test-network-function/test-network-function/cmd/tnf/run"
+	"github.com/test-network-function/test-network-function/cmd/tnf/version"
 )
 
 var (
 	rootCmd = &cobra.Command{
 		Use:   "tnf",
-		Short: "A CLI for creating, validating , and test-network-function tests.",
+		Short: "A CLI for creating, validating, running and listing test-network-function tests.",
 	}
 
 	generate = &cobra.Command{
 		Use:   "generate",
 		Short: "generator tool for various tnf artifacts.",
 	}
+
+	config = &cobra.Command{
+		Use:   "config",
+		Short: "tools for the test configuration file.",
+	}
 )
 
 func main() {
@@ -28,8 +38,15 @@ func main() {
 	rootCmd.AddCommand(generate)
 	generate.AddCommand(catalog.NewCommand())
 	generate.AddCommand(handler.NewCommand())
+	rootCmd.AddCommand(config)
+	config.AddCommand(initconfig.NewCommand())
+	config.AddCommand(initconfig.NewWizardCommand())
+	config.AddCommand(validateconfig.NewCommand())
 	rootCmd.AddCommand(jsontest.NewCommand())
 	rootCmd.AddCommand(grade.NewCommand())
+	rootCmd.AddCommand(run.NewCommand())
+	rootCmd.AddCommand(list.NewCommand())
+	rootCmd.AddCommand(version.NewCommand())
 	if err := rootCmd.Execute(); err != nil {
 		log.Fatal(err)
 	}
@@ -0,0 +1,267 @@
+package run
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/test-network-function/test-network-function-claim/pkg/claim"
+	"github.com/test-network-function/test-network-function/cmd/tnf/list"
+	"github.com/test-network-function/test-network-function/pkg/config"
+)
+
+const (
+	// junitReportFileName is the name of the JUnit report of the test run.
+	junitReportFileName = "cnf-certification-tests_junit.xml"
+	// configurationPathEnvironmentVariable is the variable the test binary reads the config file path from.
+	configurationPathEnvironmentVariable = "TNF_CONFIGURATION_PATH"
+	// logLevelEnvironmentVariable is the variable the test binary reads the log level from.
+	logLevelEnvironmentVariable = "LOG_LEVEL"
+	// cnfEnvironmentVariable is the variable the test binary reads the CNF under test from.
+	cnfEnvironmentVariable = "TNF_CNF"
+	// kubeconfigEnvironmentVariable and kubeconfigContextEnvironmentVariable override the kubeconfig and
+	// kubeconfigContext settings of the config file.
+	kubeconfigEnvironmentVariable        = "TNF_KUBECONFIG"
+	kubeconfigContextEnvironmentVariable = "TNF_KUBECONFIG_CONTEXT"
+	// defaultConfigFileName is the config file the test binary loads from its own directory.
+	defaultConfigFileName = "tnf_config.yml"
+	claimFileName         = "claim.json"
+	claimFilePermissions  = 0644
+	outputDirPermissions  = 0755
+)
+
+var (
+	// ConfigPaths are the paths to the test configuration file and its overlays.
+	ConfigPaths []string
+	// OutputDir is the directory the claim file and the JUnit report are written to.
+	OutputDir string
+	// LogLevel is the log level of the test run, e.g. info or debug.
+	LogLevel string
+	// Focus are the suites to run, all of them when empty.
+	Focus []string
+	// Skip are the suites not to run.
+	Skip []string
+	// TestBinary is the path to the test binary built by "make build-cnf-tests".
+	TestBinary string
+	// Kubeconfig is the kubeconfig file of the cluster under test.
+	Kubeconfig string
+	// KubeconfigContext is the kubeconfig context of the cluster under test.
+	KubeconfigContext string
+
+	run = &cobra.Command{
+		Use:   "run",
+		Short: "Runs the test suites against the CNF under test.",
+		Long: `run runs the selected test suites of the test binary built by "make build-cnf-tests", writing the claim
+file and the JUnit report to the output directory.  When the config file declares several CNFs, the suites are run
+once per CNF, and their claims are merged into a claim file with a section per CNF.`,
+		RunE: runSuites,
+	}
+)
+
+// getTestArgs returns the arguments of the test binary.
+func getTestArgs(outputDir string, focus, skip []string) []string {
+	if len(focus) == 0 {
+		focus = list.GetSuites()
+	}
+	args := []string{
+		"-ginkgo.focus=" + strings.Join(focus, "|"),
+		"-junit", outputDir,
+		"-claimloc", outputDir,
+		"--ginkgo.junit-report", filepath.Join(outputDir, junitReportFileName),
+		"-ginkgo.v", "-test.v",
+	}
+	if len(skip) > 0 {
+		args = append(args, "-ginkgo.skip="+strings.Join(skip, "|"))
+	}
+	return args
+}
+
+// resolveConfigPaths returns the absolute paths, or the URLs and git references, of the config file the test binary
+// loads and of its overlays.
+func resolveConfigPaths(binaryDir string) ([]string, error) {
+	var configPaths []string
+	if len(ConfigPaths) > 0 {
+		for _, configPath := range ConfigPaths {
+			if config.IsRemoteConfigPath(configPath) {
+				configPaths = append(configPaths, configPath)
+				continue
+			}
+			absPath, err := filepath.Abs(configPath)
+			if err != nil {
+				return nil, err
+			}
+			configPaths = append(configPaths, absPath)
+		}
+		return configPaths, nil
+	}
+	for _, configPath := range config.SplitConfigPaths(os.Getenv(configurationPathEnvironmentVariable)) {
+		if !filepath.IsAbs(configPath) && !config.IsRemoteConfigPath(configPath) {
+			configPath = filepath.Join(binaryDir, configPath)
+		}
+		configPaths = append(configPaths, configPath)
+	}
+	if len(configPaths) == 0 {
+		configPaths = append(configPaths, filepath.Join(binaryDir, defaultConfigFileName))
+	}
+	return configPaths, nil
+}
+
+// runSuites runs the test binary once, or once per CNF when the config file declares several of them.  The claim file
+// and the JUnit report of each CNF are written to a subdirectory of the output directory named after it, and their
+// claims are merged into the claim file of the output directory.
+func runSuites(cmd *cobra.Command, args []string) error {
+	if err := list.ValidateSuites(append(append([]string{}, Focus...), Skip...)); err != nil {
+		return err
+	}
+	binary, err := filepath.Abs(TestBinary)
+	if err != nil {
+		return err
+	}
+	outputDir, err := filepath.Abs(OutputDir)
+	if err != nil {
+		return err
+	}
+	if Kubeconfig != "" {
+		// The test binary runs from its own directory.
+		if Kubeconfig, err = filepath.Abs(Kubeconfig); err != nil {
+			return err
+		}
+	}
+	configPaths, err := resolveConfigPaths(filepath.Dir(binary))
+	if err != nil {
+		return err
+	}
+	configPath := strings.Join(configPaths, string(os.PathListSeparator))
+	cnfs, err := config.LoadCNFNames(configPaths...)
+	if err != nil {
+		return err
+	}
+	if len(cnfs) == 0 {
+		return runTestBinary(cmd, binary, configPath, outputDir, nil)
+	}
+	var failed []string
+	for _, cnf := range cnfs {
+		cnfOutputDir := filepath.Join(outputDir, cnf)
+		if err := os.MkdirAll(cnfOutputDir, outputDirPermissions); err != nil {
+			return err
+		}
+		log.Infof("Testing the CNF %s", cnf)
+		if err := runTestBinary(cmd, binary, configPath, cnfOutputDir, []string{cnfEnvironmentVariable + "=" + cnf}); err != nil {
+			log.Errorf("The test run of the CNF %s failed: %v", cnf, err)
+			failed = append(failed, cnf)
+		}
+	}
+	if err := mergeClaims(outputDir, cnfs); err != nil {
+		return err
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("the test runs of the CNFs %s failed", strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// runTestBinary runs the test binary, writing the claim file and the JUnit report to the output directory.
+func runTestBinary(cmd *cobra.Command, binary, configPath, outputDir string, env []string) error {
+	test := exec.Command(binary, getTestArgs(outputDir, Focus, Skip)...) //nolint:gosec // runs the configured test binary
+	// The test binary resolves its resources relative to its own directory.
+	test.Dir = filepath.Dir(binary)
+	test.Env = append(os.Environ(), configurationPathEnvironmentVariable+"="+configPath)
+	if LogLevel != "" {
+		test.Env = append(test.Env, logLevelEnvironmentVariable+"="+LogLevel)
+	}
+	if Kubeconfig != "" {
+		test.Env = append(test.Env, kubeconfigEnvironmentVariable+"="+Kubeconfig)
+	}
+	if KubeconfigContext != "" {
+		test.Env = append(test.Env, kubeconfigContextEnvironmentVariable+"="+KubeconfigContext)
+	}
+	test.Env = append(test.Env, env...)
+	test.Stdout = cmd.OutOrStdout()
+	test.Stderr = cmd.ErrOrStderr()
+	log.Infof("Running %s %s", binary, strings.Join(test.Args[1:], " "))
+	if err := test.Run(); err != nil {
+		return fmt.Errorf("the test run failed: %w", err)
+	}
+	return nil
+}
+
+// mergeClaims writes the claim file of the output directory from the claim files of the CNFs, keying their
+// configurations and results by CNF name.  The metadata, the versions and the nodes are those of the first CNF, but
+// for the end time of the last one.
+func mergeClaims(outputDir string, cnfs []string) error {
+	merged := &claim.Claim{
+		Configurations: map[string]interface{}{},
+		RawResults:     map[string]interface{}{},
+		Results:        map[string]interface{}{},
+	}
+	for _, cnf := range cnfs {
+		contents, err := os.ReadFile(filepath.Join(outputDir, cnf, claimFileName))
+		if err != nil {
+			log.Warnf("Leaving the CNF %s out of the claim: %v", cnf, err)
+			continue
+		}
+		var root claim.Root
+		if err := json.Unmarshal(contents, &root); err != nil || root.Claim == nil {
+			return fmt.Errorf("invalid claim file of the CNF %s: %v", cnf, err)
+		}
+		cnfClaim := root.Claim
+		if merged.Metadata == nil {
+			merged.Metadata = cnfClaim.Metadata
+			merged.Versions = cnfClaim.Versions
+			merged.Nodes = cnfClaim.Nodes
+		} else if cnfClaim.Metadata != nil {
+			merged.Metadata.EndTime = cnfClaim.Metadata.EndTime
+		}
+		merged.Configurations[cnf] = cnfClaim.Configurations
+		merged.RawResults[cnf] = cnfClaim.RawResults
+		merged.Results[cnf] = cnfClaim.Results
+	}
+	payload, err := json.MarshalIndent(&claim.Root{Claim: merged}, "", "  ")
+	if err != nil {
+		return err
+	}
+	claimFile := filepath.Join(outputDir, claimFileName)
+	log.Infof("Writing the claim of the CNFs %s to %s", strings.Join(cnfs, ", "), claimFile)
+	return os.WriteFile(claimFile, payload, claimFilePermissions)
+}
+
+func NewCommand() *cobra.Command {
+	run.Flags().StringSliceVarP(
+		&ConfigPaths, "config", "c", nil,
+		"paths, URLs or git references of the test configuration file and its overlays, merged in order, test-network-function/tnf_config.yml when omitted",
+	)
+	run.Flags().StringVarP(
+		&OutputDir, "output", "o", "test-network-function",
+		"directory the claim file and the JUnit report are written to",
+	)
+	run.Flags().StringVarP(
+		&LogLevel, "log-level", "l", "",
+		"log level of the test run: trace, debug, info, warn, error, fatal or panic",
+	)
+	run.Flags().StringSliceVarP(
+		&Focus, "focus", "f", nil,
+		"suites to run, all of them when omitted",
+	)
+	run.Flags().StringSliceVarP(
+		&Skip, "skip", "s", nil,
+		"suites not to run",
+	)
+	run.Flags().StringVar(
+		&TestBinary, "test-binary", filepath.Join("test-network-function", "test-network-function.test"),
+		"path to the test binary",
+	)
+	run.Flags().StringVar(
+		&Kubeconfig, "kubeconfig", "",
+		"kubeconfig file of the cluster under test, the one KUBECONFIG points to when omitted",
+	)
+	run.Flags().StringVar(
+		&KubeconfigContext, "context", "",
+		"kubeconfig context of the cluster under test, the current one when omitted",
+	)
+	return run
+}
@@ -0,0 +1,35 @@
+package version
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	// GitCommit is the latest commit in the current git branch, set at build time.
+	GitCommit string
+	// GitRelease is the list of tags (if any) applied to the latest commit in the current branch, set at build time.
+	GitRelease string
+	// GitPreviousRelease is the last release at the date of the latest commit in the current branch, set at build
+	// time.
+	GitPreviousRelease string
+
+	version = &cobra.Command{
+		Use:   "version",
+		Short: "Prints the version of the tnf tool.",
+		Run:   runVersion,
+	}
+)
+
+func runVersion(cmd *cobra.Command, args []string) {
+	release := GitRelease
+	if release == "" {
+		release = "Unreleased build post " + GitPreviousRelease
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Version: %s ( %s )\n", release, GitCommit)
+}
+
+func NewCommand() *cobra.Command {
+	return version
+}
@@ -60,7 +60,8 @@ func buildAnnotationName(annotationName string) string {
 	return buildLabelName(tnfLabelPrefix, annotationName)
 }
 
-func buildLabelQuery(label configsections.Label) string {
+// BuildLabelQuery returns the "oc -l" selector matching a label, ignoring its value when it is empty.
+func BuildLabelQuery(label configsections.Label) string {
 	fullLabelName := buildLabelName(label.Prefix, label.Name)
 	if label.Value != anyLabelValue {
 		return fmt.Sprintf("%s=%s", fullLabelName, label.Value)
@@ -151,6 +152,22 @@ func buildContainersFromPodResource(pr *PodResource) (containers []configsection
 			log.Warnf("error encountered getting multus IPs: %s", err)
 			err = nil
 		}
+		container.MultusNetworks, err = pr.getPodNetworks()
+		if err != nil {
+			log.Warnf("error encountered getting multus networks: %s", err)
+		}
+		container.Shell, err = pr.getContainerSetting(namespacedShellKey, containerResource.Name)
+		if err != nil {
+			log.Warnf("error encountered getting the shell: %s", err)
+		}
+		container.Prompt, err = pr.getContainerSetting(namespacedPromptKey, containerResource.Name)
+		if err != nil {
+			log.Warnf("error encountered getting the prompt: %s", err)
+		}
+		container.InitScript, err = pr.getContainerSetting(namespacedInitScriptKey, containerResource.Name)
+		if err != nil {
+			log.Warnf("error encountered getting the initialization script: %s", err)
+		}
 
 		containers = append(containers, container)
 	}
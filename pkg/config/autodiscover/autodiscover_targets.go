@@ -18,12 +18,14 @@ package autodiscover
 
 import (
 	"encoding/json"
+	"fmt"
 	"strings"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/test-network-function/test-network-function/pkg/config/configsections"
 	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/configmaps"
 	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/nodenames"
 	"github.com/test-network-function/test-network-function/pkg/tnf/interactive"
 	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
@@ -45,28 +47,34 @@ var (
 )
 
 // FindTestTarget finds test targets from the current state of the cluster,
-// using labels and annotations, and add them to the `configsections.TestTarget` passed in.
+// using labels, label selectors and annotations, and add them to the `configsections.TestTarget` passed in.
 //nolint:funlen
-func FindTestTarget(labels []configsections.Label, target *configsections.TestTarget, namespaces []string) {
+func FindTestTarget(labels []configsections.Label, selectors []string, target *configsections.TestTarget, namespaces []string) {
 	ns := make(map[string]bool)
 	for _, n := range namespaces {
 		ns[n] = true
 	}
+	found := make(map[string]bool)
 	for _, l := range labels {
 		pods, err := GetPodsByLabel(l)
 		if err == nil {
-			for _, pod := range pods.Items {
-				if ns[pod.Metadata.Namespace] {
-					target.PodsUnderTest = append(target.PodsUnderTest, buildPodUnderTest(pod))
-					target.ContainerConfigList = append(target.ContainerConfigList, buildContainersFromPodResource(pod)...)
-				} else {
-					target.NonValidPods = append(target.NonValidPods, buildPodUnderTest(pod))
-				}
-			}
+			addTargetPods(pods, ns, found, target)
 		} else {
 			log.Warnf("failed to query by label: %v %v", l, err)
 		}
 	}
+	for _, selector := range selectors {
+		if _, err := parseLabelSelector(selector); err != nil {
+			log.Errorf("skipping the target pod selector: %v", err)
+			continue
+		}
+		pods, err := GetPodsBySelector(selector)
+		if err == nil {
+			addTargetPods(pods, ns, found, target)
+		} else {
+			log.Warnf("failed to query by selector: %s %v", selector, err)
+		}
+	}
 	// Containers to exclude from connectivity tests are optional
 	identifiers, err := getContainerIdentifiersByLabel(configsections.Label{Prefix: tnfLabelPrefix, Name: skipConnectivityTestsLabel, Value: anyLabelValue})
 	for _, id := range identifiers {
@@ -87,8 +95,15 @@ func FindTestTarget(labels []configsections.Label, target *configsections.TestTa
 			target.Operators = append(target.Operators, buildOperatorFromCSVResource(&csv))
 		}
 	}
+	addOperatorsBySubscription(ns, target)
 	dps := FindTestDeploymentsByLabel(labels, target)
+	dps = append(dps, FindTestDeploymentsBySelector(selectors)...)
+	foundDeployments := make(map[string]bool)
 	for _, dp := range dps {
+		if foundDeployments[dp.Namespace+"/"+dp.Name] {
+			continue
+		}
+		foundDeployments[dp.Namespace+"/"+dp.Name] = true
 		if ns[dp.Namespace] {
 			target.DeploymentsUnderTest = append(target.DeploymentsUnderTest, dp)
 		}
@@ -96,6 +111,42 @@ func FindTestTarget(labels []configsections.Label, target *configsections.TestTa
 	target.Nodes = GetNodesList()
 }
 
+// addTargetPods adds the pods of the namespaces under test, and their containers, to the target, skipping the pods
+// already found by another label or selector.  The pods of other namespaces are recorded as non valid pods.
+func addTargetPods(pods *PodList, namespaces, found map[string]bool, target *configsections.TestTarget) {
+	for _, pod := range pods.Items {
+		key := pod.Metadata.Namespace + "/" + pod.Metadata.Name
+		if found[key] {
+			continue
+		}
+		found[key] = true
+		if namespaces[pod.Metadata.Namespace] {
+			target.PodsUnderTest = append(target.PodsUnderTest, buildPodUnderTest(pod))
+			target.ContainerConfigList = append(target.ContainerConfigList, buildContainersFromPodResource(pod)...)
+		} else {
+			target.NonValidPods = append(target.NonValidPods, buildPodUnderTest(pod))
+		}
+	}
+}
+
+// FindConfigMaps returns the ConfigMaps of a namespace, indexed by name.
+func FindConfigMaps(namespace string) (map[string]configmaps.ConfigMap, error) {
+	context := interactive.GetContext(expectersVerboseModeEnabled)
+	tester := configmaps.NewConfigMaps(DefaultTimeout, namespace)
+	test, err := tnf.NewTest(context.GetExpecter(), tester, []reel.Handler{tester}, context.GetErrorChannel())
+	if err != nil {
+		return nil, err
+	}
+	result, err := test.Run()
+	if err != nil {
+		return nil, err
+	}
+	if result != tnf.SUCCESS {
+		return nil, fmt.Errorf("failed to get the ConfigMaps of namespace %s", namespace)
+	}
+	return tester.GetConfigMaps(), nil
+}
+
 // GetNodesList Function that return a list of node and what is the type of them.
 func GetNodesList() (nodes map[string]configsections.Node) {
 	nodes = make(map[string]configsections.Node)
@@ -161,6 +212,25 @@ func FindTestDeploymentsByLabel(targetLabels []configsections.Label, target *con
 	return deployments
 }
 
+// FindTestDeploymentsBySelector returns the deployments whose pods match any of the label selectors.
+func FindTestDeploymentsBySelector(selectors []string) (deployments []configsections.Deployment) {
+	for _, selector := range selectors {
+		deploymentResourceList, err := GetTargetDeploymentsBySelector(selector)
+		if err != nil {
+			log.Error("Unable to get deployment list  Error: ", err)
+			continue
+		}
+		for _, deploymentResource := range deploymentResourceList.Items {
+			deployments = append(deployments, configsections.Deployment{
+				Name:      deploymentResource.GetName(),
+				Namespace: deploymentResource.GetNamespace(),
+				Replicas:  deploymentResource.GetReplicas(),
+			})
+		}
+	}
+	return deployments
+}
+
 // FindTestDeploymentsByLabelByNamespace uses the containers' namespace to get its parent deployment. Filters out non CNF test deployments,
 // currently partner and fs_diff ones.
 func FindTestDeploymentsByLabelByNamespace(targetLabels []configsections.Label, target *configsections.TestTarget, namespace string) (deployments []configsections.Deployment) {
@@ -56,7 +56,7 @@ func TestBuildLabelQuery(t *testing.T) {
 	}
 
 	for _, tc := range testCases {
-		assert.Equal(t, tc.expectedOutput, buildLabelQuery(tc.testLabel))
+		assert.Equal(t, tc.expectedOutput, BuildLabelQuery(tc.testLabel))
 	}
 }
 
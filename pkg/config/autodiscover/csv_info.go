@@ -70,7 +70,7 @@ func (csv *CSVResource) annotationUnmarshalError(annotationKey string, err error
 // GetCSVsByLabelByNamespace will return all CSVs with a given label value. If `labelValue` is an empty string, all CSVs with that
 // label will be returned, regardless of the labels value.
 func GetCSVsByLabelByNamespace(labelName, labelValue, namespace string) (*CSVList, error) {
-	out := executeOcGetCommand(resourceTypeCSV, buildLabelQuery(configsections.Label{Prefix: tnfLabelPrefix, Name: labelName, Value: labelValue}), namespace)
+	out := executeOcGetCommand(resourceTypeCSV, BuildLabelQuery(configsections.Label{Prefix: tnfLabelPrefix, Name: labelName, Value: labelValue}), namespace)
 
 	log.Debug("JSON output for all pods labeled with: ", labelName)
 	log.Debug("Command: ", out)
@@ -87,7 +87,7 @@ func GetCSVsByLabelByNamespace(labelName, labelValue, namespace string) (*CSVLis
 // GetCSVsByLabel will return all CSVs with a given label value. If `labelValue` is an empty string, all CSVs with that
 // label will be returned, regardless of the labels value.
 func GetCSVsByLabel(labelName, labelValue string) (*CSVList, error) {
-	out := executeOcGetAllCommand(resourceTypeCSV, buildLabelQuery(configsections.Label{Prefix: tnfLabelPrefix, Name: labelName, Value: labelValue}))
+	out := executeOcGetAllCommand(resourceTypeCSV, BuildLabelQuery(configsections.Label{Prefix: tnfLabelPrefix, Name: labelName, Value: labelValue}))
 
 	log.Debug("JSON output for all pods labeled with: ", labelName)
 	log.Debug("Command: ", out)
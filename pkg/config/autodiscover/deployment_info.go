@@ -57,6 +57,11 @@ type DeploymentResource struct {
 
 	Spec struct {
 		Replicas int `json:"replicas"`
+		Template struct {
+			Metadata struct {
+				Labels map[string]string `json:"labels"`
+			} `json:"metadata"`
+		} `json:"template"`
 	}
 }
 
@@ -130,3 +135,28 @@ func GetTargetDeploymentsByLabel(targetLabel configsections.Label) (*DeploymentL
 
 	return &deploymentList, nil
 }
+
+// GetTargetDeploymentsBySelector will return all deployments whose pods match a label selector.
+func GetTargetDeploymentsBySelector(selector string) (*DeploymentList, error) {
+	podSelector, err := parseLabelSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	ocCmd := fmt.Sprintf("oc get %s -A -o json", resourceTypeDeployment)
+
+	out := execCommandOutput(ocCmd)
+
+	var allDeployments DeploymentList
+	err = jsonUnmarshal([]byte(out), &allDeployments)
+	if err != nil {
+		return nil, err
+	}
+
+	var deploymentList DeploymentList
+	for i := range allDeployments.Items {
+		if podSelector.matches(allDeployments.Items[i].Spec.Template.Metadata.Labels) {
+			deploymentList.Items = append(deploymentList.Items, allDeployments.Items[i])
+		}
+	}
+	return &deploymentList, nil
+}
@@ -135,3 +135,28 @@ func TestGetTargetDeploymentByNamespace(t *testing.T) {
 		}
 	}
 }
+
+func TestGetTargetDeploymentsBySelector(t *testing.T) {
+	origExecFunc := execCommandOutput
+	defer func() {
+		execCommandOutput = origExecFunc
+	}()
+	execCommandOutput = func(command string) string {
+		assert.Equal(t, "oc get deployment -A -o json", command)
+		return `{"items": [
+			{"metadata": {"name": "cnf1", "namespace": "tnf"}, "spec": {"template": {"metadata": {"labels": {"app": "cnf", "tier": "front"}}}}},
+			{"metadata": {"name": "cnf2", "namespace": "tnf"}, "spec": {"template": {"metadata": {"labels": {"app": "cnf", "tier": "db"}}}}},
+			{"metadata": {"name": "other", "namespace": "tnf"}, "spec": {"template": {"metadata": {"labels": {"app": "other"}}}}}
+		]}`
+	}
+
+	list, err := GetTargetDeploymentsBySelector("app=cnf,tier notin (db)")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(list.Items))
+	assert.Equal(t, "cnf1", list.Items[0].GetName())
+
+	_, err = GetTargetDeploymentsBySelector("app=")
+	assert.Nil(t, err)
+	_, err = GetTargetDeploymentsBySelector("tier in front")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,111 @@
+// Copyright (C) 2020-2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package autodiscover
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/test-network-function/test-network-function/pkg/config/configsections"
+)
+
+const (
+	resourceTypeNamespace = "namespaces"
+	// namespaceGlobChars are the characters making the name of a target namespace a glob pattern.
+	namespaceGlobChars = "*?["
+)
+
+// NamespaceList holds the data from an `oc get namespaces -o json` command
+type NamespaceList struct {
+	Items []struct {
+		Metadata struct {
+			Name   string            `json:"name"`
+			Labels map[string]string `json:"labels"`
+		} `json:"metadata"`
+	} `json:"items"`
+}
+
+// isNamespaceFilter checks whether a target namespace is to be matched against the namespaces of the cluster rather
+// than taken as is.
+func isNamespaceFilter(namespace configsections.Namespace) bool {
+	return namespace.Selector != "" || strings.ContainsAny(namespace.Name, namespaceGlobChars)
+}
+
+// getAllNamespaces returns the namespaces of the cluster.
+func getAllNamespaces() (*NamespaceList, error) {
+	out := execCommandOutput(fmt.Sprintf("oc get %s -o json", resourceTypeNamespace))
+	var namespaces NamespaceList
+	if err := jsonUnmarshal([]byte(out), &namespaces); err != nil {
+		return nil, err
+	}
+	return &namespaces, nil
+}
+
+// ExpandNamespaces resolves the target namespaces into the names of the namespaces under test.  A target namespace
+// whose name is a glob pattern, e.g. acme-*, or which sets a label selector, e.g. vendor=acme, stands for the
+// namespaces of the cluster matching both, and any other target namespace for itself.  The names are returned once,
+// in the order of the target namespaces.
+func ExpandNamespaces(targets []configsections.Namespace) ([]string, error) {
+	var names []string
+	found := map[string]bool{}
+	addNamespace := func(name string) {
+		if !found[name] {
+			found[name] = true
+			names = append(names, name)
+		}
+	}
+	var clusterNamespaces *NamespaceList
+	for _, target := range targets {
+		if !isNamespaceFilter(target) {
+			addNamespace(target.Name)
+			continue
+		}
+		var selector labelSelector
+		var err error
+		if target.Selector != "" {
+			if selector, err = parseLabelSelector(target.Selector); err != nil {
+				return nil, err
+			}
+		}
+		if clusterNamespaces == nil {
+			if clusterNamespaces, err = getAllNamespaces(); err != nil {
+				return nil, err
+			}
+		}
+		matched := 0
+		for i := range clusterNamespaces.Items {
+			namespace := &clusterNamespaces.Items[i].Metadata
+			if target.Name != "" {
+				if ok, err := path.Match(target.Name, namespace.Name); err != nil {
+					return nil, fmt.Errorf("invalid target namespace pattern %q: %w", target.Name, err)
+				} else if !ok {
+					continue
+				}
+			}
+			if selector.matches(namespace.Labels) {
+				addNamespace(namespace.Name)
+				matched++
+			}
+		}
+		if matched == 0 {
+			log.Warnf("No namespace matches the target namespace name %q and selector %q", target.Name, target.Selector)
+		}
+	}
+	return names, nil
+}
@@ -0,0 +1,70 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package autodiscover
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/config/configsections"
+)
+
+func TestExpandNamespaces(t *testing.T) {
+	origExecFunc := execCommandOutput
+	defer func() {
+		execCommandOutput = origExecFunc
+	}()
+	calls := 0
+	execCommandOutput = func(command string) string {
+		calls++
+		assert.Equal(t, "oc get namespaces -o json", command)
+		return `{"items": [
+			{"metadata": {"name": "acme-db", "labels": {"vendor": "acme", "tier": "db"}}},
+			{"metadata": {"name": "acme-front", "labels": {"vendor": "acme"}}},
+			{"metadata": {"name": "tnf", "labels": {"vendor": "tnf"}}},
+			{"metadata": {"name": "other"}}
+		]}`
+	}
+
+	testCases := []struct {
+		targets       []configsections.Namespace
+		expected      []string
+		expectedCalls int
+		expectedError bool
+	}{
+		{targets: []configsections.Namespace{{Name: "tnf"}, {Name: "missing"}}, expected: []string{"tnf", "missing"}},
+		{targets: []configsections.Namespace{{Name: "acme-*"}}, expected: []string{"acme-db", "acme-front"}, expectedCalls: 1},
+		{targets: []configsections.Namespace{{Selector: "vendor=acme,tier!=db"}, {Selector: "vendor"}},
+			expected: []string{"acme-front", "acme-db", "tnf"}, expectedCalls: 1},
+		{targets: []configsections.Namespace{{Name: "acme-*", Selector: "tier=db"}, {Name: "acme-db"}},
+			expected: []string{"acme-db"}, expectedCalls: 1},
+		{targets: []configsections.Namespace{{Name: "none-*"}}, expected: nil, expectedCalls: 1},
+		{targets: []configsections.Namespace{{Selector: "vendor in acme"}}, expectedError: true},
+		{targets: []configsections.Namespace{{Name: "acme-["}}, expectedError: true, expectedCalls: 1},
+	}
+	for _, tc := range testCases {
+		calls = 0
+		names, err := ExpandNamespaces(tc.targets)
+		if tc.expectedError {
+			assert.NotNil(t, err, tc.targets)
+		} else {
+			assert.Nil(t, err, tc.targets)
+			assert.Equal(t, tc.expected, names, tc.targets)
+		}
+		assert.Equal(t, tc.expectedCalls, calls, tc.targets)
+	}
+}
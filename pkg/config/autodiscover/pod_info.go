@@ -18,6 +18,7 @@ package autodiscover
 
 import (
 	"fmt"
+	"sort"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/test-network-function/test-network-function/pkg/config/configsections"
@@ -26,6 +27,9 @@ import (
 const (
 	cnfDefaultNetworkInterfaceKey = "defaultnetworkinterface"
 	cnfIPsKey                     = "multusips"
+	cnfShellKey                   = "shell"
+	cnfPromptKey                  = "prompt"
+	cnfInitScriptKey              = "init_script"
 	cniNetworksStatusKey          = "k8s.v1.cni.cncf.io/networks-status"
 	resourceTypePods              = "pods"
 	podPhaseRunning               = "Running"
@@ -34,6 +38,9 @@ const (
 var (
 	namespacedDefaultNetworkInterfaceKey = buildAnnotationName(cnfDefaultNetworkInterfaceKey)
 	namespacedIPsKey                     = buildAnnotationName(cnfIPsKey)
+	namespacedShellKey                   = buildAnnotationName(cnfShellKey)
+	namespacedPromptKey                  = buildAnnotationName(cnfPromptKey)
+	namespacedInitScriptKey              = buildAnnotationName(cnfInitScriptKey)
 )
 
 // PodList holds the data from an `oc get pods -o json` command
@@ -148,6 +155,73 @@ func (pr *PodResource) getPodIPs() (ips []string, err error) {
 	return
 }
 
+// getPodNetworks gets the IPs of a pod on each of its network attachments, indexed by the "<namespace>/<name>" of the
+// attachment, from the CNI annotation "k8s.v1.cni.cncf.io/networks-status".  The default network is skipped.
+func (pr *PodResource) getPodNetworks() (networks map[string][]string, err error) {
+	val, present := pr.Metadata.Annotations[cniNetworksStatusKey]
+	if !present {
+		return nil, nil
+	}
+	var cniInfo []cniNetworkInterface
+	err = jsonUnmarshal([]byte(val), &cniInfo)
+	if err != nil {
+		return nil, pr.annotationUnmarshalError(cniNetworksStatusKey, err)
+	}
+	for _, cniInterface := range cniInfo {
+		if cniInterface.Default || cniInterface.Name == "" || len(cniInterface.IPs) == 0 {
+			continue
+		}
+		if networks == nil {
+			networks = map[string][]string{}
+		}
+		networks[cniInterface.Name] = append(networks[cniInterface.Name], cniInterface.IPs...)
+	}
+	return networks, nil
+}
+
+// GetNetworkAttachments returns the sorted names of the network attachments of a pod, as "<namespace>/<name>", from
+// the CNI annotation "k8s.v1.cni.cncf.io/networks-status".  The default network is left out, as are the attachments of
+// pods without the annotation.
+func (pr *PodResource) GetNetworkAttachments() (attachments []string, err error) {
+	val, present := pr.Metadata.Annotations[cniNetworksStatusKey]
+	if !present {
+		return nil, nil
+	}
+	var cniInfo []cniNetworkInterface
+	err = jsonUnmarshal([]byte(val), &cniInfo)
+	if err != nil {
+		return nil, pr.annotationUnmarshalError(cniNetworksStatusKey, err)
+	}
+	found := map[string]bool{}
+	for _, cniInterface := range cniInfo {
+		if cniInterface.Default || cniInterface.Name == "" || found[cniInterface.Name] {
+			continue
+		}
+		found[cniInterface.Name] = true
+		attachments = append(attachments, cniInterface.Name)
+	}
+	sort.Strings(attachments)
+	return attachments, nil
+}
+
+// getContainerSetting returns a setting of the session to a container of the pod from an annotation, either a
+// JSON-encoded string applying to all the containers of the pod, or a JSON-encoded object mapping the names of the
+// containers to their setting.  It is empty when the annotation is not set, or does not name the container.
+func (pr *PodResource) getContainerSetting(annotationKey, containerName string) (setting string, err error) {
+	val, present := pr.Metadata.Annotations[annotationKey]
+	if !present {
+		return "", nil
+	}
+	if err = jsonUnmarshal([]byte(val), &setting); err == nil {
+		return setting, nil
+	}
+	var settings map[string]string
+	if err = jsonUnmarshal([]byte(val), &settings); err != nil {
+		return "", pr.annotationUnmarshalError(annotationKey, err)
+	}
+	return settings[containerName], nil
+}
+
 func (pr *PodResource) annotationUnmarshalError(annotationKey string, err error) error {
 	return fmt.Errorf("error (%s) attempting to unmarshal value of annotation '%s' on pod '%s/%s'",
 		err, annotationKey, pr.Metadata.Namespace, pr.Metadata.Name)
@@ -157,7 +231,7 @@ func (pr *PodResource) annotationUnmarshalError(annotationKey string, err error)
 // If `labelValue` is an empty string, all pods with that
 // label will be returned, regardless of the labels value.
 func GetPodsByLabelByNamespace(label configsections.Label, namespace string) (*PodList, error) {
-	out := executeOcGetCommand(resourceTypePods, buildLabelQuery(label), namespace)
+	out := executeOcGetCommand(resourceTypePods, BuildLabelQuery(label), namespace)
 
 	log.Debug("JSON output for all pods labeled with: ", label)
 	log.Debug("Command: ", out)
@@ -179,13 +253,18 @@ func GetPodsByLabelByNamespace(label configsections.Label, namespace string) (*P
 	return &podList, nil
 }
 
-// GetPodsByLabelByNamespace will return all pods with a given label value.
+// GetPodsByLabel will return all pods with a given label value.
 // If `labelValue` is an empty string, all pods with that
 // label will be returned, regardless of the labels value.
 func GetPodsByLabel(label configsections.Label) (*PodList, error) {
-	out := executeOcGetAllCommand(resourceTypePods, buildLabelQuery(label))
+	return GetPodsBySelector(BuildLabelQuery(label))
+}
 
-	log.Debug("JSON output for all pods labeled with: ", label)
+// GetPodsBySelector will return all pods matching a label selector, such as "app in (cnf1,cnf2),tier!=db".
+func GetPodsBySelector(selector string) (*PodList, error) {
+	out := executeOcGetAllCommand(resourceTypePods, fmt.Sprintf("'%s'", selector))
+
+	log.Debug("JSON output for all pods selected by: ", selector)
 	log.Debug("Command: ", out)
 
 	var podList PodList
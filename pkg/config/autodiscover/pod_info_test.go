@@ -59,3 +59,72 @@ func TestPodGetAnnotationValue(t *testing.T) {
 	assert.Equal(t, "eth0", val)
 	assert.Nil(t, err)
 }
+
+func TestPodGetNetworks(t *testing.T) {
+	pod := loadPodResource(testSubjectFilePath)
+	networks, err := pod.getPodNetworks()
+	assert.Nil(t, err)
+	assert.Nil(t, networks)
+
+	pod.Metadata.Annotations[cniNetworksStatusKey] = `[
+		{"name": "ovn-kubernetes", "interface": "eth0", "ips": ["10.128.0.12"], "default": true},
+		{"name": "tnf/macvlan-bridge", "interface": "net1", "ips": ["192.168.1.10", "fd00::10"]},
+		{"name": "tnf/sriov-net", "interface": "net2", "ips": ["192.168.2.10"]},
+		{"name": "tnf/no-ipam", "interface": "net3"}
+	]`
+	networks, err = pod.getPodNetworks()
+	assert.Nil(t, err)
+	assert.Equal(t, map[string][]string{
+		"tnf/macvlan-bridge": {"192.168.1.10", "fd00::10"},
+		"tnf/sriov-net":      {"192.168.2.10"},
+	}, networks)
+
+	pod.Metadata.Annotations[cniNetworksStatusKey] = "not json"
+	_, err = pod.getPodNetworks()
+	assert.NotNil(t, err)
+}
+
+func TestPodGetNetworkAttachments(t *testing.T) {
+	pod := loadPodResource(testSubjectFilePath)
+	attachments, err := pod.GetNetworkAttachments()
+	assert.Nil(t, err)
+	assert.Nil(t, attachments)
+
+	pod.Metadata.Annotations[cniNetworksStatusKey] = `[
+		{"name": "ovn-kubernetes", "interface": "eth0", "ips": ["10.128.0.12"], "default": true},
+		{"name": "tnf/sriov-net", "interface": "net2", "ips": ["192.168.2.10"]},
+		{"name": "tnf/no-ipam", "interface": "net3"},
+		{"name": "tnf/sriov-net", "interface": "net4", "ips": ["192.168.2.11"]}
+	]`
+	attachments, err = pod.GetNetworkAttachments()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"tnf/no-ipam", "tnf/sriov-net"}, attachments)
+
+	pod.Metadata.Annotations[cniNetworksStatusKey] = "not json"
+	_, err = pod.GetNetworkAttachments()
+	assert.NotNil(t, err)
+}
+
+func TestPodGetContainerSetting(t *testing.T) {
+	pod := loadPodResource(testSubjectFilePath)
+	shell, err := pod.getContainerSetting(namespacedShellKey, "test")
+	assert.Nil(t, err)
+	assert.Equal(t, "", shell)
+
+	pod.Metadata.Annotations[namespacedShellKey] = `"/bin/bash"`
+	shell, err = pod.getContainerSetting(namespacedShellKey, "test")
+	assert.Nil(t, err)
+	assert.Equal(t, "/bin/bash", shell)
+
+	pod.Metadata.Annotations[namespacedPromptKey] = `{"test": "\\$ $", "sidecar": "# $"}`
+	prompt, err := pod.getContainerSetting(namespacedPromptKey, "test")
+	assert.Nil(t, err)
+	assert.Equal(t, `\$ $`, prompt)
+	prompt, err = pod.getContainerSetting(namespacedPromptKey, "other")
+	assert.Nil(t, err)
+	assert.Equal(t, "", prompt)
+
+	pod.Metadata.Annotations[namespacedShellKey] = "/bin/bash"
+	_, err = pod.getContainerSetting(namespacedShellKey, "test")
+	assert.NotNil(t, err)
+}
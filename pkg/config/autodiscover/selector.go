@@ -0,0 +1,137 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package autodiscover
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	selectorOpExists    = "exists"
+	selectorOpNotExists = "!"
+	selectorOpEquals    = "="
+	selectorOpNotEquals = "!="
+	selectorOpIn        = "in"
+	selectorOpNotIn     = "notin"
+)
+
+var (
+	selectorExistsRegex   = regexp.MustCompile(`^(!?)\s*([\w./-]+)$`)
+	selectorEqualityRegex = regexp.MustCompile(`^([\w./-]+)\s*(==|=|!=)\s*([\w.-]*)$`)
+	selectorSetRegex      = regexp.MustCompile(`^([\w./-]+)\s+(in|notin)\s*\(([^()]*)\)$`)
+)
+
+// selectorRequirement is a single comma-separated term of a label selector, such as "tier in (front,back)".
+type selectorRequirement struct {
+	key      string
+	operator string
+	values   []string
+}
+
+// labelSelector is a parsed "oc -l" label selector, supporting both the equality-based and the set-based syntaxes.
+type labelSelector []selectorRequirement
+
+// parseLabelSelector parses a label selector such as "test-network-function.com/target=true,tier notin (db)".
+func parseLabelSelector(selector string) (labelSelector, error) {
+	var requirements labelSelector
+	for _, term := range splitSelectorTerms(selector) {
+		term = strings.TrimSpace(term)
+		if match := selectorSetRegex.FindStringSubmatch(term); match != nil {
+			var values []string
+			for _, value := range strings.Split(match[3], ",") {
+				if value = strings.TrimSpace(value); value != "" {
+					values = append(values, value)
+				}
+			}
+			requirements = append(requirements, selectorRequirement{key: match[1], operator: match[2], values: values})
+		} else if match := selectorEqualityRegex.FindStringSubmatch(term); match != nil {
+			operator := match[2]
+			if operator == "==" {
+				operator = selectorOpEquals
+			}
+			requirements = append(requirements, selectorRequirement{key: match[1], operator: operator, values: []string{match[3]}})
+		} else if match := selectorExistsRegex.FindStringSubmatch(term); match != nil {
+			operator := selectorOpExists
+			if match[1] != "" {
+				operator = selectorOpNotExists
+			}
+			requirements = append(requirements, selectorRequirement{key: match[2], operator: operator})
+		} else {
+			return nil, fmt.Errorf("invalid label selector %q: cannot parse %q", selector, term)
+		}
+	}
+	if len(requirements) == 0 {
+		return nil, fmt.Errorf("invalid label selector %q: it is empty", selector)
+	}
+	return requirements, nil
+}
+
+// splitSelectorTerms splits a label selector on the commas that are not part of a set of values.
+func splitSelectorTerms(selector string) (terms []string) {
+	depth, start := 0, 0
+	for i, c := range selector {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				terms = append(terms, selector[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(terms, selector[start:])
+}
+
+// matches returns true when the labels satisfy every requirement of the selector.
+func (s labelSelector) matches(labels map[string]string) bool {
+	for _, r := range s {
+		value, present := labels[r.key]
+		var ok bool
+		switch r.operator {
+		case selectorOpExists:
+			ok = present
+		case selectorOpNotExists:
+			ok = !present
+		case selectorOpEquals:
+			ok = present && value == r.values[0]
+		case selectorOpNotEquals:
+			ok = !present || value != r.values[0]
+		case selectorOpIn:
+			ok = present && containsString(r.values, value)
+		case selectorOpNotIn:
+			ok = !present || !containsString(r.values, value)
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
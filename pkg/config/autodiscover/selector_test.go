@@ -0,0 +1,85 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package autodiscover
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLabelSelector(t *testing.T) {
+	testCases := []struct {
+		selector     string
+		expected     labelSelector
+		expectedFail bool
+	}{
+		{
+			selector: "test-network-function.com/target=true",
+			expected: labelSelector{{key: "test-network-function.com/target", operator: "=", values: []string{"true"}}},
+		},
+		{
+			selector: "app==cnf, tier!=db",
+			expected: labelSelector{
+				{key: "app", operator: "=", values: []string{"cnf"}},
+				{key: "tier", operator: "!=", values: []string{"db"}},
+			},
+		},
+		{
+			selector: "tier in (front, back),env notin (dev),canary,!legacy",
+			expected: labelSelector{
+				{key: "tier", operator: "in", values: []string{"front", "back"}},
+				{key: "env", operator: "notin", values: []string{"dev"}},
+				{key: "canary", operator: "exists"},
+				{key: "legacy", operator: "!"},
+			},
+		},
+		{selector: "", expectedFail: true},
+		{selector: "app=cnf,", expectedFail: true},
+		{selector: "tier in front", expectedFail: true},
+		{selector: "app=cnf;rm -rf /", expectedFail: true},
+	}
+	for _, tc := range testCases {
+		selector, err := parseLabelSelector(tc.selector)
+		if tc.expectedFail {
+			assert.NotNil(t, err, tc.selector)
+			continue
+		}
+		assert.Nil(t, err, tc.selector)
+		assert.Equal(t, tc.expected, selector, tc.selector)
+	}
+}
+
+func TestLabelSelectorMatches(t *testing.T) {
+	labels := map[string]string{"app": "cnf", "tier": "front", "test-network-function.com/target": "true"}
+	testCases := map[string]bool{
+		"test-network-function.com/target=true": true,
+		"test-network-function.com/target":      true,
+		"app=cnf,tier=back":                     false,
+		"app!=other,tier in (front,back)":       true,
+		"tier notin (front)":                    false,
+		"env notin (dev),!env":                  true,
+		"env":                                   false,
+		"env!=dev":                              true,
+		"env in (dev)":                          false,
+	}
+	for selector, expected := range testCases {
+		parsed, err := parseLabelSelector(selector)
+		assert.Nil(t, err, selector)
+		assert.Equal(t, expected, parsed.matches(labels), selector)
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package autodiscover
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/test-network-function/test-network-function/pkg/config/configsections"
+)
+
+const (
+	// resourceTypeSubscription is qualified, as other operators define subscriptions too.
+	resourceTypeSubscription = "subscriptions.operators.coreos.com"
+)
+
+// SubscriptionList holds the data from an `oc get subscriptions -o json` command
+type SubscriptionList struct {
+	Items []SubscriptionResource `json:"items"`
+}
+
+// SubscriptionResource is a single OLM Subscription from an `oc get subscriptions -o json` command
+type SubscriptionResource struct {
+	Metadata struct {
+		Name        string            `json:"name"`
+		Namespace   string            `json:"namespace"`
+		Labels      map[string]string `json:"labels"`
+		Annotations map[string]string `json:"annotations"`
+	} `json:"metadata"`
+	Spec struct {
+		Name    string `json:"name"`
+		Channel string `json:"channel"`
+	} `json:"spec"`
+	Status struct {
+		// InstalledCSV is the CSV the Subscription installed last, which follows the upgrades of the operator.
+		InstalledCSV string `json:"installedCSV"`
+	} `json:"status"`
+}
+
+// GetSubscriptionsByLabel will return all OLM Subscriptions with a given label value. If `labelValue` is an empty
+// string, all Subscriptions with that label will be returned, regardless of the labels value.
+func GetSubscriptionsByLabel(labelName, labelValue string) (*SubscriptionList, error) {
+	out := executeOcGetAllCommand(resourceTypeSubscription, BuildLabelQuery(configsections.Label{Prefix: tnfLabelPrefix, Name: labelName, Value: labelValue}))
+
+	log.Debug("JSON output for all subscriptions labeled with: ", labelName)
+	log.Debug("Command: ", out)
+
+	var subscriptionList SubscriptionList
+	err := jsonUnmarshal([]byte(out), &subscriptionList)
+	if err != nil {
+		return nil, err
+	}
+	return &subscriptionList, nil
+}
+
+// GetSubscription returns an OLM Subscription by namespace and name.
+func GetSubscription(namespace, name string) (*SubscriptionResource, error) {
+	out := execCommandOutput(fmt.Sprintf("oc get %s -n %s %s -o json", resourceTypeSubscription, namespace, name))
+	var subscription SubscriptionResource
+	if err := jsonUnmarshal([]byte(out), &subscription); err != nil {
+		return nil, err
+	}
+	return &subscription, nil
+}
+
+// GetCSV returns a CSV by namespace and name.
+func GetCSV(namespace, name string) (*CSVResource, error) {
+	out := execCommandOutput(fmt.Sprintf("oc get %s -n %s %s -o json", resourceTypeCSV, namespace, name))
+	var csv CSVResource
+	if err := jsonUnmarshal([]byte(out), &csv); err != nil {
+		return nil, err
+	}
+	return &csv, nil
+}
+
+// buildOperatorFromSubscription builds the operator under test installed by an OLM Subscription, from the CSV it
+// installed last.  The operator tests are taken from the annotation of the Subscription if set, as OLM does not copy the
+// annotations added to a CSV to the CSV of the next version, and from the one of the CSV otherwise.
+func buildOperatorFromSubscription(subscription *SubscriptionResource) (configsections.Operator, error) {
+	namespace := subscription.Metadata.Namespace
+	if subscription.Status.InstalledCSV == "" {
+		return configsections.Operator{}, fmt.Errorf("the subscription %s/%s has not installed any CSV", namespace, subscription.Metadata.Name)
+	}
+	csv, err := GetCSV(namespace, subscription.Status.InstalledCSV)
+	if err != nil {
+		return configsections.Operator{}, err
+	}
+	op := buildOperatorFromCSVResource(csv)
+	op.SubscriptionName = subscription.Metadata.Name
+	if value, ok := subscription.Metadata.Annotations[operatorTestsAnnotationName]; ok {
+		var tests []string
+		if err := jsonUnmarshal([]byte(value), &tests); err != nil {
+			log.Warnf("unable to extract tests from the annotation of the subscription %s/%s: %v", namespace, subscription.Metadata.Name, err)
+		} else {
+			op.Tests = tests
+		}
+	}
+	return op, nil
+}
+
+// ResolveOperatorSubscriptions resolves the operators under test configured by subscription name rather than by CSV
+// name into the CSVs their Subscriptions installed last.  The operators which cannot be resolved are left out.
+func ResolveOperatorSubscriptions(target *configsections.TestTarget) {
+	var operators []configsections.Operator
+	for _, op := range target.Operators {
+		if op.Name != "" || op.SubscriptionName == "" {
+			operators = append(operators, op)
+			continue
+		}
+		subscription, err := GetSubscription(op.Namespace, op.SubscriptionName)
+		if err != nil {
+			log.Errorf("unable to get the subscription %s/%s of an operator under test: %v", op.Namespace, op.SubscriptionName, err)
+			continue
+		}
+		resolved, err := buildOperatorFromSubscription(subscription)
+		if err != nil {
+			log.Errorf("unable to resolve the operator under test of the subscription %s/%s: %v", op.Namespace, op.SubscriptionName, err)
+			continue
+		}
+		if len(op.Tests) > 0 {
+			resolved.Tests = op.Tests
+		}
+		operators = append(operators, resolved)
+	}
+	target.Operators = operators
+}
+
+// addOperatorsBySubscription adds to the target the operators installed in the namespaces under test by the OLM
+// Subscriptions carrying the operator label, which, unlike the labels of a CSV, survive operator upgrades.  The
+// operators already under test are skipped.
+func addOperatorsBySubscription(namespaces map[string]bool, target *configsections.TestTarget) {
+	subscriptions, err := GetSubscriptionsByLabel(operatorLabelName, anyLabelValue)
+	if err != nil {
+		log.Warnf("an error (%s) occurred when looking for operator subscriptions by label", err)
+		return
+	}
+	found := map[string]bool{}
+	for _, op := range target.Operators {
+		found[op.Namespace+"/"+op.Name] = true
+	}
+	for i := range subscriptions.Items {
+		subscription := &subscriptions.Items[i]
+		if !namespaces[subscription.Metadata.Namespace] {
+			continue
+		}
+		op, err := buildOperatorFromSubscription(subscription)
+		if err != nil {
+			log.Warnf("skipping the operator subscription %s/%s: %v", subscription.Metadata.Namespace, subscription.Metadata.Name, err)
+			continue
+		}
+		if found[op.Namespace+"/"+op.Name] {
+			continue
+		}
+		found[op.Namespace+"/"+op.Name] = true
+		target.Operators = append(target.Operators, op)
+	}
+}
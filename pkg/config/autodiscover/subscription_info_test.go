@@ -0,0 +1,101 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package autodiscover
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/config/configsections"
+)
+
+const (
+	testSubscriptions = `{"items": [
+		{"metadata": {"name": "etcd-sub", "namespace": "tnf", "labels": {"test-network-function.com/operator": "target"},
+			"annotations": {"test-network-function.com/operator_tests": "[\"OPERATOR_STATUS\"]"}},
+			"spec": {"name": "etcd", "channel": "stable"}, "status": {"installedCSV": "etcdoperator.v0.9.4"}},
+		{"metadata": {"name": "pending-sub", "namespace": "tnf"}, "spec": {"name": "pending"}, "status": {}},
+		{"metadata": {"name": "other-sub", "namespace": "other"}, "status": {"installedCSV": "other.v1.0.0"}}
+	]}`
+	testCSVTemplate = `{"metadata": {"name": "%s", "namespace": "%s",
+		"annotations": {"test-network-function.com/operator_tests": "[\"OPERATOR_STATUS\", \"ANOTHER_TEST\"]"}}}`
+)
+
+func mockSubscriptionCommands(t *testing.T) func() {
+	origExecFunc := execCommandOutput
+	origGetAllFunc := executeOcGetAllCommand
+	execCommandOutput = func(command string) string {
+		fields := strings.Fields(command)
+		switch fields[2] {
+		case resourceTypeSubscription:
+			assert.Equal(t, []string{"oc", "get", resourceTypeSubscription, "-n", "tnf", "etcd-sub", "-o", "json"}, fields)
+			return `{"metadata": {"name": "etcd-sub", "namespace": "tnf"}, "status": {"installedCSV": "etcdoperator.v0.9.4"}}`
+		case resourceTypeCSV:
+			return fmt.Sprintf(testCSVTemplate, fields[5], fields[4])
+		}
+		return ""
+	}
+	executeOcGetAllCommand = func(resourceType, labelQuery string) string {
+		assert.Equal(t, resourceTypeSubscription, resourceType)
+		assert.Equal(t, "test-network-function.com/operator", labelQuery)
+		return testSubscriptions
+	}
+	return func() {
+		execCommandOutput = origExecFunc
+		executeOcGetAllCommand = origGetAllFunc
+	}
+}
+
+func TestAddOperatorsBySubscription(t *testing.T) {
+	defer mockSubscriptionCommands(t)()
+	target := &configsections.TestTarget{Operators: []configsections.Operator{{Name: "configured.v1.0.0", Namespace: "tnf"}}}
+	addOperatorsBySubscription(map[string]bool{"tnf": true}, target)
+	assert.Equal(t, []configsections.Operator{
+		{Name: "configured.v1.0.0", Namespace: "tnf"},
+		{Name: "etcdoperator.v0.9.4", Namespace: "tnf", SubscriptionName: "etcd-sub", Tests: []string{"OPERATOR_STATUS"}},
+	}, target.Operators)
+
+	// The operators found already are not added twice.
+	addOperatorsBySubscription(map[string]bool{"tnf": true}, target)
+	assert.Equal(t, 2, len(target.Operators))
+}
+
+func TestResolveOperatorSubscriptions(t *testing.T) {
+	defer mockSubscriptionCommands(t)()
+	target := &configsections.TestTarget{Operators: []configsections.Operator{
+		{Name: "configured.v1.0.0", Namespace: "tnf", SubscriptionName: "configured-sub"},
+		{Namespace: "tnf", SubscriptionName: "etcd-sub"},
+		{Namespace: "tnf", SubscriptionName: "etcd-sub", Tests: []string{"ANOTHER_TEST"}},
+	}}
+	ResolveOperatorSubscriptions(target)
+	assert.Equal(t, []configsections.Operator{
+		{Name: "configured.v1.0.0", Namespace: "tnf", SubscriptionName: "configured-sub"},
+		{Name: "etcdoperator.v0.9.4", Namespace: "tnf", SubscriptionName: "etcd-sub", Tests: []string{"OPERATOR_STATUS", "ANOTHER_TEST"}},
+		{Name: "etcdoperator.v0.9.4", Namespace: "tnf", SubscriptionName: "etcd-sub", Tests: []string{"ANOTHER_TEST"}},
+	}, target.Operators)
+}
+
+func TestBuildOperatorFromSubscription(t *testing.T) {
+	defer mockSubscriptionCommands(t)()
+	var subscription SubscriptionResource
+	subscription.Metadata.Name = "pending-sub"
+	subscription.Metadata.Namespace = "tnf"
+	_, err := buildOperatorFromSubscription(&subscription)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,74 @@
+// Copyright (C) 2020-2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package autodiscover
+
+import (
+	"fmt"
+	"sort"
+)
+
+const (
+	resourceTypeNodes = "nodes"
+)
+
+// NodeList holds the data from an `oc get nodes -o json` command
+type NodeList struct {
+	Items []struct {
+		Metadata struct {
+			Name   string            `json:"name"`
+			Labels map[string]string `json:"labels"`
+		} `json:"metadata"`
+	} `json:"items"`
+}
+
+// GetNodeNamesBySelector returns the sorted names of the nodes matching a label selector, such as
+// "node-role.kubernetes.io/worker-cnf=".
+func GetNodeNamesBySelector(selector string) ([]string, error) {
+	nodeSelector, err := parseLabelSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	out := execCommandOutput(fmt.Sprintf("oc get %s -o json", resourceTypeNodes))
+	var nodes NodeList
+	if err = jsonUnmarshal([]byte(out), &nodes); err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, node := range nodes.Items {
+		if nodeSelector.matches(node.Metadata.Labels) {
+			names = append(names, node.Metadata.Name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// GetWorkloadPods returns the pods of a namespace matching a label selector, leaving out the terminating ones.
+func GetWorkloadPods(selector, namespace string) ([]*PodResource, error) {
+	out := execCommandOutput(fmt.Sprintf(ocCommand, resourceTypePods, namespace, fmt.Sprintf("'%s'", selector)))
+	var podList PodList
+	if err := jsonUnmarshal([]byte(out), &podList); err != nil {
+		return nil, err
+	}
+	var pods []*PodResource
+	for _, pod := range podList.Items {
+		if pod.Metadata.DeletionTimestamp == "" {
+			pods = append(pods, pod)
+		}
+	}
+	return pods, nil
+}
@@ -0,0 +1,74 @@
+// Copyright (C) 2020-2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package autodiscover
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetNodeNamesBySelector(t *testing.T) {
+	origExecFunc := execCommandOutput
+	defer func() {
+		execCommandOutput = origExecFunc
+	}()
+	execCommandOutput = func(command string) string {
+		assert.Equal(t, "oc get nodes -o json", command)
+		return `{"items": [
+			{"metadata": {"name": "worker-1", "labels": {"node-role.kubernetes.io/worker": "", "node-role.kubernetes.io/worker-cnf": ""}}},
+			{"metadata": {"name": "worker-0", "labels": {"node-role.kubernetes.io/worker": "", "node-role.kubernetes.io/worker-cnf": ""}}},
+			{"metadata": {"name": "worker-2", "labels": {"node-role.kubernetes.io/worker": ""}}},
+			{"metadata": {"name": "master-0", "labels": {"node-role.kubernetes.io/master": ""}}}
+		]}`
+	}
+
+	names, err := GetNodeNamesBySelector("node-role.kubernetes.io/worker-cnf=")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"worker-0", "worker-1"}, names)
+
+	names, err = GetNodeNamesBySelector("node-role.kubernetes.io/worker,!node-role.kubernetes.io/worker-cnf")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"worker-2"}, names)
+
+	_, err = GetNodeNamesBySelector("role in (")
+	assert.NotNil(t, err)
+}
+
+func TestGetWorkloadPods(t *testing.T) {
+	origExecFunc := execCommandOutput
+	defer func() {
+		execCommandOutput = origExecFunc
+	}()
+	execCommandOutput = func(command string) string {
+		assert.Equal(t, "oc get pods -n tnf -o json -l 'app in (front)'", command)
+		return `{"items": [
+			{"metadata": {"name": "front-1", "namespace": "tnf"}, "spec": {"nodeName": "worker-0"}, "status": {"phase": "Running"}},
+			{"metadata": {"name": "front-2", "namespace": "tnf", "deletionTimestamp": "2021-11-02T10:00:00Z"},
+				"spec": {"nodeName": "worker-1"}, "status": {"phase": "Running"}},
+			{"metadata": {"name": "front-3", "namespace": "tnf"}, "status": {"phase": "Pending"}}
+		]}`
+	}
+
+	pods, err := GetWorkloadPods("app in (front)", "tnf")
+	assert.Nil(t, err)
+	if assert.Equal(t, 2, len(pods)) {
+		assert.Equal(t, "front-1", pods[0].Metadata.Name)
+		assert.Equal(t, "worker-0", pods[0].Spec.NodeName)
+		assert.Equal(t, "front-3", pods[1].Metadata.Name)
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright (C) 2020-2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/test-network-function/test-network-function/pkg/config/configsections"
+)
+
+const (
+	// cnfEnvironmentVariableKey selects the CNF under test when the config file declares several of them.
+	cnfEnvironmentVariableKey = "TNF_CNF"
+)
+
+// getCNFNames returns the names of the CNFs declared in a test configuration, failing when a name is repeated.
+func getCNFNames(config *configsections.TestConfiguration) ([]string, error) {
+	var names []string
+	declared := map[string]bool{}
+	for _, cnf := range config.CNFs {
+		if declared[cnf.Name] {
+			return nil, fmt.Errorf("the CNF %s is declared more than once", cnf.Name)
+		}
+		declared[cnf.Name] = true
+		names = append(names, cnf.Name)
+	}
+	return names, nil
+}
+
+// selectCNF replaces the targets of a test configuration declaring several CNFs with those of the named one.  The only
+// CNF of a configuration declaring one is selected when no name is given, and a configuration without CNFs is left
+// unchanged.
+func selectCNF(config *configsections.TestConfiguration, name string) error {
+	names, err := getCNFNames(config)
+	if err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		if name != "" {
+			return fmt.Errorf("%s is set to %s, but the config file declares no CNFs", cnfEnvironmentVariableKey, name)
+		}
+		return nil
+	}
+	if name == "" && len(names) == 1 {
+		name = names[0]
+	}
+	if name == "" {
+		return fmt.Errorf("the config file declares several CNFs, set %s to the one to test: %s", cnfEnvironmentVariableKey,
+			strings.Join(names, ", "))
+	}
+	for i := range config.CNFs {
+		cnf := &config.CNFs[i]
+		if cnf.Name != name {
+			continue
+		}
+		log.Infof("Testing the CNF %s", name)
+		config.TargetNameSpaces = cnf.TargetNameSpaces
+		config.TargetPodLabels = cnf.TargetPodLabels
+		config.TargetPodSelectors = cnf.TargetPodSelectors
+		config.TestTarget = cnf.TestTarget
+		config.ExpectedTopology = cnf.ExpectedTopology
+		config.CNFs = nil
+		return nil
+	}
+	return fmt.Errorf("%s is set to %s, but the config file only declares the CNFs %s", cnfEnvironmentVariableKey, name,
+		strings.Join(names, ", "))
+}
+
+// LoadCNFNames returns the names of the CNFs declared in config files, a base file and its overlays, each to be tested
+// by a separate run of the suite with TNF_CNF set to its name.  It is empty when the config files describe a single CNF.
+func LoadCNFNames(filePaths ...string) ([]string, error) {
+	var config configsections.TestConfiguration
+	if _, err := readConfigFiles(filePaths, &config); err != nil {
+		return nil, err
+	}
+	return getCNFNames(&config)
+}
+
+// LoadTestConfiguration loads config files, a base file and its overlays, as the suite would, selecting the targets of
+// the named CNF when the config files declare several of them.
+func LoadTestConfiguration(cnfName string, filePaths ...string) (*configsections.TestConfiguration, error) {
+	var config configsections.TestConfiguration
+	if _, err := readConfigFiles(filePaths, &config); err != nil {
+		return nil, err
+	}
+	if err := selectCNF(&config, cnfName); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
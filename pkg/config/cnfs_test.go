@@ -0,0 +1,115 @@
+// Copyright (C) 2020-2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/config/configsections"
+)
+
+const multipleCNFsConfig = `
+targetNameSpaces:
+  - name: default
+cnfs:
+  - name: cnf1
+    targetNameSpaces:
+      - name: tnf1
+    targetPodSelectors:
+      - app=cnf1
+    testTarget:
+      operators:
+        - name: etcd
+          namespace: tnf1
+    expectedTopology:
+      - name: cnf1
+        namespace: tnf1
+        podSelector: app=cnf1
+        pods: 2
+  - name: cnf2
+    targetNameSpaces:
+      - name: tnf2
+    targetPodLabels:
+      - name: app
+        value: cnf2
+`
+
+func TestSelectCNF(t *testing.T) {
+	var config configsections.TestConfiguration
+	assert.Nil(t, unmarshalConfig("config.yml", []byte(multipleCNFsConfig), &config))
+	names, err := getCNFNames(&config)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"cnf1", "cnf2"}, names)
+
+	assert.NotNil(t, selectCNF(&config, ""))
+	assert.NotNil(t, selectCNF(&config, "cnf3"))
+
+	assert.Nil(t, selectCNF(&config, "cnf1"))
+	assert.Equal(t, []configsections.Namespace{{Name: "tnf1"}}, config.TargetNameSpaces)
+	assert.Equal(t, []string{"app=cnf1"}, config.TargetPodSelectors)
+	assert.Nil(t, config.TargetPodLabels)
+	assert.Equal(t, []configsections.Operator{{Name: "etcd", Namespace: "tnf1"}}, config.Operators)
+	assert.Equal(t, []configsections.WorkloadTopology{{Name: "cnf1", Namespace: "tnf1", PodSelector: "app=cnf1", Pods: 2}},
+		config.ExpectedTopology)
+	assert.Nil(t, config.CNFs)
+
+	single := configsections.TestConfiguration{TargetNameSpaces: []configsections.Namespace{{Name: "tnf"}}}
+	assert.Nil(t, selectCNF(&single, ""))
+	assert.Equal(t, []configsections.Namespace{{Name: "tnf"}}, single.TargetNameSpaces)
+	assert.NotNil(t, selectCNF(&single, "cnf1"))
+
+	onlyOne := configsections.TestConfiguration{CNFs: []configsections.CNF{{Name: "cnf1", TargetPodSelectors: []string{"app=cnf1"}}}}
+	assert.Nil(t, selectCNF(&onlyOne, ""))
+	assert.Equal(t, []string{"app=cnf1"}, onlyOne.TargetPodSelectors)
+	assert.Nil(t, onlyOne.CNFs)
+
+	duplicated := configsections.TestConfiguration{CNFs: []configsections.CNF{{Name: "cnf1"}, {Name: "cnf1"}}}
+	assert.NotNil(t, selectCNF(&duplicated, "cnf1"))
+}
+
+func TestLoadCNFNames(t *testing.T) {
+	cnfsFilePath := filepath.Join(t.TempDir(), "tnf_config.yml")
+	assert.Nil(t, os.WriteFile(cnfsFilePath, []byte(multipleCNFsConfig), 0600))
+	names, err := LoadCNFNames(cnfsFilePath)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"cnf1", "cnf2"}, names)
+
+	names, err = LoadCNFNames(filePath)
+	assert.Nil(t, err)
+	assert.Nil(t, names)
+
+	assert.Nil(t, os.WriteFile(cnfsFilePath, []byte("cnfs:\n  - name: cnf/1\n"), 0600))
+	_, err = LoadCNFNames(cnfsFilePath)
+	assert.NotNil(t, err)
+}
+
+func TestLoadTestConfiguration(t *testing.T) {
+	cnfsFilePath := filepath.Join(t.TempDir(), "tnf_config.yml")
+	assert.Nil(t, os.WriteFile(cnfsFilePath, []byte(multipleCNFsConfig), 0600))
+	config, err := LoadTestConfiguration("cnf2", cnfsFilePath)
+	assert.Nil(t, err)
+	assert.Equal(t, []configsections.Namespace{{Name: "tnf2"}}, config.TargetNameSpaces)
+	assert.Nil(t, config.CNFs)
+
+	_, err = LoadTestConfiguration("", cnfsFilePath)
+	assert.NotNil(t, err)
+	_, err = LoadTestConfiguration("", filepath.Join(t.TempDir(), "missing.yml"))
+	assert.NotNil(t, err)
+}
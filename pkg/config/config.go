@@ -17,8 +17,12 @@
 package config
 
 import (
+	_ "embed" // embeds the JSON schema of the test configuration
+	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/onsi/gomega"
@@ -26,9 +30,11 @@ import (
 	"github.com/test-network-function/test-network-function/pkg/config/autodiscover"
 	"github.com/test-network-function/test-network-function/pkg/config/configsections"
 	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/configmaps"
 	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/ipaddr"
 	"github.com/test-network-function/test-network-function/pkg/tnf/interactive"
 	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+	"github.com/xeipuuv/gojsonschema"
 	"gopkg.in/yaml.v2"
 )
 
@@ -40,18 +46,23 @@ const (
 )
 
 var (
+	// configSchema is the JSON schema the test configuration is validated against on load.
+	//go:embed tnf-config.schema.json
+	configSchema []byte
+
 	expectersVerboseModeEnabled = false
 	// testEnvironment is the singleton instance of `TestEnvironment`, accessed through `GetTestEnvironment`
 	testEnvironment TestEnvironment
 )
 
-// getConfigurationFilePathFromEnvironment returns the test configuration file.
-func getConfigurationFilePathFromEnvironment() string {
-	environmentSourcedConfigurationFilePath := os.Getenv(configurationFilePathEnvironmentVariableKey)
-	if environmentSourcedConfigurationFilePath != "" {
-		return environmentSourcedConfigurationFilePath
+// getConfigurationFilePathsFromEnvironment returns the test configuration files, a base file and its overlays
+// separated like the directories of PATH, each a local file, a URL or a git reference.
+func getConfigurationFilePathsFromEnvironment() []string {
+	filePaths := SplitConfigPaths(os.Getenv(configurationFilePathEnvironmentVariableKey))
+	if len(filePaths) == 0 {
+		return []string{defaultConfigurationFilePath}
 	}
-	return defaultConfigurationFilePath
+	return filePaths
 }
 
 // Container is a construct which follows the Container design pattern.  Essentially, a Container holds the
@@ -96,7 +107,7 @@ func (n NodeConfig) HasDebugPod() bool {
 var DefaultTimeout = time.Duration(defaultTimeoutSeconds) * time.Second
 
 // Helper used to instantiate an OpenShift Client Session.
-func getOcSession(pod, container, namespace string, timeout time.Duration, options ...interactive.Option) *interactive.Oc {
+func getOcSession(pod, container, namespace, shell string, timeout time.Duration, options ...interactive.Option) *interactive.Oc {
 	// Spawn an interactive OC shell using a goroutine (needed to avoid cross expect.Expecter interaction).  Extract the
 	// Oc reference from the goroutine through a channel.  Performs basic sanity checking that the Oc session is set up
 	// correctly.
@@ -107,7 +118,7 @@ func getOcSession(pod, container, namespace string, timeout time.Duration, optio
 	var spawner interactive.Spawner = goExpectSpawner
 
 	go func() {
-		oc, outCh, err := interactive.SpawnOc(&spawner, pod, container, namespace, timeout, options...)
+		oc, outCh, err := interactive.SpawnOcWithShell(&spawner, pod, container, namespace, shell, timeout, options...)
 		gomega.Expect(outCh).ToNot(gomega.BeNil())
 		gomega.Expect(err).To(gomega.BeNil())
 		// Set up a go routine which reads from the error channel
@@ -134,7 +145,7 @@ func getOcSession(pod, container, namespace string, timeout time.Duration, optio
 // is served by dhcp, and thus is ephemeral.
 func getContainerDefaultNetworkIPAddress(oc *interactive.Oc, dev string) (string, error) {
 	log.Infof("Getting IP Information for: %s(%s) in ns=%s", oc.GetPodName(), oc.GetPodContainerName(), oc.GetPodNamespace())
-	ipTester := ipaddr.NewIPAddr(DefaultTimeout, dev)
+	ipTester := ipaddr.NewIPAddrJSON(DefaultTimeout, dev)
 	test, err := tnf.NewTest(oc.GetExpecter(), ipTester, []reel.Handler{ipTester}, oc.GetErrorChannel())
 	gomega.Expect(err).To(gomega.BeNil())
 	result, err := test.Run()
@@ -156,54 +167,280 @@ type TestEnvironment struct {
 	NameSpacesUnderTest  []string
 	CrdNames             []string
 	NodesUnderTest       map[string]*NodeConfig
+	// ConfigMapsSnapshot holds the ConfigMaps of the namespaces under test, indexed by namespace and name, as they were
+	// when the environment was first loaded at ConfigMapsSnapshotTime.
+	ConfigMapsSnapshot     map[string]map[string]configmaps.ConfigMap
+	ConfigMapsSnapshotTime time.Time
 
 	// ContainersToExcludeFromConnectivityTests is a set used for storing the containers that should be excluded from
 	// connectivity testing.
 	ContainersToExcludeFromConnectivityTests map[configsections.ContainerIdentifier]interface{}
 	TestOrchestrator                         *Container
 	Config                                   configsections.TestConfiguration
+	// CNFName is the name of the CNF under test when the config file declares several of them.
+	CNFName string
+	// ConfigSources are the config files loaded, with the checksums of their contents.
+	ConfigSources []ConfigSource
+	// KubeconfigContext and APIServer identify the cluster under test.
+	KubeconfigContext string
+	APIServer         string
+	// kubeconfigFile is the temporary kubeconfig file of the selected context, removed by Close.
+	kubeconfigFile string
 	// loaded tracks if the config has been loaded to prevent it being reloaded.
 	loaded bool
+	// discovered tracks if the autodiscovery has been performed once the config was loaded.
+	discovered bool
 	// set when an intrusive test has done something that would cause Pod/Container to be recreated
 	needsRefresh bool
 }
 
-// loadConfigFromFile loads a config file once.
-func (env *TestEnvironment) loadConfigFromFile(filePath string) error {
+// loadConfigFromFiles loads the config files once, each overlaying the ones before it.
+func (env *TestEnvironment) loadConfigFromFiles(filePaths ...string) error {
+	files, sources, err := fetchConfigFiles(filePaths)
+	if err != nil {
+		return err
+	}
+	return env.loadConfigFiles(files, sources)
+}
+
+// loadConfigFiles decodes the config files read once, each overlaying the ones before it.
+func (env *TestEnvironment) loadConfigFiles(files []configFile, sources []ConfigSource) error {
 	if env.loaded {
 		return fmt.Errorf("cannot load config from file when a config is already loaded")
 	}
-	log.Info("Loading config from file: ", filePath)
+	var filePaths []string
+	for _, file := range files {
+		filePaths = append(filePaths, file.path)
+	}
+	log.Info("Loading config from files: ", strings.Join(filePaths, ", "))
 
-	contents, err := os.ReadFile(filePath)
-	if err != nil {
+	if err := unmarshalConfigFiles(files, &env.Config); err != nil {
 		return err
 	}
-
-	err = yaml.Unmarshal(contents, &env.Config)
-	if err != nil {
+	env.ConfigSources = sources
+	env.CNFName = os.Getenv(cnfEnvironmentVariableKey)
+	if err := selectCNF(&env.Config, env.CNFName); err != nil {
 		return err
 	}
 	env.loaded = true
 	return nil
 }
 
-// LoadAndRefresh loads the config file if not loaded already and performs autodiscovery if needed
-func (env *TestEnvironment) LoadAndRefresh() {
-	if !env.loaded {
-		filePath := getConfigurationFilePathFromEnvironment()
-		log.Debugf("GetConfigInstance before config loaded, loading from file: %s", filePath)
-		err := env.loadConfigFromFile(filePath)
+// configFile is the path and the contents of a config file.
+type configFile struct {
+	path     string
+	contents []byte
+}
+
+// readConfigFiles reads, or fetches if remote, and decodes config files, each overlaying the ones before it.  It
+// returns the checksums of the config files read.
+func readConfigFiles(filePaths []string, config *configsections.TestConfiguration) ([]ConfigSource, error) {
+	files, sources, err := fetchConfigFiles(filePaths)
+	if err != nil {
+		return nil, err
+	}
+	return sources, unmarshalConfigFiles(files, config)
+}
+
+// fetchConfigFiles reads, or fetches if remote, config files without decoding them, and returns them with their
+// checksums.
+func fetchConfigFiles(filePaths []string) ([]configFile, []ConfigSource, error) {
+	var files []configFile
+	var sources []ConfigSource
+	for _, filePath := range filePaths {
+		file, err := readConfigFile(filePath)
+		if err != nil {
+			return nil, nil, err
+		}
+		files = append(files, file)
+		sources = append(sources, ConfigSource{Path: file.path, SHA256: configChecksum(file.contents)})
+	}
+	return files, sources, nil
+}
+
+// unmarshalConfig decodes a test configuration from a single config file.
+func unmarshalConfig(filePath string, contents []byte, config *configsections.TestConfiguration) error {
+	return unmarshalConfigFiles([]configFile{{path: filePath, contents: contents}}, config)
+}
+
+// ValidateConfigFile checks that the contents of a config file would be loaded by the suite, without loading them.
+func ValidateConfigFile(filePath string, contents []byte) error {
+	var config configsections.TestConfiguration
+	return unmarshalConfig(filePath, contents, &config)
+}
+
+// decodeConfigFile decodes a config file as JSON or YAML depending on its extension, once it is decrypted if SOPS
+// encrypted it and its templates are expanded with data.  YAML is assumed unless the extension is ".json".  An empty
+// file is decoded as an empty map of settings.
+func decodeConfigFile(file configFile, data *configTemplateData) (interface{}, error) {
+	if isSOPSEncrypted(file) {
+		decrypted, err := decryptConfigFile(file)
+		if err != nil {
+			return nil, err
+		}
+		file.contents = decrypted
+	}
+	contents, err := expandConfigTemplates(file.path, file.contents, data)
+	if err != nil {
+		return nil, err
+	}
+	var document interface{}
+	if isJSONConfigFile(file.path) {
+		if err := json.Unmarshal(contents, &document); err != nil {
+			return nil, fmt.Errorf("failed to parse the JSON config file %s: %w", file.path, err)
+		}
+	} else {
+		if err := yaml.Unmarshal(contents, &document); err != nil {
+			return nil, fmt.Errorf("failed to parse the YAML config file %s: %w", file.path, err)
+		}
+		document = stringifyYAMLKeys(document)
+	}
+	if document == nil {
+		document = map[string]interface{}{}
+	}
+	return document, nil
+}
+
+// isJSONConfigFile checks whether a config file is to be parsed as JSON, ignoring the query of a remote config path.
+func isJSONConfigFile(filePath string) bool {
+	if i := strings.Index(filePath, "?"); i >= 0 && IsRemoteConfigPath(filePath) {
+		filePath = filePath[:i]
+	}
+	return strings.EqualFold(filepath.Ext(filePath), ".json")
+}
+
+// unmarshalConfigFiles decodes a test configuration from config files migrated to the current schema version and
+// deep-merged in order, once the TNF_-prefixed environment variables are applied and the result is validated against
+// the configuration schema.
+func unmarshalConfigFiles(files []configFile, config *configsections.TestConfiguration) error {
+	var document interface{}
+	var filePaths []string
+	data := newConfigTemplateData(os.Environ())
+	for _, file := range files {
+		fileDocument, err := decodeConfigFile(file, data)
+		if err != nil {
+			return err
+		}
+		if settings, ok := fileDocument.(map[string]interface{}); ok {
+			if err := migrateConfig(file.path, settings); err != nil {
+				return err
+			}
+		}
+		document = mergeConfigDocuments(document, fileDocument)
+		filePaths = append(filePaths, file.path)
+	}
+	if settings, ok := document.(map[string]interface{}); ok {
+		if err := overrideConfig(settings, os.Environ()); err != nil {
+			return err
+		}
+	}
+	if err := validateConfig(strings.Join(filePaths, ", "), document); err != nil {
+		return err
+	}
+	// The merged settings are decoded in the format of the base config file.
+	if isJSONConfigFile(filePaths[0]) {
+		overridden, err := json.Marshal(document)
 		if err != nil {
-			log.Fatalf("unable to load configuration file: %s", err)
+			return err
+		}
+		return json.Unmarshal(overridden, config)
+	}
+	overridden, err := yaml.Marshal(document)
+	if err != nil {
+		return err
+	}
+	return yaml.Unmarshal(overridden, config)
+}
+
+// stringifyYAMLKeys converts the maps decoded from YAML, whose keys may be of any type, into maps keyed by strings as
+// decoded from JSON.
+func stringifyYAMLKeys(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			m[fmt.Sprint(key)] = stringifyYAMLKeys(item)
+		}
+		return m
+	case []interface{}:
+		for i, item := range v {
+			v[i] = stringifyYAMLKeys(item)
 		}
+	}
+	return value
+}
+
+// validateConfig validates a decoded test configuration against the configuration schema, reporting the path, the
+// expected type or the allowed values of every invalid field.
+func validateConfig(filePath string, document interface{}) error {
+	result, err := gojsonschema.Validate(gojsonschema.NewBytesLoader(configSchema), gojsonschema.NewGoLoader(document))
+	if err != nil {
+		return fmt.Errorf("failed to validate the config file %s: %w", filePath, err)
+	}
+	if result.Valid() {
+		return nil
+	}
+	var errors []string
+	for _, resultError := range result.Errors() {
+		errors = append(errors, fmt.Sprintf("%s: %s", resultError.Field(), resultError.Description()))
+	}
+	return fmt.Errorf("invalid config file %s:\n%s", filePath, strings.Join(errors, "\n"))
+}
+
+// LoadConfig loads the config file and selects the cluster under test if not loaded already, without performing
+// autodiscovery.
+func (env *TestEnvironment) LoadConfig() {
+	if env.loaded {
+		return
+	}
+	filePaths := getConfigurationFilePathsFromEnvironment()
+	log.Debugf("GetConfigInstance before config loaded, loading from files: %s", strings.Join(filePaths, ", "))
+	files, sources, err := fetchConfigFiles(filePaths)
+	if err != nil {
+		log.Fatalf("unable to load configuration file: %s", err)
+	}
+	// The cluster under test is selected before the config files are decoded, so that their templates refer to it.
+	env.Config.Kubeconfig, env.Config.KubeconfigContext, err = readKubeconfigSettings(files)
+	if err != nil {
+		log.Fatalf("unable to load configuration file: %s", err)
+	}
+	err = env.SelectKubeconfig()
+	if err != nil {
+		log.Fatalf("unable to select the cluster under test: %s", err)
+	}
+	err = env.loadConfigFiles(files, sources)
+	if err != nil {
+		log.Fatalf("unable to load configuration file: %s", err)
+	}
+}
+
+// LoadAndRefresh loads the config file if not loaded already and performs autodiscovery if needed
+func (env *TestEnvironment) LoadAndRefresh() {
+	if !env.discovered {
+		env.LoadConfig()
 		env.doAutodiscover()
+		env.discovered = true
+		env.snapshotConfigMaps()
 	} else if env.needsRefresh {
 		env.reset()
 		env.doAutodiscover()
 	}
 }
 
+// snapshotConfigMaps records the ConfigMaps of the namespaces under test, before any test had a chance to run.
+func (env *TestEnvironment) snapshotConfigMaps() {
+	env.ConfigMapsSnapshot = map[string]map[string]configmaps.ConfigMap{}
+	env.ConfigMapsSnapshotTime = time.Now()
+	for _, ns := range env.NameSpacesUnderTest {
+		cms, err := autodiscover.FindConfigMaps(ns)
+		if err != nil {
+			log.Errorf("Unable to snapshot the ConfigMaps of namespace %s: %v", ns, err)
+			continue
+		}
+		env.ConfigMapsSnapshot[ns] = cms
+	}
+}
+
 // Resets the environment during the drain test since all the connections are affected
 func (env *TestEnvironment) reset() {
 	log.Debug("clean up environment Test structure")
@@ -249,12 +486,16 @@ func (env *TestEnvironment) ResetOc() {
 
 func (env *TestEnvironment) doAutodiscover() {
 	log.Debug("start auto discovery")
-	for _, ns := range env.Config.TargetNameSpaces {
-		env.NameSpacesUnderTest = append(env.NameSpacesUnderTest, ns.Name)
+	namespaces, err := autodiscover.ExpandNamespaces(env.Config.TargetNameSpaces)
+	if err != nil {
+		log.Fatalf("unable to resolve the target namespaces: %s", err)
 	}
+	env.NameSpacesUnderTest = namespaces
+	log.Infof("Namespaces under test: %v", env.NameSpacesUnderTest)
 
+	autodiscover.ResolveOperatorSubscriptions(&env.Config.TestTarget)
 	if autodiscover.PerformAutoDiscovery() {
-		autodiscover.FindTestTarget(env.Config.TargetPodLabels, &env.Config.TestTarget, env.NameSpacesUnderTest)
+		autodiscover.FindTestTarget(env.Config.TargetPodLabels, env.Config.TargetPodSelectors, &env.Config.TestTarget, env.NameSpacesUnderTest)
 	}
 
 	env.ContainersToExcludeFromConnectivityTests = make(map[configsections.ContainerIdentifier]interface{})
@@ -277,7 +518,8 @@ func (env *TestEnvironment) doAutodiscover() {
 	env.CrdNames = autodiscover.FindTestCrdNames(env.Config.CrdFilters)
 
 	env.discoverNodes()
-	log.Infof("Test Configuration: %+v", *env)
+	// The environment holds the decrypted secrets of the config files.
+	log.Infof("Test Configuration: %s", RedactSecrets(fmt.Sprintf("%+v", *env)))
 
 	env.needsRefresh = false
 }
@@ -379,7 +621,10 @@ func (env *TestEnvironment) discoverNodes() {
 func (env *TestEnvironment) createContainers(containerDefinitions []configsections.ContainerConfig) map[configsections.ContainerIdentifier]*Container {
 	createdContainers := make(map[configsections.ContainerIdentifier]*Container)
 	for _, c := range containerDefinitions {
-		oc := getOcSession(c.PodName, c.ContainerName, c.Namespace, DefaultTimeout, interactive.Verbose(expectersVerboseModeEnabled), interactive.SendTimeout(DefaultTimeout))
+		oc := getOcSession(c.PodName, c.ContainerName, c.Namespace, c.Shell, DefaultTimeout, interactive.Verbose(expectersVerboseModeEnabled), interactive.SendTimeout(DefaultTimeout))
+		if err := initializeSession(*oc.GetExpecter(), c.Prompt, c.InitScript, DefaultTimeout); err != nil {
+			log.Fatalf("failed to initialize the session to container %s/%s/%s: %v, aborting the test run", c.Namespace, c.PodName, c.ContainerName, err)
+		}
 		var defaultIPAddress = "UNKNOWN"
 		var err error
 		if _, ok := env.ContainersToExcludeFromConnectivityTests[c.ContainerIdentifier]; !ok {
@@ -24,7 +24,8 @@ import (
 )
 
 const (
-	filePath = "testdata/tnf_test_config.yml"
+	filePath     = "testdata/tnf_test_config.yml"
+	jsonFilePath = "testdata/tnf_test_config.json"
 )
 
 const (
@@ -53,11 +54,87 @@ func testLoadedCrds(t *testing.T, crds []configsections.CrdFilter) {
 
 func TestLoadConfigFromFile(t *testing.T) {
 	env := GetTestEnvironment()
-	assert.Nil(t, env.loadConfigFromFile(filePath))
-	assert.NotNil(t, env.loadConfigFromFile(filePath)) // Loading when already loaded is an error case
+	assert.Nil(t, env.loadConfigFromFiles(filePath))
+	assert.NotNil(t, env.loadConfigFromFiles(filePath)) // Loading when already loaded is an error case
 	assert.Equal(t, env.Config.Partner.TestOrchestratorID.Namespace, "default")
 	assert.Equal(t, env.Config.Partner.TestOrchestratorID.ContainerName, "partner")
 	assert.Equal(t, env.Config.Partner.TestOrchestratorID.PodName, "partner")
 	testLoadedDeployments(t, env.Config.DeploymentsUnderTest)
 	testLoadedCrds(t, env.Config.CrdFilters)
 }
+
+func TestLoadConfigFromJSONFile(t *testing.T) {
+	env := &TestEnvironment{}
+	assert.Nil(t, env.loadConfigFromFiles(jsonFilePath))
+	assert.Equal(t, env.Config.Partner.TestOrchestratorID.Namespace, "default")
+	assert.Equal(t, env.Config.Partner.TestOrchestratorID.ContainerName, "partner")
+	assert.Equal(t, env.Config.Partner.TestOrchestratorID.PodName, "partner")
+	testLoadedDeployments(t, env.Config.DeploymentsUnderTest)
+	testLoadedCrds(t, env.Config.CrdFilters)
+}
+
+func TestUnmarshalConfig(t *testing.T) {
+	var config configsections.TestConfiguration
+	assert.Nil(t, unmarshalConfig("config.yaml", []byte("targetNameSpaces:\n  - name: tnf\n"), &config))
+	assert.Equal(t, []configsections.Namespace{{Name: "tnf"}}, config.TargetNameSpaces)
+	config = configsections.TestConfiguration{}
+	assert.Nil(t, unmarshalConfig("config.JSON", []byte(`{"targetNameSpaces": [{"name": "tnf"}]}`), &config))
+	assert.Equal(t, []configsections.Namespace{{Name: "tnf"}}, config.TargetNameSpaces)
+	assert.NotNil(t, unmarshalConfig("config.json", []byte("targetNameSpaces:\n  - name: tnf\n"), &config))
+	assert.NotNil(t, unmarshalConfig("config.yml", []byte("targetNameSpaces: [\n"), &config))
+}
+
+func TestValidateConfig(t *testing.T) {
+	testCases := []struct {
+		contents      string
+		expectedError string
+	}{
+		{contents: ""},
+		{contents: "targetNameSpaces:\n  - name: tnf\nminReplicas: 3\n"},
+		{contents: "targetNameSpaces:\n  - name: acme-*\n  - selector: vendor=acme\n"},
+		{contents: "targetNameSpaces:\n  - {}\n", expectedError: "targetNameSpaces.0: Must validate at least one schema (anyOf)"},
+		{contents: "minReplicas: three\n", expectedError: "minReplicas: Invalid type. Expected: integer, given: string"},
+		{contents: "minReplicas: 0\n", expectedError: "minReplicas: Must be greater than or equal to 1"},
+		{contents: "targetNamespaces:\n  - name: tnf\n", expectedError: "(root): Additional property targetNamespaces is not allowed"},
+		{contents: "sccExceptions:\n  - namespace: tnf\n    podName: agent\n    sccs:\n      - restricted\n",
+			expectedError: `sccExceptions.0.sccs.0: sccExceptions.0.sccs.0 must be one of the following: "anyuid", "privileged"`},
+		{contents: "testTarget:\n  deploymentsUnderTest:\n    - name: test\n", expectedError: "testTarget.deploymentsUnderTest.0: namespace is required"},
+		{contents: "testTarget:\n  operators:\n    - namespace: tnf\n      subscriptionName: etcd\n"},
+		{contents: "testTarget:\n  operators:\n    - namespace: tnf\n", expectedError: "testTarget.operators.0: Must validate at least one schema (anyOf)"},
+		{contents: "testOverrides:\n  networking-icmpv4-connectivity:\n    pingCount: 20\n    pingThreshold:\n      maxAvgRttMillis: 5\n"},
+		{contents: "testOverrides:\n  lifecycle-pod-recreation:\n    timeout: 60\n",
+			expectedError: "testOverrides.lifecycle-pod-recreation: Additional property timeout is not allowed"},
+		{contents: "includeTests:\n  - networking\nexcludeTests:\n  - networking-icmpv4-connectivity\n"},
+		{contents: "excludeTests: networking\n", expectedError: "excludeTests: Invalid type. Expected: array, given: string"},
+		{contents: "partnerMetadata:\n  company: Acme\n  product: vRouter\n  contacts:\n    - name: Jane Doe\n      email: jane@acme.com\n"},
+		{contents: "partnerMetadata:\n  product: vRouter\n", expectedError: "partnerMetadata: company is required"},
+		{contents: "partnerMetadata:\n  company: Acme\n  product: vRouter\n  contacts:\n    - name: Jane Doe\n      email: jane\n",
+			expectedError: "partnerMetadata.contacts.0.email: Does not match format 'email'"},
+		{contents: "expectedTopology:\n  - name: front\n    namespace: tnf\n    podSelector: app=front\n    pods: 2\n    distinctNodes: true\n" +
+			"    networks:\n      - macvlan1\n"},
+		{contents: "expectedTopology:\n  - name: front\n    namespace: tnf\n", expectedError: "expectedTopology.0: podSelector is required"},
+	}
+	for _, tc := range testCases {
+		var config configsections.TestConfiguration
+		err := unmarshalConfig("config.yml", []byte(tc.contents), &config)
+		if tc.expectedError == "" {
+			assert.Nil(t, err, tc.contents)
+		} else if assert.NotNil(t, err, tc.contents) {
+			assert.Contains(t, err.Error(), tc.expectedError)
+		}
+	}
+}
+
+func TestValidateSampleConfig(t *testing.T) {
+	env := &TestEnvironment{}
+	assert.Nil(t, env.loadConfigFromFiles("../../test-network-function/tnf_config.yml"))
+}
+
+func TestUnmarshalTestOverrides(t *testing.T) {
+	var config configsections.TestConfiguration
+	contents := "testOverrides:\n  lifecycle-pod-recreation:\n    timeoutSeconds: 60\n    retries: 2\n"
+	assert.Nil(t, unmarshalConfig("config.yml", []byte(contents), &config))
+	assert.Equal(t, map[string]configsections.TestOverride{
+		"lifecycle-pod-recreation": {TimeoutSeconds: 60, Retries: 2},
+	}, config.TestOverrides)
+}
@@ -26,7 +26,8 @@ type Label struct {
 // Operator struct defines operator manifest for testing
 type Operator struct {
 
-	// Name is a required field, Name of the csv .
+	// Name is the name of the csv.  It is resolved from the Subscription when only SubscriptionName is set, so that
+	// operator upgrades do not require changing the config.
 	Name string `yaml:"name" json:"name"`
 
 	// Namespace is a required field , namespace is where the csv is installed.
@@ -36,13 +37,16 @@ type Operator struct {
 	// Tests this is list of test that need to run against the operator.
 	Tests []string `yaml:"tests" json:"tests"`
 
-	// Subscription name is required field, Name of used subscription.
+	// SubscriptionName is the name of the OLM Subscription of the operator.
 	SubscriptionName string `yaml:"subscriptionName" json:"subscriptionName"`
 }
 
 // Namespace struct defines namespace properties
 type Namespace struct {
-	Name string `yaml:"name" json:"name"`
+	// Name is the name of the namespace, or a glob pattern matching the names of several namespaces.
+	Name string `yaml:"name,omitempty" json:"name,omitempty"`
+	// Selector is a label selector of the namespaces, e.g. vendor=acme.
+	Selector string `yaml:"selector,omitempty" json:"selector,omitempty"`
 }
 
 // TestConfiguration provides test related configuration
@@ -62,6 +66,101 @@ type TestConfiguration struct {
 	CertifiedOperatorInfo []CertifiedOperatorRequestInfo `yaml:"certifiedoperatorinfo,omitempty" json:"certifiedoperatorinfo,omitempty"`
 	// CRDs section.
 	CrdFilters []CrdFilter `yaml:"targetCrdFilters" json:"targetCrdFilters"`
+	// NICs lists the node interfaces and the supported driver and firmware versions.
+	NICs NICConfig `yaml:"nics,omitempty" json:"nics,omitempty"`
+	// PingThresholds holds the packet loss and latency limits of the network connectivity tests.
+	PingThresholds PingThresholds `yaml:"pingThresholds,omitempty" json:"pingThresholds,omitempty"`
+	// AllowedKernelVersions is the range of kernel releases the nodes are allowed to run.
+	AllowedKernelVersions KernelVersionRange `yaml:"allowedKernelVersions,omitempty" json:"allowedKernelVersions,omitempty"`
+	// MaxClockSkewMillis is the highest accepted clock offset between the nodes and the test machine, in milliseconds.
+	MaxClockSkewMillis int64 `yaml:"maxClockSkewMillis,omitempty" json:"maxClockSkewMillis,omitempty"`
+	// HostNamespaceExceptions lists the pods allowed to use hostNetwork, hostPID or hostIPC.
+	HostNamespaceExceptions []HostNamespaceException `yaml:"hostNamespaceExceptions,omitempty" json:"hostNamespaceExceptions,omitempty"`
+	// AllowedCapabilities lists the otherwise forbidden Linux capabilities, such as NET_ADMIN, the CNF containers may hold.
+	AllowedCapabilities []string `yaml:"allowedCapabilities,omitempty" json:"allowedCapabilities,omitempty"`
+	// RequireGuaranteedQoS requires the CNF containers to set their requests equal to their limits.
+	RequireGuaranteedQoS bool `yaml:"requireGuaranteedQoS,omitempty" json:"requireGuaranteedQoS,omitempty"`
+	// MinReplicas is the minimum number of replicas of the CNF Deployments and StatefulSets.  It defaults to 2.
+	MinReplicas int `yaml:"minReplicas,omitempty" json:"minReplicas,omitempty"`
+	// AllowedClusterRoleBindings lists the ClusterRoleBindings allowed to grant the CNF service accounts write access to
+	// core resources.
+	AllowedClusterRoleBindings []string `yaml:"allowedClusterRoleBindings,omitempty" json:"allowedClusterRoleBindings,omitempty"`
+	// RequiredNamespaceLabels lists the labels every namespace under test must carry.  An empty value accepts any value.
+	RequiredNamespaceLabels []Label `yaml:"requiredNamespaceLabels,omitempty" json:"requiredNamespaceLabels,omitempty"`
+	// ApprovedCatalogSources lists the catalog sources the CNF operators may be subscribed from.  It defaults to the
+	// certified-operators and redhat-operators catalog sources.
+	ApprovedCatalogSources []string `yaml:"approvedCatalogSources,omitempty" json:"approvedCatalogSources,omitempty"`
+	// ApprovedChannels lists the channels the CNF operators may be subscribed to.  Any channel is accepted when empty.
+	ApprovedChannels []string `yaml:"approvedChannels,omitempty" json:"approvedChannels,omitempty"`
+	// PodRecoveryTimeoutSeconds is the deadline for a deleted CNF pod to be replaced by a ready one.  It defaults to 120.
+	PodRecoveryTimeoutSeconds int `yaml:"podRecoveryTimeoutSeconds,omitempty" json:"podRecoveryTimeoutSeconds,omitempty"`
+	// ConfigMapDriftIntervalSeconds is the minimum time the CNF ConfigMaps are observed for runtime changes.  It defaults
+	// to 60.
+	ConfigMapDriftIntervalSeconds int `yaml:"configMapDriftIntervalSeconds,omitempty" json:"configMapDriftIntervalSeconds,omitempty"`
+	// UsesServiceMesh declares the CNF runs in an Istio or OpenShift Service Mesh, requiring its pods to be injected with
+	// a sidecar and its namespaces to enforce strict mutual TLS.
+	UsesServiceMesh bool `yaml:"usesServiceMesh,omitempty" json:"usesServiceMesh,omitempty"`
+	// ReconcileTimeoutSeconds is the deadline for an Operator to recreate a deleted Deployment it manages, and for each
+	// step of its uninstallation and reinstallation.  It defaults to 120.
+	ReconcileTimeoutSeconds int `yaml:"reconcileTimeoutSeconds,omitempty" json:"reconcileTimeoutSeconds,omitempty"`
+	// ApprovedRegistries lists the registries, optionally followed by a repository path, the CNF images may be pulled
+	// from.  It defaults to the Red Hat registries.
+	ApprovedRegistries []string `yaml:"approvedRegistries,omitempty" json:"approvedRegistries,omitempty"`
+	// MaxStartupSeconds is the longest time a CNF pod may take to become ready after its creation.  It defaults to 120.
+	MaxStartupSeconds int `yaml:"maxStartupSeconds,omitempty" json:"maxStartupSeconds,omitempty"`
+	// NetworkPartitionSeconds enables the intrusive network partition test, and sets how long the traffic between two
+	// CNF pods is blackholed.
+	NetworkPartitionSeconds int `yaml:"networkPartitionSeconds,omitempty" json:"networkPartitionSeconds,omitempty"`
+	// APIAccessExceptions lists the pods allowed to mount their service account token to call the Kubernetes API.
+	APIAccessExceptions []APIAccessException `yaml:"apiAccessExceptions,omitempty" json:"apiAccessExceptions,omitempty"`
+	// SCCExceptions lists the pods allowed to be admitted under the anyuid or privileged SecurityContextConstraints.
+	SCCExceptions []SCCException `yaml:"sccExceptions,omitempty" json:"sccExceptions,omitempty"`
+	// ApprovedStorageClasses lists the StorageClasses the CNF PersistentVolumeClaims may use.  Any StorageClass is
+	// accepted when empty.
+	ApprovedStorageClasses []string `yaml:"approvedStorageClasses,omitempty" json:"approvedStorageClasses,omitempty"`
+	// HostPathExceptions lists the pods allowed to mount hostPath volumes.
+	HostPathExceptions []HostPathException `yaml:"hostPathExceptions,omitempty" json:"hostPathExceptions,omitempty"`
+	// TargetPodSelectors are label selectors, such as "test-network-function.com/target=true,tier in (front,back)",
+	// selecting the pods under test in addition to TargetPodLabels.
+	TargetPodSelectors []string `yaml:"targetPodSelectors,omitempty" json:"targetPodSelectors,omitempty"`
+	// CNFs declares several independent CNFs, each tested by a separate run of the suite.  The targets of the CNF named
+	// by the TNF_CNF environment variable replace those of the configuration.
+	CNFs []CNF `yaml:"cnfs,omitempty" json:"cnfs,omitempty"`
+	// TestOverrides tunes the timeout, retries and ping limits of test cases, keyed by their test identifier such as
+	// networking-icmpv4-connectivity.
+	TestOverrides map[string]TestOverride `yaml:"testOverrides,omitempty" json:"testOverrides,omitempty"`
+	// IncludeTests restricts the test cases run to those matching one of its test identifiers or labels.
+	IncludeTests []string `yaml:"includeTests,omitempty" json:"includeTests,omitempty"`
+	// ExcludeTests lists the test identifiers or labels of the test cases not to run.
+	ExcludeTests []string `yaml:"excludeTests,omitempty" json:"excludeTests,omitempty"`
+	// Kubeconfig is the kubeconfig file of the cluster under test, instead of the one KUBECONFIG points to.
+	Kubeconfig string `yaml:"kubeconfig,omitempty" json:"kubeconfig,omitempty"`
+	// KubeconfigContext is the kubeconfig context of the cluster under test, instead of the current one.
+	KubeconfigContext string `yaml:"kubeconfigContext,omitempty" json:"kubeconfigContext,omitempty"`
+	// SchemaVersion is the version of the configuration layout.  Older configurations are migrated on load.
+	SchemaVersion int `yaml:"schemaVersion,omitempty" json:"schemaVersion,omitempty"`
+	// PartnerMetadata identifies the partner and the product under certification, and is copied into the claim and the
+	// JUnit report.
+	PartnerMetadata PartnerMetadata `yaml:"partnerMetadata,omitempty" json:"partnerMetadata,omitempty"`
+	// ExpectedTopology declares the pod counts, node placement and network attachments of the workloads under test,
+	// which are verified before the other test suites run.
+	ExpectedTopology []WorkloadTopology `yaml:"expectedTopology,omitempty" json:"expectedTopology,omitempty"`
+}
+
+// CNF holds the targets of one of several independent CNFs declared in a single configuration.
+type CNF struct {
+	// Name identifies the CNF in the TNF_CNF environment variable and in the claim.
+	Name string `yaml:"name" json:"name"`
+	// TargetNameSpaces are the namespaces of the CNF.
+	TargetNameSpaces []Namespace `yaml:"targetNameSpaces,omitempty" json:"targetNameSpaces,omitempty"`
+	// TargetPodLabels are the labels of the pods of the CNF.
+	TargetPodLabels []Label `yaml:"targetPodLabels,omitempty" json:"targetPodLabels,omitempty"`
+	// TargetPodSelectors are label selectors of the pods of the CNF.
+	TargetPodSelectors []string `yaml:"targetPodSelectors,omitempty" json:"targetPodSelectors,omitempty"`
+	// TestTarget contains the resources of the CNF that are not autodiscovered.
+	TestTarget TestTarget `yaml:"testTarget,omitempty" json:"testTarget,omitempty"`
+	// ExpectedTopology declares the expected topology of the workloads of the CNF.
+	ExpectedTopology []WorkloadTopology `yaml:"expectedTopology,omitempty" json:"expectedTopology,omitempty"`
 }
 
 // TestPartner contains the helper containers that can be used to facilitate tests
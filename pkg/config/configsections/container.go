@@ -31,4 +31,13 @@ type ContainerConfig struct {
 	DefaultNetworkDevice string `yaml:"defaultNetworkDevice" json:"defaultNetworkDevice"`
 	// MultusIPAddresses are the overlay IPs.
 	MultusIPAddresses []string `yaml:"multusIpAddresses" json:"multusIpAddresses"`
+	// MultusNetworks maps the "<namespace>/<name>" of the network attachments of the container to its IPs on each of them.
+	MultusNetworks map[string][]string `yaml:"multusNetworks,omitempty" json:"multusNetworks,omitempty"`
+	// Shell is the shell used to open a session to the container.  "oc rsh" defaults to /bin/sh when it is empty.
+	Shell string `yaml:"shell,omitempty" json:"shell,omitempty"`
+	// Prompt is a regular expression matching the end of what the shell prints when the session opens, such as a banner
+	// or a prompt.  Commands are only sent once it was printed.
+	Prompt string `yaml:"prompt,omitempty" json:"prompt,omitempty"`
+	// InitScript holds the commands run once when the session opens, before any test, e.g. to extend the PATH.
+	InitScript string `yaml:"initScript,omitempty" json:"initScript,omitempty"`
 }
@@ -0,0 +1,25 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package configsections
+
+// KernelVersionRange defines the inclusive range of kernel releases allowed on the cluster nodes.
+type KernelVersionRange struct {
+	// Min is the oldest allowed kernel release, e.g. `4.18.0-305`.  It is not checked when empty.
+	Min string `yaml:"min,omitempty" json:"min,omitempty"`
+	// Max is the newest allowed kernel release.  It is not checked when empty.
+	Max string `yaml:"max,omitempty" json:"max,omitempty"`
+}
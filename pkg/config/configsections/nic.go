@@ -0,0 +1,35 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package configsections
+
+// NICConfig lists the node interfaces whose driver and firmware versions are checked.
+type NICConfig struct {
+	// Interfaces is the list of node interface names to inspect, e.g. `ens1f0`.
+	Interfaces []string `yaml:"interfaces" json:"interfaces"`
+	// SupportedDrivers is the list of drivers supported on those interfaces.
+	SupportedDrivers []SupportedNICDriver `yaml:"supportedDrivers,omitempty" json:"supportedDrivers,omitempty"`
+}
+
+// SupportedNICDriver defines the versions supported for a NIC driver.
+type SupportedNICDriver struct {
+	// Name is the driver name as reported by `ethtool -i`, e.g. `i40e`.
+	Name string `yaml:"name" json:"name"`
+	// Versions is the list of supported driver versions.  An empty list accepts any version.
+	Versions []string `yaml:"versions,omitempty" json:"versions,omitempty"`
+	// FirmwareVersions is the list of supported firmware versions.  An empty list accepts any version.
+	FirmwareVersions []string `yaml:"firmwareVersions,omitempty" json:"firmwareVersions,omitempty"`
+}
@@ -0,0 +1,80 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package configsections
+
+import (
+	"fmt"
+	"strings"
+)
+
+// partnerPropertyPrefix prefixes the names of the JUnit properties holding the partner metadata.
+const partnerPropertyPrefix = "partner."
+
+// PartnerMetadata identifies the partner and the product under certification in the results.
+type PartnerMetadata struct {
+	// Company is the name of the partner company.
+	Company string `yaml:"company" json:"company"`
+	// Product is the name of the CNF under certification.
+	Product string `yaml:"product" json:"product"`
+	// Version is the version of the CNF under certification.
+	Version string `yaml:"version,omitempty" json:"version,omitempty"`
+	// Contacts are the people to contact about the results.
+	Contacts []Contact `yaml:"contacts,omitempty" json:"contacts,omitempty"`
+	// CertificationProjectID is the identifier of the certification project of the CNF in Red Hat Partner Connect.
+	CertificationProjectID string `yaml:"certificationProjectId,omitempty" json:"certificationProjectId,omitempty"`
+}
+
+// Contact is a person to contact about the results.
+type Contact struct {
+	Name  string `yaml:"name" json:"name"`
+	Email string `yaml:"email" json:"email"`
+	// Role is the responsibility of the contact, e.g. technical or business.
+	Role string `yaml:"role,omitempty" json:"role,omitempty"`
+}
+
+// String formats a contact as an email address, e.g. "Jane Doe <jane@acme.com> (technical)".
+func (c Contact) String() string {
+	contact := fmt.Sprintf("%s <%s>", c.Name, c.Email)
+	if c.Role != "" {
+		contact += " (" + c.Role + ")"
+	}
+	return contact
+}
+
+// JUnitProperties returns the partner metadata as JUnit properties, such as partner.company, leaving out the unset
+// fields.
+func (m *PartnerMetadata) JUnitProperties() map[string]string {
+	properties := map[string]string{}
+	for name, value := range map[string]string{
+		"company":                m.Company,
+		"product":                m.Product,
+		"version":                m.Version,
+		"certificationProjectId": m.CertificationProjectID,
+	} {
+		if value != "" {
+			properties[partnerPropertyPrefix+name] = value
+		}
+	}
+	var contacts []string
+	for _, contact := range m.Contacts {
+		contacts = append(contacts, contact.String())
+	}
+	if len(contacts) > 0 {
+		properties[partnerPropertyPrefix+"contacts"] = strings.Join(contacts, ", ")
+	}
+	return properties
+}
@@ -0,0 +1,42 @@
+// Copyright (C) 2020-2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package configsections
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPartnerMetadataJUnitProperties(t *testing.T) {
+	metadata := PartnerMetadata{
+		Company: "Acme",
+		Product: "vRouter",
+		Contacts: []Contact{
+			{Name: "Jane Doe", Email: "jane@acme.com", Role: "technical"},
+			{Name: "John Doe", Email: "john@acme.com"},
+		},
+		CertificationProjectID: "60f5b8e5f2c3a1b2c3d4e5f6",
+	}
+	assert.Equal(t, map[string]string{
+		"partner.company":                "Acme",
+		"partner.product":                "vRouter",
+		"partner.contacts":               "Jane Doe <jane@acme.com> (technical), John Doe <john@acme.com>",
+		"partner.certificationProjectId": "60f5b8e5f2c3a1b2c3d4e5f6",
+	}, metadata.JUnitProperties())
+	assert.Empty(t, (&PartnerMetadata{}).JUnitProperties())
+}
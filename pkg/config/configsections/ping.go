@@ -0,0 +1,39 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package configsections
+
+// PingThresholds defines the ping settings and limits of the network connectivity tests.
+type PingThresholds struct {
+	// Count is the number of ICMP requests sent by each ping.  The default count is used when it is not set.
+	Count int `yaml:"count,omitempty" json:"count,omitempty"`
+	// DefaultNetwork holds the limits applied on the default network.
+	DefaultNetwork PingThreshold `yaml:"defaultNetwork,omitempty" json:"defaultNetwork,omitempty"`
+	// MultusNetwork holds the limits applied on the secondary Multus networks.
+	MultusNetwork PingThreshold `yaml:"multusNetwork,omitempty" json:"multusNetwork,omitempty"`
+}
+
+// PingThreshold defines the packet loss and round-trip time limits of a ping.  Unset limits are not checked.
+type PingThreshold struct {
+	// MaxLossPercent is the highest accepted packet loss percentage.
+	MaxLossPercent float64 `yaml:"maxLossPercent,omitempty" json:"maxLossPercent,omitempty"`
+	// MaxAvgRTTMillis is the highest accepted average round-trip time, in milliseconds.
+	MaxAvgRTTMillis float64 `yaml:"maxAvgRttMillis,omitempty" json:"maxAvgRttMillis,omitempty"`
+	// MaxRTTMillis is the highest accepted round-trip time, in milliseconds.
+	MaxRTTMillis float64 `yaml:"maxRttMillis,omitempty" json:"maxRttMillis,omitempty"`
+	// MaxMdevMillis is the highest accepted round-trip time deviation, in milliseconds.
+	MaxMdevMillis float64 `yaml:"maxMdevMillis,omitempty" json:"maxMdevMillis,omitempty"`
+}
@@ -0,0 +1,28 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package configsections
+
+// SCCException allows a pod to be admitted under some of the SecurityContextConstraints otherwise forbidden to the
+// CNF pods.
+type SCCException struct {
+	// Namespace of the pod.
+	Namespace string `yaml:"namespace" json:"namespace"`
+	// PodName is the name of the pod, or a prefix of it such as the name of the owning Deployment.
+	PodName string `yaml:"podName" json:"podName"`
+	// SCCs lists the allowed SecurityContextConstraints, such as anyuid.  All of them are allowed when empty.
+	SCCs []string `yaml:"sccs,omitempty" json:"sccs,omitempty"`
+}
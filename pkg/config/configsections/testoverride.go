@@ -0,0 +1,30 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package configsections
+
+// TestOverride tunes the limits of a single test case, e.g. for slow lab environments.  Unset fields keep the values
+// of the test case.
+type TestOverride struct {
+	// TimeoutSeconds replaces the timeout of the pings of the test case.
+	TimeoutSeconds int `yaml:"timeoutSeconds,omitempty" json:"timeoutSeconds,omitempty"`
+	// Retries is the number of times a ping of the test case is run again when it times out or errors.
+	Retries int `yaml:"retries,omitempty" json:"retries,omitempty"`
+	// PingCount replaces the number of ICMP requests sent by each ping of the test case.
+	PingCount int `yaml:"pingCount,omitempty" json:"pingCount,omitempty"`
+	// PingThreshold replaces the packet loss and round-trip time limits of the pings of the test case.
+	PingThreshold PingThreshold `yaml:"pingThreshold,omitempty" json:"pingThreshold,omitempty"`
+}
@@ -0,0 +1,39 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package configsections
+
+// WorkloadTopology declares how the pods of a workload under test are expected to be laid out in the cluster.
+type WorkloadTopology struct {
+	// Name identifies the workload in the test results, e.g. the name of its Deployment.
+	Name string `yaml:"name" json:"name"`
+	// Namespace of the pods of the workload.
+	Namespace string `yaml:"namespace" json:"namespace"`
+	// PodSelector is the label selector of the pods of the workload, such as "app=cnf-front".
+	PodSelector string `yaml:"podSelector" json:"podSelector"`
+	// Pods is the expected number of running pods.  It is not checked when zero.
+	Pods int `yaml:"pods,omitempty" json:"pods,omitempty"`
+	// Nodes are the names of the nodes the pods may run on.  Any node is accepted when empty.
+	Nodes []string `yaml:"nodes,omitempty" json:"nodes,omitempty"`
+	// NodeSelector is a label selector of the nodes the pods may run on, such as
+	// "node-role.kubernetes.io/worker-cnf=".  Any node is accepted when empty.
+	NodeSelector string `yaml:"nodeSelector,omitempty" json:"nodeSelector,omitempty"`
+	// DistinctNodes requires every pod to run on a different node.
+	DistinctNodes bool `yaml:"distinctNodes,omitempty" json:"distinctNodes,omitempty"`
+	// Networks are the network attachments every pod must have, either as "<namespace>/<name>" or as the name of a
+	// NetworkAttachmentDefinition of the namespace of the workload.
+	Networks []string `yaml:"networks,omitempty" json:"networks,omitempty"`
+}
@@ -19,5 +19,6 @@ Package config provides test-network-function configuration through a central pl
 is automatically included in the claim. Configuration should all be contained in a single yaml file, with each
 configuration area under its own key.
 The env var "TNF_CONFIGURATION_PATH" identifies the config file. If not set, the default of `tnf_config.yml` is used.
+Any config setting can be overridden by a TNF_-prefixed env var named after its key, e.g. "TNF_MIN_REPLICAS".
 */
 package config
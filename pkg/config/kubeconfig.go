@@ -0,0 +1,135 @@
+// Copyright (C) 2020-2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package config
+
+import (
+	"fmt"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// kubeconfigEnvironmentVariableKey is the variable oc reads the kubeconfig file from.
+	kubeconfigEnvironmentVariableKey = "KUBECONFIG"
+	// kubeconfigFilePermissions are the permissions of the kubeconfig file of the selected context, which holds
+	// credentials.
+	kubeconfigFilePermissions = 0600
+	// kubeconfigKey and kubeconfigContextKey are the keys of the kubeconfig settings.
+	kubeconfigKey        = "kubeconfig"
+	kubeconfigContextKey = "kubeconfigContext"
+)
+
+// writeKubeconfigFile writes the kubeconfig of the selected context to a temporary file.
+var writeKubeconfigFile = func(contents string) (string, error) {
+	file, err := os.CreateTemp("", "tnf-kubeconfig-*.yml")
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	if err = file.Chmod(kubeconfigFilePermissions); err == nil {
+		_, err = file.WriteString(contents)
+	}
+	if err != nil {
+		os.Remove(file.Name())
+		return "", err
+	}
+	return file.Name(), nil
+}
+
+// readKubeconfigSettings reads the kubeconfig settings of config files, each overlaying the ones before it, without
+// querying any cluster, so that the cluster under test is selected before the other settings are decoded.
+func readKubeconfigSettings(files []configFile) (kubeconfig, context string, err error) {
+	var document interface{}
+	data := newOfflineConfigTemplateData(os.Environ())
+	for _, file := range files {
+		var fileDocument interface{}
+		if fileDocument, err = decodeConfigFile(file, data); err != nil {
+			return "", "", err
+		}
+		document = mergeConfigDocuments(document, fileDocument)
+	}
+	settings, ok := document.(map[string]interface{})
+	if !ok {
+		return "", "", nil
+	}
+	if err = overrideConfig(settings, os.Environ()); err != nil {
+		return "", "", err
+	}
+	kubeconfig, _ = settings[kubeconfigKey].(string)
+	context, _ = settings[kubeconfigContextKey].(string)
+	return kubeconfig, context, nil
+}
+
+// SelectKubeconfig points KUBECONFIG, and so every oc command run by the suite, to the kubeconfig file and context set
+// by the kubeconfig and kubeconfigContext settings, and checks that the cluster they select is reachable.  The context
+// and the API server of the cluster under test are recorded in the environment, whether they were selected or not.
+func (env *TestEnvironment) SelectKubeconfig() error {
+	kubeconfig := env.Config.Kubeconfig
+	context := env.Config.KubeconfigContext
+	if kubeconfig != "" || context != "" {
+		args := []string{"config", "view", "--minify", "--flatten"}
+		if kubeconfig != "" {
+			args = append(args, "--kubeconfig", kubeconfig)
+		}
+		if context != "" {
+			args = append(args, "--context", context)
+		}
+		contents, err := runOcCommand(args...)
+		if err != nil {
+			return fmt.Errorf("failed to read the kubeconfig context %q of %q: %w", context, kubeconfig, err)
+		}
+		filePath, err := writeKubeconfigFile(contents + "\n")
+		if err != nil {
+			return fmt.Errorf("failed to write the kubeconfig of the selected context: %w", err)
+		}
+		// The file holds credentials: it is removed when the suite ends, or aborts.
+		env.kubeconfigFile = filePath
+		log.RegisterExitHandler(env.Close)
+		if context != "" {
+			if _, err := runOcCommand("config", "use-context", context, "--kubeconfig", filePath); err != nil {
+				return err
+			}
+		}
+		if err := os.Setenv(kubeconfigEnvironmentVariableKey, filePath); err != nil {
+			return err
+		}
+		if _, err := runOcCommand("get", "--raw", "/version"); err != nil {
+			return fmt.Errorf("the cluster of the kubeconfig context %q of %q is not reachable: %w", context, kubeconfig, err)
+		}
+	}
+	var err error
+	if env.KubeconfigContext, err = runOcCommand("config", "current-context"); err != nil {
+		log.Warnf("Unable to get the kubeconfig context of the cluster under test: %v", err)
+	}
+	if env.APIServer, err = runOcCommand("whoami", "--show-server"); err != nil {
+		log.Warnf("Unable to get the API server of the cluster under test: %v", err)
+	}
+	log.Infof("Testing the cluster %s of the kubeconfig context %s", env.APIServer, env.KubeconfigContext)
+	return nil
+}
+
+// Close removes the temporary kubeconfig file written for the selected context, if any.
+func (env *TestEnvironment) Close() {
+	if env.kubeconfigFile == "" {
+		return
+	}
+	if err := os.Remove(env.kubeconfigFile); err != nil && !os.IsNotExist(err) {
+		log.Warnf("Unable to remove the kubeconfig file %s: %v", env.kubeconfigFile, err)
+	}
+	env.kubeconfigFile = ""
+}
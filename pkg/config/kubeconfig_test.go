@@ -0,0 +1,113 @@
+// Copyright (C) 2020-2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/config/configsections"
+)
+
+func TestSelectKubeconfig(t *testing.T) {
+	defer func(run func(args ...string) (string, error), write func(contents string) (string, error)) {
+		runOcCommand = run
+		writeKubeconfigFile = write
+	}(runOcCommand, writeKubeconfigFile)
+	t.Setenv(kubeconfigEnvironmentVariableKey, "/home/tnf/.kube/config")
+	var commands []string
+	reachable := true
+	runOcCommand = func(args ...string) (string, error) {
+		command := strings.Join(args, " ")
+		commands = append(commands, command)
+		switch {
+		case strings.HasPrefix(command, "config view"):
+			return "apiVersion: v1", nil
+		case command == "get --raw /version" && !reachable:
+			return "", errors.New("connection refused")
+		case command == "config current-context":
+			return "lab", nil
+		case command == "whoami --show-server":
+			return "https://api.lab.example.com:6443", nil
+		}
+		return "", nil
+	}
+	kubeconfigPath := filepath.Join(t.TempDir(), "tnf-kubeconfig.yml")
+	writeKubeconfigFile = func(contents string) (string, error) {
+		return kubeconfigPath, os.WriteFile(kubeconfigPath, []byte(contents), kubeconfigFilePermissions)
+	}
+
+	env := &TestEnvironment{}
+	assert.Nil(t, env.SelectKubeconfig())
+	assert.Equal(t, []string{"config current-context", "whoami --show-server"}, commands)
+	assert.Equal(t, "/home/tnf/.kube/config", os.Getenv(kubeconfigEnvironmentVariableKey))
+	assert.Equal(t, "lab", env.KubeconfigContext)
+	assert.Equal(t, "https://api.lab.example.com:6443", env.APIServer)
+
+	commands = nil
+	env = &TestEnvironment{Config: configsections.TestConfiguration{Kubeconfig: "/tmp/lab.yml", KubeconfigContext: "lab"}}
+	assert.Nil(t, env.SelectKubeconfig())
+	assert.Equal(t, []string{
+		"config view --minify --flatten --kubeconfig /tmp/lab.yml --context lab",
+		"config use-context lab --kubeconfig " + kubeconfigPath,
+		"get --raw /version",
+		"config current-context",
+		"whoami --show-server",
+	}, commands)
+	assert.Equal(t, kubeconfigPath, os.Getenv(kubeconfigEnvironmentVariableKey))
+	assert.FileExists(t, kubeconfigPath)
+	env.Close()
+	assert.NoFileExists(t, kubeconfigPath)
+
+	reachable = false
+	env = &TestEnvironment{Config: configsections.TestConfiguration{KubeconfigContext: "lab"}}
+	assert.NotNil(t, env.SelectKubeconfig())
+}
+
+func TestOverrideKubeconfigContext(t *testing.T) {
+	document := map[string]interface{}{}
+	assert.Nil(t, overrideConfig(document, []string{"TNF_KUBECONFIG=/tmp/lab.yml", "TNF_KUBECONFIG_CONTEXT=lab"}))
+	assert.Equal(t, map[string]interface{}{"kubeconfig": "/tmp/lab.yml", "kubeconfigContext": "lab"}, document)
+}
+
+func TestReadKubeconfigSettings(t *testing.T) {
+	defer func(run func(args ...string) (string, error)) {
+		runOcCommand = run
+	}(runOcCommand)
+	runOcCommand = func(args ...string) (string, error) {
+		t.Errorf("unexpected oc %s before the cluster under test is selected", strings.Join(args, " "))
+		return "", nil
+	}
+	files := []configFile{
+		{path: "base.yml", contents: []byte("kubeconfig: /tmp/lab.yml\nkubeconfigContext: lab\n" +
+			"partnerName: '{{ .Cluster.Domain }} {{ secret \"tnf\" \"partner\" \"name\" }}'\n")},
+		{path: "overlay.yml", contents: []byte("kubeconfigContext: lab2\n")},
+	}
+	kubeconfig, context, err := readKubeconfigSettings(files)
+	assert.Nil(t, err)
+	assert.Equal(t, "/tmp/lab.yml", kubeconfig)
+	assert.Equal(t, "lab2", context)
+
+	t.Setenv("TNF_KUBECONFIG_CONTEXT", "lab3")
+	_, context, err = readKubeconfigSettings(files)
+	assert.Nil(t, err)
+	assert.Equal(t, "lab3", context)
+}
@@ -0,0 +1,36 @@
+// Copyright (C) 2020-2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package config
+
+// mergeConfigDocuments deep-merges the decoded settings of an overlay config file into those of the config files before
+// it.  Maps are merged key by key, a null value removes the setting, and any other value, lists included, replaces the
+// setting of the base.
+func mergeConfigDocuments(base, overlay interface{}) interface{} {
+	baseSettings, isBaseMap := base.(map[string]interface{})
+	overlaySettings, isOverlayMap := overlay.(map[string]interface{})
+	if !isBaseMap || !isOverlayMap {
+		return overlay
+	}
+	for key, value := range overlaySettings {
+		if value == nil {
+			delete(baseSettings, key)
+			continue
+		}
+		baseSettings[key] = mergeConfigDocuments(baseSettings[key], value)
+	}
+	return baseSettings
+}
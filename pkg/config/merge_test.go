@@ -0,0 +1,63 @@
+// Copyright (C) 2020-2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package config
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/config/configsections"
+)
+
+const overlayFilePath = "testdata/tnf_test_overlay.json"
+
+func TestMergeConfigDocuments(t *testing.T) {
+	base := map[string]interface{}{
+		"minReplicas":      2,
+		"targetNameSpaces": []interface{}{map[string]interface{}{"name": "tnf"}},
+		"pingThresholds":   map[string]interface{}{"count": 5, "defaultNetwork": map[string]interface{}{"maxLossPercent": 0}},
+		"usesServiceMesh":  true,
+	}
+	overlay := map[string]interface{}{
+		"targetNameSpaces": []interface{}{map[string]interface{}{"name": "site"}},
+		"pingThresholds":   map[string]interface{}{"defaultNetwork": map[string]interface{}{"maxRttMillis": 10}},
+		"usesServiceMesh":  nil,
+	}
+	assert.Equal(t, map[string]interface{}{
+		"minReplicas":      2,
+		"targetNameSpaces": []interface{}{map[string]interface{}{"name": "site"}},
+		"pingThresholds": map[string]interface{}{"count": 5, "defaultNetwork": map[string]interface{}{
+			"maxLossPercent": 0, "maxRttMillis": 10}},
+	}, mergeConfigDocuments(base, overlay))
+	assert.Equal(t, overlay, mergeConfigDocuments(nil, overlay))
+}
+
+func TestLoadConfigFromOverlaidFiles(t *testing.T) {
+	env := &TestEnvironment{}
+	assert.Nil(t, env.loadConfigFromFiles(filePath, overlayFilePath))
+	assert.Equal(t, 3, env.Config.MinReplicas)
+	assert.Equal(t, "tnf-partner", env.Config.Partner.TestOrchestratorID.Namespace)
+	assert.Equal(t, "partner", env.Config.Partner.TestOrchestratorID.PodName)
+	assert.Equal(t, []configsections.CrdFilter{{NameSuffix: "overlay.com"}}, env.Config.CrdFilters)
+	testLoadedDeployments(t, env.Config.DeploymentsUnderTest)
+
+	t.Setenv(configurationFilePathEnvironmentVariableKey, filePath+string(os.PathListSeparator)+overlayFilePath)
+	assert.Equal(t, []string{filePath, overlayFilePath}, getConfigurationFilePathsFromEnvironment())
+	t.Setenv(configurationFilePathEnvironmentVariableKey, "")
+	assert.Equal(t, []string{defaultConfigurationFilePath}, getConfigurationFilePathsFromEnvironment())
+}
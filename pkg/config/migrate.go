@@ -0,0 +1,140 @@
+// Copyright (C) 2020-2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package config
+
+import (
+	"fmt"
+	"math"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// currentSchemaVersion is the version of the config layout this release decodes.  Config files of an older
+	// version are migrated to it on load.
+	currentSchemaVersion = 2
+	// schemaVersionKey is the key of the config layout version, assumed to be 1 when unset.
+	schemaVersionKey = "schemaVersion"
+)
+
+// configMigrations upgrade a decoded config of version i+1 to version i+2, returning a description of every change.
+var configMigrations = []func(settings map[string]interface{}) []string{
+	migrateConfigV1,
+}
+
+// migrateConfig upgrades the decoded settings of a config file to the current schema version, warning about every
+// change so that the file can be updated.
+func migrateConfig(filePath string, settings map[string]interface{}) error {
+	version := 1
+	if value, ok := settings[schemaVersionKey]; ok {
+		var err error
+		version, err = parseSchemaVersion(value)
+		if err != nil {
+			return fmt.Errorf("invalid config file %s: %w", filePath, err)
+		}
+	}
+	if version > currentSchemaVersion {
+		return fmt.Errorf("the config file %s has schema version %d, this release supports up to version %d",
+			filePath, version, currentSchemaVersion)
+	}
+	for ; version < currentSchemaVersion; version++ {
+		for _, change := range configMigrations[version-1](settings) {
+			log.Warnf("config file %s (schema version %d): %s", filePath, version, change)
+		}
+	}
+	settings[schemaVersionKey] = currentSchemaVersion
+	return nil
+}
+
+// parseSchemaVersion reads a schema version decoded either from YAML, as an int, or from JSON, as a float64.
+func parseSchemaVersion(value interface{}) (int, error) {
+	switch v := value.(type) {
+	case int:
+		if v >= 1 {
+			return v, nil
+		}
+	case float64:
+		if v >= 1 && v == math.Trunc(v) {
+			return int(v), nil
+		}
+	}
+	return 0, fmt.Errorf("%s must be a positive integer, got %v", schemaVersionKey, value)
+}
+
+// migrateConfigV1 moves the settings of the version 1 layout to where version 2 expects them:
+//   - the generic section is split into testTarget and testPartner,
+//   - the top level operators move to testTarget,
+//   - the cnfs, which were single pods rather than independent CNFs, move to testTarget.podsUnderTest.
+func migrateConfigV1(settings map[string]interface{}) []string {
+	var changes []string
+	move := func(from string, source map[string]interface{}, key, section string) {
+		value, ok := source[key]
+		if !ok {
+			return
+		}
+		delete(source, key)
+		if value == nil {
+			return
+		}
+		configSection(settings, section)[key] = value
+		changes = append(changes, fmt.Sprintf("%s%s moved to %s.%s", from, key, section, key))
+	}
+
+	if generic, ok := settings["generic"].(map[string]interface{}); ok {
+		move("generic.", generic, "containersUnderTest", "testTarget")
+		move("generic.", generic, "excludeContainersFromConnectivityTests", "testTarget")
+		move("generic.", generic, "partnerContainers", "testPartner")
+		move("generic.", generic, "testOrchestrator", "testPartner")
+		if _, ok := generic["fsDiffMasterContainer"]; ok {
+			delete(generic, "fsDiffMasterContainer")
+			changes = append(changes, "generic.fsDiffMasterContainer is no longer used and was dropped")
+		}
+		if len(generic) == 0 {
+			delete(settings, "generic")
+		}
+	}
+	move("", settings, "operators", "testTarget")
+
+	if cnfs, ok := settings["cnfs"].([]interface{}); ok && isV1CNFList(cnfs) {
+		delete(settings, "cnfs")
+		configSection(settings, "testTarget")["podsUnderTest"] = cnfs
+		changes = append(changes, "cnfs moved to testTarget.podsUnderTest")
+	}
+	return changes
+}
+
+// isV1CNFList checks whether cnfs lists pods, identified by their namespace, as in version 1, rather than CNFs.
+func isV1CNFList(cnfs []interface{}) bool {
+	for _, item := range cnfs {
+		if cnf, ok := item.(map[string]interface{}); ok {
+			if _, ok := cnf["namespace"]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// configSection returns the settings of a config section, adding it when missing.
+func configSection(settings map[string]interface{}, key string) map[string]interface{} {
+	section, ok := settings[key].(map[string]interface{})
+	if !ok {
+		section = map[string]interface{}{}
+		settings[key] = section
+	}
+	return section
+}
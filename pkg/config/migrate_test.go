@@ -0,0 +1,113 @@
+// Copyright (C) 2020-2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/config/configsections"
+)
+
+const v1Config = `
+generic:
+  containersUnderTest:
+    - namespace: tnf
+      podName: test
+      containerName: test
+      defaultNetworkDevice: eth0
+  partnerContainers:
+    - namespace: tnf
+      podName: partner
+      containerName: partner
+      defaultNetworkDevice: eth0
+  testOrchestrator:
+    namespace: tnf
+    podName: partner
+    containerName: partner
+  fsDiffMasterContainer:
+    namespace: tnf
+    podName: node-master
+    containerName: master
+operators:
+  - name: etcdoperator.v0.9.4
+    namespace: tnf
+    tests:
+      - OPERATOR_STATUS
+cnfs:
+  - name: test
+    namespace: tnf
+    tests:
+      - PRIVILEGED_POD
+`
+
+func TestMigrateConfig(t *testing.T) {
+	var config configsections.TestConfiguration
+	assert.Nil(t, unmarshalConfig("tnf_config.yml", []byte(v1Config), &config))
+	assert.Equal(t, currentSchemaVersion, config.SchemaVersion)
+	assert.Equal(t, []configsections.ContainerConfig{{
+		ContainerIdentifier:  configsections.ContainerIdentifier{Namespace: "tnf", PodName: "test", ContainerName: "test"},
+		DefaultNetworkDevice: "eth0",
+	}}, config.ContainerConfigList)
+	assert.Equal(t, "partner", config.Partner.ContainerConfigList[0].PodName)
+	assert.Equal(t, "partner", config.Partner.TestOrchestratorID.PodName)
+	assert.Equal(t, []configsections.Operator{{Name: "etcdoperator.v0.9.4", Namespace: "tnf", Tests: []string{"OPERATOR_STATUS"}}},
+		config.Operators)
+	assert.Equal(t, []configsections.Pod{{Name: "test", Namespace: "tnf", Tests: []string{"PRIVILEGED_POD"}}}, config.PodsUnderTest)
+	assert.Empty(t, config.CNFs)
+}
+
+func TestMigrateConfigVersions(t *testing.T) {
+	testCases := []struct {
+		settings        map[string]interface{}
+		expected        map[string]interface{}
+		expectedErrText string
+	}{
+		{
+			// A version 2 config is left as is.
+			settings: map[string]interface{}{"schemaVersion": 2, "operators": []interface{}{}, "cnfs": []interface{}{}},
+			expected: map[string]interface{}{"schemaVersion": 2, "operators": []interface{}{}, "cnfs": []interface{}{}},
+		},
+		{
+			// CNFs of version 1 that are not pods stay where they are.
+			settings: map[string]interface{}{"cnfs": []interface{}{map[string]interface{}{"name": "vran"}}},
+			expected: map[string]interface{}{"schemaVersion": 2, "cnfs": []interface{}{map[string]interface{}{"name": "vran"}}},
+		},
+		{
+			// Versions decoded from JSON are float64.
+			settings: map[string]interface{}{"schemaVersion": float64(1), "operators": []interface{}{}},
+			expected: map[string]interface{}{"schemaVersion": 2, "testTarget": map[string]interface{}{"operators": []interface{}{}}},
+		},
+		{
+			settings:        map[string]interface{}{"schemaVersion": 3},
+			expectedErrText: "the config file tnf_config.yml has schema version 3, this release supports up to version 2",
+		},
+		{
+			settings:        map[string]interface{}{"schemaVersion": 1.5},
+			expectedErrText: "invalid config file tnf_config.yml: schemaVersion must be a positive integer, got 1.5",
+		},
+	}
+	for _, tc := range testCases {
+		err := migrateConfig("tnf_config.yml", tc.settings)
+		if tc.expectedErrText != "" {
+			assert.EqualError(t, err, tc.expectedErrText)
+			continue
+		}
+		assert.Nil(t, err)
+		assert.Equal(t, tc.expected, tc.settings)
+	}
+}
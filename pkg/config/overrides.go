@@ -0,0 +1,177 @@
+// Copyright (C) 2020-2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/test-network-function/test-network-function/pkg/config/configsections"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// configOverridePrefix prefixes the environment variables overriding the config settings.
+	configOverridePrefix = "TNF"
+	// configOverrideNameKey is the key of the objects a comma-separated list of names is expanded into.
+	configOverrideNameKey = "name"
+)
+
+var (
+	// configOverrideAliases maps shorter environment variable names to the ones derived from the config keys.
+	configOverrideAliases = map[string]string{
+		"TNF_TARGET_NAMESPACE":  "TNF_TARGET_NAME_SPACES",
+		"TNF_TARGET_NAMESPACES": "TNF_TARGET_NAME_SPACES",
+	}
+
+	// configKeyAcronyms spells the acronyms of the config keys as words, so that they are not split.
+	configKeyAcronyms = strings.NewReplacer("QoS", "Qos")
+)
+
+// overrideConfig sets the settings of a decoded test configuration from the TNF_-prefixed environment variables named
+// after their keys, e.g. TNF_MIN_REPLICAS for minReplicas and TNF_PING_THRESHOLDS_COUNT for pingThresholds.count.
+// Values are parsed as YAML, except that lists of strings, and lists of objects identified by their name such as
+// targetNameSpaces, may be given as comma-separated values.
+func overrideConfig(document map[string]interface{}, environ []string) error {
+	const numSplitSubstrings = 2
+	variables := map[string]string{}
+	for _, variable := range environ {
+		fields := strings.SplitN(variable, "=", numSplitSubstrings)
+		if len(fields) != numSplitSubstrings || !strings.HasPrefix(fields[0], configOverridePrefix+"_") {
+			continue
+		}
+		name := fields[0]
+		if alias, ok := configOverrideAliases[name]; ok {
+			name = alias
+		}
+		variables[name] = fields[1]
+	}
+	return overrideFields(document, reflect.TypeOf(configsections.TestConfiguration{}), configOverridePrefix, variables)
+}
+
+// overrideFields overrides the fields of a struct type in a decoded document, descending into the nested structs.
+func overrideFields(document map[string]interface{}, structType reflect.Type, prefix string, variables map[string]string) error {
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		key := configKey(field)
+		if key == "" {
+			continue
+		}
+		name := prefix + "_" + configOverrideName(key)
+		if value, ok := variables[name]; ok {
+			parsed, err := parseOverride(value, field.Type)
+			if err != nil {
+				return fmt.Errorf("invalid value of environment variable %s: %w", name, err)
+			}
+			log.Infof("Overriding the config setting %s with environment variable %s", key, name)
+			document[key] = parsed
+			continue
+		}
+		if field.Type.Kind() != reflect.Struct || !hasVariableWithPrefix(variables, name+"_") {
+			continue
+		}
+		nested, ok := document[key].(map[string]interface{})
+		if !ok {
+			nested = map[string]interface{}{}
+			document[key] = nested
+		}
+		if err := overrideFields(nested, field.Type, name, variables); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// configKey returns the key of a field in the config file, or an empty string when it cannot be overridden.
+func configKey(field reflect.StructField) string {
+	if field.PkgPath != "" {
+		return ""
+	}
+	tag := field.Tag.Get("yaml")
+	key := strings.Split(tag, ",")[0]
+	switch {
+	case key == "-", key == "" && strings.Contains(tag, "inline"):
+		return ""
+	case key == "":
+		return strings.ToLower(field.Name)
+	}
+	return key
+}
+
+// configOverrideName converts a camel case config key, such as maxStartupSeconds, into the upper snake case suffix of
+// the environment variable overriding it, such as MAX_STARTUP_SECONDS.
+func configOverrideName(key string) string {
+	var name strings.Builder
+	runes := []rune(configKeyAcronyms.Replace(key))
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1])) {
+			name.WriteRune('_')
+		}
+		name.WriteRune(unicode.ToUpper(r))
+	}
+	return name.String()
+}
+
+func hasVariableWithPrefix(variables map[string]string, prefix string) bool {
+	for name := range variables {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseOverride parses the value of an environment variable overriding a field of the given type.
+func parseOverride(value string, fieldType reflect.Type) (interface{}, error) {
+	if fieldType.Kind() == reflect.String {
+		return value, nil
+	}
+	if fieldType.Kind() == reflect.Slice && !strings.HasPrefix(strings.TrimSpace(value), "[") {
+		var items []interface{}
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item == "" {
+				continue
+			}
+			switch elem := fieldType.Elem(); {
+			case elem.Kind() == reflect.String:
+				items = append(items, item)
+			case elem.Kind() == reflect.Struct && hasConfigKey(elem, configOverrideNameKey):
+				items = append(items, map[string]interface{}{configOverrideNameKey: item})
+			default:
+				return nil, fmt.Errorf("expected a YAML list, got %q", value)
+			}
+		}
+		return items, nil
+	}
+	var parsed interface{}
+	if err := yaml.Unmarshal([]byte(value), &parsed); err != nil {
+		return nil, err
+	}
+	return stringifyYAMLKeys(parsed), nil
+}
+
+func hasConfigKey(structType reflect.Type, key string) bool {
+	for i := 0; i < structType.NumField(); i++ {
+		if configKey(structType.Field(i)) == key {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+// Copyright (C) 2020-2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/config/configsections"
+)
+
+func TestConfigOverrideName(t *testing.T) {
+	assert.Equal(t, "MIN_REPLICAS", configOverrideName("minReplicas"))
+	assert.Equal(t, "TARGET_NAME_SPACES", configOverrideName("targetNameSpaces"))
+	assert.Equal(t, "REQUIRE_GUARANTEED_QOS", configOverrideName("requireGuaranteedQoS"))
+	assert.Equal(t, "MAX_AVG_RTT_MILLIS", configOverrideName("maxAvgRttMillis"))
+	assert.Equal(t, "CERTIFIEDCONTAINERINFO", configOverrideName("certifiedcontainerinfo"))
+}
+
+func TestOverrideConfig(t *testing.T) {
+	document := map[string]interface{}{
+		"minReplicas":    2,
+		"pingThresholds": map[string]interface{}{"count": 5},
+	}
+	environ := []string{
+		"TNF_MIN_REPLICAS=3",
+		"TNF_TARGET_NAMESPACE=tnf, other",
+		"TNF_APPROVED_REGISTRIES=quay.io,registry.redhat.io",
+		"TNF_REQUIRE_GUARANTEED_QOS=true",
+		"TNF_PING_THRESHOLDS_DEFAULT_NETWORK_MAX_LOSS_PERCENT=0.5",
+		"TNF_TEST_TARGET_OPERATORS=[{name: etcd, namespace: tnf}]",
+		"TNF_NON_INTRUSIVE_ONLY=true",
+		"PATH=/usr/bin",
+	}
+	assert.Nil(t, overrideConfig(document, environ))
+	assert.Equal(t, map[string]interface{}{
+		"minReplicas":          3,
+		"targetNameSpaces":     []interface{}{map[string]interface{}{"name": "tnf"}, map[string]interface{}{"name": "other"}},
+		"approvedRegistries":   []interface{}{"quay.io", "registry.redhat.io"},
+		"requireGuaranteedQoS": true,
+		"pingThresholds": map[string]interface{}{
+			"count":          5,
+			"defaultNetwork": map[string]interface{}{"maxLossPercent": 0.5},
+		},
+		"testTarget": map[string]interface{}{
+			"operators": []interface{}{map[string]interface{}{"name": "etcd", "namespace": "tnf"}},
+		},
+	}, document)
+
+	assert.NotNil(t, overrideConfig(map[string]interface{}{}, []string{"TNF_NICS=[interfaces"}))
+	assert.NotNil(t, overrideConfig(map[string]interface{}{}, []string{"TNF_TEST_TARGET_CONTAINERS_UNDER_TEST=partner"}))
+}
+
+func TestUnmarshalConfigWithOverrides(t *testing.T) {
+	t.Setenv("TNF_TARGET_NAME_SPACES", "tnf")
+	t.Setenv("TNF_MAX_STARTUP_SECONDS", "60")
+	var config configsections.TestConfiguration
+	assert.Nil(t, unmarshalConfig("config.yml", []byte("targetNameSpaces:\n  - name: default\nminReplicas: 3\n"), &config))
+	assert.Equal(t, []configsections.Namespace{{Name: "tnf"}}, config.TargetNameSpaces)
+	assert.Equal(t, 60, config.MaxStartupSeconds)
+	assert.Equal(t, 3, config.MinReplicas)
+
+	config = configsections.TestConfiguration{}
+	assert.Nil(t, unmarshalConfig("config.json", []byte(`{"minReplicas": 3}`), &config))
+	assert.Equal(t, []configsections.Namespace{{Name: "tnf"}}, config.TargetNameSpaces)
+	assert.Equal(t, 60, config.MaxStartupSeconds)
+
+	t.Setenv("TNF_MAX_STARTUP_SECONDS", "soon")
+	err := unmarshalConfig("config.yml", []byte(""), &config)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "maxStartupSeconds: Invalid type. Expected: integer, given: string")
+	}
+}
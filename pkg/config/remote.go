@@ -0,0 +1,266 @@
+// Copyright (C) 2020-2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package config
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// configCacheDirEnvironmentVariableKey sets the directory the remote config files are cached in.
+	configCacheDirEnvironmentVariableKey = "TNF_CONFIG_CACHE_DIR"
+	// gitConfigPrefix prefixes the git references of config files, e.g.
+	// git+https://github.com/acme/configs.git//lab/tnf_config.yml?ref=v1.2.
+	gitConfigPrefix = "git+"
+	// gitConfigRefKey is the query key of the git reference holding the branch, tag or commit to fetch.
+	gitConfigRefKey = "ref"
+	// checksumFragmentPrefix prefixes the expected SHA-256 checksum of a remote config file, e.g. #sha256=3a7bd3e2...
+	checksumFragmentPrefix = "#sha256="
+	remoteConfigTimeout    = 60 * time.Second
+	cacheDirPermissions    = 0700
+	cacheFilePermissions   = 0600
+)
+
+var (
+	// urlSchemeRegexp matches a URL scheme left alone when a list of config paths is split on colons.
+	urlSchemeRegexp = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9+.-]*$`)
+
+	// runGit runs a git command in a directory and returns its output.
+	runGit = func(dir string, args ...string) ([]byte, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), remoteConfigTimeout)
+		defer cancel()
+		var stderr bytes.Buffer
+		cmd := exec.CommandContext(ctx, "git", append([]string{"-C", dir}, args...)...)
+		cmd.Stderr = &stderr
+		output, err := cmd.Output()
+		if err != nil {
+			return nil, fmt.Errorf("failed to run git %s: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+		}
+		return output, nil
+	}
+)
+
+// ConfigSource identifies a config file loaded by the suite, with the checksum of its contents.
+type ConfigSource struct {
+	Path   string `json:"path"`
+	SHA256 string `json:"sha256"`
+}
+
+// IsRemoteConfigPath checks whether a config path is an HTTP(S) URL or a git reference rather than a local file.
+func IsRemoteConfigPath(filePath string) bool {
+	return strings.HasPrefix(filePath, "http://") || strings.HasPrefix(filePath, "https://") ||
+		strings.HasPrefix(filePath, gitConfigPrefix)
+}
+
+// SplitConfigPaths splits a list of config paths separated like the directories of PATH, keeping whole the URLs and
+// git references whose scheme, port or credentials are separated by colons.
+func SplitConfigPaths(list string) []string {
+	var filePaths []string
+	for _, part := range filepath.SplitList(list) {
+		if n := len(filePaths); n > 0 && os.PathListSeparator == ':' && continuesRemoteConfigPath(filePaths[n-1], part) {
+			filePaths[n-1] += ":" + part
+			continue
+		}
+		filePaths = append(filePaths, part)
+	}
+	var result []string
+	for _, filePath := range filePaths {
+		if filePath != "" {
+			result = append(result, filePath)
+		}
+	}
+	return result
+}
+
+// continuesRemoteConfigPath checks whether the part following a colon belongs to the remote config path before it,
+// either after its scheme or within its host.
+func continuesRemoteConfigPath(previous, part string) bool {
+	if urlSchemeRegexp.MatchString(previous) {
+		return strings.HasPrefix(part, "//")
+	}
+	if !IsRemoteConfigPath(previous) {
+		return false
+	}
+	host := previous[strings.Index(previous, "://")+len("://"):]
+	return !strings.Contains(host, "/")
+}
+
+// readConfigFile reads a local config file, or fetches a remote one.
+func readConfigFile(filePath string) (configFile, error) {
+	if IsRemoteConfigPath(filePath) {
+		return fetchRemoteConfig(filePath)
+	}
+	contents, err := os.ReadFile(filePath)
+	return configFile{path: filePath, contents: contents}, err
+}
+
+// fetchRemoteConfig fetches a config file from a URL or a git reference, verifying its checksum if one follows the
+// location.  The file is cached, the cached copy being used instead of fetching when it has the expected checksum.
+func fetchRemoteConfig(source string) (configFile, error) {
+	location, checksum := source, ""
+	if i := strings.LastIndex(source, checksumFragmentPrefix); i >= 0 {
+		location, checksum = source[:i], strings.ToLower(source[i+len(checksumFragmentPrefix):])
+	}
+	displayLocation := redactConfigLocation(location)
+	cachePath, cacheErr := getConfigCachePath(location)
+	if cacheErr != nil {
+		log.Warnf("Unable to cache the config %s: %v", displayLocation, cacheErr)
+	}
+	if checksum != "" && cacheErr == nil {
+		if cached, err := os.ReadFile(cachePath); err == nil && configChecksum(cached) == checksum {
+			log.Infof("Using the cached config %s (sha256 %s)", displayLocation, checksum)
+			return configFile{path: displayLocation, contents: cached}, nil
+		}
+	}
+
+	contents, err := downloadConfig(location)
+	if err != nil {
+		return configFile{}, fmt.Errorf("failed to fetch the config %s: %w", displayLocation, err)
+	}
+	if actual := configChecksum(contents); checksum != "" && actual != checksum {
+		return configFile{}, fmt.Errorf("the config %s has the checksum sha256 %s, expected %s", displayLocation, actual, checksum)
+	}
+	if cacheErr == nil {
+		if err := writeConfigCache(cachePath, contents); err != nil {
+			log.Warnf("Unable to cache the config %s: %v", displayLocation, err)
+		}
+	}
+	log.Infof("Loaded the config %s (sha256 %s)", displayLocation, configChecksum(contents))
+	return configFile{path: displayLocation, contents: contents}, nil
+}
+
+// downloadConfig fetches a config file from a URL or a git reference.
+func downloadConfig(location string) ([]byte, error) {
+	if strings.HasPrefix(location, gitConfigPrefix) {
+		repository, filePath, ref, err := parseGitConfigReference(location)
+		if err != nil {
+			return nil, err
+		}
+		return fetchGitConfig(repository, filePath, ref)
+	}
+	client := http.Client{Timeout: remoteConfigTimeout}
+	response, err := client.Get(location)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected HTTP status %s", response.Status)
+	}
+	return io.ReadAll(response.Body)
+}
+
+// parseGitConfigReference splits a git reference such as git+https://host/repo.git//dir/tnf_config.yml?ref=v1.2 into
+// the repository, the path of the config file in the repository and the optional branch, tag or commit.
+func parseGitConfigReference(location string) (repository, filePath, ref string, err error) {
+	reference := strings.TrimPrefix(location, gitConfigPrefix)
+	if i := strings.LastIndex(reference, "?"); i >= 0 {
+		query, err := url.ParseQuery(reference[i+1:])
+		if err != nil {
+			return "", "", "", fmt.Errorf("invalid git reference %s: %w", redactConfigLocation(location), err)
+		}
+		ref = query.Get(gitConfigRefKey)
+		reference = reference[:i]
+	}
+	invalidErr := fmt.Errorf("invalid git reference %s, expected git+<repository>//<path>[?ref=<ref>]",
+		redactConfigLocation(location))
+	schemeEnd := strings.Index(reference, "://")
+	if schemeEnd < 0 || strings.HasPrefix(reference, "-") || strings.HasPrefix(ref, "-") {
+		return "", "", "", invalidErr
+	}
+	schemeEnd += len("://")
+	i := strings.Index(reference[schemeEnd:], "//")
+	if i < 0 {
+		return "", "", "", invalidErr
+	}
+	return reference[:schemeEnd+i], reference[schemeEnd+i+len("//"):], ref, nil
+}
+
+// fetchGitConfig reads a config file at a branch, tag or commit of a git repository, the default branch when ref is
+// empty, fetching only that commit.
+func fetchGitConfig(repository, filePath, ref string) ([]byte, error) {
+	dir, err := os.MkdirTemp("", "tnf-config-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+	if ref == "" {
+		ref = "HEAD"
+	}
+	if _, err := runGit(dir, "init", "--quiet"); err != nil {
+		return nil, err
+	}
+	if _, err := runGit(dir, "fetch", "--quiet", "--depth", "1", "--", repository, ref); err != nil {
+		return nil, err
+	}
+	return runGit(dir, "show", "FETCH_HEAD:"+filePath)
+}
+
+// getConfigCachePath returns the path a remote config file is cached at.
+func getConfigCachePath(location string) (string, error) {
+	dir := os.Getenv(configCacheDirEnvironmentVariableKey)
+	if dir == "" {
+		userCacheDir, err := os.UserCacheDir()
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Join(userCacheDir, "tnf", "config")
+	}
+	return filepath.Join(dir, configChecksum([]byte(location))), nil
+}
+
+// writeConfigCache caches the contents of a remote config file, readable only by the user as it may hold secrets.
+func writeConfigCache(cachePath string, contents []byte) error {
+	if err := os.MkdirAll(filepath.Dir(cachePath), cacheDirPermissions); err != nil {
+		return err
+	}
+	return os.WriteFile(cachePath, contents, cacheFilePermissions)
+}
+
+// configChecksum returns the hex-encoded SHA-256 checksum of the contents of a config file.
+func configChecksum(contents []byte) string {
+	sum := sha256.Sum256(contents)
+	return hex.EncodeToString(sum[:])
+}
+
+// redactConfigLocation hides the password of a remote config location, so that it is not logged.
+func redactConfigLocation(location string) string {
+	prefix := ""
+	if strings.HasPrefix(location, gitConfigPrefix) {
+		prefix, location = gitConfigPrefix, strings.TrimPrefix(location, gitConfigPrefix)
+	}
+	u, err := url.Parse(location)
+	if err != nil || u.User == nil {
+		return prefix + location
+	}
+	return prefix + u.Redacted()
+}
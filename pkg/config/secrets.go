@@ -0,0 +1,154 @@
+// Copyright (C) 2020-2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package config
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// sopsMetadataKey is the key of the metadata SOPS adds to the config files it encrypts.
+	sopsMetadataKey = "sops"
+	// sopsEncryptedPrefix prefixes the values SOPS encrypts.
+	sopsEncryptedPrefix = "ENC["
+	// redactedSecret replaces the secret values of the config file wherever they are recorded.
+	redactedSecret = "*****"
+)
+
+var (
+	// configSecrets are the secret values resolved while loading the config files, to be redacted from the claim.
+	configSecrets = map[string]bool{}
+
+	// runSOPS decrypts the contents of a SOPS-encrypted config file with the sops command, which finds the age, PGP or
+	// cloud KMS keys in its usual locations, e.g. SOPS_AGE_KEY_FILE.
+	runSOPS = func(format string, contents []byte) ([]byte, error) {
+		cmd := exec.Command("sops", "--decrypt", "--input-type", format, "--output-type", format, "/dev/stdin")
+		cmd.Stdin = bytes.NewReader(contents)
+		var stderr bytes.Buffer
+		cmd.Stderr = &stderr
+		output, err := cmd.Output()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+		}
+		return output, nil
+	}
+)
+
+// getKubernetesSecret returns the decoded value of a key of a Kubernetes Secret.
+func getKubernetesSecret(namespace, name, key string) (string, error) {
+	jsonPathKey := strings.ReplaceAll(key, ".", `\.`)
+	encoded, err := runOcCommand("get", "secret", name, "-n", namespace, "-o", "jsonpath={.data."+jsonPathKey+"}")
+	if err != nil {
+		return "", err
+	}
+	if encoded == "" {
+		return "", fmt.Errorf("the secret %s/%s has no %s key", namespace, name, key)
+	}
+	value, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode the %s key of the secret %s/%s: %w", key, namespace, name, err)
+	}
+	return string(value), nil
+}
+
+// recordSecret records a secret value, so that it is redacted from the claim.
+func recordSecret(value string) {
+	if value != "" {
+		configSecrets[value] = true
+	}
+}
+
+// isSOPSEncrypted checks whether a config file was encrypted by SOPS, i.e. holds its metadata.
+func isSOPSEncrypted(file configFile) bool {
+	var document map[string]interface{}
+	if err := yaml.Unmarshal(file.contents, &document); err != nil {
+		return false
+	}
+	_, ok := document[sopsMetadataKey]
+	return ok
+}
+
+// decryptConfigFile decrypts a SOPS-encrypted config file, recording the values it had encrypted as secrets.
+func decryptConfigFile(file configFile) ([]byte, error) {
+	format := "yaml"
+	if isJSONConfigFile(file.path) {
+		format = "json"
+	}
+	decrypted, err := runSOPS(format, file.contents)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt the config file %s with sops: %w", file.path, err)
+	}
+	var encryptedDocument, decryptedDocument interface{}
+	if err := yaml.Unmarshal(file.contents, &encryptedDocument); err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(decrypted, &decryptedDocument); err != nil {
+		return nil, fmt.Errorf("failed to parse the decrypted config file %s: %w", file.path, err)
+	}
+	recordEncryptedValues(stringifyYAMLKeys(encryptedDocument), stringifyYAMLKeys(decryptedDocument))
+	return decrypted, nil
+}
+
+// recordEncryptedValues records as secrets the decrypted values of the settings SOPS had encrypted.
+func recordEncryptedValues(encrypted, decrypted interface{}) {
+	switch e := encrypted.(type) {
+	case map[string]interface{}:
+		if d, ok := decrypted.(map[string]interface{}); ok {
+			for key, value := range e {
+				recordEncryptedValues(value, d[key])
+			}
+		}
+	case []interface{}:
+		if d, ok := decrypted.([]interface{}); ok && len(d) == len(e) {
+			for i, value := range e {
+				recordEncryptedValues(value, d[i])
+			}
+		}
+	case string:
+		// Only strings are recorded, as redacting numbers or booleans would garble the claim.
+		if value, ok := decrypted.(string); ok && strings.HasPrefix(e, sopsEncryptedPrefix) {
+			recordSecret(value)
+		}
+	}
+}
+
+// RedactSecrets replaces the secret values of the config files found in the strings of a decoded JSON value, such as
+// the configurations of the claim.
+func RedactSecrets(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = RedactSecrets(item)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = RedactSecrets(item)
+		}
+	case string:
+		for secret := range configSecrets {
+			v = strings.ReplaceAll(v, secret, redactedSecret)
+		}
+		return v
+	}
+	return value
+}
@@ -0,0 +1,62 @@
+// Copyright (C) 2020-2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	expect "github.com/google/goexpect"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+// initScriptStatusRegex matches the exit status of the initialization script, and not the echo of the command sent.
+var initScriptStatusRegex = regexp.MustCompile(fmt.Sprintf("%s %s([0-9]+)\r?\n", reel.EndOfTestSentinel, reel.ExitKeyword))
+
+// initializeSession waits for the prompt of a new session to a container, when one is configured, then runs its
+// initialization script.  It fails when the prompt is not printed in time or when the script exits with an error.
+func initializeSession(expecter expect.Expecter, prompt, initScript string, timeout time.Duration) error {
+	if prompt != "" {
+		promptRegex, err := regexp.Compile(prompt)
+		if err != nil {
+			return fmt.Errorf("invalid prompt %q: %w", prompt, err)
+		}
+		if _, _, err = expecter.Expect(promptRegex, timeout); err != nil {
+			return fmt.Errorf("prompt %q not found: %w", prompt, err)
+		}
+	}
+	if initScript == "" {
+		return nil
+	}
+	if err := expecter.Send(reel.WrapTestCommand(initScript)); err != nil {
+		return fmt.Errorf("failed to send the initialization script: %w", err)
+	}
+	_, match, err := expecter.Expect(initScriptStatusRegex, timeout)
+	if err != nil {
+		return fmt.Errorf("initialization script did not complete: %w", err)
+	}
+	status, err := strconv.Atoi(match[1])
+	if err != nil {
+		return fmt.Errorf("failed to parse the exit status of the initialization script: %w", err)
+	}
+	if status != 0 {
+		return fmt.Errorf("initialization script exited with status %d", status)
+	}
+	return nil
+}
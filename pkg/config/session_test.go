@@ -0,0 +1,65 @@
+// Copyright (C) 2020-2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package config
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	mock_interactive "github.com/test-network-function/test-network-function/pkg/tnf/interactive/mocks"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+func TestInitializeSession(t *testing.T) {
+	const timeout = time.Second
+	const script = "export PATH=$PATH:/opt/bin"
+	testCases := []struct {
+		prompt      string
+		initScript  string
+		promptErr   error
+		status      string
+		expectedErr bool
+	}{
+		{},
+		{prompt: `\$ $`},
+		{prompt: `\$ $`, promptErr: errors.New("timeout"), expectedErr: true},
+		{prompt: `(`, expectedErr: true},
+		{initScript: script, status: "0"},
+		{prompt: `# $`, initScript: script, status: "0"},
+		{initScript: script, status: "127", expectedErr: true},
+	}
+
+	for _, tc := range testCases {
+		ctrl := gomock.NewController(t)
+		expecter := mock_interactive.NewMockExpecter(ctrl)
+		if tc.prompt != "" && tc.prompt != "(" {
+			expecter.EXPECT().Expect(regexp.MustCompile(tc.prompt), timeout).Return("", nil, tc.promptErr)
+		}
+		if tc.initScript != "" {
+			expecter.EXPECT().Send(reel.WrapTestCommand(tc.initScript)).Return(nil)
+			expecter.EXPECT().Expect(initScriptStatusRegex, timeout).Return("", []string{"", tc.status}, nil)
+		}
+
+		err := initializeSession(expecter, tc.prompt, tc.initScript, timeout)
+		assert.Equal(t, tc.expectedErr, err != nil)
+		ctrl.Finish()
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright (C) 2020-2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package config
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+	"text/template"
+	"time"
+)
+
+const (
+	// clusterFactsTimeout bounds each oc command run to gather the cluster facts of the config file templates.
+	clusterFactsTimeout = defaultTimeoutSeconds * time.Second
+)
+
+// clusterFacts are the facts about the cluster under test the config file templates may refer to as .Cluster.
+type clusterFacts struct {
+	// Domain is the domain of the cluster ingress, e.g. apps.mycluster.example.com.
+	Domain string
+	// BaseDomain is the base domain of the cluster, e.g. mycluster.example.com.
+	BaseDomain string
+	// APIServer is the URL of the cluster API server.
+	APIServer string
+}
+
+// configTemplateData is the data the config file templates are executed with.
+type configTemplateData struct {
+	// Env holds the environment variables, e.g. {{ .Env.PARTNER_NS }}.
+	Env       map[string]string
+	cluster   *clusterFacts
+	getFacts  func() (*clusterFacts, error)
+	getSecret func(namespace, name, key string) (string, error)
+}
+
+// Cluster returns the facts about the cluster under test, e.g. {{ .Cluster.Domain }}.  They are only gathered when a
+// template refers to them, so that config files without such templates do not need a cluster to be loaded.
+func (data *configTemplateData) Cluster() (*clusterFacts, error) {
+	if data.cluster == nil {
+		facts, err := data.getFacts()
+		if err != nil {
+			return nil, err
+		}
+		data.cluster = facts
+	}
+	return data.cluster, nil
+}
+
+// runOcCommand runs an oc command and returns its trimmed output.
+var runOcCommand = func(args ...string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), clusterFactsTimeout)
+	defer cancel()
+	output, err := exec.CommandContext(ctx, "oc", args...).Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to run oc %s: %w", strings.Join(args, " "), err)
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
+// getClusterFacts gathers the facts about the cluster under test with oc.
+func getClusterFacts() (*clusterFacts, error) {
+	var facts clusterFacts
+	var err error
+	if facts.Domain, err = runOcCommand("get", "ingresses.config.openshift.io", "cluster", "-o", "jsonpath={.spec.domain}"); err != nil {
+		return nil, err
+	}
+	if facts.BaseDomain, err = runOcCommand("get", "dns.config.openshift.io", "cluster", "-o", "jsonpath={.spec.baseDomain}"); err != nil {
+		return nil, err
+	}
+	if facts.APIServer, err = runOcCommand("whoami", "--show-server"); err != nil {
+		return nil, err
+	}
+	return &facts, nil
+}
+
+// newConfigTemplateData returns the data to execute the config file templates with, from the environment variables in
+// environ.
+func newConfigTemplateData(environ []string) *configTemplateData {
+	const numSplitSubstrings = 2
+	data := &configTemplateData{Env: map[string]string{}, getFacts: getClusterFacts, getSecret: getKubernetesSecret}
+	for _, variable := range environ {
+		if fields := strings.SplitN(variable, "=", numSplitSubstrings); len(fields) == numSplitSubstrings {
+			data.Env[fields[0]] = fields[1]
+		}
+	}
+	return data
+}
+
+// newOfflineConfigTemplateData returns the data to execute the config file templates with before the cluster under
+// test is selected: the cluster facts and the secrets are left empty.
+func newOfflineConfigTemplateData(environ []string) *configTemplateData {
+	data := newConfigTemplateData(environ)
+	data.getFacts = func() (*clusterFacts, error) {
+		return &clusterFacts{}, nil
+	}
+	data.getSecret = func(namespace, name, key string) (string, error) {
+		return "", nil
+	}
+	return data
+}
+
+// secret returns the value of a key of a Kubernetes Secret, e.g. {{ secret "tnf" "registry" "password" }}, recording
+// it so that it is redacted from the claim.
+func (data *configTemplateData) secret(namespace, name, key string) (string, error) {
+	value, err := data.getSecret(namespace, name, key)
+	if err != nil {
+		return "", err
+	}
+	recordSecret(value)
+	return value, nil
+}
+
+// expandConfigTemplates executes the contents of a config file as a Go template, replacing placeholders such as
+// {{ .Env.PARTNER_NS }}, {{ .Cluster.Domain }} or {{ secret "tnf" "registry" "password" }} with their values.
+// Referring to an unset environment variable is an error.
+func expandConfigTemplates(filePath string, contents []byte, data *configTemplateData) ([]byte, error) {
+	funcs := template.FuncMap{"secret": data.secret}
+	tmpl, err := template.New(filePath).Option("missingkey=error").Funcs(funcs).Parse(string(contents))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the templates of the config file %s: %w", filePath, err)
+	}
+	var expanded bytes.Buffer
+	if err := tmpl.Execute(&expanded, data); err != nil {
+		return nil, fmt.Errorf("failed to expand the templates of the config file %s: %w", filePath, err)
+	}
+	return expanded.Bytes(), nil
+}
@@ -0,0 +1,71 @@
+// Copyright (C) 2020-2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package config
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/config/configsections"
+)
+
+func TestExpandConfigTemplates(t *testing.T) {
+	facts := &clusterFacts{Domain: "apps.tnf.example.com", BaseDomain: "tnf.example.com", APIServer: "https://api.tnf.example.com:6443"}
+	testCases := []struct {
+		contents      string
+		factsErr      error
+		expected      string
+		expectedError bool
+	}{
+		{contents: "targetNameSpaces:\n  - name: tnf\n", expected: "targetNameSpaces:\n  - name: tnf\n"},
+		{contents: "targetNameSpaces:\n  - name: {{ .Env.PARTNER_NS }}\n", expected: "targetNameSpaces:\n  - name: tnf-partner\n"},
+		{contents: "approvedRegistries:\n  - registry.{{ .Cluster.Domain }}\n", expected: "approvedRegistries:\n  - registry.apps.tnf.example.com\n"},
+		{contents: "approvedRegistries:\n  - registry.{{ .Cluster.BaseDomain }}\n", expected: "approvedRegistries:\n  - registry.tnf.example.com\n"},
+		{contents: "apiServer: {{ .Cluster.APIServer }}\ndomain: {{ .Cluster.Domain }}\n",
+			expected: "apiServer: https://api.tnf.example.com:6443\ndomain: apps.tnf.example.com\n"},
+		{contents: "targetNameSpaces:\n  - name: {{ .Env.UNSET_NS }}\n", expectedError: true},
+		{contents: "targetNameSpaces:\n  - name: {{ .Env.PARTNER_NS\n", expectedError: true},
+		{contents: "approvedRegistries:\n  - {{ .Cluster.Domain }}\n", factsErr: errors.New("no cluster"), expectedError: true},
+	}
+	for _, tc := range testCases {
+		data := newConfigTemplateData([]string{"PARTNER_NS=tnf-partner"})
+		gathered := 0
+		data.getFacts = func() (*clusterFacts, error) {
+			gathered++
+			if tc.factsErr != nil {
+				return nil, tc.factsErr
+			}
+			return facts, nil
+		}
+		expanded, err := expandConfigTemplates("config.yml", []byte(tc.contents), data)
+		if tc.expectedError {
+			assert.NotNil(t, err, tc.contents)
+			continue
+		}
+		assert.Nil(t, err, tc.contents)
+		assert.Equal(t, tc.expected, string(expanded))
+		assert.LessOrEqual(t, gathered, 1)
+	}
+}
+
+func TestUnmarshalConfigTemplates(t *testing.T) {
+	t.Setenv("PARTNER_NS", "tnf-partner")
+	var config configsections.TestConfiguration
+	assert.Nil(t, unmarshalConfig("config.yml", []byte("targetNameSpaces:\n  - name: \"{{ .Env.PARTNER_NS }}\"\n"), &config))
+	assert.Equal(t, []configsections.Namespace{{Name: "tnf-partner"}}, config.TargetNameSpaces)
+}
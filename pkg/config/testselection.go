@@ -0,0 +1,55 @@
+// Copyright (C) 2020-2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package config
+
+import (
+	"fmt"
+)
+
+// SkippedByConfigurationReason is the reason reported in the claim for the test cases the config file selects out.
+const SkippedByConfigurationReason = "skipped by configuration"
+
+// matchesTestSelector checks whether one of selectors is the identifier or one of the labels of a test case.
+func matchesTestSelector(selectors []string, testID string, labels []string) (string, bool) {
+	for _, selector := range selectors {
+		if selector == testID {
+			return selector, true
+		}
+		for _, label := range labels {
+			if selector == label {
+				return selector, true
+			}
+		}
+	}
+	return "", false
+}
+
+// GetTestExclusion returns why the includeTests and excludeTests lists of the config file select out the test case
+// identified by testID and carrying labels, or an empty string when it is to be run.  excludeTests takes precedence
+// over includeTests.
+func (env *TestEnvironment) GetTestExclusion(testID string, labels []string) string {
+	if selector, excluded := matchesTestSelector(env.Config.ExcludeTests, testID, labels); excluded {
+		return fmt.Sprintf("excludeTests lists %s", selector)
+	}
+	if len(env.Config.IncludeTests) == 0 {
+		return ""
+	}
+	if _, included := matchesTestSelector(env.Config.IncludeTests, testID, labels); !included {
+		return "includeTests does not list it"
+	}
+	return ""
+}
@@ -0,0 +1,48 @@
+// Copyright (C) 2020-2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/config/configsections"
+)
+
+func TestGetTestExclusion(t *testing.T) {
+	testCases := []struct {
+		include, exclude []string
+		testID           string
+		excluded         bool
+	}{
+		{testID: "networking-icmpv4-connectivity", excluded: false},
+		{include: []string{"networking"}, testID: "networking-icmpv4-connectivity", excluded: false},
+		{include: []string{"networking"}, testID: "lifecycle-pod-recreation", excluded: true},
+		{include: []string{"lifecycle-pod-recreation"}, testID: "lifecycle-pod-recreation", excluded: false},
+		{exclude: []string{"networking-icmpv4-connectivity"}, testID: "networking-icmpv4-connectivity", excluded: true},
+		{exclude: []string{"networking-icmpv4-connectivity"}, testID: "networking-service-type", excluded: false},
+		{include: []string{"networking"}, exclude: []string{"networking-icmpv4-connectivity"},
+			testID: "networking-icmpv4-connectivity", excluded: true},
+	}
+	for _, tc := range testCases {
+		env := &TestEnvironment{Config: configsections.TestConfiguration{IncludeTests: tc.include, ExcludeTests: tc.exclude}}
+		suite := strings.SplitN(tc.testID, "-", 2)[0]
+		reason := env.GetTestExclusion(tc.testID, []string{suite})
+		assert.Equal(t, tc.excluded, reason != "", tc)
+	}
+}
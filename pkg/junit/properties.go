@@ -0,0 +1,82 @@
+// Copyright (C) 2020-2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package junit
+
+import (
+	"bytes"
+	"encoding/xml"
+	"os"
+	"regexp"
+	"sort"
+)
+
+var (
+	// testSuiteStartRegexp matches the start tag of a test suite, and its properties if any.  The report is not decoded
+	// as XML since the output of the test cases may hold characters invalid in XML, such as terminal escape codes.
+	testSuiteStartRegexp = regexp.MustCompile(`<testsuite\b[^>]*[^/>]>(\s*)(<properties>)?(\s*)`)
+)
+
+// AddProperties adds properties to every test suite of a JUnit XML file, sorted by name.
+func AddProperties(junitFilename string, properties map[string]string) error {
+	if len(properties) == 0 {
+		return nil
+	}
+	info, err := os.Stat(junitFilename)
+	if err != nil {
+		return err
+	}
+	report, err := os.ReadFile(junitFilename)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(junitFilename, addProperties(report, properties), info.Mode())
+}
+
+// addProperties adds properties to every test suite of a JUnit XML report.
+func addProperties(report []byte, properties map[string]string) []byte {
+	var names []string
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return testSuiteStartRegexp.ReplaceAllFunc(report, func(match []byte) []byte {
+		groups := testSuiteStartRegexp.FindSubmatch(match)
+		indent, hasProperties, propertyIndent := groups[1], len(groups[2]) > 0, groups[3]
+		var b bytes.Buffer
+		b.Write(match[:len(match)-len(indent)-len(groups[2])-len(propertyIndent)])
+		b.Write(indent)
+		b.WriteString("<properties>")
+		if !hasProperties {
+			propertyIndent = indent
+		}
+		for _, name := range names {
+			b.Write(propertyIndent)
+			b.WriteString(`<property name="`)
+			_ = xml.EscapeText(&b, []byte(name))
+			b.WriteString(`" value="`)
+			_ = xml.EscapeText(&b, []byte(properties[name]))
+			b.WriteString(`"></property>`)
+		}
+		if hasProperties {
+			b.Write(propertyIndent)
+		} else {
+			b.WriteString("</properties>")
+			b.Write(indent)
+		}
+		return b.Bytes()
+	})
+}
@@ -0,0 +1,60 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package junit
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddProperties(t *testing.T) {
+	report := `<testsuites>
+  <testsuite name="suite" tests="1">
+    <testcase name="test"></testcase>
+  </testsuite>
+</testsuites>`
+	expected := `<testsuites>
+  <testsuite name="suite" tests="1">
+    <properties>
+    <property name="partner.company" value="Acme &amp; Co"></property>
+    <property name="partner.product" value="&#34;vRouter&#34;"></property></properties>
+    <testcase name="test"></testcase>
+  </testsuite>
+</testsuites>`
+	properties := map[string]string{"partner.product": `"vRouter"`, "partner.company": "Acme & Co"}
+	assert.Equal(t, expected, string(addProperties([]byte(report), properties)))
+}
+
+func TestAddPropertiesToFile(t *testing.T) {
+	report, err := os.ReadFile(path.Join("testdata", "success.junit.xml"))
+	assert.Nil(t, err)
+	junitFilename := filepath.Join(t.TempDir(), "report.xml")
+	assert.Nil(t, os.WriteFile(junitFilename, report, 0600))
+
+	assert.Nil(t, AddProperties(junitFilename, map[string]string{"partner.company": "Acme"}))
+	junitMap, err := ExportJUnitAsMap(junitFilename)
+	assert.Nil(t, err)
+	suite := junitMap[junitTestSuitesKey].(map[string]interface{})[junitTestSuiteKey].(map[string]interface{})
+	properties := suite["properties"].(map[string]interface{})["property"].([]interface{})
+	assert.Equal(t, map[string]interface{}{"-name": "partner.company", "-value": "Acme"}, properties[0])
+	assert.Equal(t, "SuiteSucceeded", properties[1].(map[string]interface{})["-name"])
+	assert.Equal(t, 39, len(suite[junitTestCaseKey].([]interface{})))
+}
@@ -70,4 +70,88 @@ const (
 
 	// WcBinaryName is the name of the Unix `wc` command
 	WcBinaryName = "wc"
+
+	// DfBinaryName is the name of the Unix `df` command.
+	DfBinaryName = "df"
+
+	// SleepBinaryName is the name of the Unix `sleep` command.
+	SleepBinaryName = "sleep"
+
+	// OpensslBinaryName is the name of the `openssl` command.
+	OpensslBinaryName = "openssl"
+
+	// IptablesSaveBinaryName is the name of the `iptables-save` command.
+	IptablesSaveBinaryName = "iptables-save"
+
+	// NftBinaryName is the name of the nftables `nft` command.
+	NftBinaryName = "nft"
+
+	// SkopeoBinaryName is the name of the skopeo tool.
+	SkopeoBinaryName = "skopeo"
+
+	// RpmOstreeBinaryName is the name of the `rpm-ostree` command.
+	RpmOstreeBinaryName = "rpm-ostree"
+
+	// LscpuBinaryName is the name of the Unix `lscpu` command.
+	LscpuBinaryName = "lscpu"
+
+	// LldpctlBinaryName is the name of the lldpd `lldpctl` command.
+	LldpctlBinaryName = "lldpctl"
+
+	// ConntrackBinaryName is the name of the conntrack-tools `conntrack` command.
+	ConntrackBinaryName = "conntrack"
+
+	// FirewallCmdBinaryName is the name of the firewalld `firewall-cmd` command.
+	FirewallCmdBinaryName = "firewall-cmd"
+
+	// TunedAdmBinaryName is the name of the TuneD `tuned-adm` command.
+	TunedAdmBinaryName = "tuned-adm"
+
+	// EthtoolBinaryName is the name of the `ethtool` command.
+	EthtoolBinaryName = "ethtool"
+
+	// Iperf3BinaryName is the name of the `iperf3` network measurement tool.
+	Iperf3BinaryName = "iperf3"
+
+	// IDBinaryName is the name of the `id` command.
+	IDBinaryName = "id"
+
+	// CrictlBinaryName is the name of the CRI `crictl` command.
+	CrictlBinaryName = "crictl"
+
+	// CrioBinaryName is the name of the CRI-O `crio` daemon binary.
+	CrioBinaryName = "crio"
+
+	// RpmBinaryName is the name of the `rpm` package manager.
+	RpmBinaryName = "rpm"
+
+	// DpkgQueryBinaryName is the name of the Debian `dpkg-query` command.
+	DpkgQueryBinaryName = "dpkg-query"
+
+	// ApkBinaryName is the name of the Alpine `apk` package manager.
+	ApkBinaryName = "apk"
+
+	// DateBinaryName is the name of the `date` command.
+	DateBinaryName = "date"
+
+	// SsBinaryName is the name of the socket statistics `ss` command.
+	SsBinaryName = "ss"
+
+	// Base64BinaryName is the name of the coreutils `base64` command.
+	Base64BinaryName = "base64"
+
+	// GzipBinaryName is the name of the `gzip` command.
+	GzipBinaryName = "gzip"
+
+	// IptablesBinaryName is the name of the `iptables` command.
+	IptablesBinaryName = "iptables"
+
+	// NsenterBinaryName is the name of the util-linux `nsenter` command.
+	NsenterBinaryName = "nsenter"
+
+	// CurlBinaryName is the name of the `curl` command.
+	CurlBinaryName = "curl"
+
+	// SystemctlBinaryName is the name of the systemd `systemctl` command.
+	SystemctlBinaryName = "systemctl"
 )
@@ -0,0 +1,180 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package capabilities
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/dependencies"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	capabilitiesRegex = "(?s).+"
+	// EffectiveSet is the name of the effective capability set in /proc/<pid>/status.
+	EffectiveSet = "CapEff"
+
+	hexBase = 16
+	bitSize = 64
+)
+
+// capLineRegex matches a capability set line of /proc/<pid>/status, e.g. "CapEff:	00000000a80425fb".
+var capLineRegex = regexp.MustCompile(`(?m)^(Cap[A-Za-z]+):\s+([0-9a-fA-F]+)\s*$`)
+
+// names lists the capabilities by bit number, without the CAP_ prefix.
+var names = []string{
+	"CHOWN", "DAC_OVERRIDE", "DAC_READ_SEARCH", "FOWNER", "FSETID", "KILL", "SETGID", "SETUID", "SETPCAP",
+	"LINUX_IMMUTABLE", "NET_BIND_SERVICE", "NET_BROADCAST", "NET_ADMIN", "NET_RAW", "IPC_LOCK", "IPC_OWNER",
+	"SYS_MODULE", "SYS_RAWIO", "SYS_CHROOT", "SYS_PTRACE", "SYS_PACCT", "SYS_ADMIN", "SYS_BOOT", "SYS_NICE",
+	"SYS_RESOURCE", "SYS_TIME", "SYS_TTY_CONFIG", "MKNOD", "LEASE", "AUDIT_WRITE", "AUDIT_CONTROL", "SETFCAP",
+	"MAC_OVERRIDE", "MAC_ADMIN", "SYSLOG", "WAKE_ALARM", "BLOCK_SUSPEND", "AUDIT_READ", "PERFMON", "BPF",
+	"CHECKPOINT_RESTORE",
+}
+
+// Capabilities checks that the effective capability set of a container process holds none of the forbidden
+// capabilities.
+type Capabilities struct {
+	forbidden  []string
+	sets       map[string][]string
+	violations []string
+	result     int
+	timeout    time.Duration
+	args       []string
+}
+
+// NewCapabilities creates a new Capabilities tnf.Test which reads the capability sets of process `pid` inside a
+// container.  forbidden lists capability names without the CAP_ prefix, such as "NET_ADMIN".
+func NewCapabilities(timeout time.Duration, pid int, forbidden []string) *Capabilities {
+	return &Capabilities{
+		forbidden: forbidden,
+		timeout:   timeout,
+		result:    tnf.ERROR,
+		args:      []string{dependencies.CatBinaryName, fmt.Sprintf("/proc/%d/status", pid)},
+	}
+}
+
+// Args returns the command line args for the test.
+func (c *Capabilities) Args() []string {
+	return c.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (c *Capabilities) GetIdentifier() identifier.Identifier {
+	return identifier.CapabilitiesIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (c *Capabilities) Timeout() time.Duration {
+	return c.timeout
+}
+
+// Result returns the test result.
+func (c *Capabilities) Result() int {
+	return c.result
+}
+
+// GetSets returns the decoded capability sets, keyed by their /proc/<pid>/status name such as CapEff or CapBnd.
+func (c *Capabilities) GetSets() map[string][]string {
+	return c.sets
+}
+
+// GetEffective returns the effective capabilities of the process.
+func (c *Capabilities) GetEffective() []string {
+	return c.sets[EffectiveSet]
+}
+
+// GetViolations returns the forbidden capabilities found in the effective set.
+func (c *Capabilities) GetViolations() []string {
+	return c.violations
+}
+
+// ReelFirst returns a step which expects the process status within the test timeout.
+func (c *Capabilities) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{capabilitiesRegex},
+		Timeout: c.timeout,
+	}
+}
+
+// ReelMatch decodes the capability sets and looks for forbidden effective capabilities.
+func (c *Capabilities) ReelMatch(_, _, match string) *reel.Step {
+	c.sets = ParseSets(match)
+	c.violations = nil
+	effective, ok := c.sets[EffectiveSet]
+	if !ok {
+		c.result = tnf.ERROR
+		return nil
+	}
+	for _, name := range c.forbidden {
+		for _, capability := range effective {
+			if capability == name {
+				c.violations = append(c.violations, name)
+				break
+			}
+		}
+	}
+	if len(c.violations) > 0 {
+		c.result = tnf.FAILURE
+	} else {
+		c.result = tnf.SUCCESS
+	}
+	return nil
+}
+
+// ParseSets decodes the capability set lines of a /proc/<pid>/status file.
+func ParseSets(output string) map[string][]string {
+	sets := map[string][]string{}
+	for _, matched := range capLineRegex.FindAllStringSubmatch(output, -1) {
+		mask, err := strconv.ParseUint(matched[2], hexBase, bitSize)
+		if err != nil {
+			continue
+		}
+		sets[matched[1]] = Decode(mask)
+	}
+	return sets
+}
+
+// Decode returns the names of the capabilities set in a capability mask.  Bits without a known name are reported as
+// their number.
+func Decode(mask uint64) []string {
+	decoded := []string{}
+	for bit := 0; bit < bitSize; bit++ {
+		if mask&(1<<uint(bit)) == 0 {
+			continue
+		}
+		if bit < len(names) {
+			decoded = append(decoded, names[bit])
+		} else {
+			decoded = append(decoded, strconv.Itoa(bit))
+		}
+	}
+	return decoded
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (c *Capabilities) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (c *Capabilities) ReelEOF() {
+}
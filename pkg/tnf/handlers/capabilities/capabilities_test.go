@@ -0,0 +1,109 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package capabilities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/capabilities"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testTimeoutDuration = time.Second * 2
+
+	statusDefault = `Name:	sleep
+Umask:	0022
+State:	S (sleeping)
+Uid:	0	0	0	0
+CapInh:	0000000000000000
+CapPrm:	00000000a80425fb
+CapEff:	00000000a80425fb
+CapBnd:	00000000a80425fb
+CapAmb:	0000000000000000
+NoNewPrivs:	0
+`
+	statusNetAdmin = `Name:	sleep
+CapInh:	0000000000000000
+CapPrm:	00000000a82435fb
+CapEff:	00000000a82435fb
+CapBnd:	00000000a82435fb
+CapAmb:	0000000000000000
+`
+	statusNonRoot = `Name:	sleep
+CapInh:	0000000000000000
+CapPrm:	0000000000000000
+CapEff:	0000000000000000
+CapBnd:	00000000a80425fb
+CapAmb:	0000000000000000
+`
+	statusNoCapabilities = "cat: /proc/1/status: No such file or directory\n"
+)
+
+var forbidden = []string{"NET_ADMIN", "SYS_ADMIN", "IPC_LOCK"}
+
+func TestNewCapabilities(t *testing.T) {
+	handler := capabilities.NewCapabilities(testTimeoutDuration, 1, forbidden)
+	assert.Equal(t, []string{"cat", "/proc/1/status"}, handler.Args())
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.CapabilitiesIdentifier, handler.GetIdentifier())
+}
+
+func TestCapabilities_ReelMatch(t *testing.T) {
+	testCases := map[string]struct {
+		output             string
+		expectedResult     int
+		expectedViolations []string
+	}{
+		"default":         {output: statusDefault, expectedResult: tnf.SUCCESS},
+		"net_admin":       {output: statusNetAdmin, expectedResult: tnf.FAILURE, expectedViolations: []string{"NET_ADMIN", "SYS_ADMIN"}},
+		"non_root":        {output: statusNonRoot, expectedResult: tnf.SUCCESS},
+		"no_capabilities": {output: statusNoCapabilities, expectedResult: tnf.ERROR},
+	}
+	for name, tc := range testCases {
+		handler := capabilities.NewCapabilities(testTimeoutDuration, 1, forbidden)
+		assert.Nil(t, handler.ReelMatch("", "", tc.output), name)
+		assert.Equal(t, tc.expectedResult, handler.Result(), name)
+		assert.Equal(t, tc.expectedViolations, handler.GetViolations(), name)
+	}
+}
+
+func TestCapabilities_GetEffective(t *testing.T) {
+	handler := capabilities.NewCapabilities(testTimeoutDuration, 1, forbidden)
+	handler.ReelMatch("", "", statusDefault)
+	assert.Equal(t, []string{"CHOWN", "DAC_OVERRIDE", "FOWNER", "FSETID", "KILL", "SETGID", "SETUID", "SETPCAP",
+		"NET_BIND_SERVICE", "NET_RAW", "SYS_CHROOT", "MKNOD", "AUDIT_WRITE", "SETFCAP"}, handler.GetEffective())
+	assert.Len(t, handler.GetSets(), 5)
+	assert.Empty(t, handler.GetSets()["CapInh"])
+}
+
+func TestDecode(t *testing.T) {
+	assert.Equal(t, []string{}, capabilities.Decode(0))
+	assert.Equal(t, []string{"NET_ADMIN", "SYS_ADMIN"}, capabilities.Decode(1<<12|1<<21))
+	assert.Equal(t, []string{"63"}, capabilities.Decode(1<<63))
+}
+
+// Just ensure there are no panics.
+func TestCapabilities_ReelEOF(t *testing.T) {
+	handler := capabilities.NewCapabilities(testTimeoutDuration, 1, forbidden)
+	assert.Nil(t, handler.ReelTimeout())
+	handler.ReelEOF()
+}
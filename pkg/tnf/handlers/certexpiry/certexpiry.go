@@ -0,0 +1,173 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package certexpiry
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/dependencies"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	ceRegex = "(?s).+"
+
+	subjectPrefix  = "subject="
+	issuerPrefix   = "issuer="
+	notAfterPrefix = "notAfter="
+	// notAfterLayout is the layout used by `openssl x509 -enddate`.
+	notAfterLayout = "Jan _2 15:04:05 2006 MST"
+
+	// WillNotExpire is printed by `openssl x509 -checkend` when the certificate is valid long enough.
+	WillNotExpire = "Certificate will not expire"
+	// WillExpire is printed by `openssl x509 -checkend` when the certificate expires within the given window.
+	WillExpire = "Certificate will expire"
+
+	secondsPerDay = 24 * 60 * 60
+)
+
+// CertExpiry checks that a certificate is valid for at least a minimum number of days.
+type CertExpiry struct {
+	subject  string
+	issuer   string
+	notAfter time.Time
+	result   int
+	timeout  time.Duration
+	args     []string
+}
+
+// NewCertExpiryFromEndpoint creates a new CertExpiry tnf.Test that checks the certificate served by a TLS endpoint,
+// given as host:port.
+func NewCertExpiryFromEndpoint(timeout time.Duration, endpoint string, minDaysValid int) *CertExpiry {
+	host := endpoint
+	if i := strings.LastIndex(endpoint, ":"); i > 0 {
+		host = strings.Trim(endpoint[:i], "[]")
+	}
+	command := fmt.Sprintf("echo | %s s_client -connect %s -servername %s 2>/dev/null | %s",
+		dependencies.OpensslBinaryName, endpoint, host, x509Command("", minDaysValid))
+	return newCertExpiry(timeout, command)
+}
+
+// NewCertExpiryFromFile creates a new CertExpiry tnf.Test that checks a PEM encoded certificate file.
+func NewCertExpiryFromFile(timeout time.Duration, path string, minDaysValid int) *CertExpiry {
+	return newCertExpiry(timeout, x509Command(path, minDaysValid))
+}
+
+func newCertExpiry(timeout time.Duration, command string) *CertExpiry {
+	return &CertExpiry{
+		timeout: timeout,
+		result:  tnf.ERROR,
+		args:    strings.Split(command, " "),
+	}
+}
+
+func x509Command(path string, minDaysValid int) string {
+	command := fmt.Sprintf("%s x509 -noout -subject -issuer -enddate -checkend %d", dependencies.OpensslBinaryName, minDaysValid*secondsPerDay)
+	if path != "" {
+		command += " -in " + path
+	}
+	return command
+}
+
+// Args returns the command line args for the test.
+func (ce *CertExpiry) Args() []string {
+	return ce.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (ce *CertExpiry) GetIdentifier() identifier.Identifier {
+	return identifier.CertExpiryIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (ce *CertExpiry) Timeout() time.Duration {
+	return ce.timeout
+}
+
+// Result returns the test result.
+func (ce *CertExpiry) Result() int {
+	return ce.result
+}
+
+// GetSubject returns the subject of the certificate.
+func (ce *CertExpiry) GetSubject() string {
+	return ce.subject
+}
+
+// GetIssuer returns the issuer of the certificate.
+func (ce *CertExpiry) GetIssuer() string {
+	return ce.issuer
+}
+
+// GetNotAfter returns the expiry date of the certificate.
+func (ce *CertExpiry) GetNotAfter() time.Time {
+	return ce.notAfter
+}
+
+// ReelFirst returns a step which expects the openssl output within the test timeout.
+func (ce *CertExpiry) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{ceRegex},
+		Timeout: ce.timeout,
+	}
+}
+
+// ReelMatch extracts the certificate details and checks whether it expires within the given window.
+func (ce *CertExpiry) ReelMatch(_, _, match string) *reel.Step {
+	var checkend string
+	for _, line := range strings.Split(match, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(line, subjectPrefix):
+			ce.subject = strings.TrimSpace(strings.TrimPrefix(line, subjectPrefix))
+		case strings.HasPrefix(line, issuerPrefix):
+			ce.issuer = strings.TrimSpace(strings.TrimPrefix(line, issuerPrefix))
+		case strings.HasPrefix(line, notAfterPrefix):
+			notAfter, err := time.Parse(notAfterLayout, strings.TrimPrefix(line, notAfterPrefix))
+			if err == nil {
+				ce.notAfter = notAfter
+			}
+		case line == WillNotExpire || line == WillExpire:
+			checkend = line
+		}
+	}
+
+	switch {
+	case ce.notAfter.IsZero():
+		ce.result = tnf.ERROR
+	case checkend == WillNotExpire:
+		ce.result = tnf.SUCCESS
+	case checkend == WillExpire:
+		ce.result = tnf.FAILURE
+	default:
+		ce.result = tnf.ERROR
+	}
+	return nil
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (ce *CertExpiry) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (ce *CertExpiry) ReelEOF() {
+}
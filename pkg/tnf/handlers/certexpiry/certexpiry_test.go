@@ -0,0 +1,104 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package certexpiry_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	ce "github.com/test-network-function/test-network-function/pkg/tnf/handlers/certexpiry"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+func Test_NewCertExpiryFromEndpoint(t *testing.T) {
+	newCe := ce.NewCertExpiryFromEndpoint(testTimeoutDuration, "example.com:443", testMinDaysValid)
+	assert.NotNil(t, newCe)
+	assert.Equal(t, testTimeoutDuration, newCe.Timeout())
+	assert.Equal(t, tnf.ERROR, newCe.Result())
+	assert.Equal(t, identifier.CertExpiryIdentifier, newCe.GetIdentifier())
+	assert.Equal(t, []string{"echo", "|", "openssl", "s_client", "-connect", "example.com:443", "-servername", "example.com",
+		"2>/dev/null", "|", "openssl", "x509", "-noout", "-subject", "-issuer", "-enddate", "-checkend", "2592000"}, newCe.Args())
+}
+
+func Test_NewCertExpiryFromFile(t *testing.T) {
+	newCe := ce.NewCertExpiryFromFile(testTimeoutDuration, "/etc/tls/tls.crt", testMinDaysValid)
+	assert.NotNil(t, newCe)
+	assert.Equal(t, []string{"openssl", "x509", "-noout", "-subject", "-issuer", "-enddate", "-checkend", "2592000",
+		"-in", "/etc/tls/tls.crt"}, newCe.Args())
+}
+
+func Test_ReelFirstPositive(t *testing.T) {
+	newCe := ce.NewCertExpiryFromFile(testTimeoutDuration, "/etc/tls/tls.crt", testMinDaysValid)
+	firstStep := newCe.ReelFirst()
+	re := regexp.MustCompile(firstStep.Expect[0])
+	matches := re.FindStringSubmatch(testInputSuccess)
+	assert.Len(t, matches, 1)
+	assert.Equal(t, testInputSuccess, matches[0])
+}
+
+func Test_ReelFirstNegative(t *testing.T) {
+	newCe := ce.NewCertExpiryFromFile(testTimeoutDuration, "/etc/tls/tls.crt", testMinDaysValid)
+	firstStep := newCe.ReelFirst()
+	re := regexp.MustCompile(firstStep.Expect[0])
+	matches := re.FindStringSubmatch(testInputError)
+	assert.Len(t, matches, 0)
+}
+
+func Test_ReelMatchSuccess(t *testing.T) {
+	newCe := ce.NewCertExpiryFromEndpoint(testTimeoutDuration, "example.com:443", testMinDaysValid)
+	step := newCe.ReelMatch("", "", testInputSuccess)
+	assert.Nil(t, step)
+	assert.Equal(t, tnf.SUCCESS, newCe.Result())
+	assert.Equal(t, "CN = example.com", newCe.GetSubject())
+	assert.Equal(t, "C = US, O = Let's Encrypt, CN = R3", newCe.GetIssuer())
+	assert.Equal(t, time.Date(2030, time.January, 2, 3, 4, 5, 0, time.UTC), newCe.GetNotAfter().UTC())
+}
+
+func Test_ReelMatchFailure(t *testing.T) {
+	newCe := ce.NewCertExpiryFromEndpoint(testTimeoutDuration, "example.com:443", testMinDaysValid)
+	step := newCe.ReelMatch("", "", testInputFailure)
+	assert.Nil(t, step)
+	assert.Equal(t, tnf.FAILURE, newCe.Result())
+}
+
+func Test_ReelMatchNoCertificate(t *testing.T) {
+	newCe := ce.NewCertExpiryFromEndpoint(testTimeoutDuration, "example.com:443", testMinDaysValid)
+	step := newCe.ReelMatch("", "", testInputNoCertificate)
+	assert.Nil(t, step)
+	assert.Equal(t, tnf.ERROR, newCe.Result())
+}
+
+// Just ensure there are no panics.
+func Test_ReelEof(t *testing.T) {
+	newCe := ce.NewCertExpiryFromFile(testTimeoutDuration, "/etc/tls/tls.crt", testMinDaysValid)
+	assert.Nil(t, newCe.ReelTimeout())
+	newCe.ReelEOF()
+}
+
+const (
+	testTimeoutDuration = time.Second * 2
+	testMinDaysValid    = 30
+	testInputError      = ""
+	testInputSuccess    = "subject=CN = example.com\nissuer=C = US, O = Let's Encrypt, CN = R3\n" +
+		"notAfter=Jan  2 03:04:05 2030 GMT\nCertificate will not expire\n"
+	testInputFailure = "subject=CN = example.com\nissuer=C = US, O = Let's Encrypt, CN = R3\n" +
+		"notAfter=Jan  2 03:04:05 2021 GMT\nCertificate will expire\n"
+	testInputNoCertificate = "unable to load certificate\n"
+)
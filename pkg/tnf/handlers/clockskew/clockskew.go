@@ -0,0 +1,138 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package clockskew
+
+import (
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/dependencies"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	decimalBase = 10
+	bitSize     = 64
+	halves      = 2
+)
+
+// nanosecondsRegex matches the output of `date +%s%N`.
+var nanosecondsRegex = regexp.MustCompile(`(?m)^(\d{19})\s*$`)
+
+// ClockSkew measures the offset of a remote clock relative to the test machine clock.
+type ClockSkew struct {
+	maxSkew     time.Duration
+	startTime   time.Time
+	offset      time.Duration
+	uncertainty time.Duration
+	result      int
+	timeout     time.Duration
+	command     string
+}
+
+// NewClockSkew creates a new ClockSkew tnf.Test.  The remote time is compared to the middle of the interval between
+// the sending of the command and the reception of its output.
+func NewClockSkew(timeout, maxSkew time.Duration) *ClockSkew {
+	return &ClockSkew{
+		maxSkew: maxSkew,
+		timeout: timeout,
+		result:  tnf.ERROR,
+		command: dependencies.DateBinaryName + " +%s%N",
+	}
+}
+
+// Args returns nil;  the command is sent by ReelFirst, so that the time it is sent at is known.
+func (cs *ClockSkew) Args() []string {
+	return nil
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (cs *ClockSkew) GetIdentifier() identifier.Identifier {
+	return identifier.ClockSkewIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (cs *ClockSkew) Timeout() time.Duration {
+	return cs.timeout
+}
+
+// Result returns the test result.
+func (cs *ClockSkew) Result() int {
+	return cs.result
+}
+
+// GetOffset returns the remote clock offset;  a positive offset means the remote clock is ahead.
+func (cs *ClockSkew) GetOffset() time.Duration {
+	return cs.offset
+}
+
+// GetUncertainty returns the measurement uncertainty, which is half of the time taken to get the remote time.
+func (cs *ClockSkew) GetUncertainty() time.Duration {
+	return cs.uncertainty
+}
+
+// ReelFirst records the current time and returns a step which sends the command and expects the remote time within
+// the test timeout.
+func (cs *ClockSkew) ReelFirst() *reel.Step {
+	cs.startTime = time.Now()
+	return &reel.Step{
+		Execute: cs.command,
+		Expect:  []string{nanosecondsRegex.String()},
+		Timeout: cs.timeout,
+	}
+}
+
+// ReelMatch computes the clock offset.  The test fails only when the offset exceeds maxSkew by more than the
+// measurement uncertainty, so a slow round trip does not produce false failures.
+func (cs *ClockSkew) ReelMatch(_, _, match string) *reel.Step {
+	endTime := time.Now()
+	matched := nanosecondsRegex.FindStringSubmatch(match)
+	if matched == nil {
+		cs.result = tnf.ERROR
+		return nil
+	}
+	nanoseconds, err := strconv.ParseInt(matched[1], decimalBase, bitSize)
+	if err != nil {
+		cs.result = tnf.ERROR
+		return nil
+	}
+	cs.uncertainty = endTime.Sub(cs.startTime) / halves
+	cs.offset = time.Unix(0, nanoseconds).Sub(cs.startTime.Add(cs.uncertainty))
+
+	absOffset := cs.offset
+	if absOffset < 0 {
+		absOffset = -absOffset
+	}
+	if absOffset-cs.uncertainty > cs.maxSkew {
+		cs.result = tnf.FAILURE
+	} else {
+		cs.result = tnf.SUCCESS
+	}
+	return nil
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (cs *ClockSkew) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (cs *ClockSkew) ReelEOF() {
+}
@@ -0,0 +1,98 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package clockskew_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/clockskew"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testTimeoutDuration = time.Second * 2
+	testMaxSkew         = time.Second
+)
+
+func remoteOutput(offset time.Duration) string {
+	return fmt.Sprintf("%d\n", time.Now().Add(offset).UnixNano())
+}
+
+func TestNewClockSkew(t *testing.T) {
+	handler := clockskew.NewClockSkew(testTimeoutDuration, testMaxSkew)
+	assert.Nil(t, handler.Args())
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.ClockSkewIdentifier, handler.GetIdentifier())
+}
+
+func TestClockSkew_ReelFirst(t *testing.T) {
+	handler := clockskew.NewClockSkew(testTimeoutDuration, testMaxSkew)
+	step := handler.ReelFirst()
+	assert.Equal(t, "date +%s%N", step.Execute)
+	re := regexp.MustCompile(step.Expect[0])
+	assert.True(t, re.MatchString("1634050000123456789\n"))
+	assert.False(t, re.MatchString("date: invalid date\n"))
+}
+
+func TestClockSkew_ReelMatch(t *testing.T) {
+	testCases := map[string]struct {
+		offset         time.Duration
+		expectedResult int
+	}{
+		"in_sync":       {0, tnf.SUCCESS},
+		"within_bound":  {-500 * time.Millisecond, tnf.SUCCESS},
+		"remote_ahead":  {10 * time.Second, tnf.FAILURE},
+		"remote_behind": {-10 * time.Second, tnf.FAILURE},
+	}
+	for testName, testCase := range testCases {
+		handler := clockskew.NewClockSkew(testTimeoutDuration, testMaxSkew)
+		handler.ReelFirst()
+		assert.Nil(t, handler.ReelMatch("", "", remoteOutput(testCase.offset)))
+		assert.Equal(t, testCase.expectedResult, handler.Result(), testName)
+		assert.InDelta(t, testCase.offset, handler.GetOffset(), float64(100*time.Millisecond), testName)
+		assert.True(t, handler.GetUncertainty() >= 0, testName)
+	}
+}
+
+func TestClockSkew_UncertaintyFromReelFirst(t *testing.T) {
+	handler := clockskew.NewClockSkew(testTimeoutDuration, testMaxSkew)
+	// The time between the creation of the test and the sending of the command is not part of the measurement.
+	time.Sleep(200 * time.Millisecond)
+	handler.ReelFirst()
+	assert.Nil(t, handler.ReelMatch("", "", remoteOutput(0)))
+	assert.Less(t, int64(handler.GetUncertainty()), int64(100*time.Millisecond))
+}
+
+func TestClockSkew_ReelMatchError(t *testing.T) {
+	handler := clockskew.NewClockSkew(testTimeoutDuration, testMaxSkew)
+	handler.ReelFirst()
+	assert.Nil(t, handler.ReelMatch("", "", "%N\n"))
+	assert.Equal(t, tnf.ERROR, handler.Result())
+}
+
+// Ensure there are no panics.
+func TestClockSkew_ReelEof(t *testing.T) {
+	handler := clockskew.NewClockSkew(testTimeoutDuration, testMaxSkew)
+	assert.Nil(t, handler.ReelTimeout())
+	handler.ReelEOF()
+}
@@ -0,0 +1,188 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package clusterplatform
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	clusterPlatformRegex = "(?s).+"
+
+	ocpVersionKey  = "OCP_VERSION"
+	platformKey    = "PLATFORM"
+	networkTypeKey = "NETWORK_TYPE"
+	mtuKey         = "MTU"
+	nodeKey        = "NODE"
+
+	command = `echo "` + ocpVersionKey + `=$(oc get clusterversion version -o jsonpath='{.status.desired.version}')"; ` +
+		`echo "` + platformKey + `=$(oc get infrastructure cluster -o jsonpath='{.status.platformStatus.type}')"; ` +
+		`echo "` + networkTypeKey + `=$(oc get network.config cluster -o jsonpath='{.status.networkType}')"; ` +
+		`echo "` + mtuKey + `=$(oc get network.config cluster -o jsonpath='{.status.clusterNetworkMTU}')"; ` +
+		`oc get nodes -o jsonpath='{range .items[*]}` + nodeKey + `={.metadata.name}|{.status.nodeInfo.kubeletVersion}|` +
+		`{.status.nodeInfo.osImage}|{.status.nodeInfo.kernelVersion}|{.status.nodeInfo.architecture}|` +
+		`{.status.capacity.cpu}|{.status.capacity.memory}|{.metadata.labels}{"\n"}{end}'`
+	numNodeFields = 8
+
+	nodeRoleLabelPrefix = "node-role.kubernetes.io/"
+)
+
+// Node is the inventory entry of a cluster node.
+type Node struct {
+	Name           string   `json:"name"`
+	Roles          []string `json:"roles"`
+	KubeletVersion string   `json:"kubeletVersion"`
+	OSImage        string   `json:"osImage"`
+	KernelVersion  string   `json:"kernelVersion"`
+	Architecture   string   `json:"architecture"`
+	CPU            string   `json:"cpu"`
+	Memory         string   `json:"memory"`
+}
+
+// Platform describes the cluster the tests ran against.  The OpenShift specific fields are empty on other Kubernetes
+// distributions.
+type Platform struct {
+	OCPVersion  string `json:"ocpVersion"`
+	Platform    string `json:"platform"`
+	NetworkType string `json:"networkType"`
+	MTU         int    `json:"mtu"`
+	Nodes       []Node `json:"nodes"`
+}
+
+// ClusterPlatform collects the description of the cluster platform.
+type ClusterPlatform struct {
+	platform Platform
+	result   int
+	timeout  time.Duration
+	args     []string
+}
+
+// NewClusterPlatform creates a new ClusterPlatform tnf.Test.
+func NewClusterPlatform(timeout time.Duration) *ClusterPlatform {
+	return &ClusterPlatform{
+		timeout: timeout,
+		result:  tnf.ERROR,
+		args:    []string{command},
+	}
+}
+
+// Args returns the command line args for the test.
+func (cp *ClusterPlatform) Args() []string {
+	return cp.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (cp *ClusterPlatform) GetIdentifier() identifier.Identifier {
+	return identifier.ClusterPlatformIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (cp *ClusterPlatform) Timeout() time.Duration {
+	return cp.timeout
+}
+
+// Result returns the test result.
+func (cp *ClusterPlatform) Result() int {
+	return cp.result
+}
+
+// GetPlatform returns the description of the cluster platform.
+func (cp *ClusterPlatform) GetPlatform() Platform {
+	return cp.platform
+}
+
+// ReelFirst returns a step which expects the cluster description within the test timeout.
+func (cp *ClusterPlatform) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{clusterPlatformRegex},
+		Timeout: cp.timeout,
+	}
+}
+
+// ReelMatch parses the "<KEY>=<value>" lines of the cluster description.  The test fails when no node is found.
+func (cp *ClusterPlatform) ReelMatch(_, _, match string) *reel.Step {
+	cp.platform = Platform{}
+	for _, line := range strings.Split(match, "\n") {
+		fields := strings.SplitN(strings.TrimSpace(line), "=", 2)
+		if len(fields) != 2 {
+			continue
+		}
+		switch key, value := fields[0], fields[1]; key {
+		case ocpVersionKey:
+			cp.platform.OCPVersion = value
+		case platformKey:
+			cp.platform.Platform = value
+		case networkTypeKey:
+			cp.platform.NetworkType = value
+		case mtuKey:
+			cp.platform.MTU, _ = strconv.Atoi(value)
+		case nodeKey:
+			if node, ok := parseNode(value); ok {
+				cp.platform.Nodes = append(cp.platform.Nodes, node)
+			}
+		}
+	}
+	if len(cp.platform.Nodes) == 0 {
+		cp.result = tnf.FAILURE
+	} else {
+		cp.result = tnf.SUCCESS
+	}
+	return nil
+}
+
+func parseNode(value string) (Node, bool) {
+	fields := strings.SplitN(value, "|", numNodeFields)
+	if len(fields) != numNodeFields {
+		return Node{}, false
+	}
+	node := Node{
+		Name:           fields[0],
+		KubeletVersion: fields[1],
+		OSImage:        fields[2],
+		KernelVersion:  fields[3],
+		Architecture:   fields[4],
+		CPU:            fields[5],
+		Memory:         fields[6],
+	}
+	var labels map[string]string
+	if err := json.Unmarshal([]byte(fields[7]), &labels); err == nil {
+		for label := range labels {
+			if strings.HasPrefix(label, nodeRoleLabelPrefix) {
+				node.Roles = append(node.Roles, strings.TrimPrefix(label, nodeRoleLabelPrefix))
+			}
+		}
+		sort.Strings(node.Roles)
+	}
+	return node, true
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (cp *ClusterPlatform) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (cp *ClusterPlatform) ReelEOF() {
+}
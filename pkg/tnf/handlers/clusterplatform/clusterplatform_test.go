@@ -0,0 +1,106 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package clusterplatform_test
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/clusterplatform"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testDataDirectory   = "testdata"
+	testTimeoutDuration = time.Second * 2
+)
+
+func getMockOutput(t *testing.T, testName string) string {
+	b, err := os.ReadFile(path.Join(testDataDirectory, testName+".txt"))
+	assert.Nil(t, err)
+	return string(b)
+}
+
+func TestNewClusterPlatform(t *testing.T) {
+	handler := clusterplatform.NewClusterPlatform(testTimeoutDuration)
+	assert.Len(t, handler.Args(), 1)
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.ClusterPlatformIdentifier, handler.GetIdentifier())
+}
+
+func TestClusterPlatform_ReelMatch(t *testing.T) {
+	testCases := map[string]clusterplatform.Platform{
+		"ocp": {
+			OCPVersion:  "4.9.10",
+			Platform:    "BareMetal",
+			NetworkType: "OVNKubernetes",
+			MTU:         1400,
+			Nodes: []clusterplatform.Node{
+				{
+					Name:           "master-0",
+					Roles:          []string{"master"},
+					KubeletVersion: "v1.22.3+ffbb954",
+					OSImage:        "Red Hat Enterprise Linux CoreOS 49.84.202112021551-0 (Ootpa)",
+					KernelVersion:  "4.18.0-305.28.1.el8_4.x86_64",
+					Architecture:   "amd64",
+					CPU:            "8",
+					Memory:         "32776180Ki",
+				},
+				{
+					Name:           "worker-0",
+					Roles:          []string{"infra", "worker"},
+					KubeletVersion: "v1.22.3+ffbb954",
+					OSImage:        "Red Hat Enterprise Linux CoreOS 49.84.202112021551-0 (Ootpa)",
+					KernelVersion:  "4.18.0-305.28.1.el8_4.x86_64",
+					Architecture:   "amd64",
+					CPU:            "16",
+					Memory:         "65776180Ki",
+				},
+			},
+		},
+		"minikube": {
+			Nodes: []clusterplatform.Node{
+				{
+					Name:           "minikube",
+					KubeletVersion: "v1.22.3",
+					OSImage:        "Ubuntu 20.04.2 LTS",
+					KernelVersion:  "5.10.57",
+					Architecture:   "amd64",
+					CPU:            "2",
+					Memory:         "4030460Ki",
+				},
+			},
+		},
+	}
+	for name, expected := range testCases {
+		handler := clusterplatform.NewClusterPlatform(testTimeoutDuration)
+		assert.Nil(t, handler.ReelMatch("", "", getMockOutput(t, name)), name)
+		assert.Equal(t, tnf.SUCCESS, handler.Result(), name)
+		assert.Equal(t, expected, handler.GetPlatform(), name)
+	}
+}
+
+func TestClusterPlatform_ReelMatchNoNodes(t *testing.T) {
+	handler := clusterplatform.NewClusterPlatform(testTimeoutDuration)
+	assert.Nil(t, handler.ReelMatch("", "", "error: You must be logged in to the server (Unauthorized)\n"))
+	assert.Equal(t, tnf.FAILURE, handler.Result())
+}
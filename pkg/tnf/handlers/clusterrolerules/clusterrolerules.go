@@ -0,0 +1,176 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package clusterrolerules
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	crrRegex = "(?s).+"
+	// rulesMarker is printed before the rules, so that a role without rules still produces some output.
+	rulesMarker   = "CLUSTER_ROLE_RULES"
+	errorPrefix   = "Error from server"
+	numRuleFields = 3
+	coreAPIGroup  = ""
+	wildcard      = "*"
+)
+
+// writeVerbs are the verbs modifying resources.
+var writeVerbs = map[string]bool{
+	"create": true, "update": true, "patch": true, "delete": true, "deletecollection": true, wildcard: true,
+}
+
+// Rule is a resource rule of a cluster role.
+type Rule struct {
+	APIGroups []string
+	Resources []string
+	Verbs     []string
+}
+
+// ClusterRoleRules checks that a cluster role grants no write access to the resources of the core API group.
+type ClusterRoleRules struct {
+	rules      []Rule
+	violations []string
+	result     int
+	timeout    time.Duration
+	args       []string
+}
+
+// NewClusterRoleRules creates a new ClusterRoleRules tnf.Test for the cluster role `name`.  Non-resource URL rules are
+// ignored.
+func NewClusterRoleRules(timeout time.Duration, name string) *ClusterRoleRules {
+	return &ClusterRoleRules{
+		timeout: timeout,
+		result:  tnf.ERROR,
+		args: []string{fmt.Sprintf("echo %s; oc get clusterrole %s -o jsonpath='"+
+			`{range .rules[*]}{.apiGroups}|{.resources}|{.verbs}{"\n"}{end}'`, rulesMarker, name)},
+	}
+}
+
+// Args returns the command line args for the test.
+func (crr *ClusterRoleRules) Args() []string {
+	return crr.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (crr *ClusterRoleRules) GetIdentifier() identifier.Identifier {
+	return identifier.ClusterRoleRulesIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (crr *ClusterRoleRules) Timeout() time.Duration {
+	return crr.timeout
+}
+
+// Result returns the test result.
+func (crr *ClusterRoleRules) Result() int {
+	return crr.result
+}
+
+// GetRules returns the resource rules of the cluster role.
+func (crr *ClusterRoleRules) GetRules() []Rule {
+	return crr.rules
+}
+
+// GetViolations returns a description of every rule granting write access to core resources.
+func (crr *ClusterRoleRules) GetViolations() []string {
+	return crr.violations
+}
+
+// ReelFirst returns a step which expects the cluster role rules within the test timeout.
+func (crr *ClusterRoleRules) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{crrRegex},
+		Timeout: crr.timeout,
+	}
+}
+
+// ReelMatch parses the cluster role rules and looks for write access to core resources.
+func (crr *ClusterRoleRules) ReelMatch(_, _, match string) *reel.Step {
+	crr.rules = nil
+	crr.violations = nil
+	if !strings.Contains(match, rulesMarker) || strings.Contains(match, errorPrefix) {
+		crr.result = tnf.ERROR
+		return nil
+	}
+	for _, line := range strings.Split(match, "\n") {
+		rule, ok := parseRule(line)
+		if !ok {
+			continue
+		}
+		crr.rules = append(crr.rules, rule)
+		if rule.writesCoreResources() {
+			crr.violations = append(crr.violations, fmt.Sprintf("verbs %v on resources %v of API groups %q", rule.Verbs, rule.Resources, rule.APIGroups))
+		}
+	}
+	if len(crr.violations) > 0 {
+		crr.result = tnf.FAILURE
+	} else {
+		crr.result = tnf.SUCCESS
+	}
+	return nil
+}
+
+func (rule *Rule) writesCoreResources() bool {
+	core := false
+	for _, group := range rule.APIGroups {
+		if group == coreAPIGroup || group == wildcard {
+			core = true
+		}
+	}
+	if !core {
+		return false
+	}
+	for _, verb := range rule.Verbs {
+		if writeVerbs[verb] {
+			return true
+		}
+	}
+	return false
+}
+
+// parseRule parses a `["apps"]|["deployments"]|["get","list"]` line.
+func parseRule(line string) (Rule, bool) {
+	fields := strings.Split(strings.TrimSpace(line), "|")
+	if len(fields) != numRuleFields {
+		return Rule{}, false
+	}
+	var rule Rule
+	for i, target := range []*[]string{&rule.APIGroups, &rule.Resources, &rule.Verbs} {
+		if err := json.Unmarshal([]byte(fields[i]), target); err != nil {
+			return Rule{}, false
+		}
+	}
+	return rule, true
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (crr *ClusterRoleRules) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (crr *ClusterRoleRules) ReelEOF() {
+}
@@ -0,0 +1,88 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package clusterrolerules_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/clusterrolerules"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testTimeoutDuration = time.Second * 2
+
+	outputReadOnly = `CLUSTER_ROLE_RULES
+[""]|["pods","services"]|["get","list","watch"]
+["apps"]|["deployments"]|["get","update"]
+`
+	outputWrite = `CLUSTER_ROLE_RULES
+[""]|["pods","services"]|["get","list","watch"]
+[""]|["configmaps"]|["create","delete"]
+["*"]|["*"]|["*"]
+`
+	outputEmpty    = "CLUSTER_ROLE_RULES\n"
+	outputNotFound = "CLUSTER_ROLE_RULES\nError from server (NotFound): clusterroles.rbac.authorization.k8s.io \"test\" not found\n"
+)
+
+func TestNewClusterRoleRules(t *testing.T) {
+	handler := clusterrolerules.NewClusterRoleRules(testTimeoutDuration, "test")
+	assert.Len(t, handler.Args(), 1)
+	assert.Contains(t, handler.Args()[0], "oc get clusterrole test -o jsonpath=")
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.ClusterRoleRulesIdentifier, handler.GetIdentifier())
+}
+
+func TestClusterRoleRules_ReelMatch(t *testing.T) {
+	testCases := map[string]struct {
+		output             string
+		expectedResult     int
+		expectedRules      int
+		expectedViolations []string
+	}{
+		"read_only": {output: outputReadOnly, expectedResult: tnf.SUCCESS, expectedRules: 2},
+		"write": {
+			output:         outputWrite,
+			expectedResult: tnf.FAILURE,
+			expectedRules:  3,
+			expectedViolations: []string{
+				`verbs [create delete] on resources [configmaps] of API groups [""]`,
+				`verbs [*] on resources [*] of API groups ["*"]`,
+			},
+		},
+		"empty":     {output: outputEmpty, expectedResult: tnf.SUCCESS},
+		"not_found": {output: outputNotFound, expectedResult: tnf.ERROR},
+	}
+	for name, tc := range testCases {
+		handler := clusterrolerules.NewClusterRoleRules(testTimeoutDuration, "test")
+		assert.Nil(t, handler.ReelMatch("", "", tc.output), name)
+		assert.Equal(t, tc.expectedResult, handler.Result(), name)
+		assert.Len(t, handler.GetRules(), tc.expectedRules, name)
+		assert.Equal(t, tc.expectedViolations, handler.GetViolations(), name)
+	}
+}
+
+// Just ensure there are no panics.
+func TestClusterRoleRules_ReelEOF(t *testing.T) {
+	handler := clusterrolerules.NewClusterRoleRules(testTimeoutDuration, "test")
+	assert.Nil(t, handler.ReelTimeout())
+	handler.ReelEOF()
+}
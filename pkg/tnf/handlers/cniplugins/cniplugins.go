@@ -0,0 +1,194 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package cniplugins
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	cniPluginsRegex = "(?s).+"
+
+	networkTypeKey = "NETWORK_TYPE"
+	// attachmentsMarker separates the primary CNI from the NetworkAttachmentDefinitions manifest.
+	attachmentsMarker = "NETWORK_ATTACHMENTS"
+)
+
+// pluginConfig is the subset of a CNI configuration, or configuration list, naming its plugins.
+type pluginConfig struct {
+	Type    string         `json:"type"`
+	Plugins []pluginConfig `json:"plugins"`
+}
+
+type networkAttachments struct {
+	Items []struct {
+		Metadata struct {
+			Name      string `json:"name"`
+			Namespace string `json:"namespace"`
+		} `json:"metadata"`
+		Spec struct {
+			Config string `json:"config"`
+		} `json:"spec"`
+	} `json:"items"`
+}
+
+// CNIs describes the networking of the cluster.
+type CNIs struct {
+	// Primary is the network type of the cluster default network, such as OVNKubernetes or OpenShiftSDN.  It is empty
+	// on other Kubernetes distributions.
+	Primary string
+	// Multus is true when the NetworkAttachmentDefinition resource is served by the cluster.
+	Multus bool
+	// Plugins indexes the "<namespace>/<name>" of the NetworkAttachmentDefinitions by CNI plugin type, such as macvlan
+	// or sriov.
+	Plugins map[string][]string
+}
+
+// HasPlugin returns true when a NetworkAttachmentDefinition of the cluster uses the plugin type.
+func (c *CNIs) HasPlugin(pluginType string) bool {
+	return len(c.Plugins[pluginType]) > 0
+}
+
+// GetPluginTypes returns the sorted plugin types used by the NetworkAttachmentDefinitions of the cluster.
+func (c *CNIs) GetPluginTypes() []string {
+	types := make([]string, 0, len(c.Plugins))
+	for pluginType := range c.Plugins {
+		types = append(types, pluginType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// CNIPlugins detects the primary CNI of the cluster and the CNI plugins used by its NetworkAttachmentDefinitions.
+type CNIPlugins struct {
+	cnis    CNIs
+	result  int
+	timeout time.Duration
+	args    []string
+}
+
+// NewCNIPlugins creates a new CNIPlugins tnf.Test.
+func NewCNIPlugins(timeout time.Duration) *CNIPlugins {
+	return &CNIPlugins{
+		timeout: timeout,
+		result:  tnf.ERROR,
+		args: []string{fmt.Sprintf(`echo "%s=$(oc get network.config cluster -o jsonpath='{.status.networkType}')"; echo %s; `+
+			`oc get network-attachment-definitions.k8s.cni.cncf.io -A -o json`, networkTypeKey, attachmentsMarker)},
+	}
+}
+
+// Args returns the command line args for the test.
+func (cp *CNIPlugins) Args() []string {
+	return cp.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (cp *CNIPlugins) GetIdentifier() identifier.Identifier {
+	return identifier.CNIPluginsIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (cp *CNIPlugins) Timeout() time.Duration {
+	return cp.timeout
+}
+
+// Result returns the test result.
+func (cp *CNIPlugins) Result() int {
+	return cp.result
+}
+
+// GetCNIs returns the detected CNIs.
+func (cp *CNIPlugins) GetCNIs() CNIs {
+	return cp.cnis
+}
+
+// ReelFirst returns a step which expects the primary CNI and the NetworkAttachmentDefinitions within the test timeout.
+func (cp *CNIPlugins) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{cniPluginsRegex},
+		Timeout: cp.timeout,
+	}
+}
+
+// ReelMatch parses the network type of the cluster and the NetworkAttachmentDefinitions.  The
+// NetworkAttachmentDefinition resource not being served means Multus is not installed, which is not an error.
+func (cp *CNIPlugins) ReelMatch(_, _, match string) *reel.Step {
+	cp.cnis = CNIs{Plugins: map[string][]string{}}
+	parts := strings.SplitN(match, "\n"+attachmentsMarker, 2)
+	if len(parts) != 2 {
+		cp.result = tnf.ERROR
+		return nil
+	}
+	for _, line := range strings.Split(parts[0], "\n") {
+		if line = strings.TrimSpace(line); strings.HasPrefix(line, networkTypeKey+"=") {
+			cp.cnis.Primary = strings.TrimPrefix(line, networkTypeKey+"=")
+		}
+	}
+	start := strings.Index(parts[1], "{")
+	if start < 0 {
+		cp.result = tnf.SUCCESS
+		return nil
+	}
+	var attachments networkAttachments
+	if err := json.Unmarshal([]byte(strings.TrimSpace(parts[1][start:])), &attachments); err != nil {
+		cp.result = tnf.ERROR
+		return nil
+	}
+	cp.cnis.Multus = true
+	for i := range attachments.Items {
+		attachment := &attachments.Items[i]
+		name := attachment.Metadata.Namespace + "/" + attachment.Metadata.Name
+		var config pluginConfig
+		if err := json.Unmarshal([]byte(attachment.Spec.Config), &config); err != nil {
+			continue
+		}
+		for _, pluginType := range config.types() {
+			cp.cnis.Plugins[pluginType] = append(cp.cnis.Plugins[pluginType], name)
+		}
+	}
+	cp.result = tnf.SUCCESS
+	return nil
+}
+
+// types returns the plugin types of a CNI configuration, or of every plugin of a configuration list.
+func (pc *pluginConfig) types() []string {
+	var types []string
+	if pc.Type != "" {
+		types = append(types, pc.Type)
+	}
+	for i := range pc.Plugins {
+		types = append(types, pc.Plugins[i].types()...)
+	}
+	return types
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (cp *CNIPlugins) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (cp *CNIPlugins) ReelEOF() {
+}
@@ -0,0 +1,86 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package cniplugins_test
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/cniplugins"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testDataDirectory   = "testdata"
+	testTimeoutDuration = time.Second * 2
+)
+
+func getMockOutput(t *testing.T, testName string) string {
+	b, err := os.ReadFile(path.Join(testDataDirectory, testName+".txt"))
+	assert.Nil(t, err)
+	return string(b)
+}
+
+func TestNewCNIPlugins(t *testing.T) {
+	handler := cniplugins.NewCNIPlugins(testTimeoutDuration)
+	assert.Len(t, handler.Args(), 1)
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.CNIPluginsIdentifier, handler.GetIdentifier())
+}
+
+func TestCNIPlugins_ReelMatch(t *testing.T) {
+	testCases := map[string]cniplugins.CNIs{
+		"multus": {
+			Primary: "OVNKubernetes",
+			Multus:  true,
+			Plugins: map[string][]string{
+				"macvlan": {"tnf/macvlan-bridge", "other/macvlan-private"},
+				"sriov":   {"tnf/sriov-net"},
+				"tuning":  {"tnf/sriov-net"},
+			},
+		},
+		"nomultus": {
+			Plugins: map[string][]string{},
+		},
+	}
+	for name, expected := range testCases {
+		handler := cniplugins.NewCNIPlugins(testTimeoutDuration)
+		assert.Nil(t, handler.ReelMatch("", "", getMockOutput(t, name)), name)
+		assert.Equal(t, tnf.SUCCESS, handler.Result(), name)
+		assert.Equal(t, expected, handler.GetCNIs(), name)
+	}
+}
+
+func TestCNIs_HasPlugin(t *testing.T) {
+	handler := cniplugins.NewCNIPlugins(testTimeoutDuration)
+	assert.Nil(t, handler.ReelMatch("", "", getMockOutput(t, "multus")))
+	cnis := handler.GetCNIs()
+	assert.True(t, cnis.HasPlugin("macvlan"))
+	assert.False(t, cnis.HasPlugin("ipvlan"))
+	assert.Equal(t, []string{"macvlan", "sriov", "tuning"}, cnis.GetPluginTypes())
+}
+
+func TestCNIPlugins_ReelMatchError(t *testing.T) {
+	handler := cniplugins.NewCNIPlugins(testTimeoutDuration)
+	assert.Nil(t, handler.ReelMatch("", "", "error: You must be logged in to the server (Unauthorized)\n"))
+	assert.Equal(t, tnf.ERROR, handler.Result())
+}
@@ -0,0 +1,152 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package configmaps
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	configMapsRegex = "(?s).+"
+)
+
+// ConfigMap is the content of a ConfigMap.
+type ConfigMap struct {
+	Data       map[string]string `json:"data"`
+	BinaryData map[string]string `json:"binaryData"`
+}
+
+// Equal returns true when both ConfigMaps hold the same data.
+func (cm ConfigMap) Equal(other ConfigMap) bool {
+	return len(cm.ChangedKeys(other)) == 0
+}
+
+// ChangedKeys returns the sorted keys added, removed or modified between both ConfigMaps.
+func (cm ConfigMap) ChangedKeys(other ConfigMap) []string {
+	changed := map[string]bool{}
+	for _, pair := range [][2]map[string]string{{cm.Data, other.Data}, {cm.BinaryData, other.BinaryData}} {
+		for key, value := range pair[0] {
+			if otherValue, ok := pair[1][key]; !ok || otherValue != value {
+				changed[key] = true
+			}
+		}
+		for key := range pair[1] {
+			if _, ok := pair[0][key]; !ok {
+				changed[key] = true
+			}
+		}
+	}
+	keys := make([]string, 0, len(changed))
+	for key := range changed {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ConfigMaps collects the ConfigMaps of a namespace, indexed by name.
+type ConfigMaps struct {
+	configMaps map[string]ConfigMap
+	result     int
+	timeout    time.Duration
+	args       []string
+}
+
+// NewConfigMaps creates a new ConfigMaps tnf.Test.
+func NewConfigMaps(timeout time.Duration, namespace string) *ConfigMaps {
+	return &ConfigMaps{
+		timeout: timeout,
+		result:  tnf.ERROR,
+		args:    []string{"oc", "get", "configmaps", "-n", namespace, "-o", "json"},
+	}
+}
+
+// Args returns the command line args for the test.
+func (c *ConfigMaps) Args() []string {
+	return c.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (c *ConfigMaps) GetIdentifier() identifier.Identifier {
+	return identifier.ConfigMapsIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (c *ConfigMaps) Timeout() time.Duration {
+	return c.timeout
+}
+
+// Result returns the test result.
+func (c *ConfigMaps) Result() int {
+	return c.result
+}
+
+// GetConfigMaps returns the ConfigMaps of the namespace, indexed by name.
+func (c *ConfigMaps) GetConfigMaps() map[string]ConfigMap {
+	return c.configMaps
+}
+
+// ReelFirst returns a step which expects the ConfigMaps list within the test timeout.
+func (c *ConfigMaps) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{configMapsRegex},
+		Timeout: c.timeout,
+	}
+}
+
+// ReelMatch parses the ConfigMaps list.
+func (c *ConfigMaps) ReelMatch(_, _, match string) *reel.Step {
+	start := strings.Index(match, "{")
+	if start < 0 {
+		c.result = tnf.ERROR
+		return nil
+	}
+	var list struct {
+		Items []struct {
+			Metadata struct {
+				Name string `json:"name"`
+			} `json:"metadata"`
+			ConfigMap
+		} `json:"items"`
+	}
+	if err := json.Unmarshal([]byte(strings.TrimSpace(match[start:])), &list); err != nil {
+		c.result = tnf.ERROR
+		return nil
+	}
+	c.configMaps = map[string]ConfigMap{}
+	for i := range list.Items {
+		c.configMaps[list.Items[i].Metadata.Name] = list.Items[i].ConfigMap
+	}
+	c.result = tnf.SUCCESS
+	return nil
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (c *ConfigMaps) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (c *ConfigMaps) ReelEOF() {
+}
@@ -0,0 +1,73 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package configmaps_test
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/configmaps"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testDataDirectory   = "testdata"
+	testTimeoutDuration = time.Second * 2
+)
+
+func getMockOutput(t *testing.T, testName string) string {
+	b, err := os.ReadFile(path.Join(testDataDirectory, testName+".txt"))
+	assert.Nil(t, err)
+	return string(b)
+}
+
+func TestNewConfigMaps(t *testing.T) {
+	handler := configmaps.NewConfigMaps(testTimeoutDuration, "tnf")
+	assert.Equal(t, []string{"oc", "get", "configmaps", "-n", "tnf", "-o", "json"}, handler.Args())
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.ConfigMapsIdentifier, handler.GetIdentifier())
+}
+
+func TestConfigMaps_ReelMatch(t *testing.T) {
+	handler := configmaps.NewConfigMaps(testTimeoutDuration, "tnf")
+	assert.Nil(t, handler.ReelMatch("", "", getMockOutput(t, "configmaps")))
+	assert.Equal(t, tnf.SUCCESS, handler.Result())
+	assert.Equal(t, map[string]configmaps.ConfigMap{
+		"kube-root-ca.crt": {Data: map[string]string{"ca.crt": "-----BEGIN CERTIFICATE-----"}},
+		"settings":         {Data: map[string]string{"mode": "production", "replicas": "3"}, BinaryData: map[string]string{"blob": "AAEC"}},
+		"leader":           {},
+	}, handler.GetConfigMaps())
+
+	handler = configmaps.NewConfigMaps(testTimeoutDuration, "missing")
+	assert.Nil(t, handler.ReelMatch("", "", "Error from server (NotFound): namespaces \"missing\" not found\n"))
+	assert.Equal(t, tnf.ERROR, handler.Result())
+}
+
+func TestConfigMap_ChangedKeys(t *testing.T) {
+	before := configmaps.ConfigMap{Data: map[string]string{"mode": "production", "replicas": "3"}, BinaryData: map[string]string{"blob": "AAEC"}}
+	assert.True(t, before.Equal(before))
+	assert.Empty(t, before.ChangedKeys(before))
+
+	after := configmaps.ConfigMap{Data: map[string]string{"mode": "debug", "owner": "cnf"}, BinaryData: map[string]string{"blob": "AAEC"}}
+	assert.False(t, before.Equal(after))
+	assert.Equal(t, []string{"mode", "owner", "replicas"}, before.ChangedKeys(after))
+}
@@ -0,0 +1,214 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package conntrack
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/dependencies"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	ctRegex = "(?s).+"
+
+	countPrefix = "CONNTRACK_COUNT"
+	maxPrefix   = "CONNTRACK_MAX"
+	percent     = 100
+)
+
+// Flow is the original direction of a conntrack entry.
+type Flow struct {
+	Protocol string
+	State    string
+	Src      string
+	Dst      string
+	Sport    string
+	Dport    string
+}
+
+// Conntrack lists the conntrack entries of a node, checks that expected flows exist and that the table utilization is
+// under a threshold.
+type Conntrack struct {
+	expectedFlows  []*regexp.Regexp
+	maxUtilization int
+	flows          []Flow
+	entries        []string
+	missing        []string
+	count          int
+	max            int
+	result         int
+	timeout        time.Duration
+	args           []string
+}
+
+// NewConntrack creates a new Conntrack tnf.Test to run in a node debug pod.  filters are passed to `conntrack -L`
+// (e.g. "-p", "tcp", "--dport", "8080"), expectedFlows are regular expressions that must each match an entry, and
+// maxUtilizationPercent is the highest accepted nf_conntrack_count / nf_conntrack_max ratio (0 is not checked).
+func NewConntrack(timeout time.Duration, filters, expectedFlows []string, maxUtilizationPercent int) *Conntrack {
+	compiled := make([]*regexp.Regexp, 0, len(expectedFlows))
+	for _, expr := range expectedFlows {
+		compiled = append(compiled, regexp.MustCompile(expr))
+	}
+	command := fmt.Sprintf("chroot /host %s -L %s 2>/dev/null; echo %s $(%s /proc/sys/net/netfilter/nf_conntrack_count); echo %s $(%s /proc/sys/net/netfilter/nf_conntrack_max)",
+		dependencies.ConntrackBinaryName, strings.Join(filters, " "), countPrefix, dependencies.CatBinaryName, maxPrefix, dependencies.CatBinaryName)
+	return &Conntrack{
+		expectedFlows:  compiled,
+		maxUtilization: maxUtilizationPercent,
+		timeout:        timeout,
+		result:         tnf.ERROR,
+		args:           []string{command},
+	}
+}
+
+// Args returns the command line args for the test.
+func (ct *Conntrack) Args() []string {
+	return ct.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (ct *Conntrack) GetIdentifier() identifier.Identifier {
+	return identifier.ConntrackIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (ct *Conntrack) Timeout() time.Duration {
+	return ct.timeout
+}
+
+// Result returns the test result.
+func (ct *Conntrack) Result() int {
+	return ct.result
+}
+
+// GetFlows returns the listed conntrack entries.
+func (ct *Conntrack) GetFlows() []Flow {
+	return ct.flows
+}
+
+// GetMissingFlows returns the expected flow expressions that did not match any entry.
+func (ct *Conntrack) GetMissingFlows() []string {
+	return ct.missing
+}
+
+// GetUtilization returns the conntrack table utilization in percent.
+func (ct *Conntrack) GetUtilization() int {
+	if ct.max == 0 {
+		return 0
+	}
+	return ct.count * percent / ct.max
+}
+
+// ReelFirst returns a step which expects the conntrack output within the test timeout.
+func (ct *Conntrack) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{ctRegex},
+		Timeout: ct.timeout,
+	}
+}
+
+// ReelMatch parses the conntrack entries and table counters, and evaluates the expectations.
+func (ct *Conntrack) ReelMatch(_, _, match string) *reel.Step {
+	ct.flows, ct.entries, ct.missing = nil, nil, nil
+	for _, line := range strings.Split(match, "\n") {
+		line = strings.TrimSpace(line)
+		fields := strings.Fields(line)
+		switch {
+		case len(fields) == 2 && fields[0] == countPrefix:
+			ct.count, _ = strconv.Atoi(fields[1])
+		case len(fields) == 2 && fields[0] == maxPrefix:
+			ct.max, _ = strconv.Atoi(fields[1])
+		case strings.Contains(line, "src=") && strings.Contains(line, "dst="):
+			ct.entries = append(ct.entries, line)
+			ct.flows = append(ct.flows, parseFlow(fields))
+		}
+	}
+	if ct.max == 0 {
+		ct.result = tnf.ERROR
+		return nil
+	}
+
+	for _, re := range ct.expectedFlows {
+		if !matchesAny(re, ct.entries) {
+			ct.missing = append(ct.missing, re.String())
+		}
+	}
+	utilizationExceeded := ct.maxUtilization > 0 && ct.GetUtilization() > ct.maxUtilization
+
+	if len(ct.missing) > 0 || utilizationExceeded {
+		ct.result = tnf.FAILURE
+	} else {
+		ct.result = tnf.SUCCESS
+	}
+	return nil
+}
+
+// parseFlow extracts the original direction of an entry such as
+// "tcp 6 431999 ESTABLISHED src=10.0.0.1 dst=10.0.0.2 sport=41234 dport=8080 src=10.0.0.2 ...".
+// The protocol name, protocol number and timeout come first, followed by the state for stateful protocols.
+func parseFlow(fields []string) Flow {
+	const stateIndex = 3
+	flow := Flow{Protocol: fields[0]}
+	if len(fields) > stateIndex && !strings.Contains(fields[stateIndex], "=") {
+		flow.State = fields[stateIndex]
+	}
+	for _, field := range fields {
+		keyValue := strings.SplitN(field, "=", 2)
+		if len(keyValue) != 2 {
+			continue
+		}
+		switch keyValue[0] {
+		case "src":
+			if flow.Src != "" {
+				// The reply direction starts here.
+				return flow
+			}
+			flow.Src = keyValue[1]
+		case "dst":
+			flow.Dst = keyValue[1]
+		case "sport":
+			flow.Sport = keyValue[1]
+		case "dport":
+			flow.Dport = keyValue[1]
+		}
+	}
+	return flow
+}
+
+func matchesAny(re *regexp.Regexp, entries []string) bool {
+	for _, entry := range entries {
+		if re.MatchString(entry) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (ct *Conntrack) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (ct *Conntrack) ReelEOF() {
+}
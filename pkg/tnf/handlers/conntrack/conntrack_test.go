@@ -0,0 +1,90 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package conntrack_test
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/conntrack"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testDataDirectory   = "testdata"
+	testTimeoutDuration = time.Second * 2
+)
+
+func getMockOutput(t *testing.T) string {
+	b, err := os.ReadFile(path.Join(testDataDirectory, "entries.txt"))
+	assert.Nil(t, err)
+	return string(b)
+}
+
+func TestNewConntrack(t *testing.T) {
+	handler := conntrack.NewConntrack(testTimeoutDuration, []string{"-p", "tcp"}, nil, 0)
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.ConntrackIdentifier, handler.GetIdentifier())
+	assert.Len(t, handler.Args(), 1)
+	assert.Contains(t, handler.Args()[0], "chroot /host conntrack -L -p tcp 2>/dev/null")
+}
+
+func TestConntrack_ReelMatchSuccess(t *testing.T) {
+	handler := conntrack.NewConntrack(testTimeoutDuration, nil, []string{`dst=172.30.0.10 sport=\d+ dport=8080`}, 60)
+	step := handler.ReelMatch("", "", getMockOutput(t))
+	assert.Nil(t, step)
+	assert.Equal(t, tnf.SUCCESS, handler.Result())
+	assert.Equal(t, 50, handler.GetUtilization())
+	assert.Equal(t, []conntrack.Flow{
+		{Protocol: "tcp", State: "ESTABLISHED", Src: "10.128.2.30", Dst: "172.30.0.10", Sport: "41234", Dport: "8080"},
+		{Protocol: "udp", Src: "10.128.2.30", Dst: "172.30.0.10", Sport: "53012", Dport: "53"},
+	}, handler.GetFlows())
+}
+
+func TestConntrack_ReelMatchMissingFlow(t *testing.T) {
+	handler := conntrack.NewConntrack(testTimeoutDuration, nil, []string{`dport=9090`}, 0)
+	step := handler.ReelMatch("", "", getMockOutput(t))
+	assert.Nil(t, step)
+	assert.Equal(t, tnf.FAILURE, handler.Result())
+	assert.Equal(t, []string{`dport=9090`}, handler.GetMissingFlows())
+}
+
+func TestConntrack_ReelMatchUtilization(t *testing.T) {
+	handler := conntrack.NewConntrack(testTimeoutDuration, nil, nil, 40)
+	step := handler.ReelMatch("", "", getMockOutput(t))
+	assert.Nil(t, step)
+	assert.Equal(t, tnf.FAILURE, handler.Result())
+}
+
+func TestConntrack_ReelMatchError(t *testing.T) {
+	handler := conntrack.NewConntrack(testTimeoutDuration, nil, nil, 40)
+	step := handler.ReelMatch("", "", "CONNTRACK_COUNT\nCONNTRACK_MAX\n")
+	assert.Nil(t, step)
+	assert.Equal(t, tnf.ERROR, handler.Result())
+}
+
+// Ensure there are no panics.
+func TestConntrack_ReelEof(t *testing.T) {
+	handler := conntrack.NewConntrack(testTimeoutDuration, nil, nil, 0)
+	assert.Nil(t, handler.ReelTimeout())
+	handler.ReelEOF()
+}
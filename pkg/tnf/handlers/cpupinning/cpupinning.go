@@ -0,0 +1,203 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package cpupinning
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/dependencies"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	cpRegex = "(?s).+"
+
+	cpusetPrefix          = "CPUSET"
+	cpusAllowedListPrefix = "Cpus_allowed_list:"
+	procPrefix            = "/proc/"
+	rangeSeparator        = "-"
+)
+
+// CPUPinning checks that every process of a container is only allowed to run on the exclusive CPUs of the container.
+type CPUPinning struct {
+	expectedCPUs string
+	cpuset       []int
+	processCPUs  map[int][]int
+	unpinned     []int
+	result       int
+	timeout      time.Duration
+	args         []string
+}
+
+// NewCPUPinning creates a new CPUPinning tnf.Test.  expectedCPUs is a CPU list such as "2-3,6" holding the exclusive
+// CPUs granted by the static CPU manager; when empty, the cpuset of the container cgroup is used instead.
+func NewCPUPinning(timeout time.Duration, expectedCPUs string) *CPUPinning {
+	return &CPUPinning{
+		expectedCPUs: expectedCPUs,
+		timeout:      timeout,
+		result:       tnf.ERROR,
+		args: []string{
+			fmt.Sprintf("echo %s $(%s /sys/fs/cgroup/cpuset/cpuset.cpus /sys/fs/cgroup/cpuset.cpus.effective 2>/dev/null); %s -H %s /proc/[0-9]*/status",
+				cpusetPrefix, dependencies.CatBinaryName, dependencies.GrepBinaryName, cpusAllowedListPrefix),
+		},
+	}
+}
+
+// ParseCPUList converts a kernel CPU list such as "0-2,5" into the sorted list of CPU ids.
+func ParseCPUList(list string) ([]int, error) {
+	var cpus []int
+	for _, part := range strings.Split(strings.TrimSpace(list), ",") {
+		if part == "" {
+			continue
+		}
+		bounds := strings.SplitN(part, rangeSeparator, 2)
+		first, err := strconv.Atoi(bounds[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid cpu list %q: %w", list, err)
+		}
+		last := first
+		if len(bounds) == 2 {
+			if last, err = strconv.Atoi(bounds[1]); err != nil {
+				return nil, fmt.Errorf("invalid cpu list %q: %w", list, err)
+			}
+		}
+		for cpu := first; cpu <= last; cpu++ {
+			cpus = append(cpus, cpu)
+		}
+	}
+	sort.Ints(cpus)
+	return cpus, nil
+}
+
+// Args returns the command line args for the test.
+func (cp *CPUPinning) Args() []string {
+	return cp.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (cp *CPUPinning) GetIdentifier() identifier.Identifier {
+	return identifier.CPUPinningIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (cp *CPUPinning) Timeout() time.Duration {
+	return cp.timeout
+}
+
+// Result returns the test result.
+func (cp *CPUPinning) Result() int {
+	return cp.result
+}
+
+// GetCpuset returns the CPUs of the container cpuset.
+func (cp *CPUPinning) GetCpuset() []int {
+	return cp.cpuset
+}
+
+// GetProcessCPUs returns the allowed CPUs of every process, keyed by pid.
+func (cp *CPUPinning) GetProcessCPUs() map[int][]int {
+	return cp.processCPUs
+}
+
+// GetUnpinnedProcesses returns the pids of the processes allowed to run outside of the exclusive CPUs.
+func (cp *CPUPinning) GetUnpinnedProcesses() []int {
+	return cp.unpinned
+}
+
+// ReelFirst returns a step which expects the cpuset and process affinities within the test timeout.
+func (cp *CPUPinning) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{cpRegex},
+		Timeout: cp.timeout,
+	}
+}
+
+// ReelMatch parses the container cpuset and the Cpus_allowed_list of every process, and checks that each process is
+// restricted to the exclusive CPUs.
+func (cp *CPUPinning) ReelMatch(_, _, match string) *reel.Step {
+	cp.processCPUs = map[int][]int{}
+	cp.unpinned = nil
+	for _, line := range strings.Split(match, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, cpusetPrefix) {
+			cp.cpuset, _ = ParseCPUList(strings.TrimPrefix(line, cpusetPrefix))
+			continue
+		}
+		// /proc/<pid>/status:Cpus_allowed_list:	2-3
+		i := strings.Index(line, cpusAllowedListPrefix)
+		if !strings.HasPrefix(line, procPrefix) || i < 0 {
+			continue
+		}
+		pid, err := strconv.Atoi(strings.Split(strings.TrimPrefix(line, procPrefix), "/")[0])
+		if err != nil {
+			continue
+		}
+		if cpus, err := ParseCPUList(line[i+len(cpusAllowedListPrefix):]); err == nil {
+			cp.processCPUs[pid] = cpus
+		}
+	}
+
+	exclusive := cp.cpuset
+	if cp.expectedCPUs != "" {
+		exclusive, _ = ParseCPUList(cp.expectedCPUs)
+	}
+	if len(exclusive) == 0 || len(cp.processCPUs) == 0 {
+		cp.result = tnf.ERROR
+		return nil
+	}
+
+	for pid, cpus := range cp.processCPUs {
+		if !isSubset(cpus, exclusive) {
+			cp.unpinned = append(cp.unpinned, pid)
+		}
+	}
+	sort.Ints(cp.unpinned)
+	if len(cp.unpinned) > 0 {
+		cp.result = tnf.FAILURE
+	} else {
+		cp.result = tnf.SUCCESS
+	}
+	return nil
+}
+
+func isSubset(cpus, set []int) bool {
+	allowed := map[int]bool{}
+	for _, cpu := range set {
+		allowed[cpu] = true
+	}
+	for _, cpu := range cpus {
+		if !allowed[cpu] {
+			return false
+		}
+	}
+	return len(cpus) > 0
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (cp *CPUPinning) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (cp *CPUPinning) ReelEOF() {
+}
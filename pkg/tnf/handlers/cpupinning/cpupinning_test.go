@@ -0,0 +1,101 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package cpupinning_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	cp "github.com/test-network-function/test-network-function/pkg/tnf/handlers/cpupinning"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+func Test_NewCPUPinning(t *testing.T) {
+	newCp := cp.NewCPUPinning(testTimeoutDuration, "")
+	assert.NotNil(t, newCp)
+	assert.Equal(t, testTimeoutDuration, newCp.Timeout())
+	assert.Equal(t, tnf.ERROR, newCp.Result())
+	assert.Equal(t, identifier.CPUPinningIdentifier, newCp.GetIdentifier())
+	assert.Len(t, newCp.Args(), 1)
+}
+
+func Test_ReelFirst(t *testing.T) {
+	newCp := cp.NewCPUPinning(testTimeoutDuration, "")
+	firstStep := newCp.ReelFirst()
+	re := regexp.MustCompile(firstStep.Expect[0])
+	assert.Len(t, re.FindStringSubmatch(testInputPinned), 1)
+	assert.Len(t, re.FindStringSubmatch(testInputError), 0)
+}
+
+func Test_ReelMatchPinned(t *testing.T) {
+	newCp := cp.NewCPUPinning(testTimeoutDuration, "")
+	step := newCp.ReelMatch("", "", testInputPinned)
+	assert.Nil(t, step)
+	assert.Equal(t, tnf.SUCCESS, newCp.Result())
+	assert.Equal(t, []int{2, 3}, newCp.GetCpuset())
+	assert.Equal(t, map[int][]int{1: {2, 3}, 27: {2}}, newCp.GetProcessCPUs())
+	assert.Empty(t, newCp.GetUnpinnedProcesses())
+}
+
+func Test_ReelMatchUnpinned(t *testing.T) {
+	newCp := cp.NewCPUPinning(testTimeoutDuration, "")
+	step := newCp.ReelMatch("", "", testInputUnpinned)
+	assert.Nil(t, step)
+	assert.Equal(t, tnf.FAILURE, newCp.Result())
+	assert.Equal(t, []int{27}, newCp.GetUnpinnedProcesses())
+}
+
+func Test_ReelMatchExpectedCPUs(t *testing.T) {
+	newCp := cp.NewCPUPinning(testTimeoutDuration, "4-5")
+	step := newCp.ReelMatch("", "", testInputPinned)
+	assert.Nil(t, step)
+	assert.Equal(t, tnf.FAILURE, newCp.Result())
+	assert.Equal(t, []int{1, 27}, newCp.GetUnpinnedProcesses())
+}
+
+func Test_ReelMatchNoCpuset(t *testing.T) {
+	newCp := cp.NewCPUPinning(testTimeoutDuration, "")
+	step := newCp.ReelMatch("", "", testInputNoCpuset)
+	assert.Nil(t, step)
+	assert.Equal(t, tnf.ERROR, newCp.Result())
+}
+
+func Test_ParseCPUList(t *testing.T) {
+	cpus, err := cp.ParseCPUList("0-2,5,8-9\n")
+	assert.Nil(t, err)
+	assert.Equal(t, []int{0, 1, 2, 5, 8, 9}, cpus)
+	_, err = cp.ParseCPUList("0-x")
+	assert.NotNil(t, err)
+}
+
+// Just ensure there are no panics.
+func Test_ReelEof(t *testing.T) {
+	newCp := cp.NewCPUPinning(testTimeoutDuration, "")
+	assert.Nil(t, newCp.ReelTimeout())
+	newCp.ReelEOF()
+}
+
+const (
+	testTimeoutDuration = time.Second * 2
+	testInputError      = ""
+	testInputPinned     = "CPUSET 2-3\n/proc/1/status:Cpus_allowed_list:\t2-3\n/proc/27/status:Cpus_allowed_list:\t2\n"
+	testInputUnpinned   = "CPUSET 2-3\n/proc/1/status:Cpus_allowed_list:\t2-3\n/proc/27/status:Cpus_allowed_list:\t0-63\n"
+	testInputNoCpuset   = "CPUSET\n/proc/1/status:Cpus_allowed_list:\t0-63\n"
+)
@@ -0,0 +1,203 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package crdconformance
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	crdRegex = "(?s).+"
+
+	// DeprecatedAPIVersion is the apiextensions API version removed in Kubernetes 1.22.
+	DeprecatedAPIVersion = "apiextensions.k8s.io/v1beta1"
+
+	nonStructuralSchemaCondition = "NonStructuralSchema"
+	conditionTrue                = "True"
+	objectType                   = "object"
+)
+
+// crd is the subset of an `oc get crd -o json` output used by the test.
+type crd struct {
+	Metadata struct {
+		Name          string `json:"name"`
+		ManagedFields []struct {
+			APIVersion string `json:"apiVersion"`
+			Manager    string `json:"manager"`
+		} `json:"managedFields"`
+	} `json:"metadata"`
+	Spec struct {
+		Versions []struct {
+			Name    string `json:"name"`
+			Served  bool   `json:"served"`
+			Storage bool   `json:"storage"`
+			Schema  *struct {
+				OpenAPIV3Schema *struct {
+					Type string `json:"type"`
+				} `json:"openAPIV3Schema"`
+			} `json:"schema"`
+			Subresources *struct {
+				Status *struct{} `json:"status"`
+			} `json:"subresources"`
+		} `json:"versions"`
+	} `json:"spec"`
+	Status struct {
+		Conditions []struct {
+			Type    string `json:"type"`
+			Status  string `json:"status"`
+			Message string `json:"message"`
+		} `json:"conditions"`
+	} `json:"status"`
+}
+
+// CRDConformance checks that a CustomResourceDefinition has a single served storage version, structural schemas and
+// status subresources on its served versions, and was not created or updated through the deprecated v1beta1 API.
+type CRDConformance struct {
+	name       string
+	violations []string
+	result     int
+	timeout    time.Duration
+	args       []string
+}
+
+// NewCRDConformance creates a new CRDConformance tnf.Test.
+func NewCRDConformance(timeout time.Duration, name string) *CRDConformance {
+	return &CRDConformance{
+		name:    name,
+		timeout: timeout,
+		result:  tnf.ERROR,
+		args:    []string{"oc", "get", "crd", name, "-o", "json"},
+	}
+}
+
+// Args returns the command line args for the test.
+func (cc *CRDConformance) Args() []string {
+	return cc.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (cc *CRDConformance) GetIdentifier() identifier.Identifier {
+	return identifier.CRDConformanceIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (cc *CRDConformance) Timeout() time.Duration {
+	return cc.timeout
+}
+
+// Result returns the test result.
+func (cc *CRDConformance) Result() int {
+	return cc.result
+}
+
+// GetViolations returns a description of every convention the CRD breaks.
+func (cc *CRDConformance) GetViolations() []string {
+	return cc.violations
+}
+
+// ReelFirst returns a step which expects the CRD manifest within the test timeout.
+func (cc *CRDConformance) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{crdRegex},
+		Timeout: cc.timeout,
+	}
+}
+
+// ReelMatch parses the CRD manifest and checks it.
+func (cc *CRDConformance) ReelMatch(_, _, match string) *reel.Step {
+	cc.violations = nil
+	start := strings.Index(match, "{")
+	if start < 0 {
+		cc.result = tnf.ERROR
+		return nil
+	}
+	parsed := &crd{}
+	if err := json.Unmarshal([]byte(strings.TrimSpace(match[start:])), parsed); err != nil {
+		cc.result = tnf.ERROR
+		return nil
+	}
+	cc.checkVersions(parsed)
+	cc.checkConditions(parsed)
+	cc.checkAPIVersion(parsed)
+	if len(cc.violations) > 0 {
+		cc.result = tnf.FAILURE
+	} else {
+		cc.result = tnf.SUCCESS
+	}
+	return nil
+}
+
+func (cc *CRDConformance) checkVersions(parsed *crd) {
+	var storageVersions []string
+	for i := range parsed.Spec.Versions {
+		version := &parsed.Spec.Versions[i]
+		if version.Storage {
+			storageVersions = append(storageVersions, version.Name)
+			if !version.Served {
+				cc.violations = append(cc.violations, fmt.Sprintf("storage version %s is not served", version.Name))
+			}
+		}
+		if !version.Served {
+			continue
+		}
+		if version.Schema == nil || version.Schema.OpenAPIV3Schema == nil {
+			cc.violations = append(cc.violations, fmt.Sprintf("version %s has no openAPIV3Schema", version.Name))
+		} else if version.Schema.OpenAPIV3Schema.Type != objectType {
+			cc.violations = append(cc.violations, fmt.Sprintf("version %s schema root type is %q instead of %q",
+				version.Name, version.Schema.OpenAPIV3Schema.Type, objectType))
+		}
+		if version.Subresources == nil || version.Subresources.Status == nil {
+			cc.violations = append(cc.violations, fmt.Sprintf("version %s has no status subresource", version.Name))
+		}
+	}
+	if len(storageVersions) != 1 {
+		cc.violations = append(cc.violations, fmt.Sprintf("found %d storage versions %v instead of 1", len(storageVersions), storageVersions))
+	}
+}
+
+func (cc *CRDConformance) checkConditions(parsed *crd) {
+	for _, condition := range parsed.Status.Conditions {
+		if condition.Type == nonStructuralSchemaCondition && condition.Status == conditionTrue {
+			cc.violations = append(cc.violations, fmt.Sprintf("schema is not structural: %s", condition.Message))
+		}
+	}
+}
+
+func (cc *CRDConformance) checkAPIVersion(parsed *crd) {
+	for _, field := range parsed.Metadata.ManagedFields {
+		if field.APIVersion == DeprecatedAPIVersion {
+			cc.violations = append(cc.violations, fmt.Sprintf("managed through the deprecated %s API by %s", DeprecatedAPIVersion, field.Manager))
+			return
+		}
+	}
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (cc *CRDConformance) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (cc *CRDConformance) ReelEOF() {
+}
@@ -0,0 +1,81 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package crdconformance_test
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/crdconformance"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testDataDirectory   = "testdata"
+	testTimeoutDuration = time.Second * 2
+)
+
+func getMockOutput(t *testing.T, testName string) string {
+	b, err := os.ReadFile(path.Join(testDataDirectory, testName+".txt"))
+	assert.Nil(t, err)
+	return string(b)
+}
+
+func TestNewCRDConformance(t *testing.T) {
+	handler := crdconformance.NewCRDConformance(testTimeoutDuration, "crdexamples.test-network-function.com")
+	assert.Equal(t, []string{"oc", "get", "crd", "crdexamples.test-network-function.com", "-o", "json"}, handler.Args())
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.CRDConformanceIdentifier, handler.GetIdentifier())
+}
+
+func TestCRDConformance_ReelMatch(t *testing.T) {
+	testCases := map[string]struct {
+		expectedResult     int
+		expectedViolations []string
+	}{
+		"conformant": {
+			expectedResult: tnf.SUCCESS,
+		},
+		"nonconformant": {
+			expectedResult: tnf.FAILURE,
+			expectedViolations: []string{
+				"storage version v1beta1 is not served",
+				`version v1 schema root type is "" instead of "object"`,
+				"version v1 has no status subresource",
+				"schema is not structural: spec.versions[1].schema.openAPIV3Schema.type: Required value",
+				"managed through the deprecated apiextensions.k8s.io/v1beta1 API by kubectl-client-side-apply",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		handler := crdconformance.NewCRDConformance(testTimeoutDuration, name)
+		assert.Nil(t, handler.ReelMatch("", "", getMockOutput(t, name)), name)
+		assert.Equal(t, tc.expectedResult, handler.Result(), name)
+		assert.Equal(t, tc.expectedViolations, handler.GetViolations(), name)
+	}
+}
+
+func TestCRDConformance_ReelMatchError(t *testing.T) {
+	handler := crdconformance.NewCRDConformance(testTimeoutDuration, "missing")
+	assert.Nil(t, handler.ReelMatch("", "", "Error from server (NotFound): customresourcedefinitions.apiextensions.k8s.io \"missing\" not found\n"))
+	assert.Equal(t, tnf.ERROR, handler.Result())
+}
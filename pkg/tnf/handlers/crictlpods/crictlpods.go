@@ -0,0 +1,187 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package crictlpods
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/dependencies"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	crictlRegex = "(?s).+"
+	// containersMarker separates the `crictl pods` output from the `crictl ps` output.
+	containersMarker = "CRICTL_CONTAINERS"
+
+	podNameLabel       = "io.kubernetes.pod.name"
+	podNamespaceLabel  = "io.kubernetes.pod.namespace"
+	containerNameLabel = "io.kubernetes.container.name"
+	numOutputs         = 2
+)
+
+// Metadata holds the Kubernetes metadata of a pod sandbox or container.
+type Metadata struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+	UID       string `json:"uid,omitempty"`
+}
+
+// Pod describes a pod sandbox, as reported by `crictl pods -o json`.
+type Pod struct {
+	ID       string            `json:"id"`
+	Metadata Metadata          `json:"metadata"`
+	State    string            `json:"state"`
+	Labels   map[string]string `json:"labels"`
+}
+
+// Container describes a container, as reported by `crictl ps -o json`.
+type Container struct {
+	ID           string   `json:"id"`
+	PodSandboxID string   `json:"podSandboxId"`
+	Metadata     Metadata `json:"metadata"`
+	Image        struct {
+		Image string `json:"image"`
+	} `json:"image"`
+	ImageRef string            `json:"imageRef"`
+	State    string            `json:"state"`
+	Labels   map[string]string `json:"labels"`
+}
+
+// CrictlPods lists the pod sandboxes and containers known to the container runtime of a node.
+type CrictlPods struct {
+	namespace  string
+	pods       []Pod
+	containers []Container
+	result     int
+	timeout    time.Duration
+	args       []string
+}
+
+// NewCrictlPods creates a new CrictlPods tnf.Test to run in a node debug pod.  When namespace is not empty, only the
+// pods and containers of that namespace are kept.
+func NewCrictlPods(timeout time.Duration, namespace string) *CrictlPods {
+	return &CrictlPods{
+		namespace: namespace,
+		timeout:   timeout,
+		result:    tnf.ERROR,
+		args: []string{fmt.Sprintf("chroot /host %s pods -o json; %s %s; chroot /host %s ps -o json",
+			dependencies.CrictlBinaryName, dependencies.EchoBinaryName, containersMarker, dependencies.CrictlBinaryName)},
+	}
+}
+
+// Args returns the command line args for the test.
+func (cp *CrictlPods) Args() []string {
+	return cp.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (cp *CrictlPods) GetIdentifier() identifier.Identifier {
+	return identifier.CrictlPodsIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (cp *CrictlPods) Timeout() time.Duration {
+	return cp.timeout
+}
+
+// Result returns the test result.
+func (cp *CrictlPods) Result() int {
+	return cp.result
+}
+
+// GetPods returns the pod sandboxes.
+func (cp *CrictlPods) GetPods() []Pod {
+	return cp.pods
+}
+
+// GetContainers returns the running containers.
+func (cp *CrictlPods) GetContainers() []Container {
+	return cp.containers
+}
+
+// FindContainer returns the runtime container of the given Kubernetes pod and container.
+func (cp *CrictlPods) FindContainer(namespace, podName, containerName string) (Container, bool) {
+	for _, container := range cp.containers {
+		if container.Labels[podNamespaceLabel] == namespace && container.Labels[podNameLabel] == podName &&
+			container.Labels[containerNameLabel] == containerName {
+			return container, true
+		}
+	}
+	return Container{}, false
+}
+
+// ReelFirst returns a step which expects the crictl listings within the test timeout.
+func (cp *CrictlPods) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{crictlRegex},
+		Timeout: cp.timeout,
+	}
+}
+
+// ReelMatch unmarshals the pod and container listings.
+func (cp *CrictlPods) ReelMatch(_, _, match string) *reel.Step {
+	cp.pods = nil
+	cp.containers = nil
+	outputs := strings.SplitN(match, containersMarker, numOutputs)
+	var pods struct {
+		Items []Pod `json:"items"`
+	}
+	var containers struct {
+		Containers []Container `json:"containers"`
+	}
+	if len(outputs) != numOutputs || unmarshalObject(outputs[0], &pods) != nil || unmarshalObject(outputs[1], &containers) != nil {
+		cp.result = tnf.ERROR
+		return nil
+	}
+	for _, pod := range pods.Items {
+		if cp.namespace == "" || pod.Metadata.Namespace == cp.namespace {
+			cp.pods = append(cp.pods, pod)
+		}
+	}
+	for _, container := range containers.Containers {
+		if cp.namespace == "" || container.Labels[podNamespaceLabel] == cp.namespace {
+			cp.containers = append(cp.containers, container)
+		}
+	}
+	cp.result = tnf.SUCCESS
+	return nil
+}
+
+// unmarshalObject unmarshals the outermost JSON object found in output.
+func unmarshalObject(output string, v interface{}) error {
+	start := strings.Index(output, "{")
+	end := strings.LastIndex(output, "}")
+	if start < 0 || end < start {
+		return fmt.Errorf("no JSON object found in %q", output)
+	}
+	return json.Unmarshal([]byte(output[start:end+1]), v)
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (cp *CrictlPods) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (cp *CrictlPods) ReelEOF() {
+}
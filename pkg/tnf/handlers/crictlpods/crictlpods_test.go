@@ -0,0 +1,93 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package crictlpods_test
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/crictlpods"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testDataDirectory   = "testdata"
+	testTimeoutDuration = time.Second * 2
+)
+
+func getMockOutput(t *testing.T) string {
+	b, err := os.ReadFile(path.Join(testDataDirectory, "crictl.txt"))
+	assert.Nil(t, err)
+	return string(b)
+}
+
+func TestNewCrictlPods(t *testing.T) {
+	handler := crictlpods.NewCrictlPods(testTimeoutDuration, "")
+	assert.Equal(t, []string{"chroot /host crictl pods -o json; echo CRICTL_CONTAINERS; chroot /host crictl ps -o json"}, handler.Args())
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.CrictlPodsIdentifier, handler.GetIdentifier())
+}
+
+func TestCrictlPods_ReelMatch(t *testing.T) {
+	testCases := map[string]struct {
+		namespace          string
+		expectedPods       int
+		expectedContainers int
+	}{
+		"all_namespaces": {"", 2, 2},
+		"tnf_namespace":  {"tnf", 1, 1},
+		"empty":          {"missing", 0, 0},
+	}
+	for testName, testCase := range testCases {
+		handler := crictlpods.NewCrictlPods(testTimeoutDuration, testCase.namespace)
+		assert.Nil(t, handler.ReelMatch("", "", getMockOutput(t)))
+		assert.Equal(t, tnf.SUCCESS, handler.Result(), testName)
+		assert.Len(t, handler.GetPods(), testCase.expectedPods, testName)
+		assert.Len(t, handler.GetContainers(), testCase.expectedContainers, testName)
+	}
+}
+
+func TestCrictlPods_ReelMatchError(t *testing.T) {
+	handler := crictlpods.NewCrictlPods(testTimeoutDuration, "")
+	assert.Nil(t, handler.ReelMatch("", "", "chroot: failed to run command 'crictl': No such file or directory\n"))
+	assert.Equal(t, tnf.ERROR, handler.Result())
+}
+
+func TestCrictlPods_FindContainer(t *testing.T) {
+	handler := crictlpods.NewCrictlPods(testTimeoutDuration, "")
+	handler.ReelMatch("", "", getMockOutput(t))
+	container, found := handler.FindContainer("tnf", "test-5b9c9c8d7d-xk2lp", "test")
+	assert.True(t, found)
+	assert.Equal(t, "c1e2a3b4c5d6e7f8a9b0c1d2e3f4a5b6c7d8e9f0a1b2c3d4e5f6a7b8c9d0e1f2", container.ID)
+	assert.Equal(t, handler.GetPods()[0].ID, container.PodSandboxID)
+	assert.Equal(t, "quay.io/testnetworkfunction/cnf-test-partner:latest", container.Image.Image)
+	assert.Equal(t, "CONTAINER_RUNNING", container.State)
+	_, found = handler.FindContainer("tnf", "test-5b9c9c8d7d-xk2lp", "missing")
+	assert.False(t, found)
+}
+
+// Ensure there are no panics.
+func TestCrictlPods_ReelEof(t *testing.T) {
+	handler := crictlpods.NewCrictlPods(testTimeoutDuration, "")
+	assert.Nil(t, handler.ReelTimeout())
+	handler.ReelEOF()
+}
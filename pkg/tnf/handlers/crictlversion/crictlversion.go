@@ -0,0 +1,179 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package crictlversion
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/dependencies"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	crictlRegex = "(?s).+"
+	// CRIORuntimeName is the runtime name reported by CRI-O.
+	CRIORuntimeName = "cri-o"
+	// SystemdCgroupDriver is the cgroup driver used by OpenShift nodes.
+	SystemdCgroupDriver = "systemd"
+
+	// crioMinorOffset is the difference between the CRI-O and OpenShift 4 minor versions, e.g. CRI-O 1.21 for OCP 4.8.
+	crioMinorOffset = 13
+	ocpMajorVersion = "4"
+	numVersionParts = 2
+)
+
+var (
+	runtimeNameRegex    = regexp.MustCompile(`(?m)^RuntimeName:\s+(\S+)`)
+	runtimeVersionRegex = regexp.MustCompile(`(?m)^RuntimeVersion:\s+(\S+)`)
+	cgroupManagerRegex  = regexp.MustCompile(`(?m)^\s*cgroup_manager\s*=\s*"([^"]+)"`)
+)
+
+// CrictlVersion checks the container runtime name, version and cgroup driver of a node.
+type CrictlVersion struct {
+	expectedVersionPrefix string
+	expectedCgroupDriver  string
+	runtimeName           string
+	runtimeVersion        string
+	cgroupDriver          string
+	violations            []string
+	result                int
+	timeout               time.Duration
+	args                  []string
+}
+
+// NewCrictlVersion creates a new CrictlVersion tnf.Test to run in a node debug pod.  The runtime must be CRI-O, its
+// version must start with expectedVersionPrefix (see ExpectedCRIOVersion) and its cgroup manager must be
+// expectedCgroupDriver.  Empty expectations are not checked.
+func NewCrictlVersion(timeout time.Duration, expectedVersionPrefix, expectedCgroupDriver string) *CrictlVersion {
+	return &CrictlVersion{
+		expectedVersionPrefix: expectedVersionPrefix,
+		expectedCgroupDriver:  expectedCgroupDriver,
+		timeout:               timeout,
+		result:                tnf.ERROR,
+		args: []string{fmt.Sprintf("chroot /host %s version; chroot /host %s config 2>/dev/null | %s cgroup_manager",
+			dependencies.CrictlBinaryName, dependencies.CrioBinaryName, dependencies.GrepBinaryName)},
+	}
+}
+
+// ExpectedCRIOVersion returns the CRI-O version prefix shipped with an OpenShift 4 release, e.g. "1.21" for "4.8.3".
+func ExpectedCRIOVersion(ocpVersion string) (string, error) {
+	parts := strings.SplitN(ocpVersion, ".", numVersionParts+1)
+	if len(parts) < numVersionParts || parts[0] != ocpMajorVersion {
+		return "", fmt.Errorf("unsupported OpenShift version %q", ocpVersion)
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return "", fmt.Errorf("unsupported OpenShift version %q: %w", ocpVersion, err)
+	}
+	return fmt.Sprintf("1.%d", minor+crioMinorOffset), nil
+}
+
+// Args returns the command line args for the test.
+func (cv *CrictlVersion) Args() []string {
+	return cv.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (cv *CrictlVersion) GetIdentifier() identifier.Identifier {
+	return identifier.CrictlVersionIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (cv *CrictlVersion) Timeout() time.Duration {
+	return cv.timeout
+}
+
+// Result returns the test result.
+func (cv *CrictlVersion) Result() int {
+	return cv.result
+}
+
+// GetRuntimeName returns the container runtime name.
+func (cv *CrictlVersion) GetRuntimeName() string {
+	return cv.runtimeName
+}
+
+// GetRuntimeVersion returns the container runtime version.
+func (cv *CrictlVersion) GetRuntimeVersion() string {
+	return cv.runtimeVersion
+}
+
+// GetCgroupDriver returns the cgroup manager configured for CRI-O.
+func (cv *CrictlVersion) GetCgroupDriver() string {
+	return cv.cgroupDriver
+}
+
+// GetViolations returns a description of every unmet expectation.
+func (cv *CrictlVersion) GetViolations() []string {
+	return cv.violations
+}
+
+// ReelFirst returns a step which expects the runtime version within the test timeout.
+func (cv *CrictlVersion) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{crictlRegex},
+		Timeout: cv.timeout,
+	}
+}
+
+// ReelMatch parses the runtime version and cgroup manager and compares them to the expectations.
+func (cv *CrictlVersion) ReelMatch(_, _, match string) *reel.Step {
+	cv.violations = nil
+	name := runtimeNameRegex.FindStringSubmatch(match)
+	version := runtimeVersionRegex.FindStringSubmatch(match)
+	if name == nil || version == nil {
+		cv.result = tnf.ERROR
+		return nil
+	}
+	cv.runtimeName = name[1]
+	cv.runtimeVersion = version[1]
+	if driver := cgroupManagerRegex.FindStringSubmatch(match); driver != nil {
+		cv.cgroupDriver = driver[1]
+	}
+
+	if cv.runtimeName != CRIORuntimeName {
+		cv.violations = append(cv.violations, fmt.Sprintf("runtime %s is not %s", cv.runtimeName, CRIORuntimeName))
+	}
+	if cv.expectedVersionPrefix != "" && !strings.HasPrefix(cv.runtimeVersion, cv.expectedVersionPrefix+".") {
+		cv.violations = append(cv.violations, fmt.Sprintf("runtime version %s does not match %s", cv.runtimeVersion, cv.expectedVersionPrefix))
+	}
+	if cv.expectedCgroupDriver != "" && cv.cgroupDriver != cv.expectedCgroupDriver {
+		cv.violations = append(cv.violations, fmt.Sprintf("cgroup driver %q is not %s", cv.cgroupDriver, cv.expectedCgroupDriver))
+	}
+
+	if len(cv.violations) > 0 {
+		cv.result = tnf.FAILURE
+	} else {
+		cv.result = tnf.SUCCESS
+	}
+	return nil
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (cv *CrictlVersion) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (cv *CrictlVersion) ReelEOF() {
+}
@@ -0,0 +1,97 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package crictlversion_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/crictlversion"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testTimeoutDuration = time.Second * 2
+	testInputCRIO       = "Version:  0.1.0\nRuntimeName:  cri-o\nRuntimeVersion:  1.21.2-5.rhaos4.8.gitb27d974.el8\n" +
+		"RuntimeApiVersion:  v1alpha2\ncgroup_manager = \"systemd\"\n"
+	testInputCgroupfs = "Version:  0.1.0\nRuntimeName:  cri-o\nRuntimeVersion:  1.20.4-11.rhaos4.7.gitc4f5c69.el8\n" +
+		"RuntimeApiVersion:  v1alpha2\n# cgroup_manager = \"systemd\"\ncgroup_manager = \"cgroupfs\"\n"
+	testInputContainerd = "Version:  0.1.0\nRuntimeName:  containerd\nRuntimeVersion:  v1.5.5\nRuntimeApiVersion:  v1alpha2\n"
+	testInputError      = "chroot: failed to run command 'crictl': No such file or directory\n"
+)
+
+func TestNewCrictlVersion(t *testing.T) {
+	handler := crictlversion.NewCrictlVersion(testTimeoutDuration, "1.21", crictlversion.SystemdCgroupDriver)
+	assert.Equal(t, []string{"chroot /host crictl version; chroot /host crio config 2>/dev/null | grep cgroup_manager"}, handler.Args())
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.CrictlVersionIdentifier, handler.GetIdentifier())
+}
+
+func TestCrictlVersion_ReelMatch(t *testing.T) {
+	testCases := map[string]struct {
+		input              string
+		expectedResult     int
+		expectedViolations []string
+	}{
+		"expected_runtime": {testInputCRIO, tnf.SUCCESS, nil},
+		"wrong_version_and_driver": {testInputCgroupfs, tnf.FAILURE, []string{
+			"runtime version 1.20.4-11.rhaos4.7.gitc4f5c69.el8 does not match 1.21",
+			`cgroup driver "cgroupfs" is not systemd`,
+		}},
+		"wrong_runtime": {testInputContainerd, tnf.FAILURE, []string{
+			"runtime containerd is not cri-o",
+			"runtime version v1.5.5 does not match 1.21",
+			`cgroup driver "" is not systemd`,
+		}},
+		"no_crictl": {testInputError, tnf.ERROR, nil},
+	}
+	for testName, testCase := range testCases {
+		handler := crictlversion.NewCrictlVersion(testTimeoutDuration, "1.21", crictlversion.SystemdCgroupDriver)
+		assert.Nil(t, handler.ReelMatch("", "", testCase.input))
+		assert.Equal(t, testCase.expectedResult, handler.Result(), testName)
+		assert.Equal(t, testCase.expectedViolations, handler.GetViolations(), testName)
+	}
+}
+
+func TestCrictlVersion_Getters(t *testing.T) {
+	handler := crictlversion.NewCrictlVersion(testTimeoutDuration, "", "")
+	handler.ReelMatch("", "", testInputCgroupfs)
+	assert.Equal(t, tnf.SUCCESS, handler.Result())
+	assert.Equal(t, "cri-o", handler.GetRuntimeName())
+	assert.Equal(t, "1.20.4-11.rhaos4.7.gitc4f5c69.el8", handler.GetRuntimeVersion())
+	assert.Equal(t, "cgroupfs", handler.GetCgroupDriver())
+}
+
+func TestExpectedCRIOVersion(t *testing.T) {
+	version, err := crictlversion.ExpectedCRIOVersion("4.8.3")
+	assert.Nil(t, err)
+	assert.Equal(t, "1.21", version)
+	_, err = crictlversion.ExpectedCRIOVersion("n/a")
+	assert.NotNil(t, err)
+	_, err = crictlversion.ExpectedCRIOVersion("4.x")
+	assert.NotNil(t, err)
+}
+
+// Ensure there are no panics.
+func TestCrictlVersion_ReelEof(t *testing.T) {
+	handler := crictlversion.NewCrictlVersion(testTimeoutDuration, "", "")
+	assert.Nil(t, handler.ReelTimeout())
+	handler.ReelEOF()
+}
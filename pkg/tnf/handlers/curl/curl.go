@@ -0,0 +1,120 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package curl
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/dependencies"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	// curlRegex matches the HTTP status code and connection time written by curl.  The status code is 000 when no HTTP
+	// response was received.
+	curlRegex = `CURL_RESULT (\d{3}) ([\d.]+)`
+)
+
+// Curl opens a connection to a URL.  The test succeeds when the connection is established, whether or not the server
+// speaks HTTP, so it can probe any TCP service.
+type Curl struct {
+	statusCode int
+	connected  bool
+	result     int
+	timeout    time.Duration
+	args       []string
+}
+
+// NewCurl creates a new Curl tnf.Test giving up on the connection after connectTimeout.
+func NewCurl(timeout time.Duration, url string, connectTimeout time.Duration) *Curl {
+	return &Curl{
+		timeout: timeout,
+		result:  tnf.ERROR,
+		args: []string{dependencies.CurlBinaryName, "-s", "-o", "/dev/null", "--connect-timeout", fmt.Sprintf("%.0f", connectTimeout.Seconds()),
+			"--max-time", fmt.Sprintf("%.0f", timeout.Seconds()), "-w", "'CURL_RESULT %{http_code} %{time_connect}\\n'", url},
+	}
+}
+
+// Args returns the command line args for the test.
+func (c *Curl) Args() []string {
+	return c.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (c *Curl) GetIdentifier() identifier.Identifier {
+	return identifier.CurlIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (c *Curl) Timeout() time.Duration {
+	return c.timeout
+}
+
+// Result returns the test result.
+func (c *Curl) Result() int {
+	return c.result
+}
+
+// IsConnected returns true when the connection was established.
+func (c *Curl) IsConnected() bool {
+	return c.connected
+}
+
+// GetStatusCode returns the HTTP status code of the response, or 0 when no HTTP response was received.
+func (c *Curl) GetStatusCode() int {
+	return c.statusCode
+}
+
+// ReelFirst returns a step which expects the curl result within the test timeout.
+func (c *Curl) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{curlRegex},
+		Timeout: c.timeout,
+	}
+}
+
+// ReelMatch parses the curl result.
+func (c *Curl) ReelMatch(_, _, match string) *reel.Step {
+	matches := regexp.MustCompile(curlRegex).FindStringSubmatch(match)
+	if matches == nil {
+		c.result = tnf.ERROR
+		return nil
+	}
+	c.statusCode, _ = strconv.Atoi(matches[1])
+	connectTime, _ := strconv.ParseFloat(matches[2], 64)
+	c.connected = connectTime > 0
+	if c.connected {
+		c.result = tnf.SUCCESS
+	} else {
+		c.result = tnf.FAILURE
+	}
+	return nil
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (c *Curl) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (c *Curl) ReelEOF() {
+}
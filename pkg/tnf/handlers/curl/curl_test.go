@@ -0,0 +1,66 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package curl_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/curl"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const testTimeoutDuration = time.Second * 5
+
+func TestNewCurl(t *testing.T) {
+	handler := curl.NewCurl(testTimeoutDuration, "http://172.30.0.10:8080", time.Second)
+	assert.Equal(t, []string{"curl", "-s", "-o", "/dev/null", "--connect-timeout", "1", "--max-time", "5", "-w",
+		"'CURL_RESULT %{http_code} %{time_connect}\\n'", "http://172.30.0.10:8080"}, handler.Args())
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.CurlIdentifier, handler.GetIdentifier())
+}
+
+func TestCurl_ReelMatch(t *testing.T) {
+	testCases := []struct {
+		output             string
+		expectedResult     int
+		expectedConnected  bool
+		expectedStatusCode int
+	}{
+		{output: "CURL_RESULT 200 0.001234\n", expectedResult: tnf.SUCCESS, expectedConnected: true, expectedStatusCode: 200},
+		{output: "CURL_RESULT 000 0.000812\n", expectedResult: tnf.SUCCESS, expectedConnected: true},
+		{output: "CURL_RESULT 000 0.000000\n", expectedResult: tnf.FAILURE},
+		{output: "sh: curl: command not found\n", expectedResult: tnf.ERROR},
+	}
+	for _, tc := range testCases {
+		handler := curl.NewCurl(testTimeoutDuration, "http://172.30.0.10:8080", time.Second)
+		assert.Nil(t, handler.ReelMatch("", "", tc.output))
+		assert.Equal(t, tc.expectedResult, handler.Result(), tc.output)
+		assert.Equal(t, tc.expectedConnected, handler.IsConnected(), tc.output)
+		assert.Equal(t, tc.expectedStatusCode, handler.GetStatusCode(), tc.output)
+	}
+}
+
+// Ensure there are no panics.
+func TestCurl_ReelEof(t *testing.T) {
+	handler := curl.NewCurl(testTimeoutDuration, "http://172.30.0.10:8080", time.Second)
+	handler.ReelEOF()
+	assert.Nil(t, handler.ReelTimeout())
+}
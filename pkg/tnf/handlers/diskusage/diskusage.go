@@ -0,0 +1,171 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package diskusage
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/dependencies"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	duRegex = "(?s).+"
+	// blockSize is the size in bytes of the blocks reported by `df -Pk`.
+	blockSize = 1024
+	// percent is used to convert ratios into percentages.
+	percent = 100
+	// decimalBase and bitSize are used to parse the df columns.
+	decimalBase = 10
+	bitSize     = 64
+)
+
+// Filesystem holds the usage of a single mounted filesystem as reported by `df`.
+type Filesystem struct {
+	Name           string
+	MountPoint     string
+	SizeBytes      int64
+	UsedBytes      int64
+	AvailableBytes int64
+}
+
+// FreePercent returns the percentage of the filesystem that is still available.
+func (fs Filesystem) FreePercent() int {
+	if fs.SizeBytes == 0 {
+		return 0
+	}
+	return int(fs.AvailableBytes * percent / fs.SizeBytes)
+}
+
+// DiskUsage checks that the given mount points exist and have enough free space.
+type DiskUsage struct {
+	mountPoints    []string
+	minFreeBytes   int64
+	minFreePercent int
+	filesystems    map[string]Filesystem
+	failures       []string
+	result         int
+	timeout        time.Duration
+	args           []string
+}
+
+// NewDiskUsage creates a new DiskUsage tnf.Test. Every mount point must be reported by `df` as a mount point of its
+// own and must have at least minFreeBytes bytes and minFreePercent percent available. A zero threshold is not checked.
+func NewDiskUsage(timeout time.Duration, mountPoints []string, minFreeBytes int64, minFreePercent int) *DiskUsage {
+	args := append([]string{dependencies.DfBinaryName, "-Pk"}, mountPoints...)
+	return &DiskUsage{
+		mountPoints:    mountPoints,
+		minFreeBytes:   minFreeBytes,
+		minFreePercent: minFreePercent,
+		filesystems:    map[string]Filesystem{},
+		timeout:        timeout,
+		result:         tnf.ERROR,
+		args:           args,
+	}
+}
+
+// Args returns the command line args for the test.
+func (du *DiskUsage) Args() []string {
+	return du.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (du *DiskUsage) GetIdentifier() identifier.Identifier {
+	return identifier.DiskUsageIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (du *DiskUsage) Timeout() time.Duration {
+	return du.timeout
+}
+
+// Result returns the test result.
+func (du *DiskUsage) Result() int {
+	return du.result
+}
+
+// GetFilesystems returns the filesystems reported by `df`, keyed by mount point.
+func (du *DiskUsage) GetFilesystems() map[string]Filesystem {
+	return du.filesystems
+}
+
+// GetFailures returns the mount points that are missing or do not have enough free space.
+func (du *DiskUsage) GetFailures() []string {
+	return du.failures
+}
+
+// ReelFirst returns a step which expects the df output within the test timeout.
+func (du *DiskUsage) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{duRegex},
+		Timeout: du.timeout,
+	}
+}
+
+// ReelMatch parses the df output and checks the free space of every requested mount point.
+func (du *DiskUsage) ReelMatch(_, _, match string) *reel.Step {
+	const numExpectedFields = 6
+	for _, line := range strings.Split(strings.Trim(match, "\n"), "\n") {
+		fields := strings.Fields(line)
+		// Error lines such as "df: /foo: No such file or directory" and the header are skipped.
+		if len(fields) < numExpectedFields || fields[0] == "Filesystem" {
+			continue
+		}
+		size, errSize := strconv.ParseInt(fields[1], decimalBase, bitSize)
+		used, errUsed := strconv.ParseInt(fields[2], decimalBase, bitSize)
+		available, errAvailable := strconv.ParseInt(fields[3], decimalBase, bitSize)
+		if errSize != nil || errUsed != nil || errAvailable != nil {
+			continue
+		}
+		mountPoint := strings.Join(fields[numExpectedFields-1:], " ")
+		du.filesystems[mountPoint] = Filesystem{
+			Name:           fields[0],
+			MountPoint:     mountPoint,
+			SizeBytes:      size * blockSize,
+			UsedBytes:      used * blockSize,
+			AvailableBytes: available * blockSize,
+		}
+	}
+
+	du.failures = nil
+	for _, mountPoint := range du.mountPoints {
+		fs, ok := du.filesystems[mountPoint]
+		if !ok || fs.AvailableBytes < du.minFreeBytes || fs.FreePercent() < du.minFreePercent {
+			du.failures = append(du.failures, mountPoint)
+		}
+	}
+
+	if len(du.failures) > 0 {
+		du.result = tnf.FAILURE
+	} else {
+		du.result = tnf.SUCCESS
+	}
+	return nil
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (du *DiskUsage) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (du *DiskUsage) ReelEOF() {
+}
@@ -0,0 +1,116 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package diskusage_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	du "github.com/test-network-function/test-network-function/pkg/tnf/handlers/diskusage"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+func Test_NewDiskUsage(t *testing.T) {
+	newDu := du.NewDiskUsage(testTimeoutDuration, testMountPoints, testMinFreeBytes, testMinFreePercent)
+	assert.NotNil(t, newDu)
+	assert.Equal(t, testTimeoutDuration, newDu.Timeout())
+	assert.Equal(t, tnf.ERROR, newDu.Result())
+	assert.Equal(t, []string{"df", "-Pk", "/", "/cache"}, newDu.Args())
+	assert.Equal(t, identifier.DiskUsageIdentifier, newDu.GetIdentifier())
+}
+
+func Test_ReelFirstPositive(t *testing.T) {
+	newDu := du.NewDiskUsage(testTimeoutDuration, testMountPoints, testMinFreeBytes, testMinFreePercent)
+	firstStep := newDu.ReelFirst()
+	re := regexp.MustCompile(firstStep.Expect[0])
+	matches := re.FindStringSubmatch(testInputSuccess)
+	assert.Len(t, matches, 1)
+	assert.Equal(t, testInputSuccess, matches[0])
+}
+
+func Test_ReelFirstNegative(t *testing.T) {
+	newDu := du.NewDiskUsage(testTimeoutDuration, testMountPoints, testMinFreeBytes, testMinFreePercent)
+	firstStep := newDu.ReelFirst()
+	re := regexp.MustCompile(firstStep.Expect[0])
+	matches := re.FindStringSubmatch(testInputError)
+	assert.Len(t, matches, 0)
+}
+
+func Test_ReelMatchSuccess(t *testing.T) {
+	newDu := du.NewDiskUsage(testTimeoutDuration, testMountPoints, testMinFreeBytes, testMinFreePercent)
+	step := newDu.ReelMatch("", "", testInputSuccess)
+	assert.Nil(t, step)
+	assert.Equal(t, tnf.SUCCESS, newDu.Result())
+	assert.Empty(t, newDu.GetFailures())
+	fs := newDu.GetFilesystems()["/cache"]
+	assert.Equal(t, "tmpfs", fs.Name)
+	assert.Equal(t, int64(1048576*1024), fs.SizeBytes)
+	assert.Equal(t, int64(262144*1024), fs.UsedBytes)
+	assert.Equal(t, int64(786432*1024), fs.AvailableBytes)
+	assert.Equal(t, 75, fs.FreePercent())
+}
+
+func Test_ReelMatchLowFreeSpace(t *testing.T) {
+	newDu := du.NewDiskUsage(testTimeoutDuration, testMountPoints, testMinFreeBytes, testMinFreePercent)
+	step := newDu.ReelMatch("", "", testInputLowFreeSpace)
+	assert.Nil(t, step)
+	assert.Equal(t, tnf.FAILURE, newDu.Result())
+	assert.Equal(t, []string{"/cache"}, newDu.GetFailures())
+}
+
+func Test_ReelMatchMissingMountPoint(t *testing.T) {
+	newDu := du.NewDiskUsage(testTimeoutDuration, testMountPoints, testMinFreeBytes, testMinFreePercent)
+	step := newDu.ReelMatch("", "", testInputMissingMountPoint)
+	assert.Nil(t, step)
+	assert.Equal(t, tnf.FAILURE, newDu.Result())
+	assert.Equal(t, []string{"/cache"}, newDu.GetFailures())
+}
+
+func Test_ReelMatchNoThresholds(t *testing.T) {
+	newDu := du.NewDiskUsage(testTimeoutDuration, testMountPoints, 0, 0)
+	step := newDu.ReelMatch("", "", testInputLowFreeSpace)
+	assert.Nil(t, step)
+	assert.Equal(t, tnf.SUCCESS, newDu.Result())
+}
+
+// Just ensure there are no panics.
+func Test_ReelEof(t *testing.T) {
+	newDu := du.NewDiskUsage(testTimeoutDuration, testMountPoints, testMinFreeBytes, testMinFreePercent)
+	assert.Nil(t, newDu.ReelTimeout())
+	newDu.ReelEOF()
+}
+
+var testMountPoints = []string{"/", "/cache"}
+
+const (
+	testTimeoutDuration = time.Second * 2
+	testMinFreeBytes    = 100 * 1024 * 1024
+	testMinFreePercent  = 10
+	testInputError      = ""
+	testInputSuccess    = "Filesystem     1024-blocks     Used Available Capacity Mounted on\n" +
+		"overlay          125293548 61876112  63417436      50% /\n" +
+		"tmpfs              1048576   262144    786432      25% /cache\n"
+	testInputLowFreeSpace = "Filesystem     1024-blocks     Used Available Capacity Mounted on\n" +
+		"overlay          125293548 61876112  63417436      50% /\n" +
+		"tmpfs              1048576  1000000     48576      96% /cache\n"
+	testInputMissingMountPoint = "df: /cache: No such file or directory\n" +
+		"Filesystem     1024-blocks     Used Available Capacity Mounted on\n" +
+		"overlay          125293548 61876112  63417436      50% /\n"
+)
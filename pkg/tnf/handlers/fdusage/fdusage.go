@@ -0,0 +1,155 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package fdusage
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/dependencies"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	fdRegex      = "(?s).+"
+	percentScale = 100
+)
+
+// processLineRegex matches a "FD <pid> <open fds> <soft limit> <command>" line.  Lines with an "unlimited" or missing
+// limit, e.g. when the process exited while being inspected, do not match.
+var processLineRegex = regexp.MustCompile(`(?m)^FD (\d+) (\d+) (\d+) (.*)$`)
+
+// Process holds the file descriptor usage of a process.
+type Process struct {
+	PID       int
+	Command   string
+	OpenFDs   int
+	SoftLimit int
+}
+
+// UsagePercent returns the open file descriptors as a percentage of the soft limit.
+func (p Process) UsagePercent() int {
+	if p.SoftLimit == 0 {
+		return 0
+	}
+	return p.OpenFDs * percentScale / p.SoftLimit
+}
+
+// FDUsage checks that no process of a container is close to exhausting its open file descriptor limit.
+type FDUsage struct {
+	maxUsagePercent int
+	processes       []Process
+	violations      []string
+	result          int
+	timeout         time.Duration
+	args            []string
+}
+
+// NewFDUsage creates a new FDUsage tnf.Test to run in a container.  A process fails the test when its open file
+// descriptors reach maxUsagePercent of its "Max open files" soft limit.  Processes with an unlimited soft limit are
+// not checked.
+func NewFDUsage(timeout time.Duration, maxUsagePercent int) *FDUsage {
+	command := fmt.Sprintf("for p in /proc/[0-9]*; do "+
+		"n=$(%[1]s $p/fd 2>/dev/null | %[2]s -l); "+
+		"l=$(%[3]s '/^Max open files/ {print $4}' $p/limits 2>/dev/null); "+
+		"%[4]s \"FD ${p#/proc/} $n $l $(%[5]s $p/comm 2>/dev/null)\"; done",
+		dependencies.LsBinaryName, dependencies.WcBinaryName, dependencies.AwkBinaryName, dependencies.EchoBinaryName, dependencies.CatBinaryName)
+	return &FDUsage{
+		maxUsagePercent: maxUsagePercent,
+		timeout:         timeout,
+		result:          tnf.ERROR,
+		args:            []string{command},
+	}
+}
+
+// Args returns the command line args for the test.
+func (fd *FDUsage) Args() []string {
+	return fd.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (fd *FDUsage) GetIdentifier() identifier.Identifier {
+	return identifier.FDUsageIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (fd *FDUsage) Timeout() time.Duration {
+	return fd.timeout
+}
+
+// Result returns the test result.
+func (fd *FDUsage) Result() int {
+	return fd.result
+}
+
+// GetProcesses returns the file descriptor usage of every process with a finite soft limit.
+func (fd *FDUsage) GetProcesses() []Process {
+	return fd.processes
+}
+
+// GetViolations returns a description of every process close to exhausting its file descriptors.
+func (fd *FDUsage) GetViolations() []string {
+	return fd.violations
+}
+
+// ReelFirst returns a step which expects the per-process file descriptor usage within the test timeout.
+func (fd *FDUsage) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{fdRegex},
+		Timeout: fd.timeout,
+	}
+}
+
+// ReelMatch parses the file descriptor usage of each process and compares it to the soft limit.
+func (fd *FDUsage) ReelMatch(_, _, match string) *reel.Step {
+	fd.processes = nil
+	fd.violations = nil
+	for _, matched := range processLineRegex.FindAllStringSubmatch(match, -1) {
+		// Ignore errors, as processLineRegex only captures decimal numbers.
+		pid, _ := strconv.Atoi(matched[1])
+		openFDs, _ := strconv.Atoi(matched[2])
+		limit, _ := strconv.Atoi(matched[3])
+		process := Process{PID: pid, Command: matched[4], OpenFDs: openFDs, SoftLimit: limit}
+		fd.processes = append(fd.processes, process)
+		if process.UsagePercent() >= fd.maxUsagePercent {
+			fd.violations = append(fd.violations, fmt.Sprintf("process %d (%s) uses %d of %d file descriptors",
+				pid, process.Command, openFDs, limit))
+		}
+	}
+	switch {
+	case len(fd.processes) == 0:
+		fd.result = tnf.ERROR
+	case len(fd.violations) > 0:
+		fd.result = tnf.FAILURE
+	default:
+		fd.result = tnf.SUCCESS
+	}
+	return nil
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (fd *FDUsage) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (fd *FDUsage) ReelEOF() {
+}
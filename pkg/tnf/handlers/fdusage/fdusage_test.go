@@ -0,0 +1,98 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package fdusage_test
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/fdusage"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testDataDirectory   = "testdata"
+	testTimeoutDuration = time.Second * 2
+)
+
+func getMockOutput(t *testing.T) string {
+	b, err := os.ReadFile(path.Join(testDataDirectory, "fds.txt"))
+	assert.Nil(t, err)
+	return string(b)
+}
+
+func TestNewFDUsage(t *testing.T) {
+	handler := fdusage.NewFDUsage(testTimeoutDuration, 90)
+	assert.Equal(t, []string{"for p in /proc/[0-9]*; do n=$(ls $p/fd 2>/dev/null | wc -l); " +
+		"l=$(awk '/^Max open files/ {print $4}' $p/limits 2>/dev/null); " +
+		"echo \"FD ${p#/proc/} $n $l $(cat $p/comm 2>/dev/null)\"; done"}, handler.Args())
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.FDUsageIdentifier, handler.GetIdentifier())
+}
+
+func TestFDUsage_ReelMatch(t *testing.T) {
+	testCases := map[string]struct {
+		maxUsagePercent    int
+		expectedResult     int
+		expectedViolations []string
+	}{
+		"below_limit": {
+			maxUsagePercent: 100,
+			expectedResult:  tnf.SUCCESS,
+		},
+		"near_exhaustion": {
+			maxUsagePercent:    90,
+			expectedResult:     tnf.FAILURE,
+			expectedViolations: []string{"process 17 (worker) uses 1020 of 1024 file descriptors"},
+		},
+	}
+	for testName, testCase := range testCases {
+		handler := fdusage.NewFDUsage(testTimeoutDuration, testCase.maxUsagePercent)
+		assert.Nil(t, handler.ReelMatch("", "", getMockOutput(t)))
+		assert.Equal(t, testCase.expectedResult, handler.Result(), testName)
+		assert.Equal(t, testCase.expectedViolations, handler.GetViolations(), testName)
+	}
+}
+
+func TestFDUsage_GetProcesses(t *testing.T) {
+	handler := fdusage.NewFDUsage(testTimeoutDuration, 90)
+	handler.ReelMatch("", "", getMockOutput(t))
+	assert.Equal(t, []fdusage.Process{
+		{PID: 1, Command: "cnf-server", OpenFDs: 12, SoftLimit: 1048576},
+		{PID: 17, Command: "worker", OpenFDs: 1020, SoftLimit: 1024},
+		{PID: 52, Command: "sh", OpenFDs: 3, SoftLimit: 1024},
+	}, handler.GetProcesses())
+	assert.Equal(t, 99, handler.GetProcesses()[1].UsagePercent())
+}
+
+func TestFDUsage_ReelMatchNoProcess(t *testing.T) {
+	handler := fdusage.NewFDUsage(testTimeoutDuration, 90)
+	assert.Nil(t, handler.ReelMatch("", "", "sh: syntax error\n"))
+	assert.Equal(t, tnf.ERROR, handler.Result())
+}
+
+// Ensure there are no panics.
+func TestFDUsage_ReelEof(t *testing.T) {
+	handler := fdusage.NewFDUsage(testTimeoutDuration, 90)
+	assert.Nil(t, handler.ReelTimeout())
+	handler.ReelEOF()
+}
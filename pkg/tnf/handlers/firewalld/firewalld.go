@@ -0,0 +1,233 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package firewalld
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/dependencies"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	fwRegex = "(?s).+"
+
+	// NotRunningOutput is printed by firewall-cmd when firewalld is not running.
+	NotRunningOutput = "FirewallD is not running"
+
+	activeMarker      = "active"
+	servicesKey       = "services"
+	portsKey          = "ports"
+	interfacesKey     = "interfaces"
+	richRulesKey      = "rich rules"
+	rulePrefix        = "rule "
+	portSeparator     = "/"
+	numPortFields     = 2
+	numKeyValueFields = 2
+)
+
+var (
+	// zoneHeaderRegex matches a zone header such as "public (default, active)" or "block".
+	zoneHeaderRegex = regexp.MustCompile(`^([\w-]+)(?: \(([^)]*)\))?$`)
+	// richRulePortRegex extracts the port and protocol accepted by a rich rule.
+	richRulePortRegex = regexp.MustCompile(`port port="([^"]+)" protocol="([^"]+)".* accept`)
+)
+
+// Zone describes a firewalld zone.
+type Zone struct {
+	Name       string
+	Active     bool
+	Interfaces []string
+	Services   []string
+	Ports      []string
+	RichRules  []string
+}
+
+// Firewalld checks that the ports and services required by a CNF are opened in a firewalld zone.
+type Firewalld struct {
+	zone             string
+	requiredPorts    []string
+	requiredServices []string
+	zones            map[string]*Zone
+	missing          []string
+	result           int
+	timeout          time.Duration
+	args             []string
+}
+
+// NewFirewalld creates a new Firewalld tnf.Test to run in a node debug pod.  requiredPorts are given as port/protocol,
+// e.g. "8080/tcp", and are satisfied by either a port entry or an accepting rich rule of the zone.
+func NewFirewalld(timeout time.Duration, zone string, requiredPorts, requiredServices []string) *Firewalld {
+	return &Firewalld{
+		zone:             zone,
+		requiredPorts:    requiredPorts,
+		requiredServices: requiredServices,
+		timeout:          timeout,
+		result:           tnf.ERROR,
+		args:             []string{"chroot", "/host", dependencies.FirewallCmdBinaryName, "--list-all-zones"},
+	}
+}
+
+// Args returns the command line args for the test.
+func (fw *Firewalld) Args() []string {
+	return fw.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (fw *Firewalld) GetIdentifier() identifier.Identifier {
+	return identifier.FirewalldIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (fw *Firewalld) Timeout() time.Duration {
+	return fw.timeout
+}
+
+// Result returns the test result.
+func (fw *Firewalld) Result() int {
+	return fw.result
+}
+
+// GetZones returns every zone reported by firewalld, keyed by name.
+func (fw *Firewalld) GetZones() map[string]*Zone {
+	return fw.zones
+}
+
+// GetMissing returns the required ports and services that are not opened in the zone.
+func (fw *Firewalld) GetMissing() []string {
+	return fw.missing
+}
+
+// ReelFirst returns a step which expects the zone listing within the test timeout.
+func (fw *Firewalld) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{fwRegex},
+		Timeout: fw.timeout,
+	}
+}
+
+// ReelMatch parses the zones and checks that the required ports and services are opened in the active zone.
+func (fw *Firewalld) ReelMatch(_, _, match string) *reel.Step {
+	fw.zones = map[string]*Zone{}
+	fw.missing = nil
+	if strings.Contains(match, NotRunningOutput) {
+		fw.result = tnf.ERROR
+		return nil
+	}
+	fw.parse(match)
+
+	zone, ok := fw.zones[fw.zone]
+	if !ok || !zone.Active {
+		fw.missing = append(fw.missing, fmt.Sprintf("zone %s is not active", fw.zone))
+		fw.result = tnf.FAILURE
+		return nil
+	}
+	for _, service := range fw.requiredServices {
+		if !contains(zone.Services, service) {
+			fw.missing = append(fw.missing, "service "+service)
+		}
+	}
+	for _, port := range fw.requiredPorts {
+		if !contains(zone.Ports, port) && !richRulesAccept(zone.RichRules, port) {
+			fw.missing = append(fw.missing, "port "+port)
+		}
+	}
+
+	if len(fw.missing) > 0 {
+		fw.result = tnf.FAILURE
+	} else {
+		fw.result = tnf.SUCCESS
+	}
+	return nil
+}
+
+func (fw *Firewalld) parse(match string) {
+	var zone *Zone
+	inRichRules := false
+	for _, line := range strings.Split(match, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			continue
+		}
+		if !strings.HasPrefix(line, " ") && !strings.HasPrefix(line, "\t") {
+			if matched := zoneHeaderRegex.FindStringSubmatch(trimmed); matched != nil {
+				zone = &Zone{Name: matched[1], Active: strings.Contains(matched[2], activeMarker)}
+				fw.zones[zone.Name] = zone
+				inRichRules = false
+			}
+			continue
+		}
+		if zone == nil {
+			continue
+		}
+		if inRichRules && strings.HasPrefix(trimmed, rulePrefix) {
+			zone.RichRules = append(zone.RichRules, trimmed)
+			continue
+		}
+		keyValue := strings.SplitN(trimmed, ":", numKeyValueFields)
+		if len(keyValue) != numKeyValueFields {
+			continue
+		}
+		values := strings.Fields(keyValue[1])
+		inRichRules = keyValue[0] == richRulesKey
+		switch keyValue[0] {
+		case interfacesKey:
+			zone.Interfaces = values
+		case servicesKey:
+			zone.Services = values
+		case portsKey:
+			zone.Ports = values
+		}
+	}
+}
+
+func richRulesAccept(rules []string, port string) bool {
+	portProtocol := strings.SplitN(port, portSeparator, numPortFields)
+	if len(portProtocol) != numPortFields {
+		return false
+	}
+	for _, rule := range rules {
+		matched := richRulePortRegex.FindStringSubmatch(rule)
+		if matched != nil && matched[1] == portProtocol[0] && matched[2] == portProtocol[1] {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (fw *Firewalld) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (fw *Firewalld) ReelEOF() {
+}
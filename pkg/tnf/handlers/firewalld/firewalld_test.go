@@ -0,0 +1,120 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package firewalld_test
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/firewalld"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testDataDirectory   = "testdata"
+	testTimeoutDuration = time.Second * 2
+)
+
+type TestCase struct {
+	zone             string
+	requiredPorts    []string
+	requiredServices []string
+	expectedResult   int
+	expectedMissing  []string
+}
+
+var testCases = map[string]TestCase{
+	"ports_opened": {
+		zone:             "public",
+		requiredPorts:    []string{"8080/tcp", "8443/tcp"},
+		requiredServices: []string{"ssh"},
+		expectedResult:   tnf.SUCCESS,
+	},
+	"ports_missing": {
+		zone:             "public",
+		requiredPorts:    []string{"8080/udp", "2222/tcp"},
+		requiredServices: []string{"http"},
+		expectedResult:   tnf.FAILURE,
+		expectedMissing:  []string{"service http", "port 8080/udp", "port 2222/tcp"},
+	},
+	"zone_not_active": {
+		zone:            "trusted",
+		expectedResult:  tnf.FAILURE,
+		expectedMissing: []string{"zone trusted is not active"},
+	},
+}
+
+func getMockOutput(t *testing.T) string {
+	b, err := os.ReadFile(path.Join(testDataDirectory, "zones.txt"))
+	assert.Nil(t, err)
+	return string(b)
+}
+
+func TestNewFirewalld(t *testing.T) {
+	handler := firewalld.NewFirewalld(testTimeoutDuration, "public", nil, nil)
+	assert.Equal(t, []string{"chroot", "/host", "firewall-cmd", "--list-all-zones"}, handler.Args())
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.FirewalldIdentifier, handler.GetIdentifier())
+}
+
+func TestFirewalld_ReelMatch(t *testing.T) {
+	for testName, testCase := range testCases {
+		handler := firewalld.NewFirewalld(testTimeoutDuration, testCase.zone, testCase.requiredPorts, testCase.requiredServices)
+		step := handler.ReelMatch("", "", getMockOutput(t))
+		assert.Nil(t, step)
+		assert.Equal(t, testCase.expectedResult, handler.Result(), testName)
+		assert.Equal(t, testCase.expectedMissing, handler.GetMissing(), testName)
+	}
+}
+
+func TestFirewalld_GetZones(t *testing.T) {
+	handler := firewalld.NewFirewalld(testTimeoutDuration, "public", nil, nil)
+	handler.ReelMatch("", "", getMockOutput(t))
+	zones := handler.GetZones()
+	assert.Len(t, zones, 3)
+	assert.Equal(t, &firewalld.Zone{
+		Name:       "public",
+		Active:     true,
+		Interfaces: []string{"ens3"},
+		Services:   []string{"dhcpv6-client", "ssh"},
+		Ports:      []string{"8080/tcp", "9090/udp"},
+		RichRules: []string{
+			`rule family="ipv4" source address="10.0.0.0/8" port port="8443" protocol="tcp" accept`,
+			`rule family="ipv4" source address="10.0.0.0/8" port port="2222" protocol="tcp" reject`,
+		},
+	}, zones["public"])
+	assert.False(t, zones["block"].Active)
+}
+
+func TestFirewalld_ReelMatchNotRunning(t *testing.T) {
+	handler := firewalld.NewFirewalld(testTimeoutDuration, "public", nil, nil)
+	step := handler.ReelMatch("", "", "FirewallD is not running\n")
+	assert.Nil(t, step)
+	assert.Equal(t, tnf.ERROR, handler.Result())
+}
+
+// Ensure there are no panics.
+func TestFirewalld_ReelEof(t *testing.T) {
+	handler := firewalld.NewFirewalld(testTimeoutDuration, "public", nil, nil)
+	assert.Nil(t, handler.ReelTimeout())
+	handler.ReelEOF()
+}
@@ -0,0 +1,173 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package firewallrules
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/dependencies"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	frRegex = "(?s).+"
+
+	// IptablesBackend dumps the ruleset with `iptables-save`.
+	IptablesBackend = "iptables"
+	// NftBackend dumps the ruleset with `nft list ruleset`.
+	NftBackend = "nft"
+)
+
+// FirewallRules dumps a firewall ruleset and checks that required rules are present and forbidden rules are absent.
+type FirewallRules struct {
+	required  []*regexp.Regexp
+	forbidden []*regexp.Regexp
+	rules     []string
+	missing   []string
+	unwanted  []string
+	result    int
+	timeout   time.Duration
+	args      []string
+}
+
+// NewFirewallRules creates a new FirewallRules tnf.Test for the given backend.  Each required and forbidden entry is
+// a regular expression matched against the individual lines of the ruleset.  When onNode is set, the ruleset is read
+// from the host filesystem of a node debug pod.
+func NewFirewallRules(timeout time.Duration, backend string, onNode bool, required, forbidden []string) *FirewallRules {
+	var args []string
+	if onNode {
+		args = []string{"chroot", "/host"}
+	}
+	if backend == NftBackend {
+		args = append(args, dependencies.NftBinaryName, "list", "ruleset")
+	} else {
+		args = append(args, dependencies.IptablesSaveBinaryName)
+	}
+	return &FirewallRules{
+		required:  compile(required),
+		forbidden: compile(forbidden),
+		timeout:   timeout,
+		result:    tnf.ERROR,
+		args:      args,
+	}
+}
+
+func compile(exprs []string) []*regexp.Regexp {
+	res := make([]*regexp.Regexp, 0, len(exprs))
+	for _, expr := range exprs {
+		res = append(res, regexp.MustCompile(expr))
+	}
+	return res
+}
+
+// Args returns the command line args for the test.
+func (fr *FirewallRules) Args() []string {
+	return fr.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (fr *FirewallRules) GetIdentifier() identifier.Identifier {
+	return identifier.FirewallRulesIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (fr *FirewallRules) Timeout() time.Duration {
+	return fr.timeout
+}
+
+// Result returns the test result.
+func (fr *FirewallRules) Result() int {
+	return fr.result
+}
+
+// GetRules returns the non-comment lines of the dumped ruleset.
+func (fr *FirewallRules) GetRules() []string {
+	return fr.rules
+}
+
+// GetMissingRules returns the required expressions that did not match any rule.
+func (fr *FirewallRules) GetMissingRules() []string {
+	return fr.missing
+}
+
+// GetForbiddenRules returns the rules that matched a forbidden expression.
+func (fr *FirewallRules) GetForbiddenRules() []string {
+	return fr.unwanted
+}
+
+// ReelFirst returns a step which expects the ruleset within the test timeout.
+func (fr *FirewallRules) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{frRegex},
+		Timeout: fr.timeout,
+	}
+}
+
+// ReelMatch stores the ruleset and evaluates the required and forbidden expressions against it.
+func (fr *FirewallRules) ReelMatch(_, _, match string) *reel.Step {
+	fr.rules, fr.missing, fr.unwanted = nil, nil, nil
+	for _, line := range strings.Split(match, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fr.rules = append(fr.rules, line)
+	}
+
+	for _, re := range fr.required {
+		if !matchesAny(re, fr.rules) {
+			fr.missing = append(fr.missing, re.String())
+		}
+	}
+	for _, rule := range fr.rules {
+		for _, re := range fr.forbidden {
+			if re.MatchString(rule) {
+				fr.unwanted = append(fr.unwanted, rule)
+				break
+			}
+		}
+	}
+
+	if len(fr.missing) > 0 || len(fr.unwanted) > 0 {
+		fr.result = tnf.FAILURE
+	} else {
+		fr.result = tnf.SUCCESS
+	}
+	return nil
+}
+
+func matchesAny(re *regexp.Regexp, rules []string) bool {
+	for _, rule := range rules {
+		if re.MatchString(rule) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (fr *FirewallRules) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (fr *FirewallRules) ReelEOF() {
+}
@@ -0,0 +1,105 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package firewallrules_test
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	fr "github.com/test-network-function/test-network-function/pkg/tnf/handlers/firewallrules"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testDataDirectory   = "testdata"
+	testDataFileSuffix  = ".txt"
+	testTimeoutDuration = time.Second * 2
+)
+
+type TestCase struct {
+	backend           string
+	required          []string
+	forbidden         []string
+	expectedResult    int
+	expectedMissing   []string
+	expectedForbidden []string
+}
+
+var testCases = map[string]TestCase{
+	"iptables": {
+		backend:        fr.IptablesBackend,
+		required:       []string{`^-A INPUT -j KUBE-FIREWALL$`},
+		forbidden:      []string{`--dport 8080 .*-j DROP`},
+		expectedResult: tnf.SUCCESS,
+	},
+	"nft": {
+		backend:           fr.NftBackend,
+		required:          []string{`policy accept`, `^chain FORWARD`},
+		forbidden:         []string{`dport 8080 drop`},
+		expectedResult:    tnf.FAILURE,
+		expectedMissing:   []string{`^chain FORWARD`},
+		expectedForbidden: []string{"tcp dport 8080 drop"},
+	},
+}
+
+func getMockOutput(t *testing.T, testName string) string {
+	b, err := os.ReadFile(path.Join(testDataDirectory, fmt.Sprintf("%s%s", testName, testDataFileSuffix)))
+	assert.Nil(t, err)
+	return string(b)
+}
+
+func TestNewFirewallRules(t *testing.T) {
+	iptables := fr.NewFirewallRules(testTimeoutDuration, fr.IptablesBackend, false, nil, nil)
+	assert.Equal(t, []string{"iptables-save"}, iptables.Args())
+	assert.Equal(t, tnf.ERROR, iptables.Result())
+	assert.Equal(t, testTimeoutDuration, iptables.Timeout())
+	assert.Equal(t, identifier.FirewallRulesIdentifier, iptables.GetIdentifier())
+
+	nft := fr.NewFirewallRules(testTimeoutDuration, fr.NftBackend, true, nil, nil)
+	assert.Equal(t, []string{"chroot", "/host", "nft", "list", "ruleset"}, nft.Args())
+}
+
+func TestFirewallRules_ReelFirst(t *testing.T) {
+	handler := fr.NewFirewallRules(testTimeoutDuration, fr.IptablesBackend, false, nil, nil)
+	step := handler.ReelFirst()
+	assert.Equal(t, "", step.Execute)
+	assert.Equal(t, testTimeoutDuration, step.Timeout)
+}
+
+func TestFirewallRules_ReelMatch(t *testing.T) {
+	for testName, testCase := range testCases {
+		handler := fr.NewFirewallRules(testTimeoutDuration, testCase.backend, false, testCase.required, testCase.forbidden)
+		step := handler.ReelMatch("", "", getMockOutput(t, testName))
+		assert.Nil(t, step)
+		assert.Equal(t, testCase.expectedResult, handler.Result(), testName)
+		assert.Equal(t, testCase.expectedMissing, handler.GetMissingRules(), testName)
+		assert.Equal(t, testCase.expectedForbidden, handler.GetForbiddenRules(), testName)
+		assert.NotEmpty(t, handler.GetRules())
+	}
+}
+
+// Ensure there are no panics.
+func TestFirewallRules_ReelEof(t *testing.T) {
+	handler := fr.NewFirewallRules(testTimeoutDuration, fr.IptablesBackend, false, nil, nil)
+	assert.Nil(t, handler.ReelTimeout())
+	handler.ReelEOF()
+}
@@ -0,0 +1,105 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package fsroundtrip
+
+import (
+	"path"
+	"regexp"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/dependencies"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	// FileName is the name of the file written to the tested directory.  It is removed once read back.
+	FileName = ".tnf-fsroundtrip"
+	// roundTripRegex matches the outcome of the round trip.  The quotes in the command keep its echo from matching.
+	roundTripRegex = "FSROUNDTRIP_(OK|FAILED)"
+	okOutcome      = "OK"
+)
+
+// FsRoundTrip writes a file to a directory and reads it back.
+type FsRoundTrip struct {
+	result  int
+	timeout time.Duration
+	args    []string
+}
+
+// NewFsRoundTrip creates a new FsRoundTrip tnf.Test writing FileName to dir.
+func NewFsRoundTrip(timeout time.Duration, dir string) *FsRoundTrip {
+	file := path.Join(dir, FileName)
+	return &FsRoundTrip{
+		timeout: timeout,
+		result:  tnf.ERROR,
+		args: []string{dependencies.EchoBinaryName, `FSROUNDTRIP_"OK"`, ">", file, "&&", dependencies.CatBinaryName, file, "&&",
+			"rm", "-f", file, "||", dependencies.EchoBinaryName, `FSROUNDTRIP_"FAILED"`},
+	}
+}
+
+// Args returns the command line args for the test.
+func (f *FsRoundTrip) Args() []string {
+	return f.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (f *FsRoundTrip) GetIdentifier() identifier.Identifier {
+	return identifier.FsRoundTripIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (f *FsRoundTrip) Timeout() time.Duration {
+	return f.timeout
+}
+
+// Result returns the test result.
+func (f *FsRoundTrip) Result() int {
+	return f.result
+}
+
+// ReelFirst returns a step which expects the outcome of the round trip within the test timeout.
+func (f *FsRoundTrip) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{roundTripRegex},
+		Timeout: f.timeout,
+	}
+}
+
+// ReelMatch succeeds when the written data was read back.
+func (f *FsRoundTrip) ReelMatch(_, _, match string) *reel.Step {
+	matches := regexp.MustCompile(roundTripRegex).FindStringSubmatch(match)
+	switch {
+	case matches == nil:
+		f.result = tnf.ERROR
+	case matches[1] == okOutcome:
+		f.result = tnf.SUCCESS
+	default:
+		f.result = tnf.FAILURE
+	}
+	return nil
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (f *FsRoundTrip) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (f *FsRoundTrip) ReelEOF() {
+}
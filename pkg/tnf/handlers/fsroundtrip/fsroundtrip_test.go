@@ -0,0 +1,70 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package fsroundtrip_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/fsroundtrip"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const testTimeoutDuration = time.Second * 2
+
+func TestNewFsRoundTrip(t *testing.T) {
+	handler := fsroundtrip.NewFsRoundTrip(testTimeoutDuration, "/data")
+	assert.Equal(t, []string{"echo", `FSROUNDTRIP_"OK"`, ">", "/data/.tnf-fsroundtrip", "&&", "cat", "/data/.tnf-fsroundtrip", "&&",
+		"rm", "-f", "/data/.tnf-fsroundtrip", "||", "echo", `FSROUNDTRIP_"FAILED"`}, handler.Args())
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.FsRoundTripIdentifier, handler.GetIdentifier())
+}
+
+func TestFsRoundTrip_ReelMatch(t *testing.T) {
+	testCases := []struct {
+		output         string
+		expectedResult int
+	}{
+		{output: "FSROUNDTRIP_OK\n", expectedResult: tnf.SUCCESS},
+		{output: "sh: /data/.tnf-fsroundtrip: Read-only file system\nFSROUNDTRIP_FAILED\n", expectedResult: tnf.FAILURE},
+		{output: "sh: /data/.tnf-fsroundtrip: Permission denied\nFSROUNDTRIP_FAILED\n", expectedResult: tnf.FAILURE},
+		{output: "", expectedResult: tnf.ERROR},
+	}
+	for _, tc := range testCases {
+		handler := fsroundtrip.NewFsRoundTrip(testTimeoutDuration, "/data")
+		assert.Nil(t, handler.ReelMatch("", "", tc.output))
+		assert.Equal(t, tc.expectedResult, handler.Result(), tc.output)
+	}
+}
+
+func TestFsRoundTrip_ReelFirst(t *testing.T) {
+	handler := fsroundtrip.NewFsRoundTrip(testTimeoutDuration, "/data")
+	step := handler.ReelFirst()
+	assert.Equal(t, testTimeoutDuration, step.Timeout)
+	// The echo of the command itself must not match.
+	assert.NotRegexp(t, step.Expect[0], `echo FSROUNDTRIP_"OK" > /data/.tnf-fsroundtrip`)
+}
+
+// Ensure there are no panics.
+func TestFsRoundTrip_ReelEof(t *testing.T) {
+	handler := fsroundtrip.NewFsRoundTrip(testTimeoutDuration, "/data")
+	handler.ReelEOF()
+	assert.Nil(t, handler.ReelTimeout())
+}
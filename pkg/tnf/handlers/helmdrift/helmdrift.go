@@ -0,0 +1,133 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package helmdrift
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/helmreleases"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	helmRegex = "(?s).+"
+	// exitMarker prefixes the exit code of `oc diff`, which is 0 without differences, 1 with differences and above on
+	// errors.
+	exitMarker = "HELM_DIFF_EXIT="
+	diffPrefix = "diff "
+)
+
+var exitRegex = regexp.MustCompile(exitMarker + `(\d+)`)
+
+// HelmDrift compares the manifest of a Helm release with the live cluster state using `oc diff`.
+type HelmDrift struct {
+	drifted []string
+	diff    string
+	result  int
+	timeout time.Duration
+	args    []string
+}
+
+// NewHelmDrift creates a new HelmDrift tnf.Test reading the release stored in a Helm release secret.
+func NewHelmDrift(timeout time.Duration, namespace, secret string) *HelmDrift {
+	return &HelmDrift{
+		timeout: timeout,
+		result:  tnf.ERROR,
+		args: []string{fmt.Sprintf("%s | jq -r .manifest | oc diff -n %s -f -; echo %s$?",
+			helmreleases.DecodeReleaseCommand(namespace, secret), namespace, exitMarker)},
+	}
+}
+
+// Args returns the command line args for the test.
+func (hd *HelmDrift) Args() []string {
+	return hd.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (hd *HelmDrift) GetIdentifier() identifier.Identifier {
+	return identifier.HelmDriftIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (hd *HelmDrift) Timeout() time.Duration {
+	return hd.timeout
+}
+
+// Result returns the test result.
+func (hd *HelmDrift) Result() int {
+	return hd.result
+}
+
+// GetDrifted returns the objects whose live state differs from the release manifest, as named by `oc diff`, e.g.
+// "apps.v1.Deployment.tnf.cnf".
+func (hd *HelmDrift) GetDrifted() []string {
+	return hd.drifted
+}
+
+// GetDiff returns the output of `oc diff`.
+func (hd *HelmDrift) GetDiff() string {
+	return hd.diff
+}
+
+// ReelFirst returns a step which expects the `oc diff` exit code within the test timeout.
+func (hd *HelmDrift) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{helmRegex},
+		Timeout: hd.timeout,
+	}
+}
+
+// ReelMatch parses the output of `oc diff`.
+func (hd *HelmDrift) ReelMatch(_, _, match string) *reel.Step {
+	hd.drifted = nil
+	exit := exitRegex.FindStringSubmatchIndex(match)
+	if exit == nil {
+		hd.result = tnf.ERROR
+		return nil
+	}
+	hd.diff = strings.TrimSpace(match[:exit[0]])
+	switch match[exit[2]:exit[3]] {
+	case "0":
+		hd.result = tnf.SUCCESS
+	case "1":
+		for _, line := range strings.Split(hd.diff, "\n") {
+			fields := strings.Fields(line)
+			if strings.HasPrefix(line, diffPrefix) && len(fields) > 1 {
+				hd.drifted = append(hd.drifted, path.Base(fields[len(fields)-2]))
+			}
+		}
+		hd.result = tnf.FAILURE
+	default:
+		hd.result = tnf.ERROR
+	}
+	return nil
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (hd *HelmDrift) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (hd *HelmDrift) ReelEOF() {
+}
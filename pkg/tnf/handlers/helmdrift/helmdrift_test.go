@@ -0,0 +1,81 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package helmdrift_test
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/helmdrift"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testDataDirectory   = "testdata"
+	testTimeoutDuration = time.Second * 2
+)
+
+func getMockOutput(t *testing.T, testName string) string {
+	b, err := os.ReadFile(path.Join(testDataDirectory, testName+".txt"))
+	assert.Nil(t, err)
+	return string(b)
+}
+
+func TestNewHelmDrift(t *testing.T) {
+	handler := helmdrift.NewHelmDrift(testTimeoutDuration, "tnf", "sh.helm.release.v1.cnf.v3")
+	assert.Len(t, handler.Args(), 1)
+	assert.Contains(t, handler.Args()[0], "| jq -r .manifest | oc diff -n tnf -f -")
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.HelmDriftIdentifier, handler.GetIdentifier())
+}
+
+func TestHelmDrift_ReelMatch(t *testing.T) {
+	testCases := map[string]struct {
+		input           string
+		expectedResult  int
+		expectedDrifted []string
+	}{
+		"in sync": {
+			input:          "HELM_DIFF_EXIT=0\n",
+			expectedResult: tnf.SUCCESS,
+		},
+		"drifted": {
+			input:           getMockOutput(t, "drifted"),
+			expectedResult:  tnf.FAILURE,
+			expectedDrifted: []string{"apps.v1.Deployment.tnf.cnf", "v1.ConfigMap.tnf.cnf-config"},
+		},
+		"diff error": {
+			input:          "error: no objects passed to apply\nHELM_DIFF_EXIT=2\n",
+			expectedResult: tnf.ERROR,
+		},
+		"no exit code": {
+			input:          "bash: oc: command not found\n",
+			expectedResult: tnf.ERROR,
+		},
+	}
+	for name, tc := range testCases {
+		handler := helmdrift.NewHelmDrift(testTimeoutDuration, "tnf", "sh.helm.release.v1.cnf.v3")
+		assert.Nil(t, handler.ReelMatch("", "", tc.input), name)
+		assert.Equal(t, tc.expectedResult, handler.Result(), name)
+		assert.Equal(t, tc.expectedDrifted, handler.GetDrifted(), name)
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package helmhooks
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/helmreleases"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	helmRegex = "(?s).+"
+)
+
+// rbacKinds are the kinds of the objects granting permissions.  Hooks are not part of the release manifest, so the
+// permissions they create escape the review and the lifecycle of the release.
+var rbacKinds = map[string]bool{
+	"ServiceAccount":             true,
+	"Role":                       true,
+	"ClusterRole":                true,
+	"RoleBinding":                true,
+	"ClusterRoleBinding":         true,
+	"SecurityContextConstraints": true,
+}
+
+// Hook describes a hook of a Helm release.
+type Hook struct {
+	Name   string   `json:"name"`
+	Kind   string   `json:"kind"`
+	Path   string   `json:"path"`
+	Events []string `json:"events"`
+}
+
+// release is the subset of a decoded Helm release used by the test.
+type release struct {
+	Hooks []Hook `json:"hooks"`
+}
+
+// HelmHooks checks that the hooks of a Helm release do not create RBAC objects.
+type HelmHooks struct {
+	hooks      []Hook
+	violations []string
+	result     int
+	timeout    time.Duration
+	args       []string
+}
+
+// NewHelmHooks creates a new HelmHooks tnf.Test reading the release stored in a Helm release secret.
+func NewHelmHooks(timeout time.Duration, namespace, secret string) *HelmHooks {
+	return &HelmHooks{
+		timeout: timeout,
+		result:  tnf.ERROR,
+		args:    []string{helmreleases.DecodeReleaseCommand(namespace, secret)},
+	}
+}
+
+// Args returns the command line args for the test.
+func (hh *HelmHooks) Args() []string {
+	return hh.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (hh *HelmHooks) GetIdentifier() identifier.Identifier {
+	return identifier.HelmHooksIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (hh *HelmHooks) Timeout() time.Duration {
+	return hh.timeout
+}
+
+// Result returns the test result.
+func (hh *HelmHooks) Result() int {
+	return hh.result
+}
+
+// GetHooks returns the hooks of the release.
+func (hh *HelmHooks) GetHooks() []Hook {
+	return hh.hooks
+}
+
+// GetViolations returns a description of every hook creating an RBAC object.
+func (hh *HelmHooks) GetViolations() []string {
+	return hh.violations
+}
+
+// ReelFirst returns a step which expects the decoded release within the test timeout.
+func (hh *HelmHooks) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{helmRegex},
+		Timeout: hh.timeout,
+	}
+}
+
+// ReelMatch parses the hooks of the decoded release and checks their kinds.
+func (hh *HelmHooks) ReelMatch(_, _, match string) *reel.Step {
+	hh.violations = nil
+	start := strings.Index(match, "{")
+	if start < 0 {
+		hh.result = tnf.ERROR
+		return nil
+	}
+	parsed := &release{}
+	if err := json.Unmarshal([]byte(strings.TrimSpace(match[start:])), parsed); err != nil {
+		hh.result = tnf.ERROR
+		return nil
+	}
+	hh.hooks = parsed.Hooks
+	for _, hook := range hh.hooks {
+		if rbacKinds[hook.Kind] {
+			hh.violations = append(hh.violations, fmt.Sprintf("%s hook %s creates %s %s",
+				strings.Join(hook.Events, ","), hook.Path, hook.Kind, hook.Name))
+		}
+	}
+	if len(hh.violations) > 0 {
+		hh.result = tnf.FAILURE
+	} else {
+		hh.result = tnf.SUCCESS
+	}
+	return nil
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (hh *HelmHooks) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (hh *HelmHooks) ReelEOF() {
+}
@@ -0,0 +1,80 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package helmhooks_test
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/helmhooks"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testDataDirectory   = "testdata"
+	testTimeoutDuration = time.Second * 2
+)
+
+func getMockOutput(t *testing.T, testName string) string {
+	b, err := os.ReadFile(path.Join(testDataDirectory, testName+".txt"))
+	assert.Nil(t, err)
+	return string(b)
+}
+
+func TestNewHelmHooks(t *testing.T) {
+	handler := helmhooks.NewHelmHooks(testTimeoutDuration, "tnf", "sh.helm.release.v1.cnf.v3")
+	assert.Len(t, handler.Args(), 1)
+	assert.Contains(t, handler.Args()[0], "oc get secret -n tnf sh.helm.release.v1.cnf.v3")
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.HelmHooksIdentifier, handler.GetIdentifier())
+}
+
+func TestHelmHooks_ReelMatch(t *testing.T) {
+	testCases := map[string]struct {
+		expectedResult     int
+		expectedHooks      int
+		expectedViolations []string
+	}{
+		"safe": {
+			expectedResult: tnf.SUCCESS,
+			expectedHooks:  1,
+		},
+		"rbac": {
+			expectedResult:     tnf.FAILURE,
+			expectedHooks:      2,
+			expectedViolations: []string{"pre-install,pre-upgrade hook cnf/templates/installer-crb.yaml creates ClusterRoleBinding cnf-installer"},
+		},
+	}
+	for name, tc := range testCases {
+		handler := helmhooks.NewHelmHooks(testTimeoutDuration, "tnf", name)
+		assert.Nil(t, handler.ReelMatch("", "", getMockOutput(t, name)), name)
+		assert.Equal(t, tc.expectedResult, handler.Result(), name)
+		assert.Len(t, handler.GetHooks(), tc.expectedHooks, name)
+		assert.Equal(t, tc.expectedViolations, handler.GetViolations(), name)
+	}
+}
+
+func TestHelmHooks_ReelMatchError(t *testing.T) {
+	handler := helmhooks.NewHelmHooks(testTimeoutDuration, "tnf", "missing")
+	assert.Nil(t, handler.ReelMatch("", "", "Error from server (NotFound): secrets \"missing\" not found\nbase64: invalid input\n"))
+	assert.Equal(t, tnf.ERROR, handler.Result())
+}
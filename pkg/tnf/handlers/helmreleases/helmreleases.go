@@ -0,0 +1,133 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package helmreleases
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	helmRegex = "(?s).+"
+
+	// decodeReleaseFormat prints the JSON encoded release stored in a Helm release secret.  Helm base64 encodes the
+	// gzipped release once more on top of the secret data encoding.
+	decodeReleaseFormat = "oc get secret -n %s %s -o jsonpath='{.data.release}' | base64 -d | base64 -d | gzip -d"
+
+	numReleaseFields = 3
+)
+
+// Release describes the deployed revision of a Helm release.
+type Release struct {
+	Name      string
+	Namespace string
+	Revision  int
+	// Secret is the name of the secret holding the release.
+	Secret string
+}
+
+// HelmReleases lists the deployed Helm releases of a namespace.  The release secrets are read instead of running
+// `helm list`, so that the helm client is not needed.
+type HelmReleases struct {
+	namespace string
+	releases  []Release
+	result    int
+	timeout   time.Duration
+	args      []string
+}
+
+// NewHelmReleases creates a new HelmReleases tnf.Test.
+func NewHelmReleases(timeout time.Duration, namespace string) *HelmReleases {
+	return &HelmReleases{
+		namespace: namespace,
+		timeout:   timeout,
+		result:    tnf.ERROR,
+		args: []string{"oc", "get", "secrets", "-n", namespace, "-l", "owner=helm,status=deployed", "-o",
+			"custom-columns=NAME:.metadata.labels.name,REVISION:.metadata.labels.version,SECRET:.metadata.name", "--no-headers"},
+	}
+}
+
+// DecodeReleaseCommand returns the command printing the JSON encoded release stored in a Helm release secret.
+func DecodeReleaseCommand(namespace, secret string) string {
+	return fmt.Sprintf(decodeReleaseFormat, namespace, secret)
+}
+
+// Args returns the command line args for the test.
+func (hr *HelmReleases) Args() []string {
+	return hr.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (hr *HelmReleases) GetIdentifier() identifier.Identifier {
+	return identifier.HelmReleasesIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (hr *HelmReleases) Timeout() time.Duration {
+	return hr.timeout
+}
+
+// Result returns the test result.
+func (hr *HelmReleases) Result() int {
+	return hr.result
+}
+
+// GetReleases returns the deployed Helm releases of the namespace.
+func (hr *HelmReleases) GetReleases() []Release {
+	return hr.releases
+}
+
+// ReelFirst returns a step which expects the release secrets listing within the test timeout.
+func (hr *HelmReleases) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{helmRegex},
+		Timeout: hr.timeout,
+	}
+}
+
+// ReelMatch parses the release secrets listing.  A namespace without any release succeeds.
+func (hr *HelmReleases) ReelMatch(_, _, match string) *reel.Step {
+	hr.releases = nil
+	for _, line := range strings.Split(match, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != numReleaseFields {
+			continue
+		}
+		revision, err := strconv.Atoi(fields[1])
+		if err != nil {
+			continue
+		}
+		hr.releases = append(hr.releases, Release{Name: fields[0], Namespace: hr.namespace, Revision: revision, Secret: fields[2]})
+	}
+	hr.result = tnf.SUCCESS
+	return nil
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (hr *HelmReleases) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (hr *HelmReleases) ReelEOF() {
+}
@@ -0,0 +1,63 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package helmreleases_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/helmreleases"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testTimeoutDuration = time.Second * 2
+	testInputReleases   = "cnf      3   sh.helm.release.v1.cnf.v3\nmetrics  1   sh.helm.release.v1.metrics.v1\n"
+	testInputNone       = "No resources found in tnf namespace.\n"
+)
+
+func TestNewHelmReleases(t *testing.T) {
+	handler := helmreleases.NewHelmReleases(testTimeoutDuration, "tnf")
+	assert.Contains(t, handler.Args(), "owner=helm,status=deployed")
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.HelmReleasesIdentifier, handler.GetIdentifier())
+}
+
+func TestHelmReleases_ReelMatch(t *testing.T) {
+	handler := helmreleases.NewHelmReleases(testTimeoutDuration, "tnf")
+	assert.Nil(t, handler.ReelMatch("", "", testInputReleases))
+	assert.Equal(t, tnf.SUCCESS, handler.Result())
+	assert.Equal(t, []helmreleases.Release{
+		{Name: "cnf", Namespace: "tnf", Revision: 3, Secret: "sh.helm.release.v1.cnf.v3"},
+		{Name: "metrics", Namespace: "tnf", Revision: 1, Secret: "sh.helm.release.v1.metrics.v1"},
+	}, handler.GetReleases())
+}
+
+func TestHelmReleases_ReelMatchNone(t *testing.T) {
+	handler := helmreleases.NewHelmReleases(testTimeoutDuration, "tnf")
+	assert.Nil(t, handler.ReelMatch("", "", testInputNone))
+	assert.Equal(t, tnf.SUCCESS, handler.Result())
+	assert.Empty(t, handler.GetReleases())
+}
+
+func TestDecodeReleaseCommand(t *testing.T) {
+	assert.Equal(t, "oc get secret -n tnf sh.helm.release.v1.cnf.v3 -o jsonpath='{.data.release}' | base64 -d | base64 -d | gzip -d",
+		helmreleases.DecodeReleaseCommand("tnf", "sh.helm.release.v1.cnf.v3"))
+}
@@ -0,0 +1,195 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package imageinspect
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/dependencies"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	iiRegex = "(?s).+"
+	// digestSeparator separates the repository from the digest in a pinned image reference.
+	digestSeparator = "@sha256:"
+	latestTag       = "latest"
+	// defaultRegistry is the registry of the image references which do not name one.
+	defaultRegistry = "docker.io"
+)
+
+// ImageInfo holds the subset of the `skopeo inspect` output used by the tests.
+type ImageInfo struct {
+	Name         string            `json:"Name"`
+	Digest       string            `json:"Digest"`
+	RepoTags     []string          `json:"RepoTags"`
+	Created      string            `json:"Created"`
+	Labels       map[string]string `json:"Labels"`
+	Architecture string            `json:"Architecture"`
+	Os           string            `json:"Os"`
+	Layers       []string          `json:"Layers"`
+}
+
+// ImageInspect runs `skopeo inspect` against an image reference.
+type ImageInspect struct {
+	image   string
+	info    ImageInfo
+	result  int
+	timeout time.Duration
+	args    []string
+}
+
+// NewImageInspect creates a new ImageInspect tnf.Test for the given image reference, e.g. quay.io/org/image:tag.
+func NewImageInspect(timeout time.Duration, image string) *ImageInspect {
+	return &ImageInspect{
+		image:   image,
+		timeout: timeout,
+		result:  tnf.ERROR,
+		args:    []string{dependencies.SkopeoBinaryName, "inspect", "docker://" + image},
+	}
+}
+
+// Args returns the command line args for the test.
+func (ii *ImageInspect) Args() []string {
+	return ii.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (ii *ImageInspect) GetIdentifier() identifier.Identifier {
+	return identifier.ImageInspectIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (ii *ImageInspect) Timeout() time.Duration {
+	return ii.timeout
+}
+
+// Result returns the test result.
+func (ii *ImageInspect) Result() int {
+	return ii.result
+}
+
+// GetImageInfo returns the image information extracted from `skopeo inspect`.
+func (ii *ImageInspect) GetImageInfo() ImageInfo {
+	return ii.info
+}
+
+// GetLabels returns the image labels.
+func (ii *ImageInspect) GetLabels() map[string]string {
+	return ii.info.Labels
+}
+
+// GetLayers returns the image layer digests.
+func (ii *ImageInspect) GetLayers() []string {
+	return ii.info.Layers
+}
+
+// GetDigest returns the image manifest digest.
+func (ii *ImageInspect) GetDigest() string {
+	return ii.info.Digest
+}
+
+// UsesFloatingTag returns true when the inspected reference is not pinned by digest and therefore may resolve to a
+// different image over time.
+func (ii *ImageInspect) UsesFloatingTag() bool {
+	return IsFloatingTag(ii.image)
+}
+
+// IsFloatingTag returns true when an image reference is not pinned by digest.
+func IsFloatingTag(image string) bool {
+	return !strings.Contains(image, digestSeparator)
+}
+
+// IsLatestTag returns true when an image reference uses the "latest" tag, either explicitly or implicitly.
+func IsLatestTag(image string) bool {
+	if !IsFloatingTag(image) {
+		return false
+	}
+	name := image[strings.LastIndex(image, "/")+1:]
+	i := strings.LastIndex(name, ":")
+	return i < 0 || name[i+1:] == latestTag
+}
+
+// GetRegistry returns the registry hosting an image reference, which is docker.io when the reference does not name one.
+func GetRegistry(image string) string {
+	i := strings.Index(image, "/")
+	if i < 0 {
+		return defaultRegistry
+	}
+	host := image[:i]
+	if host != "localhost" && !strings.ContainsAny(host, ".:") {
+		return defaultRegistry
+	}
+	return host
+}
+
+// IsFromRegistry returns true when an image reference is hosted by one of the given registries.  A registry may be
+// followed by a repository path, e.g. quay.io/org, to only accept the images under that path.
+func IsFromRegistry(image string, registries []string) bool {
+	registry := GetRegistry(image)
+	repository := image
+	switch {
+	case !strings.Contains(image, "/"):
+		repository = defaultRegistry + "/library/" + image
+	case !strings.HasPrefix(image, registry+"/"):
+		repository = defaultRegistry + "/" + image
+	}
+	for _, approved := range registries {
+		approved = strings.TrimSuffix(approved, "/")
+		if approved == registry || strings.HasPrefix(repository, approved+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// ReelFirst returns a step which expects the skopeo output within the test timeout.
+func (ii *ImageInspect) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{iiRegex},
+		Timeout: ii.timeout,
+	}
+}
+
+// ReelMatch parses the JSON output of `skopeo inspect`.
+func (ii *ImageInspect) ReelMatch(_, _, match string) *reel.Step {
+	start := strings.Index(match, "{")
+	end := strings.LastIndex(match, "}")
+	if start < 0 || end < start {
+		ii.result = tnf.ERROR
+		return nil
+	}
+	if err := json.Unmarshal([]byte(match[start:end+1]), &ii.info); err != nil || ii.info.Digest == "" {
+		ii.result = tnf.ERROR
+		return nil
+	}
+	ii.result = tnf.SUCCESS
+	return nil
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (ii *ImageInspect) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (ii *ImageInspect) ReelEOF() {
+}
@@ -0,0 +1,108 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package imageinspect_test
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	ii "github.com/test-network-function/test-network-function/pkg/tnf/handlers/imageinspect"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testDataDirectory   = "testdata"
+	testDataFileSuffix  = ".txt"
+	testTimeoutDuration = time.Second * 2
+	testImage           = "quay.io/testnetworkfunction/cnf-test-partner:latest"
+)
+
+func getMockOutput(t *testing.T, testName string) string {
+	b, err := os.ReadFile(path.Join(testDataDirectory, fmt.Sprintf("%s%s", testName, testDataFileSuffix)))
+	assert.Nil(t, err)
+	return string(b)
+}
+
+func TestNewImageInspect(t *testing.T) {
+	handler := ii.NewImageInspect(testTimeoutDuration, testImage)
+	assert.Equal(t, []string{"skopeo", "inspect", "docker://" + testImage}, handler.Args())
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.ImageInspectIdentifier, handler.GetIdentifier())
+	assert.True(t, handler.UsesFloatingTag())
+}
+
+func TestImageInspect_ReelMatchSuccess(t *testing.T) {
+	handler := ii.NewImageInspect(testTimeoutDuration, testImage)
+	step := handler.ReelMatch("", "", getMockOutput(t, "inspect"))
+	assert.Nil(t, step)
+	assert.Equal(t, tnf.SUCCESS, handler.Result())
+	assert.Equal(t, "sha256:8d9a1b1e2b5bd1d7e5b2a1c3dca36d3ad0eb8b0f1ed2d2a0f5c1a73b2b5e1f44", handler.GetDigest())
+	assert.Equal(t, "Red Hat, Inc.", handler.GetLabels()["maintainer"])
+	assert.Len(t, handler.GetLayers(), 2)
+	assert.Equal(t, "amd64", handler.GetImageInfo().Architecture)
+}
+
+func TestImageInspect_ReelMatchError(t *testing.T) {
+	handler := ii.NewImageInspect(testTimeoutDuration, "quay.io/does/not:exist")
+	step := handler.ReelMatch("", "", getMockOutput(t, "manifest_unknown"))
+	assert.Nil(t, step)
+	assert.Equal(t, tnf.ERROR, handler.Result())
+}
+
+func TestIsFloatingTag(t *testing.T) {
+	assert.True(t, ii.IsFloatingTag("quay.io/org/image:v1.0.0"))
+	assert.False(t, ii.IsFloatingTag("quay.io/org/image@sha256:8d9a1b1e2b5bd1d7e5b2a1c3dca36d3ad0eb8b0f1ed2d2a0f5c1a73b2b5e1f44"))
+}
+
+func TestIsLatestTag(t *testing.T) {
+	assert.True(t, ii.IsLatestTag("quay.io/org/image"))
+	assert.True(t, ii.IsLatestTag("quay.io/org/image:latest"))
+	assert.True(t, ii.IsLatestTag("registry.local:5000/org/image"))
+	assert.False(t, ii.IsLatestTag("registry.local:5000/org/image:v1"))
+	assert.False(t, ii.IsLatestTag("quay.io/org/image@sha256:8d9a1b1e2b5bd1d7e5b2a1c3dca36d3ad0eb8b0f1ed2d2a0f5c1a73b2b5e1f44"))
+}
+
+func TestGetRegistry(t *testing.T) {
+	assert.Equal(t, "docker.io", ii.GetRegistry("nginx"))
+	assert.Equal(t, "docker.io", ii.GetRegistry("library/nginx:1.21"))
+	assert.Equal(t, "quay.io", ii.GetRegistry("quay.io/org/image:v1"))
+	assert.Equal(t, "registry.local:5000", ii.GetRegistry("registry.local:5000/org/image"))
+	assert.Equal(t, "localhost", ii.GetRegistry("localhost/image"))
+}
+
+func TestIsFromRegistry(t *testing.T) {
+	registries := []string{"registry.connect.redhat.com", "quay.io/org/"}
+	assert.True(t, ii.IsFromRegistry("registry.connect.redhat.com/vendor/cnf@sha256:8d9a", registries))
+	assert.True(t, ii.IsFromRegistry("quay.io/org/image:v1", registries))
+	assert.False(t, ii.IsFromRegistry("quay.io/other/image:v1", registries))
+	assert.False(t, ii.IsFromRegistry("nginx", registries))
+	assert.True(t, ii.IsFromRegistry("nginx", []string{"docker.io/library"}))
+	assert.False(t, ii.IsFromRegistry("image-registry.openshift-image-registry.svc:5000/ns/image", registries))
+}
+
+// Ensure there are no panics.
+func TestImageInspect_ReelEof(t *testing.T) {
+	handler := ii.NewImageInspect(testTimeoutDuration, testImage)
+	assert.Nil(t, handler.ReelTimeout())
+	handler.ReelEOF()
+}
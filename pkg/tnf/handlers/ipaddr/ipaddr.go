@@ -17,6 +17,7 @@
 package ipaddr
 
 import (
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"strings"
@@ -28,13 +29,39 @@ import (
 	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
 )
 
+// AddrInfo describes an address assigned to an interface, as reported by `ip -j addr`.
+type AddrInfo struct {
+	Family    string `json:"family"`
+	Local     string `json:"local"`
+	PrefixLen int    `json:"prefixlen"`
+	Broadcast string `json:"broadcast,omitempty"`
+	Scope     string `json:"scope"`
+	Label     string `json:"label,omitempty"`
+}
+
+// Interface describes an interface and its addresses, as reported by `ip -j addr`.
+type Interface struct {
+	IfIndex   int        `json:"ifindex"`
+	IfName    string     `json:"ifname"`
+	Flags     []string   `json:"flags"`
+	MTU       int        `json:"mtu"`
+	OperState string     `json:"operstate"`
+	LinkType  string     `json:"link_type"`
+	Address   string     `json:"address"`
+	AddrInfo  []AddrInfo `json:"addr_info"`
+}
+
 // IPAddr provides an ip addr test implemented using command line tool `ip addr`.
 type IPAddr struct {
 	result  int
 	timeout time.Duration
 	args    []string
+	// useJSON is set when the test runs `ip -j addr` and unmarshals its output.
+	useJSON bool
 	// The ipv4 address for a given device if the Handler matches.
 	ipv4Address string
+	// The interfaces unmarshalled from the `ip -j addr` output.  Nil when the regex fallback was used.
+	interfaces []Interface
 }
 
 const (
@@ -42,6 +69,10 @@ const (
 	DeviceDoesNotExistRegex = `(?m)Device \"(\w+)\" does not exist.$`
 	// SuccessfulOutputRegex matches `ip addr` output for a given device, and provides grouping to extract the associated Ipv4 address.
 	SuccessfulOutputRegex = `(?m)^\s+inet ((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\.(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\.(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\.(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?))`
+	// JSONOutputRegex matches the whole output of the `ip -j addr` command, or of its plain text fallback.
+	JSONOutputRegex = `(?s).+`
+
+	inetFamily = "inet"
 )
 
 var (
@@ -71,6 +102,12 @@ func (i *IPAddr) Result() int {
 
 // ReelFirst returns a step which expects an ip summary for the given device.
 func (i *IPAddr) ReelFirst() *reel.Step {
+	if i.useJSON {
+		return &reel.Step{
+			Expect:  []string{JSONOutputRegex},
+			Timeout: i.timeout,
+		}
+	}
 	return &reel.Step{
 		Expect:  []string{SuccessfulOutputRegex, DeviceDoesNotExistRegex},
 		Timeout: i.timeout,
@@ -80,10 +117,18 @@ func (i *IPAddr) ReelFirst() *reel.Step {
 // ReelMatch parses the ip addr output and set the test result on match.
 // Returns no step; the test is complete.
 func (i *IPAddr) ReelMatch(pattern, _, match string) *reel.Step {
+	if i.useJSON {
+		return i.reelMatchJSON(match)
+	}
 	if pattern == DeviceDoesNotExistRegex {
 		i.result = tnf.ERROR
 		return nil
 	}
+	return i.reelMatchRegex(match)
+}
+
+// reelMatchRegex extracts the ipv4 address from the plain text `ip addr` output.
+func (i *IPAddr) reelMatchRegex(match string) *reel.Step {
 	re := regexp.MustCompile(SuccessfulOutputRegex)
 	matched := re.FindStringSubmatch(match)
 	if matched != nil {
@@ -93,6 +138,32 @@ func (i *IPAddr) ReelMatch(pattern, _, match string) *reel.Step {
 	return nil
 }
 
+// reelMatchJSON unmarshals the `ip -j addr` output.  When `ip` does not support -j the command falls back to the plain
+// text output, which is scraped with SuccessfulOutputRegex instead.
+func (i *IPAddr) reelMatchJSON(match string) *reel.Step {
+	if regexp.MustCompile(DeviceDoesNotExistRegex).MatchString(match) {
+		i.result = tnf.ERROR
+		return nil
+	}
+	start := strings.Index(match, "[")
+	end := strings.LastIndex(match, "]")
+	if start < 0 || end < start || json.Unmarshal([]byte(match[start:end+1]), &i.interfaces) != nil {
+		i.interfaces = nil
+		return i.reelMatchRegex(match)
+	}
+	for _, iface := range i.interfaces {
+		for _, addr := range iface.AddrInfo {
+			if addr.Family == inetFamily && i.ipv4Address == "" {
+				i.ipv4Address = addr.Local
+			}
+		}
+	}
+	if i.ipv4Address != "" {
+		i.result = tnf.SUCCESS
+	}
+	return nil
+}
+
 // ReelTimeout does nothing;  no intervention is needed for `ip addr` timeout.
 func (i *IPAddr) ReelTimeout() *reel.Step {
 	return nil
@@ -107,6 +178,12 @@ func (i *IPAddr) GetIPv4Address() string {
 	return i.ipv4Address
 }
 
+// GetInterfaces returns the interfaces unmarshalled from the `ip -j addr` output.  It is nil when the test was not
+// created with NewIPAddrJSON, or when `ip` does not support JSON output.
+func (i *IPAddr) GetInterfaces() []Interface {
+	return i.interfaces
+}
+
 func ipAddrCmd(dev string) []string {
 	return strings.Split(fmt.Sprintf("%s %s", ipAddrCommand, dev), " ")
 }
@@ -115,3 +192,10 @@ func ipAddrCmd(dev string) []string {
 func NewIPAddr(timeout time.Duration, device string) *IPAddr {
 	return &IPAddr{result: tnf.ERROR, timeout: timeout, args: ipAddrCmd(device)}
 }
+
+// NewIPAddrJSON creates a new `ip -j addr` test for the given device.  The command falls back to the plain `ip addr`
+// output on systems where `ip` does not support the -j option.
+func NewIPAddrJSON(timeout time.Duration, device string) *IPAddr {
+	command := fmt.Sprintf("%s -j addr show dev %s 2>/dev/null || %s %s", dependencies.IPBinaryName, device, ipAddrCommand, device)
+	return &IPAddr{result: tnf.ERROR, timeout: timeout, args: []string{command}, useJSON: true}
+}
@@ -141,3 +141,43 @@ func TestIpAddr_ReelEof(t *testing.T) {
 		ipAddr.ReelEOF()
 	}
 }
+
+func TestNewIPAddrJSON(t *testing.T) {
+	ipAddr := ipaddr.NewIPAddrJSON(testTimeoutDuration, "eth0")
+	assert.Equal(t, tnf.ERROR, ipAddr.Result())
+	assert.Equal(t, []string{"ip -j addr show dev eth0 2>/dev/null || ip addr show dev eth0"}, ipAddr.Args())
+	assert.Equal(t, []string{ipaddr.JSONOutputRegex}, ipAddr.ReelFirst().Expect)
+}
+
+func TestIPAddrJSON_ReelMatch(t *testing.T) {
+	jsonTestCases := map[string]struct {
+		expectedResult      int
+		expectedIpv4Address string
+		expectedInterfaces  int
+	}{
+		"device_exists_json":    {tnf.SUCCESS, "172.17.0.7", 1},
+		"device_exists":         {tnf.SUCCESS, "172.17.0.7", 0},
+		"device_does_not_exist": {tnf.ERROR, "", 0},
+	}
+	for testName, testCase := range jsonTestCases {
+		ipAddr := ipaddr.NewIPAddrJSON(testTimeoutDuration, "eth0")
+		step := ipAddr.ReelMatch(ipaddr.JSONOutputRegex, "", getMockOutput(t, testName))
+		assert.Nil(t, step)
+		assert.Equal(t, testCase.expectedResult, ipAddr.Result(), testName)
+		assert.Equal(t, testCase.expectedIpv4Address, ipAddr.GetIPv4Address(), testName)
+		assert.Len(t, ipAddr.GetInterfaces(), testCase.expectedInterfaces, testName)
+	}
+}
+
+func TestIPAddrJSON_GetInterfaces(t *testing.T) {
+	ipAddr := ipaddr.NewIPAddrJSON(testTimeoutDuration, "eth0")
+	ipAddr.ReelMatch(ipaddr.JSONOutputRegex, "", getMockOutput(t, "device_exists_json"))
+	interfaces := ipAddr.GetInterfaces()
+	assert.Equal(t, "eth0", interfaces[0].IfName)
+	assert.Equal(t, 1500, interfaces[0].MTU)
+	assert.Equal(t, "02:42:ac:11:00:07", interfaces[0].Address)
+	assert.Equal(t, []ipaddr.AddrInfo{
+		{Family: "inet", Local: "172.17.0.7", PrefixLen: 16, Broadcast: "172.17.255.255", Scope: "global", Label: "eth0"},
+		{Family: "inet6", Local: "fe80::42:acff:fe11:7", PrefixLen: 64, Scope: "link"},
+	}, interfaces[0].AddrInfo)
+}
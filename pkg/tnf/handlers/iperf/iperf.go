@@ -0,0 +1,167 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package iperf
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/dependencies"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	iperfRegex = "(?s).+"
+)
+
+// Metrics holds the UDP statistics reported by the iperf3 client at the end of the measurement.
+type Metrics struct {
+	JitterMillis  float64 `json:"jitter_ms"`
+	LostPackets   int     `json:"lost_packets"`
+	Packets       int     `json:"packets"`
+	LostPercent   float64 `json:"lost_percent"`
+	BitsPerSecond float64 `json:"bits_per_second"`
+}
+
+// Thresholds holds the jitter and loss limits of the measurement.  A zero value disables the corresponding check.
+type Thresholds struct {
+	MaxJitterMillis float64
+	MaxLossPercent  float64
+}
+
+// report is the subset of the `iperf3 -J` output used by the handler.
+type report struct {
+	End struct {
+		Sum Metrics `json:"sum"`
+	} `json:"end"`
+	Error string `json:"error"`
+}
+
+// Iperf measures the jitter and packet loss towards an iperf3 server and checks them against the thresholds.
+type Iperf struct {
+	thresholds Thresholds
+	metrics    Metrics
+	violations []string
+	err        string
+	result     int
+	timeout    time.Duration
+	args       []string
+}
+
+// NewIperf creates a new Iperf tnf.Test which sends UDP traffic at `bandwidth` (e.g. "10M") to the iperf3 server at
+// `server` during `duration`.  The server must already be running, e.g. started with ServerCommand.
+func NewIperf(timeout time.Duration, server string, duration time.Duration, bandwidth string, thresholds Thresholds) *Iperf {
+	return &Iperf{
+		thresholds: thresholds,
+		timeout:    timeout,
+		result:     tnf.ERROR,
+		args: []string{dependencies.Iperf3BinaryName, "-c", server, "-u", "-b", bandwidth,
+			"-t", strconv.Itoa(int(duration.Seconds())), "-J"},
+	}
+}
+
+// ServerCommand returns command line args for starting a daemonized iperf3 server.
+func ServerCommand() []string {
+	return []string{dependencies.Iperf3BinaryName, "-s", "-D"}
+}
+
+// Args returns the command line args for the test.
+func (ip *Iperf) Args() []string {
+	return ip.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (ip *Iperf) GetIdentifier() identifier.Identifier {
+	return identifier.IperfIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (ip *Iperf) Timeout() time.Duration {
+	return ip.timeout
+}
+
+// Result returns the test result.
+func (ip *Iperf) Result() int {
+	return ip.result
+}
+
+// GetMetrics returns the measured jitter and packet loss.
+func (ip *Iperf) GetMetrics() Metrics {
+	return ip.metrics
+}
+
+// GetViolations returns a description of every exceeded threshold.
+func (ip *Iperf) GetViolations() []string {
+	return ip.violations
+}
+
+// GetError returns the error reported by iperf3, if any.
+func (ip *Iperf) GetError() string {
+	return ip.err
+}
+
+// ReelFirst returns a step which expects the iperf3 JSON report within the test timeout.
+func (ip *Iperf) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{iperfRegex},
+		Timeout: ip.timeout,
+	}
+}
+
+// ReelMatch parses the iperf3 JSON report and checks the jitter and packet loss against the thresholds.
+func (ip *Iperf) ReelMatch(_, _, match string) *reel.Step {
+	ip.violations = nil
+	start := strings.Index(match, "{")
+	end := strings.LastIndex(match, "}")
+	var parsed report
+	if start < 0 || end < start || json.Unmarshal([]byte(match[start:end+1]), &parsed) != nil {
+		ip.result = tnf.ERROR
+		return nil
+	}
+	if parsed.Error != "" {
+		ip.err = parsed.Error
+		ip.result = tnf.ERROR
+		return nil
+	}
+	ip.metrics = parsed.End.Sum
+	if ip.thresholds.MaxJitterMillis > 0 && ip.metrics.JitterMillis > ip.thresholds.MaxJitterMillis {
+		ip.violations = append(ip.violations, fmt.Sprintf("jitter %.3fms exceeds %.3fms", ip.metrics.JitterMillis, ip.thresholds.MaxJitterMillis))
+	}
+	if ip.thresholds.MaxLossPercent > 0 && ip.metrics.LostPercent > ip.thresholds.MaxLossPercent {
+		ip.violations = append(ip.violations, fmt.Sprintf("packet loss %.2f%% exceeds %.2f%%", ip.metrics.LostPercent, ip.thresholds.MaxLossPercent))
+	}
+	if len(ip.violations) > 0 {
+		ip.result = tnf.FAILURE
+	} else {
+		ip.result = tnf.SUCCESS
+	}
+	return nil
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (ip *Iperf) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (ip *Iperf) ReelEOF() {
+}
@@ -0,0 +1,110 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package iperf_test
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/iperf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testDataDirectory   = "testdata"
+	testTimeoutDuration = time.Second * 2
+	testServer          = "10.128.0.15"
+)
+
+func getMockOutput(t *testing.T, fileName string) string {
+	b, err := os.ReadFile(path.Join(testDataDirectory, fileName))
+	assert.Nil(t, err)
+	return string(b)
+}
+
+func TestNewIperf(t *testing.T) {
+	handler := iperf.NewIperf(testTimeoutDuration, testServer, 10*time.Second, "10M", iperf.Thresholds{})
+	assert.Equal(t, []string{"iperf3", "-c", testServer, "-u", "-b", "10M", "-t", "10", "-J"}, handler.Args())
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.IperfIdentifier, handler.GetIdentifier())
+	assert.Equal(t, []string{"iperf3", "-s", "-D"}, iperf.ServerCommand())
+}
+
+func TestIperf_ReelMatch(t *testing.T) {
+	testCases := map[string]struct {
+		fileName           string
+		thresholds         iperf.Thresholds
+		expectedResult     int
+		expectedViolations []string
+	}{
+		"no_thresholds": {
+			fileName:       "udp.json",
+			expectedResult: tnf.SUCCESS,
+		},
+		"within_thresholds": {
+			fileName:       "udp.json",
+			thresholds:     iperf.Thresholds{MaxJitterMillis: 0.1, MaxLossPercent: 0.5},
+			expectedResult: tnf.SUCCESS,
+		},
+		"exceeded_thresholds": {
+			fileName:           "udp.json",
+			thresholds:         iperf.Thresholds{MaxJitterMillis: 0.01, MaxLossPercent: 0.1},
+			expectedResult:     tnf.FAILURE,
+			expectedViolations: []string{"jitter 0.027ms exceeds 0.010ms", "packet loss 0.20% exceeds 0.10%"},
+		},
+		"server_unreachable": {
+			fileName:       "error.json",
+			expectedResult: tnf.ERROR,
+		},
+	}
+	for testName, testCase := range testCases {
+		handler := iperf.NewIperf(testTimeoutDuration, testServer, 10*time.Second, "10M", testCase.thresholds)
+		assert.Nil(t, handler.ReelMatch("", "", getMockOutput(t, testCase.fileName)))
+		assert.Equal(t, testCase.expectedResult, handler.Result(), testName)
+		assert.Equal(t, testCase.expectedViolations, handler.GetViolations(), testName)
+	}
+}
+
+func TestIperf_GetMetrics(t *testing.T) {
+	handler := iperf.NewIperf(testTimeoutDuration, testServer, 10*time.Second, "10M", iperf.Thresholds{})
+	handler.ReelMatch("", "", getMockOutput(t, "udp.json"))
+	assert.Equal(t, iperf.Metrics{
+		JitterMillis:  0.027,
+		LostPackets:   18,
+		Packets:       9051,
+		LostPercent:   0.198873,
+		BitsPerSecond: 10484668.3,
+	}, handler.GetMetrics())
+}
+
+func TestIperf_GetError(t *testing.T) {
+	handler := iperf.NewIperf(testTimeoutDuration, testServer, 10*time.Second, "10M", iperf.Thresholds{})
+	handler.ReelMatch("", "", getMockOutput(t, "error.json"))
+	assert.Equal(t, "unable to connect to server: Connection refused", handler.GetError())
+}
+
+// Ensure there are no panics.
+func TestIperf_ReelEof(t *testing.T) {
+	handler := iperf.NewIperf(testTimeoutDuration, testServer, time.Second, "1M", iperf.Thresholds{})
+	assert.Nil(t, handler.ReelTimeout())
+	handler.ReelEOF()
+}
@@ -0,0 +1,150 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package kernelcmdline
+
+import (
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/dependencies"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	cmdlineRegex      = `.+`
+	keyValueSeparator = "="
+	numKeyValueFields = 2
+)
+
+// KernelCmdline checks that the required boot parameters are set on the kernel command line.
+type KernelCmdline struct {
+	required   []string
+	parameters map[string][]string
+	missing    []string
+	result     int
+	timeout    time.Duration
+	args       []string
+}
+
+// NewKernelCmdline creates a new KernelCmdline tnf.Test.  Each required parameter is either a bare name, such as
+// "nosmt", which only has to be present, or a name=value pair, such as "intel_iommu=on", which has to match exactly.
+func NewKernelCmdline(timeout time.Duration, required []string) *KernelCmdline {
+	return &KernelCmdline{
+		required: required,
+		timeout:  timeout,
+		result:   tnf.ERROR,
+		args:     []string{dependencies.CatBinaryName, "/proc/cmdline"},
+	}
+}
+
+// Args returns the command line args for the test.
+func (kc *KernelCmdline) Args() []string {
+	return kc.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (kc *KernelCmdline) GetIdentifier() identifier.Identifier {
+	return identifier.KernelCmdlineIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (kc *KernelCmdline) Timeout() time.Duration {
+	return kc.timeout
+}
+
+// Result returns the test result.
+func (kc *KernelCmdline) Result() int {
+	return kc.result
+}
+
+// GetParameters returns the boot parameters keyed by name.  A parameter given several times keeps all of its values
+// in order, and a parameter without a value maps to an empty string.
+func (kc *KernelCmdline) GetParameters() map[string][]string {
+	return kc.parameters
+}
+
+// GetMissing returns the required parameters that are absent or set to a different value.
+func (kc *KernelCmdline) GetMissing() []string {
+	return kc.missing
+}
+
+// ReelFirst returns a step which expects the kernel command line within the test timeout.
+func (kc *KernelCmdline) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{cmdlineRegex},
+		Timeout: kc.timeout,
+	}
+}
+
+// ReelMatch parses the kernel command line and checks that every required parameter is set.
+func (kc *KernelCmdline) ReelMatch(_, _, match string) *reel.Step {
+	kc.parameters = ParseParameters(match)
+	kc.missing = nil
+	for _, param := range kc.required {
+		if !kc.hasParameter(param) {
+			kc.missing = append(kc.missing, param)
+		}
+	}
+	if len(kc.missing) > 0 {
+		kc.result = tnf.FAILURE
+	} else {
+		kc.result = tnf.SUCCESS
+	}
+	return nil
+}
+
+func (kc *KernelCmdline) hasParameter(param string) bool {
+	keyValue := strings.SplitN(param, keyValueSeparator, numKeyValueFields)
+	values, ok := kc.parameters[keyValue[0]]
+	if !ok {
+		return false
+	}
+	if len(keyValue) == 1 {
+		return true
+	}
+	for _, value := range values {
+		if value == keyValue[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseParameters splits a kernel command line into its parameters keyed by name.
+func ParseParameters(cmdline string) map[string][]string {
+	parameters := map[string][]string{}
+	for _, field := range strings.Fields(cmdline) {
+		keyValue := strings.SplitN(field, keyValueSeparator, numKeyValueFields)
+		value := ""
+		if len(keyValue) == numKeyValueFields {
+			value = keyValue[1]
+		}
+		parameters[keyValue[0]] = append(parameters[keyValue[0]], value)
+	}
+	return parameters
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (kc *KernelCmdline) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (kc *KernelCmdline) ReelEOF() {
+}
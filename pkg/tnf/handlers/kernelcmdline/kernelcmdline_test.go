@@ -0,0 +1,85 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package kernelcmdline_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/kernelcmdline"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testTimeoutDuration = time.Second * 2
+	testInput           = "BOOT_IMAGE=(hd0,gpt3)/ostree/rhcos-8db99645/vmlinuz-4.18.0-305.el8.x86_64 random.trust_cpu=on " +
+		"ostree=/ostree/boot.1/rhcos/8db99645/0 skew_tick=1 nohz=on isolcpus=managed_irq,2-19 intel_iommu=on iommu=pt " +
+		"nosmt default_hugepagesz=1G hugepagesz=1G hugepages=16 hugepagesz=2M hugepages=1024\n"
+)
+
+func TestNewKernelCmdline(t *testing.T) {
+	handler := kernelcmdline.NewKernelCmdline(testTimeoutDuration, nil)
+	assert.Equal(t, []string{"cat", "/proc/cmdline"}, handler.Args())
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.KernelCmdlineIdentifier, handler.GetIdentifier())
+}
+
+func TestKernelCmdline_ReelMatch(t *testing.T) {
+	testCases := map[string]struct {
+		required        []string
+		expectedResult  int
+		expectedMissing []string
+	}{
+		"all_present": {
+			required:       []string{"isolcpus=managed_irq,2-19", "hugepages=1024", "intel_iommu=on", "nosmt"},
+			expectedResult: tnf.SUCCESS,
+		},
+		"presence_only": {
+			required:       []string{"isolcpus", "hugepages"},
+			expectedResult: tnf.SUCCESS,
+		},
+		"missing": {
+			required:        []string{"intel_iommu=off", "nosmt", "rcu_nocbs"},
+			expectedResult:  tnf.FAILURE,
+			expectedMissing: []string{"intel_iommu=off", "rcu_nocbs"},
+		},
+	}
+	for testName, testCase := range testCases {
+		handler := kernelcmdline.NewKernelCmdline(testTimeoutDuration, testCase.required)
+		assert.Nil(t, handler.ReelMatch("", "", testInput))
+		assert.Equal(t, testCase.expectedResult, handler.Result(), testName)
+		assert.Equal(t, testCase.expectedMissing, handler.GetMissing(), testName)
+	}
+}
+
+func TestParseParameters(t *testing.T) {
+	parameters := kernelcmdline.ParseParameters(testInput)
+	assert.Equal(t, []string{"16", "1024"}, parameters["hugepages"])
+	assert.Equal(t, []string{"1G", "2M"}, parameters["hugepagesz"])
+	assert.Equal(t, []string{""}, parameters["nosmt"])
+	assert.Equal(t, []string{"(hd0,gpt3)/ostree/rhcos-8db99645/vmlinuz-4.18.0-305.el8.x86_64"}, parameters["BOOT_IMAGE"])
+}
+
+// Ensure there are no panics.
+func TestKernelCmdline_ReelEof(t *testing.T) {
+	handler := kernelcmdline.NewKernelCmdline(testTimeoutDuration, nil)
+	assert.Nil(t, handler.ReelTimeout())
+	handler.ReelEOF()
+}
@@ -0,0 +1,233 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package listeningports
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/dependencies"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	ssRegex = "(?s).+"
+	// TCP is the Kubernetes name of the TCP protocol.
+	TCP = "TCP"
+	// UDP is the Kubernetes name of the UDP protocol.
+	UDP = "UDP"
+
+	netidColumn     = 0
+	localAddrColumn = 4
+	minColumns      = 5
+	portSeparator   = "/"
+	numPortFields   = 2
+)
+
+// loopbackPrefixes are the local addresses of listeners which are not reachable from outside the pod.
+var loopbackPrefixes = []string{"127.", "[::1]", "[::ffff:127."}
+
+// Port is a port number and protocol, such as a containerPort of a pod spec.
+type Port struct {
+	Number   int
+	Protocol string
+}
+
+// String returns the port as "<number>/<protocol>", e.g. "8080/TCP".
+func (p Port) String() string {
+	return fmt.Sprintf("%d%s%s", p.Number, portSeparator, p.Protocol)
+}
+
+// ParsePorts parses whitespace separated "<number>/<protocol>" ports, as printed by a jsonpath query of the
+// containerPorts.  A missing protocol defaults to TCP, like in the pod spec.
+func ParsePorts(ports string) ([]Port, error) {
+	var parsed []Port
+	for _, field := range strings.Fields(ports) {
+		parts := strings.SplitN(field, portSeparator, numPortFields)
+		number, err := strconv.Atoi(parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid port %q: %w", field, err)
+		}
+		protocol := TCP
+		if len(parts) == numPortFields && parts[1] != "" {
+			protocol = strings.ToUpper(parts[1])
+		}
+		parsed = append(parsed, Port{Number: number, Protocol: protocol})
+	}
+	return parsed, nil
+}
+
+// ListeningPorts compares the ports a container listens on to the ports it declares.
+type ListeningPorts struct {
+	declared     []Port
+	listening    []Port
+	undeclared   []Port
+	notListening []Port
+	result       int
+	timeout      time.Duration
+	args         []string
+}
+
+// NewListeningPorts creates a new ListeningPorts tnf.Test to run in a container.  Listeners bound to a loopback
+// address are not reachable from outside the pod, and are ignored.
+func NewListeningPorts(timeout time.Duration, declared []Port) *ListeningPorts {
+	return &ListeningPorts{
+		declared: declared,
+		timeout:  timeout,
+		result:   tnf.ERROR,
+		args:     []string{dependencies.SsBinaryName, "-ltun"},
+	}
+}
+
+// Args returns the command line args for the test.
+func (lp *ListeningPorts) Args() []string {
+	return lp.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (lp *ListeningPorts) GetIdentifier() identifier.Identifier {
+	return identifier.ListeningPortsIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (lp *ListeningPorts) Timeout() time.Duration {
+	return lp.timeout
+}
+
+// Result returns the test result.
+func (lp *ListeningPorts) Result() int {
+	return lp.result
+}
+
+// GetListening returns the sorted ports the container listens on.
+func (lp *ListeningPorts) GetListening() []Port {
+	return lp.listening
+}
+
+// GetUndeclared returns the ports the container listens on without declaring them.
+func (lp *ListeningPorts) GetUndeclared() []Port {
+	return lp.undeclared
+}
+
+// GetNotListening returns the declared ports nothing listens on.
+func (lp *ListeningPorts) GetNotListening() []Port {
+	return lp.notListening
+}
+
+// ReelFirst returns a step which expects the `ss` output within the test timeout.
+func (lp *ListeningPorts) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{ssRegex},
+		Timeout: lp.timeout,
+	}
+}
+
+// ReelMatch parses the listening sockets and compares them to the declared ports.
+func (lp *ListeningPorts) ReelMatch(_, _, match string) *reel.Step {
+	listening, ok := parseListening(match)
+	if !ok {
+		lp.result = tnf.ERROR
+		return nil
+	}
+	lp.listening = listening
+	lp.undeclared = difference(lp.listening, lp.declared)
+	lp.notListening = difference(lp.declared, lp.listening)
+	if len(lp.undeclared) > 0 || len(lp.notListening) > 0 {
+		lp.result = tnf.FAILURE
+	} else {
+		lp.result = tnf.SUCCESS
+	}
+	return nil
+}
+
+// parseListening returns the sorted, deduplicated ports of the non-loopback listeners, and false when the output is
+// not an `ss` table.
+func parseListening(match string) ([]Port, bool) {
+	lines := strings.Split(strings.TrimSpace(match), "\n")
+	if len(lines) == 0 || !strings.HasPrefix(lines[0], "Netid") {
+		return nil, false
+	}
+	found := map[Port]bool{}
+	for _, line := range lines[1:] {
+		fields := strings.Fields(line)
+		if len(fields) < minColumns {
+			continue
+		}
+		local := fields[localAddrColumn]
+		separator := strings.LastIndex(local, ":")
+		if separator < 0 || isLoopback(local) {
+			continue
+		}
+		number, err := strconv.Atoi(local[separator+1:])
+		if err != nil {
+			continue
+		}
+		found[Port{Number: number, Protocol: strings.ToUpper(fields[netidColumn])}] = true
+	}
+	ports := make([]Port, 0, len(found))
+	for port := range found {
+		ports = append(ports, port)
+	}
+	sort.Slice(ports, func(i, j int) bool {
+		if ports[i].Number != ports[j].Number {
+			return ports[i].Number < ports[j].Number
+		}
+		return ports[i].Protocol < ports[j].Protocol
+	})
+	return ports, true
+}
+
+func isLoopback(address string) bool {
+	for _, prefix := range loopbackPrefixes {
+		if strings.HasPrefix(address, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// difference returns the ports of a which are not in b.
+func difference(a, b []Port) []Port {
+	var ports []Port
+	for _, port := range a {
+		found := false
+		for _, other := range b {
+			if port == other {
+				found = true
+				break
+			}
+		}
+		if !found {
+			ports = append(ports, port)
+		}
+	}
+	return ports
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (lp *ListeningPorts) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (lp *ListeningPorts) ReelEOF() {
+}
@@ -0,0 +1,109 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package listeningports_test
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/listeningports"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testDataDirectory   = "testdata"
+	testTimeoutDuration = time.Second * 2
+)
+
+func getMockOutput(t *testing.T) string {
+	b, err := os.ReadFile(path.Join(testDataDirectory, "ss.txt"))
+	assert.Nil(t, err)
+	return string(b)
+}
+
+func TestNewListeningPorts(t *testing.T) {
+	handler := listeningports.NewListeningPorts(testTimeoutDuration, nil)
+	assert.Equal(t, []string{"ss", "-ltun"}, handler.Args())
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.ListeningPortsIdentifier, handler.GetIdentifier())
+}
+
+func TestListeningPorts_ReelMatch(t *testing.T) {
+	testCases := map[string]struct {
+		declared             string
+		expectedResult       int
+		expectedUndeclared   []listeningports.Port
+		expectedNotListening []listeningports.Port
+	}{
+		"all_declared": {
+			declared:       "8080/TCP 8443/TCP 5353/UDP",
+			expectedResult: tnf.SUCCESS,
+		},
+		"mismatch": {
+			declared:             "8080 8443/TCP 5353/TCP 9000/TCP",
+			expectedResult:       tnf.FAILURE,
+			expectedUndeclared:   []listeningports.Port{{Number: 5353, Protocol: "UDP"}},
+			expectedNotListening: []listeningports.Port{{Number: 5353, Protocol: "TCP"}, {Number: 9000, Protocol: "TCP"}},
+		},
+	}
+	for testName, testCase := range testCases {
+		declared, err := listeningports.ParsePorts(testCase.declared)
+		assert.Nil(t, err)
+		handler := listeningports.NewListeningPorts(testTimeoutDuration, declared)
+		assert.Nil(t, handler.ReelMatch("", "", getMockOutput(t)))
+		assert.Equal(t, testCase.expectedResult, handler.Result(), testName)
+		assert.Equal(t, testCase.expectedUndeclared, handler.GetUndeclared(), testName)
+		assert.Equal(t, testCase.expectedNotListening, handler.GetNotListening(), testName)
+	}
+}
+
+func TestListeningPorts_GetListening(t *testing.T) {
+	handler := listeningports.NewListeningPorts(testTimeoutDuration, nil)
+	handler.ReelMatch("", "", getMockOutput(t))
+	assert.Equal(t, []listeningports.Port{
+		{Number: 5353, Protocol: "UDP"},
+		{Number: 8080, Protocol: "TCP"},
+		{Number: 8443, Protocol: "TCP"},
+	}, handler.GetListening())
+}
+
+func TestListeningPorts_ReelMatchError(t *testing.T) {
+	handler := listeningports.NewListeningPorts(testTimeoutDuration, nil)
+	assert.Nil(t, handler.ReelMatch("", "", "sh: ss: command not found\n"))
+	assert.Equal(t, tnf.ERROR, handler.Result())
+}
+
+func TestParsePorts(t *testing.T) {
+	ports, err := listeningports.ParsePorts("8080/TCP 53/udp 9090")
+	assert.Nil(t, err)
+	assert.Equal(t, []listeningports.Port{{8080, "TCP"}, {53, "UDP"}, {9090, "TCP"}}, ports)
+	assert.Equal(t, "53/UDP", ports[1].String())
+	_, err = listeningports.ParsePorts("http/TCP")
+	assert.NotNil(t, err)
+}
+
+// Ensure there are no panics.
+func TestListeningPorts_ReelEof(t *testing.T) {
+	handler := listeningports.NewListeningPorts(testTimeoutDuration, nil)
+	assert.Nil(t, handler.ReelTimeout())
+	handler.ReelEOF()
+}
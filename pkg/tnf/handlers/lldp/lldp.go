@@ -0,0 +1,180 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package lldp
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/dependencies"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	lldpRegex = "(?s).+"
+	keyPrefix = "lldp."
+)
+
+// Neighbor describes the LLDP neighbor seen on an interface.
+type Neighbor struct {
+	ChassisName string
+	ChassisID   string
+	PortID      string
+	PortDescr   string
+}
+
+// LLDP reports the LLDP neighbors of node interfaces and optionally checks them against the expected cabling.
+type LLDP struct {
+	interfaces []string
+	expected   map[string]Neighbor
+	neighbors  map[string]Neighbor
+	mismatched []string
+	result     int
+	timeout    time.Duration
+	args       []string
+}
+
+// NewLLDP creates a new LLDP tnf.Test to run in a node debug pod.  expected maps every interface to check to its
+// expected neighbor; empty Neighbor fields are not compared, so an empty Neighbor only requires a neighbor to exist.
+func NewLLDP(timeout time.Duration, expected map[string]Neighbor) *LLDP {
+	interfaces := make([]string, 0, len(expected))
+	for iface := range expected {
+		interfaces = append(interfaces, iface)
+	}
+	sort.Strings(interfaces)
+	args := append([]string{"chroot", "/host", dependencies.LldpctlBinaryName, "-f", "keyvalue"}, interfaces...)
+	return &LLDP{
+		interfaces: interfaces,
+		expected:   expected,
+		timeout:    timeout,
+		result:     tnf.ERROR,
+		args:       args,
+	}
+}
+
+// Args returns the command line args for the test.
+func (l *LLDP) Args() []string {
+	return l.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (l *LLDP) GetIdentifier() identifier.Identifier {
+	return identifier.LLDPIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (l *LLDP) Timeout() time.Duration {
+	return l.timeout
+}
+
+// Result returns the test result.
+func (l *LLDP) Result() int {
+	return l.result
+}
+
+// GetNeighbors returns the LLDP neighbors keyed by local interface.
+func (l *LLDP) GetNeighbors() map[string]Neighbor {
+	return l.neighbors
+}
+
+// GetMismatches returns a description of every interface whose neighbor is missing or differs from the expected one.
+func (l *LLDP) GetMismatches() []string {
+	return l.mismatched
+}
+
+// ReelFirst returns a step which expects the lldpctl output within the test timeout.
+func (l *LLDP) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{lldpRegex},
+		Timeout: l.timeout,
+	}
+}
+
+// ReelMatch parses the `lldpctl -f keyvalue` output and compares the neighbors to the expected ones.
+func (l *LLDP) ReelMatch(_, _, match string) *reel.Step {
+	l.neighbors = map[string]Neighbor{}
+	l.mismatched = nil
+	for _, line := range strings.Split(match, "\n") {
+		l.parseLine(strings.TrimSpace(line))
+	}
+
+	for _, iface := range l.interfaces {
+		neighbor, ok := l.neighbors[iface]
+		if !ok {
+			l.mismatched = append(l.mismatched, fmt.Sprintf("%s: no neighbor", iface))
+			continue
+		}
+		expected := l.expected[iface]
+		if (expected.ChassisName != "" && expected.ChassisName != neighbor.ChassisName) ||
+			(expected.ChassisID != "" && expected.ChassisID != neighbor.ChassisID) ||
+			(expected.PortID != "" && expected.PortID != neighbor.PortID) {
+			l.mismatched = append(l.mismatched, fmt.Sprintf("%s: connected to %s/%s", iface, neighbor.ChassisName, neighbor.PortID))
+		}
+	}
+
+	if len(l.mismatched) > 0 {
+		l.result = tnf.FAILURE
+	} else {
+		l.result = tnf.SUCCESS
+	}
+	return nil
+}
+
+// parseLine handles a single lldp.<interface>.<key>=<value> line.
+func (l *LLDP) parseLine(line string) {
+	const numKeyValueElements = 2
+	keyValue := strings.SplitN(line, "=", numKeyValueElements)
+	if len(keyValue) != numKeyValueElements || !strings.HasPrefix(keyValue[0], keyPrefix) {
+		return
+	}
+	keyParts := strings.SplitN(strings.TrimPrefix(keyValue[0], keyPrefix), ".", numKeyValueElements)
+	if len(keyParts) != numKeyValueElements {
+		return
+	}
+	iface, key, value := keyParts[0], keyParts[1], keyValue[1]
+	neighbor := l.neighbors[iface]
+	switch key {
+	case "chassis.name":
+		neighbor.ChassisName = value
+	case "chassis.mac", "chassis.local", "chassis.ip":
+		if neighbor.ChassisID == "" {
+			neighbor.ChassisID = value
+		}
+	case "port.ifname", "port.local", "port.mac":
+		if neighbor.PortID == "" {
+			neighbor.PortID = value
+		}
+	case "port.descr":
+		neighbor.PortDescr = value
+	default:
+		return
+	}
+	l.neighbors[iface] = neighbor
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (l *LLDP) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (l *LLDP) ReelEOF() {
+}
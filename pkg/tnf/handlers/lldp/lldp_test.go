@@ -0,0 +1,109 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package lldp_test
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/lldp"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testDataDirectory   = "testdata"
+	testDataFileSuffix  = ".txt"
+	testTimeoutDuration = time.Second * 2
+	testNeighbors       = "neighbors"
+)
+
+type TestCase struct {
+	expected           map[string]lldp.Neighbor
+	expectedResult     int
+	expectedMismatches []string
+}
+
+var testCases = map[string]TestCase{
+	"cabling_matches": {
+		expected: map[string]lldp.Neighbor{
+			"ens1f0": {ChassisName: "leaf-01", PortID: "Ethernet12"},
+			"ens1f1": {},
+		},
+		expectedResult: tnf.SUCCESS,
+	},
+	"wrong_port": {
+		expected: map[string]lldp.Neighbor{
+			"ens1f1": {ChassisName: "leaf-02", PortID: "Ethernet12"},
+		},
+		expectedResult:     tnf.FAILURE,
+		expectedMismatches: []string{"ens1f1: connected to leaf-02/Ethernet14"},
+	},
+	"no_neighbor": {
+		expected: map[string]lldp.Neighbor{
+			"ens2f0": {},
+		},
+		expectedResult:     tnf.FAILURE,
+		expectedMismatches: []string{"ens2f0: no neighbor"},
+	},
+}
+
+func getMockOutput(t *testing.T, testName string) string {
+	b, err := os.ReadFile(path.Join(testDataDirectory, fmt.Sprintf("%s%s", testName, testDataFileSuffix)))
+	assert.Nil(t, err)
+	return string(b)
+}
+
+func TestNewLLDP(t *testing.T) {
+	handler := lldp.NewLLDP(testTimeoutDuration, map[string]lldp.Neighbor{"ens1f1": {}, "ens1f0": {}})
+	assert.Equal(t, []string{"chroot", "/host", "lldpctl", "-f", "keyvalue", "ens1f0", "ens1f1"}, handler.Args())
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.LLDPIdentifier, handler.GetIdentifier())
+}
+
+func TestLLDP_ReelMatch(t *testing.T) {
+	for testName, testCase := range testCases {
+		handler := lldp.NewLLDP(testTimeoutDuration, testCase.expected)
+		step := handler.ReelMatch("", "", getMockOutput(t, testNeighbors))
+		assert.Nil(t, step)
+		assert.Equal(t, testCase.expectedResult, handler.Result(), testName)
+		assert.Equal(t, testCase.expectedMismatches, handler.GetMismatches(), testName)
+	}
+}
+
+func TestLLDP_GetNeighbors(t *testing.T) {
+	handler := lldp.NewLLDP(testTimeoutDuration, map[string]lldp.Neighbor{"ens1f0": {}})
+	handler.ReelMatch("", "", getMockOutput(t, testNeighbors))
+	assert.Equal(t, lldp.Neighbor{
+		ChassisName: "leaf-01",
+		ChassisID:   "00:1c:73:aa:bb:cc",
+		PortID:      "Ethernet12",
+		PortDescr:   "worker-0 ens1f0",
+	}, handler.GetNeighbors()["ens1f0"])
+}
+
+// Ensure there are no panics.
+func TestLLDP_ReelEof(t *testing.T) {
+	handler := lldp.NewLLDP(testTimeoutDuration, nil)
+	assert.Nil(t, handler.ReelTimeout())
+	handler.ReelEOF()
+}
@@ -0,0 +1,192 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package multusinterfaces
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/dependencies"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	miRegex = "(?s).+"
+
+	// NetworksStatusAnnotation is the pod annotation in which Multus records the status of every attachment.
+	NetworksStatusAnnotation = "k8s.v1.cni.cncf.io/networks-status"
+
+	inetFamily  = "inet"
+	inet6Family = "inet6"
+)
+
+// NetworkStatus is a single entry of the networks-status annotation.
+type NetworkStatus struct {
+	Name      string   `json:"name"`
+	Interface string   `json:"interface"`
+	IPs       []string `json:"ips"`
+	Mac       string   `json:"mac"`
+	Default   bool     `json:"default"`
+}
+
+// ParseNetworksStatus parses the JSON value of the networks-status annotation.
+func ParseNetworksStatus(annotation string) ([]NetworkStatus, error) {
+	var statuses []NetworkStatus
+	if err := json.Unmarshal([]byte(annotation), &statuses); err != nil {
+		return nil, fmt.Errorf("unable to parse %s annotation: %w", NetworksStatusAnnotation, err)
+	}
+	return statuses, nil
+}
+
+// MultusInterfaces enumerates the interfaces of a pod and checks that every secondary attachment declared in the
+// networks-status annotation has an interface holding the declared IPs.
+type MultusInterfaces struct {
+	attachments []NetworkStatus
+	interfaces  map[string][]string
+	mismatched  []string
+	result      int
+	timeout     time.Duration
+	args        []string
+}
+
+// NewMultusInterfaces creates a new MultusInterfaces tnf.Test, to run in a container of the pod the statuses belong to.
+// Default network entries are ignored.
+func NewMultusInterfaces(timeout time.Duration, statuses []NetworkStatus) *MultusInterfaces {
+	var attachments []NetworkStatus
+	for _, status := range statuses {
+		if !status.Default {
+			attachments = append(attachments, status)
+		}
+	}
+	return &MultusInterfaces{
+		attachments: attachments,
+		timeout:     timeout,
+		result:      tnf.ERROR,
+		args:        []string{dependencies.IPBinaryName, "-o", "addr", "show"},
+	}
+}
+
+// Args returns the command line args for the test.
+func (mi *MultusInterfaces) Args() []string {
+	return mi.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (mi *MultusInterfaces) GetIdentifier() identifier.Identifier {
+	return identifier.MultusInterfacesIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (mi *MultusInterfaces) Timeout() time.Duration {
+	return mi.timeout
+}
+
+// Result returns the test result.
+func (mi *MultusInterfaces) Result() int {
+	return mi.result
+}
+
+// GetInterfaces returns the IP addresses of every interface of the pod, keyed by interface name.
+func (mi *MultusInterfaces) GetInterfaces() map[string][]string {
+	return mi.interfaces
+}
+
+// GetSecondaryInterfaces returns the sorted names of the interfaces other than lo and eth0.
+func (mi *MultusInterfaces) GetSecondaryInterfaces() []string {
+	var names []string
+	for name := range mi.interfaces {
+		if name != "lo" && name != "eth0" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// GetMismatches returns a description of every declared attachment that is missing or lacks an IP.
+func (mi *MultusInterfaces) GetMismatches() []string {
+	return mi.mismatched
+}
+
+// ReelFirst returns a step which expects the address list within the test timeout.
+func (mi *MultusInterfaces) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{miRegex},
+		Timeout: mi.timeout,
+	}
+}
+
+// ReelMatch parses the `ip -o addr show` output and checks every declared attachment.
+func (mi *MultusInterfaces) ReelMatch(_, _, match string) *reel.Step {
+	const minFields = 4
+	mi.interfaces = map[string][]string{}
+	mi.mismatched = nil
+	for _, line := range strings.Split(match, "\n") {
+		// 4: net1    inet 192.168.1.10/24 brd 192.168.1.255 scope global net1\       valid_lft forever preferred_lft forever
+		fields := strings.Fields(line)
+		if len(fields) < minFields || !strings.HasSuffix(fields[0], ":") {
+			continue
+		}
+		name := strings.Split(fields[1], "@")[0]
+		if fields[2] == inetFamily || fields[2] == inet6Family {
+			mi.interfaces[name] = append(mi.interfaces[name], strings.Split(fields[3], "/")[0])
+		}
+	}
+
+	for _, attachment := range mi.attachments {
+		ips, ok := mi.interfaces[attachment.Interface]
+		if !ok {
+			mi.mismatched = append(mi.mismatched, fmt.Sprintf("%s: interface %s not found", attachment.Name, attachment.Interface))
+			continue
+		}
+		for _, ip := range attachment.IPs {
+			if !contains(ips, ip) {
+				mi.mismatched = append(mi.mismatched, fmt.Sprintf("%s: interface %s has no ip %s", attachment.Name, attachment.Interface, ip))
+			}
+		}
+	}
+
+	if len(mi.mismatched) > 0 {
+		mi.result = tnf.FAILURE
+	} else {
+		mi.result = tnf.SUCCESS
+	}
+	return nil
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (mi *MultusInterfaces) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (mi *MultusInterfaces) ReelEOF() {
+}
@@ -0,0 +1,97 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package multusinterfaces_test
+
+import (
+	"os"
+	"path"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	mi "github.com/test-network-function/test-network-function/pkg/tnf/handlers/multusinterfaces"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testDataDirectory   = "testdata"
+	testTimeoutDuration = time.Second * 2
+)
+
+func getTestData(t *testing.T, fileName string) string {
+	b, err := os.ReadFile(path.Join(testDataDirectory, fileName))
+	assert.Nil(t, err)
+	return string(b)
+}
+
+func getNetworksStatus(t *testing.T) []mi.NetworkStatus {
+	statuses, err := mi.ParseNetworksStatus(getTestData(t, "networks_status.json"))
+	assert.Nil(t, err)
+	return statuses
+}
+
+func TestParseNetworksStatus(t *testing.T) {
+	statuses := getNetworksStatus(t)
+	assert.Len(t, statuses, 3)
+	assert.True(t, statuses[0].Default)
+	assert.Equal(t, "net1", statuses[1].Interface)
+	assert.Equal(t, []string{"192.168.1.10", "fd00:1::10"}, statuses[1].IPs)
+
+	_, err := mi.ParseNetworksStatus("not json")
+	assert.NotNil(t, err)
+}
+
+func TestNewMultusInterfaces(t *testing.T) {
+	handler := mi.NewMultusInterfaces(testTimeoutDuration, getNetworksStatus(t))
+	assert.Equal(t, []string{"ip", "-o", "addr", "show"}, handler.Args())
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.MultusInterfacesIdentifier, handler.GetIdentifier())
+}
+
+func TestMultusInterfaces_ReelMatchSuccess(t *testing.T) {
+	handler := mi.NewMultusInterfaces(testTimeoutDuration, getNetworksStatus(t)[:2])
+	step := handler.ReelMatch("", "", getTestData(t, "addresses.txt"))
+	assert.Nil(t, step)
+	assert.Equal(t, tnf.SUCCESS, handler.Result())
+	assert.Equal(t, []string{"192.168.1.10", "fd00:1::10"}, handler.GetInterfaces()["net1"])
+	assert.Equal(t, []string{"net1"}, handler.GetSecondaryInterfaces())
+}
+
+func TestMultusInterfaces_ReelMatchFailure(t *testing.T) {
+	statuses := getNetworksStatus(t)
+	statuses[1].IPs = append(statuses[1].IPs, "192.168.1.11")
+	handler := mi.NewMultusInterfaces(testTimeoutDuration, statuses)
+	step := handler.ReelMatch("", "", getTestData(t, "addresses.txt"))
+	assert.Nil(t, step)
+	assert.Equal(t, tnf.FAILURE, handler.Result())
+	mismatches := handler.GetMismatches()
+	sort.Strings(mismatches)
+	assert.Equal(t, []string{
+		"tnf/macvlan: interface net1 has no ip 192.168.1.11",
+		"tnf/sriov: interface net2 not found",
+	}, mismatches)
+}
+
+// Ensure there are no panics.
+func TestMultusInterfaces_ReelEof(t *testing.T) {
+	handler := mi.NewMultusInterfaces(testTimeoutDuration, nil)
+	assert.Nil(t, handler.ReelTimeout())
+	handler.ReelEOF()
+}
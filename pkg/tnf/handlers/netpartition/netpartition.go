@@ -0,0 +1,137 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package netpartition
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/dependencies"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	// RuleComment tags the iptables rules added by the test, so they can be told apart from the rules of the CNF.
+	RuleComment = "tnf-partition"
+	// rulesRegex matches the number of tagged rules left in the pod network namespace.
+	rulesRegex = `PARTITION_RULES=(\d+)`
+	// numPartitionRules is the number of rules blackholing a peer: one for the ingress and one for the egress traffic.
+	numPartitionRules = 2
+)
+
+// NetworkPartition adds or removes, from the debug pod of the node of a pod, the iptables rules dropping the traffic
+// between the pod and a peer IP address.  The rules are added to the network namespace of the pod sandbox, so they
+// apply whatever the CNI, and vanish with the pod.  Removing deletes every copy of the rules, so it is safe to run
+// whether or not they were added.
+type NetworkPartition struct {
+	partition bool
+	rules     int
+	result    int
+	timeout   time.Duration
+	args      []string
+}
+
+// NewNetworkPartition creates a new NetworkPartition tnf.Test.  It adds the rules when partition is true, and removes
+// them otherwise.
+func NewNetworkPartition(timeout time.Duration, namespace, podName, peerIP string, partition bool) *NetworkPartition {
+	netns := fmt.Sprintf(`netns=$(chroot /host %s inspectp -o go-template --template '{{range .info.runtimeSpec.linux.namespaces}}{{if eq .type "network"}}{{.path}}{{end}}{{end}}' $(chroot /host %s pods --name '^%s$' --namespace '^%s$' -q | %s -1))`,
+		dependencies.CrictlBinaryName, dependencies.CrictlBinaryName, podName, namespace, dependencies.HeadBinaryName)
+	iptables := fmt.Sprintf("chroot /host %s --net=$netns %s", dependencies.NsenterBinaryName, dependencies.IptablesBinaryName)
+	rules := []string{
+		fmt.Sprintf("INPUT -s %s -j DROP -m comment --comment %s", peerIP, RuleComment),
+		fmt.Sprintf("OUTPUT -d %s -j DROP -m comment --comment %s", peerIP, RuleComment),
+	}
+	commands := []string{netns}
+	for _, rule := range rules {
+		if partition {
+			commands = append(commands, fmt.Sprintf("%s -I %s", iptables, rule))
+		} else {
+			commands = append(commands, fmt.Sprintf("while %s -D %s 2>/dev/null; do :; done", iptables, rule))
+		}
+	}
+	commands = append(commands, fmt.Sprintf("%s PARTITION_RULES=$(%s -S | %s -c %s)",
+		dependencies.EchoBinaryName, iptables, dependencies.GrepBinaryName, RuleComment))
+	return &NetworkPartition{
+		partition: partition,
+		timeout:   timeout,
+		result:    tnf.ERROR,
+		args:      []string{strings.Join(commands, "; ")},
+	}
+}
+
+// Args returns the command line args for the test.
+func (np *NetworkPartition) Args() []string {
+	return np.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (np *NetworkPartition) GetIdentifier() identifier.Identifier {
+	return identifier.NetworkPartitionIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (np *NetworkPartition) Timeout() time.Duration {
+	return np.timeout
+}
+
+// Result returns the test result.
+func (np *NetworkPartition) Result() int {
+	return np.result
+}
+
+// GetRules returns the number of rules tagged with RuleComment left in the pod network namespace.
+func (np *NetworkPartition) GetRules() int {
+	return np.rules
+}
+
+// ReelFirst returns a step which expects the number of tagged rules within the test timeout.
+func (np *NetworkPartition) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{rulesRegex},
+		Timeout: np.timeout,
+	}
+}
+
+// ReelMatch checks the rules were added or removed.
+func (np *NetworkPartition) ReelMatch(_, _, match string) *reel.Step {
+	matches := regexp.MustCompile(rulesRegex).FindStringSubmatch(match)
+	if matches == nil {
+		np.result = tnf.ERROR
+		return nil
+	}
+	np.rules, _ = strconv.Atoi(matches[1])
+	if (np.partition && np.rules >= numPartitionRules) || (!np.partition && np.rules == 0) {
+		np.result = tnf.SUCCESS
+	} else {
+		np.result = tnf.FAILURE
+	}
+	return nil
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (np *NetworkPartition) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (np *NetworkPartition) ReelEOF() {
+}
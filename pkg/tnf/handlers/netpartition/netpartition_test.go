@@ -0,0 +1,72 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package netpartition_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/netpartition"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const testTimeoutDuration = time.Second * 2
+
+func TestNewNetworkPartition(t *testing.T) {
+	handler := netpartition.NewNetworkPartition(testTimeoutDuration, "tnf", "test-0", "10.128.0.12", true)
+	assert.Len(t, handler.Args(), 1)
+	assert.Contains(t, handler.Args()[0], "pods --name '^test-0$' --namespace '^tnf$'")
+	assert.Contains(t, handler.Args()[0], "-I INPUT -s 10.128.0.12 -j DROP -m comment --comment tnf-partition")
+	assert.Contains(t, handler.Args()[0], "-I OUTPUT -d 10.128.0.12 -j DROP -m comment --comment tnf-partition")
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.NetworkPartitionIdentifier, handler.GetIdentifier())
+
+	handler = netpartition.NewNetworkPartition(testTimeoutDuration, "tnf", "test-0", "10.128.0.12", false)
+	assert.Contains(t, handler.Args()[0], "while chroot /host nsenter --net=$netns iptables -D INPUT -s 10.128.0.12")
+	assert.False(t, strings.Contains(handler.Args()[0], " -I "))
+}
+
+func TestNetworkPartition_ReelMatch(t *testing.T) {
+	testCases := []struct {
+		partition      bool
+		output         string
+		expectedResult int
+		expectedRules  int
+	}{
+		{partition: true, output: "PARTITION_RULES=2\n", expectedResult: tnf.SUCCESS, expectedRules: 2},
+		{partition: true, output: "nsenter: neither filename nor target pid supplied\nPARTITION_RULES=0\n", expectedResult: tnf.FAILURE},
+		{partition: false, output: "PARTITION_RULES=0\n", expectedResult: tnf.SUCCESS},
+		{partition: false, output: "PARTITION_RULES=1\n", expectedResult: tnf.FAILURE, expectedRules: 1},
+	}
+	for _, tc := range testCases {
+		handler := netpartition.NewNetworkPartition(testTimeoutDuration, "tnf", "test-0", "10.128.0.12", tc.partition)
+		assert.Nil(t, handler.ReelMatch("", "", tc.output))
+		assert.Equal(t, tc.expectedResult, handler.Result(), tc.output)
+		assert.Equal(t, tc.expectedRules, handler.GetRules(), tc.output)
+	}
+}
+
+// Ensure there are no panics.
+func TestNetworkPartition_ReelEof(t *testing.T) {
+	handler := netpartition.NewNetworkPartition(testTimeoutDuration, "tnf", "test-0", "10.128.0.12", false)
+	handler.ReelEOF()
+	assert.Nil(t, handler.ReelTimeout())
+}
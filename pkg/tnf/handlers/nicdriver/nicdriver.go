@@ -0,0 +1,202 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package nicdriver
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/dependencies"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	nicRegex          = "(?s).+"
+	interfacePrefix   = "INTERFACE="
+	driverKey         = "driver"
+	versionKey        = "version"
+	firmwareKey       = "firmware-version"
+	busInfoKey        = "bus-info"
+	keyValueSeparator = ":"
+	numKeyValueFields = 2
+)
+
+// DriverInfo holds the `ethtool -i` information of an interface.
+type DriverInfo struct {
+	Driver          string
+	Version         string
+	FirmwareVersion string
+	BusInfo         string
+}
+
+// SupportedVersions lists the versions supported for a driver.  An empty list accepts any version.
+type SupportedVersions struct {
+	DriverVersions   []string
+	FirmwareVersions []string
+}
+
+// NICDriver collects the driver and firmware versions of node interfaces and checks them against the supported ones.
+type NICDriver struct {
+	interfaces  []string
+	supported   map[string]SupportedVersions
+	drivers     map[string]DriverInfo
+	unsupported []string
+	result      int
+	timeout     time.Duration
+	args        []string
+}
+
+// NewNICDriver creates a new NICDriver tnf.Test to run in a node debug pod.  supported is keyed by driver name; when
+// it is empty the versions are only collected, otherwise every interface must use a supported driver and versions.
+func NewNICDriver(timeout time.Duration, interfaces []string, supported map[string]SupportedVersions) *NICDriver {
+	commands := make([]string, 0, len(interfaces))
+	for _, iface := range interfaces {
+		commands = append(commands, fmt.Sprintf("%s %s%s; chroot /host %s -i %s",
+			dependencies.EchoBinaryName, interfacePrefix, iface, dependencies.EthtoolBinaryName, iface))
+	}
+	return &NICDriver{
+		interfaces: interfaces,
+		supported:  supported,
+		timeout:    timeout,
+		result:     tnf.ERROR,
+		args:       []string{strings.Join(commands, "; ")},
+	}
+}
+
+// Args returns the command line args for the test.
+func (nd *NICDriver) Args() []string {
+	return nd.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (nd *NICDriver) GetIdentifier() identifier.Identifier {
+	return identifier.NICDriverIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (nd *NICDriver) Timeout() time.Duration {
+	return nd.timeout
+}
+
+// Result returns the test result.
+func (nd *NICDriver) Result() int {
+	return nd.result
+}
+
+// GetDrivers returns the driver information of every interface, keyed by interface name.
+func (nd *NICDriver) GetDrivers() map[string]DriverInfo {
+	return nd.drivers
+}
+
+// GetUnsupported returns a description of every interface without driver information or with unsupported versions.
+func (nd *NICDriver) GetUnsupported() []string {
+	return nd.unsupported
+}
+
+// ReelFirst returns a step which expects the `ethtool -i` output within the test timeout.
+func (nd *NICDriver) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{nicRegex},
+		Timeout: nd.timeout,
+	}
+}
+
+// ReelMatch parses the driver information of each interface and checks it against the supported versions.
+func (nd *NICDriver) ReelMatch(_, _, match string) *reel.Step {
+	nd.drivers = parseDrivers(match)
+	nd.unsupported = nil
+	for _, iface := range nd.interfaces {
+		info, ok := nd.drivers[iface]
+		if !ok || info.Driver == "" {
+			nd.unsupported = append(nd.unsupported, fmt.Sprintf("%s: no driver information", iface))
+			continue
+		}
+		if len(nd.supported) == 0 {
+			continue
+		}
+		supported, ok := nd.supported[info.Driver]
+		switch {
+		case !ok:
+			nd.unsupported = append(nd.unsupported, fmt.Sprintf("%s: driver %s is not supported", iface, info.Driver))
+		case !isListed(supported.DriverVersions, info.Version):
+			nd.unsupported = append(nd.unsupported, fmt.Sprintf("%s: %s driver version %s is not supported", iface, info.Driver, info.Version))
+		case !isListed(supported.FirmwareVersions, info.FirmwareVersion):
+			nd.unsupported = append(nd.unsupported, fmt.Sprintf("%s: %s firmware version %s is not supported", iface, info.Driver, info.FirmwareVersion))
+		}
+	}
+	if len(nd.unsupported) > 0 {
+		nd.result = tnf.FAILURE
+	} else {
+		nd.result = tnf.SUCCESS
+	}
+	return nil
+}
+
+func parseDrivers(match string) map[string]DriverInfo {
+	drivers := map[string]DriverInfo{}
+	iface := ""
+	for _, line := range strings.Split(match, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, interfacePrefix) {
+			iface = strings.TrimPrefix(line, interfacePrefix)
+			drivers[iface] = DriverInfo{}
+			continue
+		}
+		keyValue := strings.SplitN(line, keyValueSeparator, numKeyValueFields)
+		if iface == "" || len(keyValue) != numKeyValueFields {
+			continue
+		}
+		info := drivers[iface]
+		value := strings.TrimSpace(keyValue[1])
+		switch keyValue[0] {
+		case driverKey:
+			info.Driver = value
+		case versionKey:
+			info.Version = value
+		case firmwareKey:
+			info.FirmwareVersion = value
+		case busInfoKey:
+			info.BusInfo = value
+		}
+		drivers[iface] = info
+	}
+	return drivers
+}
+
+func isListed(list []string, version string) bool {
+	if len(list) == 0 {
+		return true
+	}
+	for _, item := range list {
+		if item == version {
+			return true
+		}
+	}
+	return false
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (nd *NICDriver) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (nd *NICDriver) ReelEOF() {
+}
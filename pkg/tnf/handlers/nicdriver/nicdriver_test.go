@@ -0,0 +1,109 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package nicdriver_test
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/nicdriver"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testDataDirectory   = "testdata"
+	testTimeoutDuration = time.Second * 2
+)
+
+type TestCase struct {
+	interfaces          []string
+	supported           map[string]nicdriver.SupportedVersions
+	expectedResult      int
+	expectedUnsupported []string
+}
+
+var testCases = map[string]TestCase{
+	"collect_only": {
+		interfaces:     []string{"ens1f0", "ens2f0"},
+		expectedResult: tnf.SUCCESS,
+	},
+	"supported": {
+		interfaces: []string{"ens1f0", "ens2f0"},
+		supported: map[string]nicdriver.SupportedVersions{
+			"i40e":      {DriverVersions: []string{"2.8.20-k"}, FirmwareVersions: []string{"6.01 0x800036b8 1.1747.0"}},
+			"mlx5_core": {},
+		},
+		expectedResult: tnf.SUCCESS,
+	},
+	"unsupported": {
+		interfaces: []string{"ens1f0", "ens2f0", "ens9"},
+		supported: map[string]nicdriver.SupportedVersions{
+			"i40e": {FirmwareVersions: []string{"7.00 0x80004cdb 1.2154.0"}},
+		},
+		expectedResult: tnf.FAILURE,
+		expectedUnsupported: []string{
+			"ens1f0: i40e firmware version 6.01 0x800036b8 1.1747.0 is not supported",
+			"ens2f0: driver mlx5_core is not supported",
+			"ens9: no driver information",
+		},
+	},
+}
+
+func getMockOutput(t *testing.T) string {
+	b, err := os.ReadFile(path.Join(testDataDirectory, "ethtool.txt"))
+	assert.Nil(t, err)
+	return string(b)
+}
+
+func TestNewNICDriver(t *testing.T) {
+	handler := nicdriver.NewNICDriver(testTimeoutDuration, []string{"ens1f0", "ens2f0"}, nil)
+	assert.Equal(t, []string{"echo INTERFACE=ens1f0; chroot /host ethtool -i ens1f0; echo INTERFACE=ens2f0; chroot /host ethtool -i ens2f0"}, handler.Args())
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.NICDriverIdentifier, handler.GetIdentifier())
+}
+
+func TestNICDriver_ReelMatch(t *testing.T) {
+	for testName, testCase := range testCases {
+		handler := nicdriver.NewNICDriver(testTimeoutDuration, testCase.interfaces, testCase.supported)
+		assert.Nil(t, handler.ReelMatch("", "", getMockOutput(t)))
+		assert.Equal(t, testCase.expectedResult, handler.Result(), testName)
+		assert.Equal(t, testCase.expectedUnsupported, handler.GetUnsupported(), testName)
+	}
+}
+
+func TestNICDriver_GetDrivers(t *testing.T) {
+	handler := nicdriver.NewNICDriver(testTimeoutDuration, []string{"ens2f0"}, nil)
+	handler.ReelMatch("", "", getMockOutput(t))
+	assert.Equal(t, nicdriver.DriverInfo{
+		Driver:          "mlx5_core",
+		Version:         "5.0-0",
+		FirmwareVersion: "16.28.2006 (MT_0000000080)",
+		BusInfo:         "0000:5e:00.0",
+	}, handler.GetDrivers()["ens2f0"])
+}
+
+// Ensure there are no panics.
+func TestNICDriver_ReelEof(t *testing.T) {
+	handler := nicdriver.NewNICDriver(testTimeoutDuration, nil, nil)
+	assert.Nil(t, handler.ReelTimeout())
+	handler.ReelEOF()
+}
@@ -0,0 +1,142 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package nodedrain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	// exitMarker prefixes the exit code of `oc adm drain`.
+	exitMarker             = "DRAIN_EXIT="
+	drainTimeoutPercentage = 90 // drain timeout is a percentage of test timeout
+	// budgetMessage is part of the error printed by `oc adm drain` when an eviction would violate a
+	// PodDisruptionBudget.
+	budgetMessage = "disruption budget"
+)
+
+var (
+	exitRegex = regexp.MustCompile(exitMarker + `(\d+)`)
+	// evictedRegex matches the "pod/<name> evicted" lines of `oc adm drain`.
+	evictedRegex = regexp.MustCompile(`(?m)^pod/(\S+) evicted`)
+)
+
+// NodeDrain cordons a node and evicts its pods, DaemonSet pods excepted.  Unlike a deletion, an eviction is refused
+// while it would violate a PodDisruptionBudget, and is retried until the drain timeout.
+type NodeDrain struct {
+	node    string
+	evicted []string
+	blocked []string
+	result  int
+	timeout time.Duration
+	args    []string
+}
+
+// NewNodeDrain creates a new NodeDrain tnf.Test.
+func NewNodeDrain(timeout time.Duration, node string) *NodeDrain {
+	drainTimeout := timeout * drainTimeoutPercentage / 100
+	return &NodeDrain{
+		node:    node,
+		timeout: timeout,
+		result:  tnf.ERROR,
+		args: []string{fmt.Sprintf("oc adm drain %s --ignore-daemonsets=true --delete-emptydir-data=true --timeout=%s 2>&1; echo %s$?",
+			node, drainTimeout, exitMarker)},
+	}
+}
+
+// Args returns the command line args for the test.
+func (nd *NodeDrain) Args() []string {
+	return nd.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (nd *NodeDrain) GetIdentifier() identifier.Identifier {
+	return identifier.NodeDrainIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (nd *NodeDrain) Timeout() time.Duration {
+	return nd.timeout
+}
+
+// Result returns the test result.
+func (nd *NodeDrain) Result() int {
+	return nd.result
+}
+
+// GetEvicted returns the names of the evicted pods.
+func (nd *NodeDrain) GetEvicted() []string {
+	return nd.evicted
+}
+
+// GetBlocked returns the messages of the evictions refused because of a PodDisruptionBudget.
+func (nd *NodeDrain) GetBlocked() []string {
+	return nd.blocked
+}
+
+// ReelFirst returns a step which expects the drain exit code within the test timeout.
+func (nd *NodeDrain) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{exitRegex.String()},
+		Timeout: nd.timeout,
+	}
+}
+
+// ReelMatch parses the output of the drain.  The drain fails when it does not complete before its timeout, typically
+// because a PodDisruptionBudget keeps refusing an eviction.
+func (nd *NodeDrain) ReelMatch(_, _, match string) *reel.Step {
+	nd.evicted = nil
+	nd.blocked = nil
+	for _, matched := range evictedRegex.FindAllStringSubmatch(match, -1) {
+		nd.evicted = append(nd.evicted, matched[1])
+	}
+	for _, line := range strings.Split(match, "\n") {
+		if strings.Contains(line, budgetMessage) {
+			nd.blocked = append(nd.blocked, strings.TrimSpace(line))
+		}
+	}
+	exit := exitRegex.FindStringSubmatch(match)
+	switch {
+	case exit == nil:
+		nd.result = tnf.ERROR
+	case exit[1] == "0":
+		nd.result = tnf.SUCCESS
+	default:
+		nd.result = tnf.FAILURE
+	}
+	return nil
+}
+
+// ReelTimeout uncordons the node, so that it is not left unschedulable.
+func (nd *NodeDrain) ReelTimeout() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{"(?m).*uncordoned"},
+		Timeout: nd.timeout,
+		Execute: strings.Join([]string{"oc", "adm", "uncordon", nd.node}, " "),
+	}
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (nd *NodeDrain) ReelEOF() {
+}
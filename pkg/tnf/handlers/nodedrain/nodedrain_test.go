@@ -0,0 +1,82 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package nodedrain_test
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/nodedrain"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testDataDirectory   = "testdata"
+	testTimeoutDuration = time.Second * 2
+)
+
+func getMockOutput(t *testing.T, testName string) string {
+	b, err := os.ReadFile(path.Join(testDataDirectory, testName+".txt"))
+	assert.Nil(t, err)
+	return string(b)
+}
+
+func TestNewNodeDrain(t *testing.T) {
+	handler := nodedrain.NewNodeDrain(time.Minute, "worker-1")
+	assert.Len(t, handler.Args(), 1)
+	assert.Contains(t, handler.Args()[0], "oc adm drain worker-1 ")
+	assert.Contains(t, handler.Args()[0], "--timeout=54s")
+	assert.NotContains(t, handler.Args()[0], "--disable-eviction")
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, time.Minute, handler.Timeout())
+	assert.Equal(t, identifier.NodeDrainIdentifier, handler.GetIdentifier())
+}
+
+func TestNodeDrain_ReelMatch(t *testing.T) {
+	testCases := map[string]struct {
+		expectedResult  int
+		expectedEvicted []string
+		expectedBlocked int
+	}{
+		"drained": {
+			expectedResult:  tnf.SUCCESS,
+			expectedEvicted: []string{"test-7d9c5f6b8-x2lq4", "db-1"},
+		},
+		"blocked": {
+			expectedResult:  tnf.FAILURE,
+			expectedEvicted: []string{"test-7d9c5f6b8-x2lq4"},
+			expectedBlocked: 2,
+		},
+	}
+	for name, tc := range testCases {
+		handler := nodedrain.NewNodeDrain(testTimeoutDuration, "worker-1")
+		assert.Nil(t, handler.ReelMatch("", "", getMockOutput(t, name)), name)
+		assert.Equal(t, tc.expectedResult, handler.Result(), name)
+		assert.Equal(t, tc.expectedEvicted, handler.GetEvicted(), name)
+		assert.Len(t, handler.GetBlocked(), tc.expectedBlocked, name)
+	}
+}
+
+func TestNodeDrain_ReelTimeout(t *testing.T) {
+	handler := nodedrain.NewNodeDrain(testTimeoutDuration, "worker-1")
+	step := handler.ReelTimeout()
+	assert.Equal(t, "oc adm uncordon worker-1", step.Execute)
+}
@@ -0,0 +1,180 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package nodekernels
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/dependencies"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	nkRegex         = "(?s).+"
+	numColumns      = 2
+	headerLineCount = 1
+)
+
+// numberRegex matches the numeric parts of a kernel release, e.g. 4, 18, 0 and 305 in "4.18.0-305.el8.x86_64".
+var numberRegex = regexp.MustCompile(`\d+`)
+
+// NodeKernels checks that the nodes run a kernel release within an allowed range, and groups them by kernel release so
+// that any skew between them can be reported.
+type NodeKernels struct {
+	minVersion string
+	maxVersion string
+	kernels    map[string][]string
+	violations []string
+	result     int
+	timeout    time.Duration
+	args       []string
+}
+
+// NewNodeKernels creates a new NodeKernels tnf.Test.  The kernel release of each node is read from its status, which
+// the kubelet fills with `uname -r`.  selector is an optional label selector, e.g. "node-role.kubernetes.io/worker"
+// to only compare the workers.  minVersion and maxVersion are inclusive bounds;  an empty bound is not checked.
+func NewNodeKernels(timeout time.Duration, selector, minVersion, maxVersion string) *NodeKernels {
+	args := []string{dependencies.OcBinaryName, "get", "nodes", "-o",
+		"custom-columns=NAME:.metadata.name,KERNEL:.status.nodeInfo.kernelVersion"}
+	if selector != "" {
+		args = append(args, "-l", selector)
+	}
+	return &NodeKernels{
+		minVersion: minVersion,
+		maxVersion: maxVersion,
+		timeout:    timeout,
+		result:     tnf.ERROR,
+		args:       args,
+	}
+}
+
+// Args returns the command line args for the test.
+func (nk *NodeKernels) Args() []string {
+	return nk.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (nk *NodeKernels) GetIdentifier() identifier.Identifier {
+	return identifier.NodeKernelsIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (nk *NodeKernels) Timeout() time.Duration {
+	return nk.timeout
+}
+
+// Result returns the test result.
+func (nk *NodeKernels) Result() int {
+	return nk.result
+}
+
+// GetKernels returns the sorted names of the nodes running each kernel release, keyed by release.
+func (nk *NodeKernels) GetKernels() map[string][]string {
+	return nk.kernels
+}
+
+// GetViolations returns a description of every kernel release out of range.
+func (nk *NodeKernels) GetViolations() []string {
+	return nk.violations
+}
+
+// ReelFirst returns a step which expects the node kernel releases within the test timeout.
+func (nk *NodeKernels) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{nkRegex},
+		Timeout: nk.timeout,
+	}
+}
+
+// ReelMatch groups the nodes by kernel release and checks the releases against the allowed range.
+func (nk *NodeKernels) ReelMatch(_, _, match string) *reel.Step {
+	nk.kernels = map[string][]string{}
+	nk.violations = nil
+	lines := strings.Split(strings.TrimSpace(match), "\n")
+	for _, line := range lines[headerLineCount:] {
+		fields := strings.Fields(line)
+		if len(fields) != numColumns {
+			continue
+		}
+		nk.kernels[fields[1]] = append(nk.kernels[fields[1]], fields[0])
+	}
+	if len(nk.kernels) == 0 {
+		nk.result = tnf.ERROR
+		return nil
+	}
+
+	releases := make([]string, 0, len(nk.kernels))
+	for release, nodes := range nk.kernels {
+		sort.Strings(nodes)
+		releases = append(releases, release)
+	}
+	sort.Strings(releases)
+	for _, release := range releases {
+		if nk.minVersion != "" && CompareVersions(release, nk.minVersion) < 0 {
+			nk.violations = append(nk.violations, fmt.Sprintf("kernel %s on %v is older than %s", release, nk.kernels[release], nk.minVersion))
+		}
+		if nk.maxVersion != "" && CompareVersions(release, nk.maxVersion) > 0 {
+			nk.violations = append(nk.violations, fmt.Sprintf("kernel %s on %v is newer than %s", release, nk.kernels[release], nk.maxVersion))
+		}
+	}
+	if len(nk.violations) > 0 {
+		nk.result = tnf.FAILURE
+	} else {
+		nk.result = tnf.SUCCESS
+	}
+	return nil
+}
+
+// CompareVersions compares the numeric parts of two kernel releases in order, returning -1, 0 or 1 when a is older
+// than, the same as, or newer than b.  A release which is a prefix of the other, e.g. "4.18", is the older one.
+func CompareVersions(a, b string) int {
+	partsA := numberRegex.FindAllString(a, -1)
+	partsB := numberRegex.FindAllString(b, -1)
+	for i := 0; i < len(partsA) && i < len(partsB); i++ {
+		numberA, _ := strconv.Atoi(partsA[i])
+		numberB, _ := strconv.Atoi(partsB[i])
+		if numberA != numberB {
+			if numberA < numberB {
+				return -1
+			}
+			return 1
+		}
+	}
+	switch {
+	case len(partsA) < len(partsB):
+		return -1
+	case len(partsA) > len(partsB):
+		return 1
+	}
+	return 0
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (nk *NodeKernels) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (nk *NodeKernels) ReelEOF() {
+}
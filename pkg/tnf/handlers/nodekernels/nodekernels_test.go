@@ -0,0 +1,122 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package nodekernels_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/nodekernels"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testTimeoutDuration = time.Second * 2
+	testInputUniform    = "NAME       KERNEL\n" +
+		"master-0   4.18.0-305.19.1.el8_4.x86_64\n" +
+		"worker-0   4.18.0-305.19.1.el8_4.x86_64\n" +
+		"worker-1   4.18.0-305.19.1.el8_4.x86_64\n"
+	testInputSkew = "NAME       KERNEL\n" +
+		"worker-1   4.18.0-305.19.1.el8_4.x86_64\n" +
+		"worker-0   4.18.0-240.22.1.el8_3.x86_64\n"
+	testInputEmpty = "No resources found\n"
+)
+
+func TestNewNodeKernels(t *testing.T) {
+	handler := nodekernels.NewNodeKernels(testTimeoutDuration, "node-role.kubernetes.io/worker", "", "")
+	assert.Equal(t, []string{"oc", "get", "nodes", "-o", "custom-columns=NAME:.metadata.name,KERNEL:.status.nodeInfo.kernelVersion",
+		"-l", "node-role.kubernetes.io/worker"}, handler.Args())
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.NodeKernelsIdentifier, handler.GetIdentifier())
+}
+
+func TestNodeKernels_ReelMatch(t *testing.T) {
+	testCases := map[string]struct {
+		input              string
+		minVersion         string
+		maxVersion         string
+		expectedResult     int
+		expectedViolations []string
+	}{
+		"uniform_in_range": {
+			input:          testInputUniform,
+			minVersion:     "4.18.0-305",
+			maxVersion:     "4.18.0-305.99",
+			expectedResult: tnf.SUCCESS,
+		},
+		"uniform_too_old": {
+			input:              testInputUniform,
+			minVersion:         "4.18.0-372",
+			expectedResult:     tnf.FAILURE,
+			expectedViolations: []string{"kernel 4.18.0-305.19.1.el8_4.x86_64 on [master-0 worker-0 worker-1] is older than 4.18.0-372"},
+		},
+		"skew": {
+			input:          testInputSkew,
+			maxVersion:     "4.18.0-300",
+			expectedResult: tnf.FAILURE,
+			expectedViolations: []string{
+				"kernel 4.18.0-305.19.1.el8_4.x86_64 on [worker-1] is newer than 4.18.0-300",
+			},
+		},
+		"no_nodes": {
+			input:          testInputEmpty,
+			expectedResult: tnf.ERROR,
+		},
+	}
+	for testName, testCase := range testCases {
+		handler := nodekernels.NewNodeKernels(testTimeoutDuration, "", testCase.minVersion, testCase.maxVersion)
+		assert.Nil(t, handler.ReelMatch("", "", testCase.input))
+		assert.Equal(t, testCase.expectedResult, handler.Result(), testName)
+		assert.Equal(t, testCase.expectedViolations, handler.GetViolations(), testName)
+	}
+}
+
+func TestNodeKernels_GetKernels(t *testing.T) {
+	handler := nodekernels.NewNodeKernels(testTimeoutDuration, "", "", "")
+	handler.ReelMatch("", "", testInputUniform)
+	assert.Equal(t, map[string][]string{
+		"4.18.0-305.19.1.el8_4.x86_64": {"master-0", "worker-0", "worker-1"},
+	}, handler.GetKernels())
+}
+
+func TestNodeKernels_GetKernelsSkew(t *testing.T) {
+	handler := nodekernels.NewNodeKernels(testTimeoutDuration, "", "", "")
+	handler.ReelMatch("", "", testInputSkew)
+	assert.Equal(t, tnf.SUCCESS, handler.Result())
+	assert.Equal(t, map[string][]string{
+		"4.18.0-240.22.1.el8_3.x86_64": {"worker-0"},
+		"4.18.0-305.19.1.el8_4.x86_64": {"worker-1"},
+	}, handler.GetKernels())
+}
+
+func TestCompareVersions(t *testing.T) {
+	assert.Equal(t, 0, nodekernels.CompareVersions("4.18.0-305.el8.x86_64", "4.18.0-305.el8.x86_64"))
+	assert.Equal(t, -1, nodekernels.CompareVersions("4.18.0-240.el8", "4.18.0-305.el8"))
+	assert.Equal(t, 1, nodekernels.CompareVersions("5.14.0-70.el9", "4.18.0-305.el8"))
+	assert.Equal(t, -1, nodekernels.CompareVersions("4.18", "4.18.0-305"))
+	assert.Equal(t, 1, nodekernels.CompareVersions("4.18.0-305.19.1", "4.18.0-305"))
+}
+
+// Ensure there are no panics.
+func TestNodeKernels_ReelEof(t *testing.T) {
+	handler := nodekernels.NewNodeKernels(testTimeoutDuration, "", "", "")
+	assert.Nil(t, handler.ReelTimeout())
+	handler.ReelEOF()
+}
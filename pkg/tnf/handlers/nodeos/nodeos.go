@@ -0,0 +1,187 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package nodeos
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/dependencies"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	noRegex = "(?s).+"
+
+	kernelReleaseKey = "KERNEL_RELEASE"
+	ostreeVersionKey = "Version:"
+	bootedPrefix     = "* "
+
+	// RHCOSID is the os-release ID of Red Hat Enterprise Linux CoreOS.
+	RHCOSID = "rhcos"
+)
+
+var (
+	osReleaseLineRegex = regexp.MustCompile(`^([A-Z_]+)=(.*)$`)
+	kernelVersionRegex = regexp.MustCompile(`^(\d+)\.(\d+)(?:\.(\d+))?`)
+)
+
+// OSDescriptor describes the operating system of a node.
+type OSDescriptor struct {
+	// ID, VersionID, Name and PrettyName are taken from /etc/os-release.
+	ID         string
+	VersionID  string
+	Name       string
+	PrettyName string
+	// OSRelease holds all the /etc/os-release entries, with quotes removed.
+	OSRelease map[string]string
+	// KernelRelease is the output of `uname -r`.
+	KernelRelease string
+	// OstreeDeployment and OstreeVersion describe the booted rpm-ostree deployment; they are empty on non ostree systems.
+	OstreeDeployment string
+	OstreeVersion    string
+}
+
+// IsRHCOS returns true when the node runs Red Hat Enterprise Linux CoreOS.
+func (d *OSDescriptor) IsRHCOS() bool {
+	return d.ID == RHCOSID
+}
+
+// KernelVersion returns the major, minor and patch numbers of the kernel release.
+func (d *OSDescriptor) KernelVersion() (major, minor, patch int, err error) {
+	matched := kernelVersionRegex.FindStringSubmatch(d.KernelRelease)
+	if matched == nil {
+		return 0, 0, 0, fmt.Errorf("unable to parse kernel release %q", d.KernelRelease)
+	}
+	major, _ = strconv.Atoi(matched[1])
+	minor, _ = strconv.Atoi(matched[2])
+	patch, _ = strconv.Atoi(matched[3])
+	return major, minor, patch, nil
+}
+
+// KernelVersionAtLeast returns true when the kernel release is at least major.minor.  It is meant to gate
+// kernel-dependent checks; an unparsable release is reported as false.
+func (d *OSDescriptor) KernelVersionAtLeast(major, minor int) bool {
+	kernelMajor, kernelMinor, _, err := d.KernelVersion()
+	if err != nil {
+		return false
+	}
+	return kernelMajor > major || (kernelMajor == major && kernelMinor >= minor)
+}
+
+// NodeOS identifies the operating system of a node from a node debug pod.
+type NodeOS struct {
+	descriptor OSDescriptor
+	result     int
+	timeout    time.Duration
+	args       []string
+}
+
+// NewNodeOS creates a new NodeOS tnf.Test.  The test is meant to run in a node debug pod, with the host filesystem
+// mounted at /host.
+func NewNodeOS(timeout time.Duration) *NodeOS {
+	return &NodeOS{
+		timeout: timeout,
+		result:  tnf.ERROR,
+		args: []string{
+			fmt.Sprintf("%s /host/etc/os-release; echo %s=$(uname -r); chroot /host %s status --booted 2>/dev/null",
+				dependencies.CatBinaryName, kernelReleaseKey, dependencies.RpmOstreeBinaryName),
+		},
+	}
+}
+
+// Args returns the command line args for the test.
+func (no *NodeOS) Args() []string {
+	return no.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (no *NodeOS) GetIdentifier() identifier.Identifier {
+	return identifier.NodeOSIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (no *NodeOS) Timeout() time.Duration {
+	return no.timeout
+}
+
+// Result returns the test result.
+func (no *NodeOS) Result() int {
+	return no.result
+}
+
+// GetOSDescriptor returns the operating system descriptor of the node.
+func (no *NodeOS) GetOSDescriptor() OSDescriptor {
+	return no.descriptor
+}
+
+// ReelFirst returns a step which expects the os identification output within the test timeout.
+func (no *NodeOS) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{noRegex},
+		Timeout: no.timeout,
+	}
+}
+
+// ReelMatch parses /etc/os-release, the kernel release and the booted rpm-ostree deployment.
+func (no *NodeOS) ReelMatch(_, _, match string) *reel.Step {
+	d := OSDescriptor{OSRelease: map[string]string{}}
+	for _, line := range strings.Split(match, "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(trimmed, bootedPrefix):
+			d.OstreeDeployment = strings.TrimPrefix(trimmed, bootedPrefix)
+		case strings.HasPrefix(trimmed, ostreeVersionKey) && d.OstreeDeployment != "" && d.OstreeVersion == "":
+			fields := strings.Fields(strings.TrimPrefix(trimmed, ostreeVersionKey))
+			if len(fields) > 0 {
+				d.OstreeVersion = fields[0]
+			}
+		default:
+			if matched := osReleaseLineRegex.FindStringSubmatch(trimmed); matched != nil {
+				d.OSRelease[matched[1]] = strings.Trim(matched[2], `"'`)
+			}
+		}
+	}
+	d.KernelRelease = d.OSRelease[kernelReleaseKey]
+	delete(d.OSRelease, kernelReleaseKey)
+	d.ID = d.OSRelease["ID"]
+	d.VersionID = d.OSRelease["VERSION_ID"]
+	d.Name = d.OSRelease["NAME"]
+	d.PrettyName = d.OSRelease["PRETTY_NAME"]
+	no.descriptor = d
+
+	if d.ID == "" || d.KernelRelease == "" {
+		no.result = tnf.ERROR
+		return nil
+	}
+	no.result = tnf.SUCCESS
+	return nil
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (no *NodeOS) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (no *NodeOS) ReelEOF() {
+}
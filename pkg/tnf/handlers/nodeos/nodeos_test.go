@@ -0,0 +1,124 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package nodeos_test
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/nodeos"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testDataDirectory   = "testdata"
+	testDataFileSuffix  = ".txt"
+	testTimeoutDuration = time.Second * 2
+)
+
+type TestCase struct {
+	expectedResult     int
+	expectedDescriptor nodeos.OSDescriptor
+	expectedRHCOS      bool
+}
+
+var testCases = map[string]TestCase{
+	"rhcos": {
+		expectedResult: tnf.SUCCESS,
+		expectedDescriptor: nodeos.OSDescriptor{
+			ID:               "rhcos",
+			VersionID:        "4.8",
+			Name:             "Red Hat Enterprise Linux CoreOS",
+			PrettyName:       "Red Hat Enterprise Linux CoreOS 48.84.202110270303-0 (Ootpa)",
+			KernelRelease:    "4.18.0-305.19.1.el8_4.x86_64",
+			OstreeDeployment: "pivot://quay.io/openshift-release-dev/ocp-v4.0-art-dev@sha256:3b5c2b1f6e2c8a7b4a1e7c9d0f3e6a2b8c4d1e5f7a9b3c6d8e2f4a6b8c0d2e4f",
+			OstreeVersion:    "48.84.202110270303-0",
+		},
+		expectedRHCOS: true,
+	},
+	"ubuntu": {
+		expectedResult: tnf.SUCCESS,
+		expectedDescriptor: nodeos.OSDescriptor{
+			ID:            "ubuntu",
+			VersionID:     "20.04",
+			Name:          "Ubuntu",
+			PrettyName:    "Ubuntu 20.04.3 LTS",
+			KernelRelease: "5.4.0-88-generic",
+		},
+	},
+	"no_os_release": {
+		expectedResult: tnf.ERROR,
+		expectedDescriptor: nodeos.OSDescriptor{
+			KernelRelease: "5.4.0-88-generic",
+		},
+	},
+}
+
+func getMockOutput(t *testing.T, testName string) string {
+	b, err := os.ReadFile(path.Join(testDataDirectory, fmt.Sprintf("%s%s", testName, testDataFileSuffix)))
+	assert.Nil(t, err)
+	return string(b)
+}
+
+func TestNewNodeOS(t *testing.T) {
+	handler := nodeos.NewNodeOS(testTimeoutDuration)
+	assert.Equal(t, []string{"cat /host/etc/os-release; echo KERNEL_RELEASE=$(uname -r); chroot /host rpm-ostree status --booted 2>/dev/null"}, handler.Args())
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.NodeOSIdentifier, handler.GetIdentifier())
+}
+
+func TestNodeOS_ReelMatch(t *testing.T) {
+	for testName, testCase := range testCases {
+		handler := nodeos.NewNodeOS(testTimeoutDuration)
+		step := handler.ReelMatch("", "", getMockOutput(t, testName))
+		assert.Nil(t, step)
+		assert.Equal(t, testCase.expectedResult, handler.Result(), testName)
+		descriptor := handler.GetOSDescriptor()
+		descriptor.OSRelease = nil
+		assert.Equal(t, testCase.expectedDescriptor, descriptor, testName)
+		assert.Equal(t, testCase.expectedRHCOS, descriptor.IsRHCOS(), testName)
+	}
+}
+
+func TestOSDescriptor_KernelVersionAtLeast(t *testing.T) {
+	descriptor := nodeos.OSDescriptor{KernelRelease: "4.18.0-305.19.1.el8_4.x86_64"}
+	major, minor, patch, err := descriptor.KernelVersion()
+	assert.Nil(t, err)
+	assert.Equal(t, []int{4, 18, 0}, []int{major, minor, patch})
+	assert.True(t, descriptor.KernelVersionAtLeast(4, 18))
+	assert.True(t, descriptor.KernelVersionAtLeast(3, 20))
+	assert.False(t, descriptor.KernelVersionAtLeast(4, 19))
+	assert.False(t, descriptor.KernelVersionAtLeast(5, 0))
+
+	descriptor = nodeos.OSDescriptor{}
+	_, _, _, err = descriptor.KernelVersion()
+	assert.NotNil(t, err)
+	assert.False(t, descriptor.KernelVersionAtLeast(1, 0))
+}
+
+// Ensure there are no panics.
+func TestNodeOS_ReelEof(t *testing.T) {
+	handler := nodeos.NewNodeOS(testTimeoutDuration)
+	assert.Nil(t, handler.ReelTimeout())
+	handler.ReelEOF()
+}
@@ -0,0 +1,273 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package nodepressure
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/podresources"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	nodePressureRegex = "(?s).+"
+	// podsMarker separates the nodes manifest from the pods manifest.
+	podsMarker = "POD_REQUESTS"
+
+	conditionTrue = "True"
+	percent       = 100
+)
+
+// pressureConditions are the node conditions reporting a resource shortage.
+var pressureConditions = map[string]bool{"MemoryPressure": true, "DiskPressure": true, "PIDPressure": true}
+
+type resourceList struct {
+	CPU    string `json:"cpu"`
+	Memory string `json:"memory"`
+}
+
+type nodes struct {
+	Items []struct {
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+		Status struct {
+			Allocatable resourceList `json:"allocatable"`
+			Conditions  []struct {
+				Type   string `json:"type"`
+				Status string `json:"status"`
+			} `json:"conditions"`
+		} `json:"status"`
+	} `json:"items"`
+}
+
+type container struct {
+	Resources struct {
+		Requests resourceList `json:"requests"`
+	} `json:"resources"`
+}
+
+type pods struct {
+	Items []struct {
+		Spec struct {
+			NodeName       string      `json:"nodeName"`
+			InitContainers []container `json:"initContainers"`
+			Containers     []container `json:"containers"`
+		} `json:"spec"`
+	} `json:"items"`
+}
+
+// Node is the pressure and resource usage of a node.  CPU is in cores and memory in bytes.
+type Node struct {
+	Name              string
+	Pressure          []string
+	AllocatableCPU    float64
+	AllocatableMemory float64
+	RequestedCPU      float64
+	RequestedMemory   float64
+}
+
+// CPUPercent returns the share of the allocatable CPU requested by the pods of the node.
+func (n *Node) CPUPercent() float64 {
+	if n.AllocatableCPU == 0 {
+		return 0
+	}
+	return n.RequestedCPU * percent / n.AllocatableCPU
+}
+
+// MemoryPercent returns the share of the allocatable memory requested by the pods of the node.
+func (n *Node) MemoryPercent() float64 {
+	if n.AllocatableMemory == 0 {
+		return 0
+	}
+	return n.RequestedMemory * percent / n.AllocatableMemory
+}
+
+// NodePressure reports the nodes which are under pressure, or whose pods request more than a given share of their
+// allocatable CPU or memory.  The requests of the running and pending pods are accounted the way the scheduler does:
+// the largest of the sum of the container requests and of every init container request.
+type NodePressure struct {
+	maxRequestedPercent float64
+	nodes               []Node
+	saturated           []string
+	result              int
+	timeout             time.Duration
+	args                []string
+}
+
+// NewNodePressure creates a new NodePressure tnf.Test.
+func NewNodePressure(timeout time.Duration, maxRequestedPercent float64) *NodePressure {
+	return &NodePressure{
+		maxRequestedPercent: maxRequestedPercent,
+		timeout:             timeout,
+		result:              tnf.ERROR,
+		args: []string{fmt.Sprintf("oc get nodes -o json; echo %s; oc get pods -A --field-selector=status.phase!=Succeeded,status.phase!=Failed -o json",
+			podsMarker)},
+	}
+}
+
+// Args returns the command line args for the test.
+func (np *NodePressure) Args() []string {
+	return np.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (np *NodePressure) GetIdentifier() identifier.Identifier {
+	return identifier.NodePressureIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (np *NodePressure) Timeout() time.Duration {
+	return np.timeout
+}
+
+// Result returns the test result.
+func (np *NodePressure) Result() int {
+	return np.result
+}
+
+// GetNodes returns the pressure and resource usage of every node, sorted by name.
+func (np *NodePressure) GetNodes() []Node {
+	return np.nodes
+}
+
+// GetSaturated returns a description of every node under pressure or requested beyond the accepted share.
+func (np *NodePressure) GetSaturated() []string {
+	return np.saturated
+}
+
+// ReelFirst returns a step which expects the nodes and pods manifests within the test timeout.
+func (np *NodePressure) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{nodePressureRegex},
+		Timeout: np.timeout,
+	}
+}
+
+// ReelMatch parses the nodes and pods manifests and sums the requests of the pods of every node.
+func (np *NodePressure) ReelMatch(_, _, match string) *reel.Step {
+	np.nodes, np.saturated = nil, nil
+	parts := strings.SplitN(match, podsMarker, 2)
+	if len(parts) != 2 {
+		np.result = tnf.ERROR
+		return nil
+	}
+	var nodeList nodes
+	var podList pods
+	if unmarshal(parts[0], &nodeList) != nil || unmarshal(parts[1], &podList) != nil {
+		np.result = tnf.ERROR
+		return nil
+	}
+	requested := map[string]*Node{}
+	for i := range nodeList.Items {
+		item := &nodeList.Items[i]
+		node := Node{Name: item.Metadata.Name}
+		node.AllocatableCPU, node.AllocatableMemory = parseResources(item.Status.Allocatable)
+		for _, condition := range item.Status.Conditions {
+			if pressureConditions[condition.Type] && condition.Status == conditionTrue {
+				node.Pressure = append(node.Pressure, condition.Type)
+			}
+		}
+		np.nodes = append(np.nodes, node)
+	}
+	sort.Slice(np.nodes, func(i, j int) bool { return np.nodes[i].Name < np.nodes[j].Name })
+	for i := range np.nodes {
+		requested[np.nodes[i].Name] = &np.nodes[i]
+	}
+	for i := range podList.Items {
+		spec := &podList.Items[i].Spec
+		node, ok := requested[spec.NodeName]
+		if !ok {
+			continue
+		}
+		cpu, memory := podRequests(spec.InitContainers, spec.Containers)
+		node.RequestedCPU += cpu
+		node.RequestedMemory += memory
+	}
+	for i := range np.nodes {
+		node := &np.nodes[i]
+		if len(node.Pressure) > 0 {
+			np.saturated = append(np.saturated, fmt.Sprintf("node %s reports %v", node.Name, node.Pressure))
+		}
+		if node.CPUPercent() > np.maxRequestedPercent {
+			np.saturated = append(np.saturated, fmt.Sprintf("node %s has %.0f%% of its allocatable CPU requested", node.Name, node.CPUPercent()))
+		}
+		if node.MemoryPercent() > np.maxRequestedPercent {
+			np.saturated = append(np.saturated, fmt.Sprintf("node %s has %.0f%% of its allocatable memory requested", node.Name, node.MemoryPercent()))
+		}
+	}
+	if len(np.saturated) > 0 {
+		np.result = tnf.FAILURE
+	} else {
+		np.result = tnf.SUCCESS
+	}
+	return nil
+}
+
+// podRequests returns the CPU and memory requests of a pod, the largest of the sum of its container requests and of
+// every init container request.
+func podRequests(initContainers, containers []container) (cpu, memory float64) {
+	for i := range containers {
+		containerCPU, containerMemory := parseResources(containers[i].Resources.Requests)
+		cpu += containerCPU
+		memory += containerMemory
+	}
+	for i := range initContainers {
+		initCPU, initMemory := parseResources(initContainers[i].Resources.Requests)
+		if initCPU > cpu {
+			cpu = initCPU
+		}
+		if initMemory > memory {
+			memory = initMemory
+		}
+	}
+	return cpu, memory
+}
+
+// parseResources returns the CPU and memory of a resource list, counting unset or invalid quantities as zero.
+func parseResources(resources resourceList) (cpu, memory float64) {
+	if resources.CPU != "" {
+		cpu, _ = podresources.ParseQuantity(resources.CPU)
+	}
+	if resources.Memory != "" {
+		memory, _ = podresources.ParseQuantity(resources.Memory)
+	}
+	return cpu, memory
+}
+
+func unmarshal(output string, v interface{}) error {
+	start := strings.Index(output, "{")
+	if start < 0 {
+		return fmt.Errorf("no JSON output found")
+	}
+	return json.Unmarshal([]byte(strings.TrimSpace(output[start:])), v)
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (np *NodePressure) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (np *NodePressure) ReelEOF() {
+}
@@ -0,0 +1,100 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package nodepressure_test
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/nodepressure"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testDataDirectory   = "testdata"
+	testTimeoutDuration = time.Second * 2
+	gi                  = 1 << 30
+)
+
+func getMockOutput(t *testing.T, testName string) string {
+	b, err := os.ReadFile(path.Join(testDataDirectory, testName+".txt"))
+	assert.Nil(t, err)
+	return string(b)
+}
+
+func TestNewNodePressure(t *testing.T) {
+	handler := nodepressure.NewNodePressure(testTimeoutDuration, 90)
+	assert.Len(t, handler.Args(), 1)
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.NodePressureIdentifier, handler.GetIdentifier())
+}
+
+func TestNodePressure_ReelMatch(t *testing.T) {
+	testCases := []struct {
+		maxRequestedPercent float64
+		expectedResult      int
+		expectedSaturated   []string
+	}{
+		{
+			maxRequestedPercent: 90,
+			expectedResult:      tnf.FAILURE,
+			expectedSaturated: []string{
+				"node master-0 reports [DiskPressure]",
+				"node worker-0 has 100% of its allocatable CPU requested",
+			},
+		},
+		{
+			maxRequestedPercent: 70,
+			expectedResult:      tnf.FAILURE,
+			expectedSaturated: []string{
+				"node master-0 reports [DiskPressure]",
+				"node worker-0 has 100% of its allocatable CPU requested",
+				"node worker-0 has 75% of its allocatable memory requested",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		handler := nodepressure.NewNodePressure(testTimeoutDuration, tc.maxRequestedPercent)
+		assert.Nil(t, handler.ReelMatch("", "", getMockOutput(t, "saturated")))
+		assert.Equal(t, tc.expectedResult, handler.Result())
+		assert.Equal(t, tc.expectedSaturated, handler.GetSaturated())
+
+		nodes := handler.GetNodes()
+		assert.Len(t, nodes, 2)
+		assert.Equal(t, nodepressure.Node{
+			Name: "master-0", Pressure: []string{"DiskPressure"},
+			AllocatableCPU: 7.5, AllocatableMemory: 16 * gi, RequestedCPU: 0.75, RequestedMemory: 1 * gi,
+		}, nodes[0])
+		assert.Equal(t, nodepressure.Node{
+			Name:           "worker-0",
+			AllocatableCPU: 4, AllocatableMemory: 8 * gi, RequestedCPU: 4, RequestedMemory: 6 * gi,
+		}, nodes[1])
+		assert.InDelta(t, 10, nodes[0].CPUPercent(), 0.001)
+		assert.InDelta(t, 75, nodes[1].MemoryPercent(), 0.001)
+	}
+}
+
+func TestNodePressure_ReelMatchError(t *testing.T) {
+	handler := nodepressure.NewNodePressure(testTimeoutDuration, 90)
+	assert.Nil(t, handler.ReelMatch("", "", "error: You must be logged in to the server (Unauthorized)\n"))
+	assert.Equal(t, tnf.ERROR, handler.Result())
+}
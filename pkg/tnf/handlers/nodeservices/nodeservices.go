@@ -0,0 +1,134 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package nodeservices
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/dependencies"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	// serviceStateRegex matches the state printed for a service.  The "$s" of the echoed command does not match it.
+	serviceStateRegex = `SERVICE_STATE:([\w.@-]+)=(\S+)`
+	// ActiveState is the state of a running service.
+	ActiveState = "active"
+)
+
+// NodeServices reports the state of systemd services on a node.
+type NodeServices struct {
+	services []string
+	states   map[string]string
+	result   int
+	timeout  time.Duration
+	args     []string
+}
+
+// NewNodeServices creates a new NodeServices tnf.Test reporting the state of services.  It runs in the debug pod of a
+// node.
+func NewNodeServices(timeout time.Duration, services ...string) *NodeServices {
+	return &NodeServices{
+		services: services,
+		states:   map[string]string{},
+		timeout:  timeout,
+		result:   tnf.ERROR,
+		args: []string{fmt.Sprintf(`for s in %s; do echo "SERVICE_STATE:$s=$(chroot /host %s is-active $s)"; done`,
+			strings.Join(services, " "), dependencies.SystemctlBinaryName)},
+	}
+}
+
+// Args returns the command line args for the test.
+func (ns *NodeServices) Args() []string {
+	return ns.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (ns *NodeServices) GetIdentifier() identifier.Identifier {
+	return identifier.NodeServicesIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (ns *NodeServices) Timeout() time.Duration {
+	return ns.timeout
+}
+
+// Result returns the test result.
+func (ns *NodeServices) Result() int {
+	return ns.result
+}
+
+// GetStates returns the state of each service, such as "active", "inactive" or "failed".
+func (ns *NodeServices) GetStates() map[string]string {
+	return ns.states
+}
+
+// GetInactive returns the sorted services which are not active.
+func (ns *NodeServices) GetInactive() []string {
+	var inactive []string
+	for _, service := range ns.services {
+		if ns.states[service] != ActiveState {
+			inactive = append(inactive, service)
+		}
+	}
+	sort.Strings(inactive)
+	return inactive
+}
+
+// ReelFirst returns a step which expects the state of every service within the test timeout.
+func (ns *NodeServices) ReelFirst() *reel.Step {
+	last := ""
+	if len(ns.services) > 0 {
+		last = regexp.QuoteMeta(ns.services[len(ns.services)-1])
+	}
+	return &reel.Step{
+		Expect:  []string{fmt.Sprintf(`(?s)(?:%s\s+)*SERVICE_STATE:%s=\S+`, serviceStateRegex, last)},
+		Timeout: ns.timeout,
+	}
+}
+
+// ReelMatch parses the state of the services.  The test fails when any of them is not active.
+func (ns *NodeServices) ReelMatch(_, _, match string) *reel.Step {
+	for _, matches := range regexp.MustCompile(serviceStateRegex).FindAllStringSubmatch(match, -1) {
+		ns.states[matches[1]] = matches[2]
+	}
+	if len(ns.states) != len(ns.services) {
+		ns.result = tnf.ERROR
+		return nil
+	}
+	if len(ns.GetInactive()) > 0 {
+		ns.result = tnf.FAILURE
+	} else {
+		ns.result = tnf.SUCCESS
+	}
+	return nil
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (ns *NodeServices) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (ns *NodeServices) ReelEOF() {
+}
@@ -0,0 +1,76 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package nodeservices_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/nodeservices"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const testTimeoutDuration = time.Second * 2
+
+func TestNewNodeServices(t *testing.T) {
+	handler := nodeservices.NewNodeServices(testTimeoutDuration, "kubelet", "crio")
+	assert.Equal(t, []string{`for s in kubelet crio; do echo "SERVICE_STATE:$s=$(chroot /host systemctl is-active $s)"; done`}, handler.Args())
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.NodeServicesIdentifier, handler.GetIdentifier())
+}
+
+func TestNodeServices_ReelFirst(t *testing.T) {
+	handler := nodeservices.NewNodeServices(testTimeoutDuration, "kubelet", "crio")
+	re := regexp.MustCompile(handler.ReelFirst().Expect[0])
+	assert.False(t, re.MatchString(handler.Args()[0]))
+	assert.False(t, re.MatchString("SERVICE_STATE:kubelet=active\n"))
+	assert.Equal(t, "SERVICE_STATE:kubelet=active\nSERVICE_STATE:crio=failed",
+		re.FindString(handler.Args()[0]+"\nSERVICE_STATE:kubelet=active\nSERVICE_STATE:crio=failed\n"))
+}
+
+func TestNodeServices_ReelMatch(t *testing.T) {
+	testCases := []struct {
+		output           string
+		expectedResult   int
+		expectedInactive []string
+	}{
+		{output: "SERVICE_STATE:kubelet=active\nSERVICE_STATE:crio=active", expectedResult: tnf.SUCCESS},
+		{output: "SERVICE_STATE:kubelet=active\nSERVICE_STATE:crio=failed", expectedResult: tnf.FAILURE, expectedInactive: []string{"crio"}},
+		{output: "SERVICE_STATE:kubelet=inactive\nSERVICE_STATE:crio=unknown", expectedResult: tnf.FAILURE,
+			expectedInactive: []string{"crio", "kubelet"}},
+		{output: "SERVICE_STATE:crio=active", expectedResult: tnf.ERROR},
+	}
+	for _, tc := range testCases {
+		handler := nodeservices.NewNodeServices(testTimeoutDuration, "kubelet", "crio")
+		assert.Nil(t, handler.ReelMatch("", "", tc.output))
+		assert.Equal(t, tc.expectedResult, handler.Result(), tc.output)
+		if tc.expectedResult != tnf.ERROR {
+			assert.Equal(t, tc.expectedInactive, handler.GetInactive(), tc.output)
+		}
+	}
+}
+
+// Ensure there are no panics.
+func TestNodeServices_ReelEof(t *testing.T) {
+	handler := nodeservices.NewNodeServices(testTimeoutDuration, "kubelet")
+	handler.ReelEOF()
+	assert.Nil(t, handler.ReelTimeout())
+}
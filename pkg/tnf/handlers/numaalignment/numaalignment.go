@@ -0,0 +1,218 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package numaalignment
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/dependencies"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/cpupinning"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	naRegex = "(?s).+"
+
+	pciPrefix       = "PCI"
+	nodePrefix      = "Node"
+	hugepagesTotal  = "HugePages_Total:"
+	unknownNUMANode = -1
+)
+
+// NUMAAlignment checks that the CPUs, hugepages and PCI devices (e.g. SR-IOV VFs) of a pod belong to one NUMA node.
+type NUMAAlignment struct {
+	cpus             []int
+	pciDevices       []string
+	requireHugepages bool
+	cpuNodes         map[int]int
+	hugepagesPerNode map[int]int
+	deviceNodes      map[string]int
+	numaNode         int
+	misaligned       []string
+	result           int
+	timeout          time.Duration
+	args             []string
+}
+
+// NewNUMAAlignment creates a new NUMAAlignment tnf.Test to run in a node debug pod.  cpus is the CPU list assigned to
+// the pod (e.g. "2-3"), pciDevices are the PCI addresses of its devices (e.g. "0000:3b:02.1"), and requireHugepages
+// asks for hugepages to be configured on the NUMA node of the CPUs.
+func NewNUMAAlignment(timeout time.Duration, cpus string, pciDevices []string, requireHugepages bool) *NUMAAlignment {
+	parsedCPUs, _ := cpupinning.ParseCPUList(cpus)
+	command := fmt.Sprintf("%s -p=CPU,NODE | %s -v '^#'; %s /sys/devices/system/node/node*/meminfo | %s %s",
+		dependencies.LscpuBinaryName, dependencies.GrepBinaryName, dependencies.CatBinaryName, dependencies.GrepBinaryName, hugepagesTotal)
+	for _, device := range pciDevices {
+		command += fmt.Sprintf("; echo %s %s $(%s /sys/bus/pci/devices/%s/numa_node)", pciPrefix, device, dependencies.CatBinaryName, device)
+	}
+	return &NUMAAlignment{
+		cpus:             parsedCPUs,
+		pciDevices:       pciDevices,
+		requireHugepages: requireHugepages,
+		numaNode:         unknownNUMANode,
+		timeout:          timeout,
+		result:           tnf.ERROR,
+		args:             []string{command},
+	}
+}
+
+// Args returns the command line args for the test.
+func (na *NUMAAlignment) Args() []string {
+	return na.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (na *NUMAAlignment) GetIdentifier() identifier.Identifier {
+	return identifier.NUMAAlignmentIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (na *NUMAAlignment) Timeout() time.Duration {
+	return na.timeout
+}
+
+// Result returns the test result.
+func (na *NUMAAlignment) Result() int {
+	return na.result
+}
+
+// GetNUMANode returns the NUMA node of the pod CPUs, or -1 when it could not be determined.
+func (na *NUMAAlignment) GetNUMANode() int {
+	return na.numaNode
+}
+
+// GetHugepagesPerNode returns the total number of hugepages configured on every NUMA node.
+func (na *NUMAAlignment) GetHugepagesPerNode() map[int]int {
+	return na.hugepagesPerNode
+}
+
+// GetDeviceNodes returns the NUMA node of every PCI device; -1 means the platform does not report one.
+func (na *NUMAAlignment) GetDeviceNodes() map[string]int {
+	return na.deviceNodes
+}
+
+// GetMisaligned returns a description of every resource that is not on the NUMA node of the pod CPUs.
+func (na *NUMAAlignment) GetMisaligned() []string {
+	return na.misaligned
+}
+
+// ReelFirst returns a step which expects the NUMA topology within the test timeout.
+func (na *NUMAAlignment) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{naRegex},
+		Timeout: na.timeout,
+	}
+}
+
+// ReelMatch parses the NUMA topology and checks the alignment of the pod resources.
+func (na *NUMAAlignment) ReelMatch(_, _, match string) *reel.Step {
+	na.parse(match)
+	na.misaligned = nil
+	na.numaNode = unknownNUMANode
+
+	cpuNUMANodes := map[int]bool{}
+	for _, cpu := range na.cpus {
+		node, ok := na.cpuNodes[cpu]
+		if !ok {
+			na.result = tnf.ERROR
+			return nil
+		}
+		cpuNUMANodes[node] = true
+		na.numaNode = node
+	}
+	if len(cpuNUMANodes) != 1 {
+		if len(cpuNUMANodes) == 0 {
+			na.result = tnf.ERROR
+			return nil
+		}
+		na.numaNode = unknownNUMANode
+		na.misaligned = append(na.misaligned, fmt.Sprintf("cpus span %d NUMA nodes", len(cpuNUMANodes)))
+	}
+
+	if na.numaNode != unknownNUMANode {
+		na.checkResources()
+	}
+
+	if len(na.misaligned) > 0 {
+		na.result = tnf.FAILURE
+	} else {
+		na.result = tnf.SUCCESS
+	}
+	return nil
+}
+
+// checkResources records the hugepages and devices that are not on the NUMA node of the pod CPUs.
+func (na *NUMAAlignment) checkResources() {
+	if na.requireHugepages && na.hugepagesPerNode[na.numaNode] == 0 {
+		na.misaligned = append(na.misaligned, fmt.Sprintf("no hugepages on NUMA node %d", na.numaNode))
+	}
+	for _, device := range na.pciDevices {
+		node, ok := na.deviceNodes[device]
+		if !ok {
+			na.misaligned = append(na.misaligned, fmt.Sprintf("device %s not found", device))
+		} else if node != unknownNUMANode && node != na.numaNode {
+			na.misaligned = append(na.misaligned, fmt.Sprintf("device %s on NUMA node %d", device, node))
+		}
+	}
+}
+
+// parse extracts the cpu to node mapping from `lscpu -p=CPU,NODE`, the hugepages per node from the node meminfo files
+// and the numa_node of every requested PCI device.
+func (na *NUMAAlignment) parse(match string) {
+	na.cpuNodes = map[int]int{}
+	na.hugepagesPerNode = map[int]int{}
+	na.deviceNodes = map[string]int{}
+	for _, line := range strings.Split(match, "\n") {
+		line = strings.TrimSpace(line)
+		fields := strings.Fields(line)
+		switch {
+		case len(fields) == 4 && fields[0] == nodePrefix && fields[2] == hugepagesTotal:
+			// Node 0 HugePages_Total:    16
+			node, errNode := strconv.Atoi(fields[1])
+			total, errTotal := strconv.Atoi(fields[3])
+			if errNode == nil && errTotal == nil {
+				na.hugepagesPerNode[node] = total
+			}
+		case len(fields) == 3 && fields[0] == pciPrefix:
+			// PCI 0000:3b:02.1 0
+			if node, err := strconv.Atoi(fields[2]); err == nil {
+				na.deviceNodes[fields[1]] = node
+			}
+		case len(fields) == 1 && strings.Contains(line, ","):
+			// 2,0
+			values := strings.Split(line, ",")
+			cpu, errCPU := strconv.Atoi(values[0])
+			node, errNode := strconv.Atoi(values[1])
+			if errCPU == nil && errNode == nil {
+				na.cpuNodes[cpu] = node
+			}
+		}
+	}
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (na *NUMAAlignment) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (na *NUMAAlignment) ReelEOF() {
+}
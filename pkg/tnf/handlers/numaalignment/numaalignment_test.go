@@ -0,0 +1,120 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package numaalignment_test
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	na "github.com/test-network-function/test-network-function/pkg/tnf/handlers/numaalignment"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testDataDirectory   = "testdata"
+	testDataFileSuffix  = ".txt"
+	testTimeoutDuration = time.Second * 2
+	testTopology        = "topology"
+)
+
+type TestCase struct {
+	cpus               string
+	pciDevices         []string
+	requireHugepages   bool
+	expectedResult     int
+	expectedNUMANode   int
+	expectedMisaligned []string
+}
+
+var testCases = map[string]TestCase{
+	"aligned": {
+		cpus:             "2-3",
+		pciDevices:       []string{"0000:3b:02.1"},
+		requireHugepages: true,
+		expectedResult:   tnf.SUCCESS,
+		expectedNUMANode: 0,
+	},
+	"cpus_span_nodes": {
+		cpus:               "3-4",
+		expectedResult:     tnf.FAILURE,
+		expectedNUMANode:   -1,
+		expectedMisaligned: []string{"cpus span 2 NUMA nodes"},
+	},
+	"misaligned_resources": {
+		cpus:             "4,5",
+		pciDevices:       []string{"0000:3b:02.1", "0000:af:02.1", "0000:d8:00.0"},
+		requireHugepages: true,
+		expectedResult:   tnf.FAILURE,
+		expectedNUMANode: 1,
+		expectedMisaligned: []string{
+			"no hugepages on NUMA node 1",
+			"device 0000:3b:02.1 on NUMA node 0",
+			"device 0000:d8:00.0 not found",
+		},
+	},
+	"unknown_cpu": {
+		cpus:             "12",
+		expectedResult:   tnf.ERROR,
+		expectedNUMANode: -1,
+	},
+}
+
+func getMockOutput(t *testing.T, testName string) string {
+	b, err := os.ReadFile(path.Join(testDataDirectory, fmt.Sprintf("%s%s", testName, testDataFileSuffix)))
+	assert.Nil(t, err)
+	return string(b)
+}
+
+func TestNewNUMAAlignment(t *testing.T) {
+	handler := na.NewNUMAAlignment(testTimeoutDuration, "2-3", []string{"0000:3b:02.1"}, true)
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.NUMAAlignmentIdentifier, handler.GetIdentifier())
+	assert.Len(t, handler.Args(), 1)
+	assert.Contains(t, handler.Args()[0], "lscpu -p=CPU,NODE")
+	assert.Contains(t, handler.Args()[0], "echo PCI 0000:3b:02.1 $(cat /sys/bus/pci/devices/0000:3b:02.1/numa_node)")
+}
+
+func TestNUMAAlignment_ReelMatch(t *testing.T) {
+	for testName, testCase := range testCases {
+		handler := na.NewNUMAAlignment(testTimeoutDuration, testCase.cpus, testCase.pciDevices, testCase.requireHugepages)
+		step := handler.ReelMatch("", "", getMockOutput(t, testTopology))
+		assert.Nil(t, step)
+		assert.Equal(t, testCase.expectedResult, handler.Result(), testName)
+		assert.Equal(t, testCase.expectedNUMANode, handler.GetNUMANode(), testName)
+		assert.Equal(t, testCase.expectedMisaligned, handler.GetMisaligned(), testName)
+	}
+}
+
+func TestNUMAAlignment_Topology(t *testing.T) {
+	handler := na.NewNUMAAlignment(testTimeoutDuration, "0", []string{"0000:af:02.1"}, false)
+	handler.ReelMatch("", "", getMockOutput(t, testTopology))
+	assert.Equal(t, map[int]int{0: 16, 1: 0}, handler.GetHugepagesPerNode())
+	assert.Equal(t, map[string]int{"0000:3b:02.1": 0, "0000:af:02.1": 1}, handler.GetDeviceNodes())
+}
+
+// Ensure there are no panics.
+func TestNUMAAlignment_ReelEof(t *testing.T) {
+	handler := na.NewNUMAAlignment(testTimeoutDuration, "0", nil, false)
+	assert.Nil(t, handler.ReelTimeout())
+	handler.ReelEOF()
+}
@@ -0,0 +1,208 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package olminstall
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	olmRegex = "(?s).+"
+
+	// SubscriptionKind, InstallPlanKind and CSVKind are the kinds of the OLM objects checked by the test.
+	SubscriptionKind = "Subscription"
+	InstallPlanKind  = "InstallPlan"
+	CSVKind          = "ClusterServiceVersion"
+
+	// SubscriptionSucceeded, InstallPlanSucceeded and CSVSucceeded are the final phases of the OLM objects.
+	SubscriptionSucceeded = "AtLatestKnown"
+	InstallPlanSucceeded  = "Complete"
+	CSVSucceeded          = "Succeeded"
+
+	numStatusFields = 3
+)
+
+// markers separate the status of each OLM object, in the order they are printed.
+var markers = []string{"OLM_SUBSCRIPTION", "OLM_INSTALL_PLAN", "OLM_CSV"}
+
+// Status is the installation status of an OLM object.
+type Status struct {
+	Kind string
+	Name string
+	// Phase is the state of a Subscription, or the phase of an InstallPlan or ClusterServiceVersion.
+	Phase string
+	// Conditions lists the "type=status:reason" conditions of a Subscription or InstallPlan, or the "phase:reason"
+	// transitions of a ClusterServiceVersion.
+	Conditions []string
+}
+
+// Succeeded returns true when the object reached its final phase.
+func (s *Status) Succeeded() bool {
+	switch s.Kind {
+	case SubscriptionKind:
+		return s.Phase == SubscriptionSucceeded
+	case InstallPlanKind:
+		return s.Phase == InstallPlanSucceeded
+	case CSVKind:
+		return s.Phase == CSVSucceeded
+	}
+	return false
+}
+
+// OLMInstall checks that the Subscription of an operator, its InstallPlan and its installed ClusterServiceVersion
+// reached their final phase.
+type OLMInstall struct {
+	subscription string
+	namespace    string
+	statuses     []Status
+	missing      []string
+	result       int
+	timeout      time.Duration
+	args         []string
+}
+
+// NewOLMInstall creates a new OLMInstall tnf.Test.  The InstallPlan and ClusterServiceVersion are the ones referenced
+// by the status of the Subscription.
+func NewOLMInstall(timeout time.Duration, subscription, namespace string) *OLMInstall {
+	getSubscription := fmt.Sprintf("oc get subscription -n %s %s -o jsonpath=", namespace, subscription)
+	return &OLMInstall{
+		subscription: subscription,
+		namespace:    namespace,
+		timeout:      timeout,
+		result:       tnf.ERROR,
+		args: []string{fmt.Sprintf("echo %s; %s'{.metadata.name}|{.status.state}|"+
+			`{range .status.conditions[*]}{.type}={.status}:{.reason} {end}'; echo; `+
+			"echo %s; oc get installplan -n %s $(%s'{.status.installPlanRef.name}') -o jsonpath='{.metadata.name}|{.status.phase}|"+
+			`{range .status.conditions[*]}{.type}={.status}:{.reason} {end}'; echo; `+
+			"echo %s; oc get csv -n %s $(%s'{.status.installedCSV}') -o jsonpath='{.metadata.name}|{.status.phase}|"+
+			`{range .status.conditions[*]}{.phase}:{.reason} {end}'; echo`,
+			markers[0], getSubscription,
+			markers[1], namespace, getSubscription,
+			markers[2], namespace, getSubscription)},
+	}
+}
+
+// Args returns the command line args for the test.
+func (oi *OLMInstall) Args() []string {
+	return oi.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (oi *OLMInstall) GetIdentifier() identifier.Identifier {
+	return identifier.OLMInstallIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (oi *OLMInstall) Timeout() time.Duration {
+	return oi.timeout
+}
+
+// Result returns the test result.
+func (oi *OLMInstall) Result() int {
+	return oi.result
+}
+
+// GetStatuses returns the status of the Subscription, InstallPlan and ClusterServiceVersion that could be read.
+func (oi *OLMInstall) GetStatuses() []Status {
+	return oi.statuses
+}
+
+// GetMissing returns the kinds of the OLM objects that could not be found.
+func (oi *OLMInstall) GetMissing() []string {
+	return oi.missing
+}
+
+// ReelFirst returns a step which expects the OLM objects status within the test timeout.
+func (oi *OLMInstall) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{olmRegex},
+		Timeout: oi.timeout,
+	}
+}
+
+// ReelMatch parses the status of the OLM objects and checks they all reached their final phase.  A missing object
+// fails the test.
+func (oi *OLMInstall) ReelMatch(_, _, match string) *reel.Step {
+	kinds := []string{SubscriptionKind, InstallPlanKind, CSVKind}
+	sections := splitSections(match)
+	if sections == nil {
+		oi.result = tnf.ERROR
+		return nil
+	}
+	oi.statuses = nil
+	oi.missing = nil
+	oi.result = tnf.SUCCESS
+	for i, section := range sections {
+		status, ok := parseStatus(kinds[i], section)
+		if !ok {
+			oi.missing = append(oi.missing, kinds[i])
+			oi.result = tnf.FAILURE
+			continue
+		}
+		oi.statuses = append(oi.statuses, status)
+		if !status.Succeeded() {
+			oi.result = tnf.FAILURE
+		}
+	}
+	return nil
+}
+
+// splitSections returns the output printed after each marker, or nil if a marker is missing.
+func splitSections(output string) []string {
+	var sections []string
+	for i, marker := range markers {
+		start := strings.Index(output, marker)
+		if start < 0 {
+			return nil
+		}
+		output = output[start+len(marker):]
+		end := len(output)
+		if i+1 < len(markers) {
+			if next := strings.Index(output, markers[i+1]); next >= 0 {
+				end = next
+			}
+		}
+		sections = append(sections, output[:end])
+	}
+	return sections
+}
+
+func parseStatus(kind, section string) (Status, bool) {
+	for _, line := range strings.Split(section, "\n") {
+		fields := strings.Split(strings.TrimSpace(line), "|")
+		if len(fields) != numStatusFields || fields[0] == "" {
+			continue
+		}
+		return Status{Kind: kind, Name: fields[0], Phase: fields[1], Conditions: strings.Fields(fields[2])}, true
+	}
+	return Status{}, false
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (oi *OLMInstall) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (oi *OLMInstall) ReelEOF() {
+}
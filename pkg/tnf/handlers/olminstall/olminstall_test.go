@@ -0,0 +1,100 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package olminstall_test
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/olminstall"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testDataDirectory   = "testdata"
+	testTimeoutDuration = time.Second * 2
+)
+
+func getMockOutput(t *testing.T, testName string) string {
+	b, err := os.ReadFile(path.Join(testDataDirectory, testName+".txt"))
+	assert.Nil(t, err)
+	return string(b)
+}
+
+func TestNewOLMInstall(t *testing.T) {
+	handler := olminstall.NewOLMInstall(testTimeoutDuration, "etcd", "tnf")
+	assert.Len(t, handler.Args(), 1)
+	assert.Contains(t, handler.Args()[0], "oc get subscription -n tnf etcd")
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.OLMInstallIdentifier, handler.GetIdentifier())
+}
+
+func TestOLMInstall_ReelMatch(t *testing.T) {
+	testCases := map[string]struct {
+		expectedResult   int
+		expectedPhases   []string
+		expectedCSVConds int
+		expectedMissing  []string
+	}{
+		"succeeded": {
+			expectedResult:   tnf.SUCCESS,
+			expectedPhases:   []string{"AtLatestKnown", "Complete", "Succeeded"},
+			expectedCSVConds: 4,
+		},
+		"installing": {
+			expectedResult:   tnf.FAILURE,
+			expectedPhases:   []string{"AtLatestKnown", "Complete", "Installing"},
+			expectedCSVConds: 3,
+		},
+		"pending": {
+			expectedResult:  tnf.FAILURE,
+			expectedPhases:  []string{"UpgradePending", "RequiresApproval"},
+			expectedMissing: []string{olminstall.CSVKind},
+		},
+	}
+	for name, tc := range testCases {
+		handler := olminstall.NewOLMInstall(testTimeoutDuration, "etcd", "tnf")
+		assert.Nil(t, handler.ReelMatch("", "", getMockOutput(t, name)), name)
+		assert.Equal(t, tc.expectedResult, handler.Result(), name)
+		var phases []string
+		for _, status := range handler.GetStatuses() {
+			phases = append(phases, status.Phase)
+			if status.Kind == olminstall.CSVKind {
+				assert.Len(t, status.Conditions, tc.expectedCSVConds, name)
+			}
+		}
+		assert.Equal(t, tc.expectedPhases, phases, name)
+		assert.Equal(t, tc.expectedMissing, handler.GetMissing(), name)
+	}
+}
+
+func TestOLMInstall_ReelMatchError(t *testing.T) {
+	handler := olminstall.NewOLMInstall(testTimeoutDuration, "etcd", "tnf")
+	assert.Nil(t, handler.ReelMatch("", "", "error: the server doesn't have a resource type \"subscription\"\n"))
+	assert.Equal(t, tnf.ERROR, handler.Result())
+}
+
+func TestStatus_Succeeded(t *testing.T) {
+	assert.True(t, (&olminstall.Status{Kind: olminstall.SubscriptionKind, Phase: olminstall.SubscriptionSucceeded}).Succeeded())
+	assert.False(t, (&olminstall.Status{Kind: olminstall.InstallPlanKind, Phase: olminstall.CSVSucceeded}).Succeeded())
+	assert.True(t, (&olminstall.Status{Kind: olminstall.CSVKind, Phase: olminstall.CSVSucceeded}).Succeeded())
+}
@@ -0,0 +1,162 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package packages
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/dependencies"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	packagesRegex = "(?s).+"
+	// NoPackageManagerOutput is printed when none of rpm, dpkg-query and apk is available in the container.
+	NoPackageManagerOutput = "NO_PACKAGE_MANAGER"
+
+	maxPackageFields = 2
+)
+
+// Packages checks that required packages are installed in a container and that forbidden ones are not.
+type Packages struct {
+	required   []string
+	forbidden  []string
+	installed  map[string]string
+	violations []string
+	result     int
+	timeout    time.Duration
+	args       []string
+}
+
+// NewPackages creates a new Packages tnf.Test to run in a container.  Packages are queried with rpm, falling back to
+// dpkg-query and then apk on images without rpm.
+func NewPackages(timeout time.Duration, required, forbidden []string) *Packages {
+	names := strings.Join(append(append([]string{}, required...), forbidden...), " ")
+	command := fmt.Sprintf("if command -v %[1]s >/dev/null; then %[1]s -q --qf '%%{NAME} %%{VERSION}-%%{RELEASE}\\n' %[4]s; "+
+		"elif command -v %[2]s >/dev/null; then %[2]s -W -f '${Package} ${Version}\\n' %[4]s; "+
+		"elif command -v %[3]s >/dev/null; then %[3]s info -e %[4]s; "+
+		"else echo %[5]s; fi",
+		dependencies.RpmBinaryName, dependencies.DpkgQueryBinaryName, dependencies.ApkBinaryName, names, NoPackageManagerOutput)
+	return &Packages{
+		required:  required,
+		forbidden: forbidden,
+		timeout:   timeout,
+		result:    tnf.ERROR,
+		args:      []string{command},
+	}
+}
+
+// Args returns the command line args for the test.
+func (p *Packages) Args() []string {
+	return p.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (p *Packages) GetIdentifier() identifier.Identifier {
+	return identifier.PackagesIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (p *Packages) Timeout() time.Duration {
+	return p.timeout
+}
+
+// Result returns the test result.
+func (p *Packages) Result() int {
+	return p.result
+}
+
+// GetInstalled returns the version of every queried package which is installed, keyed by name.  The version is empty
+// when the package manager does not report it (apk).
+func (p *Packages) GetInstalled() map[string]string {
+	return p.installed
+}
+
+// GetViolations returns a description of every missing required package and installed forbidden package.
+func (p *Packages) GetViolations() []string {
+	return p.violations
+}
+
+// ReelFirst returns a step which expects the package query output within the test timeout.
+func (p *Packages) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{packagesRegex},
+		Timeout: p.timeout,
+	}
+}
+
+// ReelMatch parses the installed packages and checks the required and forbidden lists.
+func (p *Packages) ReelMatch(_, _, match string) *reel.Step {
+	p.violations = nil
+	if strings.Contains(match, NoPackageManagerOutput) {
+		p.installed = nil
+		p.result = tnf.ERROR
+		return nil
+	}
+	p.installed = p.parseInstalled(match)
+	for _, name := range p.required {
+		if _, ok := p.installed[name]; !ok {
+			p.violations = append(p.violations, fmt.Sprintf("required package %s is not installed", name))
+		}
+	}
+	for _, name := range p.forbidden {
+		if _, ok := p.installed[name]; ok {
+			p.violations = append(p.violations, fmt.Sprintf("forbidden package %s is installed", name))
+		}
+	}
+	if len(p.violations) > 0 {
+		p.result = tnf.FAILURE
+	} else {
+		p.result = tnf.SUCCESS
+	}
+	return nil
+}
+
+// parseInstalled keeps the output lines made of a queried package name and an optional version.  Lines reporting a
+// missing package, such as "package gdb is not installed", have more fields and are skipped.
+func (p *Packages) parseInstalled(match string) map[string]string {
+	queried := map[string]bool{}
+	for _, name := range append(append([]string{}, p.required...), p.forbidden...) {
+		queried[name] = true
+	}
+	installed := map[string]string{}
+	for _, line := range strings.Split(match, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || len(fields) > maxPackageFields || !queried[fields[0]] {
+			continue
+		}
+		version := ""
+		if len(fields) == maxPackageFields {
+			version = fields[1]
+		}
+		installed[fields[0]] = version
+	}
+	return installed
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (p *Packages) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (p *Packages) ReelEOF() {
+}
@@ -0,0 +1,82 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package packages_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/packages"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testTimeoutDuration = time.Second * 2
+	testInputRpm        = "glibc 2.28-151.el8\npackage gdb is not installed\npackage strace is not installed\n"
+	testInputRpmDebug   = "glibc 2.28-151.el8\ngdb 8.2-15.el8\npackage strace is not installed\n"
+	testInputDpkg       = "libc6 2.31-13+deb11u2\ndpkg-query: no packages found matching gdb\ndpkg-query: no packages found matching strace\n"
+	testInputApk        = "musl\n"
+	testInputNone       = "NO_PACKAGE_MANAGER\n"
+)
+
+func TestNewPackages(t *testing.T) {
+	handler := packages.NewPackages(testTimeoutDuration, []string{"glibc"}, []string{"gdb"})
+	assert.Equal(t, []string{"if command -v rpm >/dev/null; then rpm -q --qf '%{NAME} %{VERSION}-%{RELEASE}\\n' glibc gdb; " +
+		"elif command -v dpkg-query >/dev/null; then dpkg-query -W -f '${Package} ${Version}\\n' glibc gdb; " +
+		"elif command -v apk >/dev/null; then apk info -e glibc gdb; " +
+		"else echo NO_PACKAGE_MANAGER; fi"}, handler.Args())
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.PackagesIdentifier, handler.GetIdentifier())
+}
+
+func TestPackages_ReelMatch(t *testing.T) {
+	testCases := map[string]struct {
+		input              string
+		required           []string
+		expectedResult     int
+		expectedViolations []string
+	}{
+		"rpm_compliant":      {testInputRpm, []string{"glibc"}, tnf.SUCCESS, nil},
+		"rpm_debug_tools":    {testInputRpmDebug, []string{"glibc"}, tnf.FAILURE, []string{"forbidden package gdb is installed"}},
+		"dpkg_missing_glibc": {testInputDpkg, []string{"glibc"}, tnf.FAILURE, []string{"required package glibc is not installed"}},
+		"dpkg_compliant":     {testInputDpkg, []string{"libc6"}, tnf.SUCCESS, nil},
+		"apk_compliant":      {testInputApk, []string{"musl"}, tnf.SUCCESS, nil},
+		"no_package_manager": {testInputNone, []string{"glibc"}, tnf.ERROR, nil},
+	}
+	for testName, testCase := range testCases {
+		handler := packages.NewPackages(testTimeoutDuration, testCase.required, []string{"gdb", "strace"})
+		assert.Nil(t, handler.ReelMatch("", "", testCase.input))
+		assert.Equal(t, testCase.expectedResult, handler.Result(), testName)
+		assert.Equal(t, testCase.expectedViolations, handler.GetViolations(), testName)
+	}
+}
+
+func TestPackages_GetInstalled(t *testing.T) {
+	handler := packages.NewPackages(testTimeoutDuration, []string{"glibc"}, []string{"gdb", "strace"})
+	handler.ReelMatch("", "", testInputRpmDebug)
+	assert.Equal(t, map[string]string{"glibc": "2.28-151.el8", "gdb": "8.2-15.el8"}, handler.GetInstalled())
+}
+
+// Ensure there are no panics.
+func TestPackages_ReelEof(t *testing.T) {
+	handler := packages.NewPackages(testTimeoutDuration, nil, nil)
+	assert.Nil(t, handler.ReelTimeout())
+	handler.ReelEOF()
+}
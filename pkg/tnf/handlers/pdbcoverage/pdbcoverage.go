@@ -0,0 +1,323 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package pdbcoverage
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	pdbRegex = "(?s).+"
+	// budgetsMarker separates the workload listing from the PodDisruptionBudget listing.
+	budgetsMarker = "PDB_LIST"
+	percentSuffix = "%"
+	percent       = 100
+)
+
+// Workload describes a Deployment or StatefulSet.
+type Workload struct {
+	Kind     string
+	Name     string
+	Replicas int
+	// Labels are the labels of the pod template.
+	Labels map[string]string
+}
+
+// Budget describes a PodDisruptionBudget.  MinAvailable and MaxUnavailable hold an absolute number or a percentage,
+// and are empty when not set.
+type Budget struct {
+	Name           string
+	MatchLabels    map[string]string
+	MinAvailable   string
+	MaxUnavailable string
+}
+
+// list is the subset of an `oc get -o json` list used by the test.
+type list struct {
+	Items []struct {
+		Kind     string `json:"kind"`
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+		Spec struct {
+			Replicas *int `json:"replicas"`
+			Template struct {
+				Metadata struct {
+					Labels map[string]string `json:"labels"`
+				} `json:"metadata"`
+			} `json:"template"`
+			Selector struct {
+				MatchLabels map[string]string `json:"matchLabels"`
+			} `json:"selector"`
+			MinAvailable   interface{} `json:"minAvailable"`
+			MaxUnavailable interface{} `json:"maxUnavailable"`
+		} `json:"spec"`
+	} `json:"items"`
+}
+
+// PDBCoverage checks that every Deployment and StatefulSet of a namespace running more than one replica is covered by
+// a PodDisruptionBudget which allows at least one, but not every, replica to be disrupted.
+type PDBCoverage struct {
+	workloads  []Workload
+	budgets    []Budget
+	coverage   map[string][]string
+	violations []string
+	result     int
+	timeout    time.Duration
+	args       []string
+}
+
+// NewPDBCoverage creates a new PDBCoverage tnf.Test.  Only the matchLabels of the PodDisruptionBudget selectors are
+// compared with the pod template labels.
+func NewPDBCoverage(timeout time.Duration, namespace string) *PDBCoverage {
+	return &PDBCoverage{
+		timeout: timeout,
+		result:  tnf.ERROR,
+		args: []string{fmt.Sprintf("oc get deployments,statefulsets -n %s -o json; echo %s; oc get pdb -n %s -o json",
+			namespace, budgetsMarker, namespace)},
+	}
+}
+
+// Args returns the command line args for the test.
+func (pc *PDBCoverage) Args() []string {
+	return pc.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (pc *PDBCoverage) GetIdentifier() identifier.Identifier {
+	return identifier.PDBCoverageIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (pc *PDBCoverage) Timeout() time.Duration {
+	return pc.timeout
+}
+
+// Result returns the test result.
+func (pc *PDBCoverage) Result() int {
+	return pc.result
+}
+
+// GetWorkloads returns the Deployments and StatefulSets of the namespace.
+func (pc *PDBCoverage) GetWorkloads() []Workload {
+	return pc.workloads
+}
+
+// GetBudgets returns the PodDisruptionBudgets of the namespace.
+func (pc *PDBCoverage) GetBudgets() []Budget {
+	return pc.budgets
+}
+
+// GetCoverage maps the "Kind/Name" key of each workload to the names of the PodDisruptionBudgets covering it.
+func (pc *PDBCoverage) GetCoverage() map[string][]string {
+	return pc.coverage
+}
+
+// GetViolations returns a description of every uncovered workload and badly sized PodDisruptionBudget.
+func (pc *PDBCoverage) GetViolations() []string {
+	return pc.violations
+}
+
+// ReelFirst returns a step which expects the workload and PodDisruptionBudget listings within the test timeout.
+func (pc *PDBCoverage) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{pdbRegex},
+		Timeout: pc.timeout,
+	}
+}
+
+// ReelMatch parses the workloads and PodDisruptionBudgets and checks the coverage.
+func (pc *PDBCoverage) ReelMatch(_, _, match string) *reel.Step {
+	pc.violations = nil
+	pc.coverage = map[string][]string{}
+	parts := strings.SplitN(match, budgetsMarker, 2)
+	if len(parts) != 2 {
+		pc.result = tnf.ERROR
+		return nil
+	}
+	var err error
+	if pc.workloads, err = parseWorkloads(parts[0]); err != nil {
+		pc.result = tnf.ERROR
+		return nil
+	}
+	if pc.budgets, err = parseBudgets(parts[1]); err != nil {
+		pc.result = tnf.ERROR
+		return nil
+	}
+	for _, workload := range pc.workloads {
+		pc.checkWorkload(workload)
+	}
+	if len(pc.violations) > 0 {
+		pc.result = tnf.FAILURE
+	} else {
+		pc.result = tnf.SUCCESS
+	}
+	return nil
+}
+
+func (pc *PDBCoverage) checkWorkload(workload Workload) {
+	key := workload.Kind + "/" + workload.Name
+	for _, budget := range pc.budgets {
+		if !selects(budget.MatchLabels, workload.Labels) {
+			continue
+		}
+		pc.coverage[key] = append(pc.coverage[key], budget.Name)
+		if workload.Replicas <= 1 {
+			continue
+		}
+		if violation := checkBudget(budget, workload.Replicas); violation != "" {
+			pc.violations = append(pc.violations, fmt.Sprintf("%s: PodDisruptionBudget %s %s", key, budget.Name, violation))
+		}
+	}
+	if workload.Replicas > 1 && len(pc.coverage[key]) == 0 {
+		pc.violations = append(pc.violations, fmt.Sprintf("%s has %d replicas but no PodDisruptionBudget", key, workload.Replicas))
+	}
+}
+
+// checkBudget returns why a budget is not sane for the number of replicas, or an empty string.
+func checkBudget(budget Budget, replicas int) string {
+	if budget.MinAvailable != "" {
+		minAvailable, err := resolve(budget.MinAvailable, replicas)
+		switch {
+		case err != nil:
+			return err.Error()
+		case minAvailable < 1:
+			return fmt.Sprintf("minAvailable %s allows every replica to be disrupted", budget.MinAvailable)
+		case minAvailable >= replicas:
+			return fmt.Sprintf("minAvailable %s does not allow any of the %d replicas to be disrupted", budget.MinAvailable, replicas)
+		}
+	}
+	if budget.MaxUnavailable != "" {
+		maxUnavailable, err := resolve(budget.MaxUnavailable, replicas)
+		switch {
+		case err != nil:
+			return err.Error()
+		case maxUnavailable < 1:
+			return fmt.Sprintf("maxUnavailable %s does not allow any replica to be disrupted", budget.MaxUnavailable)
+		case maxUnavailable >= replicas:
+			return fmt.Sprintf("maxUnavailable %s allows all the %d replicas to be disrupted", budget.MaxUnavailable, replicas)
+		}
+	}
+	return ""
+}
+
+// resolve converts an absolute number or a percentage of replicas, rounded up as the disruption controller does, to a
+// number of replicas.
+func resolve(value string, replicas int) (int, error) {
+	if strings.HasSuffix(value, percentSuffix) {
+		p, err := strconv.Atoi(strings.TrimSuffix(value, percentSuffix))
+		if err != nil {
+			return 0, fmt.Errorf("has invalid value %s", value)
+		}
+		return int(math.Ceil(float64(p*replicas) / percent)), nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("has invalid value %s", value)
+	}
+	return n, nil
+}
+
+// selects returns true when every label of the selector is set on the pod template.  An empty selector selects
+// nothing.
+func selects(selector, labels map[string]string) bool {
+	if len(selector) == 0 {
+		return false
+	}
+	for key, value := range selector {
+		if labels[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+func parseWorkloads(output string) ([]Workload, error) {
+	parsed, err := parseList(output)
+	if err != nil {
+		return nil, err
+	}
+	var workloads []Workload
+	for i := range parsed.Items {
+		item := &parsed.Items[i]
+		replicas := 1
+		if item.Spec.Replicas != nil {
+			replicas = *item.Spec.Replicas
+		}
+		workloads = append(workloads, Workload{Kind: item.Kind, Name: item.Metadata.Name, Replicas: replicas, Labels: item.Spec.Template.Metadata.Labels})
+	}
+	sort.Slice(workloads, func(i, j int) bool {
+		return workloads[i].Kind+"/"+workloads[i].Name < workloads[j].Kind+"/"+workloads[j].Name
+	})
+	return workloads, nil
+}
+
+func parseBudgets(output string) ([]Budget, error) {
+	parsed, err := parseList(output)
+	if err != nil {
+		return nil, err
+	}
+	var budgets []Budget
+	for i := range parsed.Items {
+		item := &parsed.Items[i]
+		budgets = append(budgets, Budget{
+			Name:           item.Metadata.Name,
+			MatchLabels:    item.Spec.Selector.MatchLabels,
+			MinAvailable:   intOrString(item.Spec.MinAvailable),
+			MaxUnavailable: intOrString(item.Spec.MaxUnavailable),
+		})
+	}
+	return budgets, nil
+}
+
+func parseList(output string) (*list, error) {
+	start := strings.Index(output, "{")
+	if start < 0 {
+		return nil, fmt.Errorf("no JSON output found")
+	}
+	parsed := &list{}
+	if err := json.Unmarshal([]byte(strings.TrimSpace(output[start:])), parsed); err != nil {
+		return nil, err
+	}
+	return parsed, nil
+}
+
+func intOrString(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (pc *PDBCoverage) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (pc *PDBCoverage) ReelEOF() {
+}
@@ -0,0 +1,100 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package pdbcoverage_test
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/pdbcoverage"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testDataDirectory   = "testdata"
+	testTimeoutDuration = time.Second * 2
+)
+
+func getMockOutput(t *testing.T, testName string) string {
+	b, err := os.ReadFile(path.Join(testDataDirectory, testName+".txt"))
+	assert.Nil(t, err)
+	return string(b)
+}
+
+func TestNewPDBCoverage(t *testing.T) {
+	handler := pdbcoverage.NewPDBCoverage(testTimeoutDuration, "tnf")
+	assert.Len(t, handler.Args(), 1)
+	assert.Contains(t, handler.Args()[0], "oc get pdb -n tnf -o json")
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.PDBCoverageIdentifier, handler.GetIdentifier())
+}
+
+func TestPDBCoverage_ReelMatch(t *testing.T) {
+	testCases := map[string]struct {
+		expectedResult     int
+		expectedCoverage   map[string][]string
+		expectedViolations []string
+	}{
+		"covered": {
+			expectedResult:   tnf.SUCCESS,
+			expectedCoverage: map[string][]string{"Deployment/test": {"test-pdb"}, "StatefulSet/db": {"db-pdb"}},
+		},
+		"uncovered": {
+			expectedResult:   tnf.FAILURE,
+			expectedCoverage: map[string][]string{"Deployment/test": {"test-pdb"}},
+			expectedViolations: []string{
+				"Deployment/test: PodDisruptionBudget test-pdb minAvailable 100% does not allow any of the 2 replicas to be disrupted",
+				"StatefulSet/db has 3 replicas but no PodDisruptionBudget",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		handler := pdbcoverage.NewPDBCoverage(testTimeoutDuration, "tnf")
+		assert.Nil(t, handler.ReelMatch("", "", getMockOutput(t, name)), name)
+		assert.Equal(t, tc.expectedResult, handler.Result(), name)
+		assert.Equal(t, tc.expectedCoverage, handler.GetCoverage(), name)
+		assert.Equal(t, tc.expectedViolations, handler.GetViolations(), name)
+	}
+}
+
+func TestPDBCoverage_ReelMatchError(t *testing.T) {
+	handler := pdbcoverage.NewPDBCoverage(testTimeoutDuration, "tnf")
+	assert.Nil(t, handler.ReelMatch("", "", `error: the server doesn't have a resource type "pdb"`))
+	assert.Equal(t, tnf.ERROR, handler.Result())
+}
+
+func TestPDBCoverage_GetBudgets(t *testing.T) {
+	handler := pdbcoverage.NewPDBCoverage(testTimeoutDuration, "tnf")
+	handler.ReelMatch("", "", getMockOutput(t, "covered"))
+	assert.Equal(t, []pdbcoverage.Budget{
+		{Name: "test-pdb", MatchLabels: map[string]string{"app": "test"}, MinAvailable: "1"},
+		{Name: "db-pdb", MatchLabels: map[string]string{"app": "db"}, MaxUnavailable: "34%"},
+	}, handler.GetBudgets())
+	assert.Len(t, handler.GetWorkloads(), 3)
+}
+
+// Just ensure there are no panics.
+func TestPDBCoverage_ReelEOF(t *testing.T) {
+	handler := pdbcoverage.NewPDBCoverage(testTimeoutDuration, "tnf")
+	assert.Nil(t, handler.ReelTimeout())
+	handler.ReelEOF()
+}
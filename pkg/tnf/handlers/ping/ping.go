@@ -17,6 +17,7 @@
 package ping
 
 import (
+	"fmt"
 	"regexp"
 	"strconv"
 	"time"
@@ -35,6 +36,26 @@ type Ping struct {
 	transmitted int
 	received    int
 	errors      int
+	rtt         RTT
+	thresholds  Thresholds
+	violations  []string
+}
+
+// RTT holds the round-trip time statistics reported by ping, in milliseconds.
+type RTT struct {
+	Min  float64
+	Avg  float64
+	Max  float64
+	Mdev float64
+}
+
+// Thresholds holds the limits a ping test must satisfy to succeed.  RTT limits are in milliseconds, and a zero value
+// disables the corresponding check.
+type Thresholds struct {
+	MaxLossPercent float64
+	MaxAvgRTT      float64
+	MaxRTT         float64
+	MaxMdev        float64
 }
 
 const (
@@ -43,6 +64,11 @@ const (
 	// SuccessfulOutputRegex matches a successfully run "ping" command.  That does not mean that no errors or drops
 	// occurred during the test.
 	SuccessfulOutputRegex = `(?m)(\d+) packets transmitted, (\d+)( packets){0,1} received, (?:\+(\d+) errors)?.*$`
+	// RTTOutputRegex matches the round-trip time statistics line.  busybox ping reports no mdev.
+	RTTOutputRegex = `(?m)(?:rtt|round-trip) min/avg/max(?:/mdev)? = ([\d.]+)/([\d.]+)/([\d.]+)(?:/([\d.]+))? ms`
+
+	floatBitSize   = 64
+	percentPerUnit = 100
 )
 
 // Args returns the command line args for the test.
@@ -95,6 +121,7 @@ func (p *Ping) ReelMatch(_, _, match string) *reel.Step {
 		p.transmitted, _ = strconv.Atoi(matched[1])
 		p.received, _ = strconv.Atoi(matched[2])
 		p.errors, _ = strconv.Atoi(matched[4])
+		p.rtt = parseRTT(match)
 		switch {
 		case p.transmitted == 0 || p.errors > 0:
 			p.result = tnf.ERROR
@@ -103,10 +130,43 @@ func (p *Ping) ReelMatch(_, _, match string) *reel.Step {
 		default:
 			p.result = tnf.FAILURE
 		}
+		if p.result == tnf.SUCCESS && !p.checkThresholds() {
+			p.result = tnf.FAILURE
+		}
 	}
 	return nil
 }
 
+func parseRTT(match string) RTT {
+	var rtt RTT
+	matched := regexp.MustCompile(RTTOutputRegex).FindStringSubmatch(match)
+	if matched != nil {
+		// Ignore errors in converting matches, as RTTOutputRegex only captures decimal numbers.
+		rtt.Min, _ = strconv.ParseFloat(matched[1], floatBitSize)
+		rtt.Avg, _ = strconv.ParseFloat(matched[2], floatBitSize)
+		rtt.Max, _ = strconv.ParseFloat(matched[3], floatBitSize)
+		rtt.Mdev, _ = strconv.ParseFloat(matched[4], floatBitSize)
+	}
+	return rtt
+}
+
+// checkThresholds records every exceeded threshold, and returns true when none was exceeded.
+func (p *Ping) checkThresholds() bool {
+	p.violations = nil
+	check := func(name string, value, limit float64) {
+		if limit > 0 && value > limit {
+			p.violations = append(p.violations, fmt.Sprintf("%s %.3f exceeds %.3f", name, value, limit))
+		}
+	}
+	if loss := p.GetLossPercent(); p.thresholds.MaxLossPercent > 0 && loss > p.thresholds.MaxLossPercent {
+		p.violations = append(p.violations, fmt.Sprintf("packet loss %.1f%% exceeds %.1f%%", loss, p.thresholds.MaxLossPercent))
+	}
+	check("avg rtt", p.rtt.Avg, p.thresholds.MaxAvgRTT)
+	check("max rtt", p.rtt.Max, p.thresholds.MaxRTT)
+	check("mdev", p.rtt.Mdev, p.thresholds.MaxMdev)
+	return len(p.violations) == 0
+}
+
 // ReelTimeout returns a step which kills the ping test by sending it ^C.
 func (p *Ping) ReelTimeout() *reel.Step {
 	return nil
@@ -121,6 +181,24 @@ func (p *Ping) GetStats() (transmitted, received, errors int) {
 	return p.transmitted, p.received, p.errors
 }
 
+// GetRTT returns the round-trip time statistics, in milliseconds.
+func (p *Ping) GetRTT() RTT {
+	return p.rtt
+}
+
+// GetLossPercent returns the percentage of requests which received no response.
+func (p *Ping) GetLossPercent() float64 {
+	if p.transmitted == 0 {
+		return 0
+	}
+	return float64(p.transmitted-p.received) * percentPerUnit / float64(p.transmitted)
+}
+
+// GetViolations returns a description of every exceeded threshold.
+func (p *Ping) GetViolations() []string {
+	return p.violations
+}
+
 // Command returns command line args for pinging `host` with `count` requests, or indefinitely if `count` is not
 // positive.
 func Command(host string, count int) []string {
@@ -140,6 +218,14 @@ func NewPing(timeout time.Duration, host string, count int) *Ping {
 	}
 }
 
+// NewPingWithThresholds creates a new `Ping` test like NewPing, which additionally fails when the packet loss or
+// round-trip time statistics exceed `thresholds`.
+func NewPingWithThresholds(timeout time.Duration, host string, count int, thresholds Thresholds) *Ping {
+	p := NewPing(timeout, host, count)
+	p.thresholds = thresholds
+	return p
+}
+
 // GetReelFirstRegularExpressions returns the regular expressions used for matching in ReelFirst.
 func (p *Ping) GetReelFirstRegularExpressions() []string {
 	return []string{ConnectInvalidArgumentRegex, SuccessfulOutputRegex}
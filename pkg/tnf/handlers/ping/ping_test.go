@@ -198,3 +198,37 @@ func TestPingCmd(t *testing.T) {
 	cmd = ping.Command("192.168.1.1", 1)
 	assert.Equal(t, []string{"ping", "-c", "1", "192.168.1.1"}, cmd)
 }
+
+func TestPing_GetRTT(t *testing.T) {
+	pingTest := ping.NewPing(testTimeoutDuration, "192.168.1.5", 20)
+	pingTest.ReelMatch("", "", getMockOutput(t, "ip_address_passing_packet_loss"))
+	assert.Equal(t, ping.RTT{Min: 3.381, Avg: 7.772, Max: 14.867, Mdev: 4.167}, pingTest.GetRTT())
+	assert.Equal(t, 5.0, pingTest.GetLossPercent())
+}
+
+func TestPingWithThresholds(t *testing.T) {
+	thresholdTestCases := map[string]struct {
+		thresholds         ping.Thresholds
+		expectedResult     int
+		expectedViolations []string
+	}{
+		"no_thresholds": {
+			expectedResult: tnf.SUCCESS,
+		},
+		"within_thresholds": {
+			thresholds:     ping.Thresholds{MaxLossPercent: 5, MaxAvgRTT: 10, MaxRTT: 15, MaxMdev: 5},
+			expectedResult: tnf.SUCCESS,
+		},
+		"exceeded_thresholds": {
+			thresholds:         ping.Thresholds{MaxLossPercent: 1, MaxAvgRTT: 1, MaxMdev: 5},
+			expectedResult:     tnf.FAILURE,
+			expectedViolations: []string{"packet loss 5.0% exceeds 1.0%", "avg rtt 7.772 exceeds 1.000"},
+		},
+	}
+	for testName, testCase := range thresholdTestCases {
+		pingTest := ping.NewPingWithThresholds(testTimeoutDuration, "192.168.1.5", 20, testCase.thresholds)
+		assert.Nil(t, pingTest.ReelMatch("", "", getMockOutput(t, "ip_address_passing_packet_loss")))
+		assert.Equal(t, testCase.expectedResult, pingTest.Result(), testName)
+		assert.Equal(t, testCase.expectedViolations, pingTest.GetViolations(), testName)
+	}
+}
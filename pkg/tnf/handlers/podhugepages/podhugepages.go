@@ -0,0 +1,203 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package podhugepages
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/dependencies"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	phRegex = "(?s).+"
+
+	hugePagesTotal = "HugePages_Total:"
+	hugePagesFree  = "HugePages_Free:"
+	hugepagesize   = "Hugepagesize:"
+
+	// cgroup v1 and v2 hugetlb file suffixes holding the limit and the usage in bytes.
+	limitV1 = "limit_in_bytes"
+	usageV1 = "usage_in_bytes"
+	limitV2 = "max"
+	usageV2 = "current"
+
+	kiB = 1024
+	miB = kiB * 1024
+	giB = miB * 1024
+
+	decimalBase = 10
+	bitSize     = 64
+)
+
+// PodHugepages checks that the hugepages requested by a container are available in its hugetlb cgroup and consumed.
+type PodHugepages struct {
+	pageSize       int64
+	requestedBytes int64
+	limitBytes     int64
+	usageBytes     int64
+	total          int
+	free           int
+	defaultSize    int64
+	result         int
+	timeout        time.Duration
+	args           []string
+}
+
+// NewPodHugepages creates a new PodHugepages tnf.Test.  pageSize is the hugepage size in bytes (e.g. 1Gi) and
+// requestedBytes the amount requested in the pod spec for that size (e.g. hugepages-1Gi: 4Gi).
+func NewPodHugepages(timeout time.Duration, pageSize, requestedBytes int64) *PodHugepages {
+	prefix := "hugetlb." + PageSizeName(pageSize) + "."
+	files := strings.Join([]string{
+		"/sys/fs/cgroup/hugetlb/" + prefix + limitV1,
+		"/sys/fs/cgroup/hugetlb/" + prefix + usageV1,
+		"/sys/fs/cgroup/" + prefix + limitV2,
+		"/sys/fs/cgroup/" + prefix + usageV2,
+	}, " ")
+	return &PodHugepages{
+		pageSize:       pageSize,
+		requestedBytes: requestedBytes,
+		limitBytes:     -1,
+		usageBytes:     -1,
+		timeout:        timeout,
+		result:         tnf.ERROR,
+		args: []string{
+			fmt.Sprintf("%s -E '%s|%s|%s' /proc/meminfo; %s -H . %s 2>/dev/null",
+				dependencies.GrepBinaryName, hugePagesTotal, hugePagesFree, hugepagesize, dependencies.GrepBinaryName, files),
+		},
+	}
+}
+
+// PageSizeName returns the name the kernel uses for a hugepage size in the hugetlb cgroup files, e.g. 2MB or 1GB.
+func PageSizeName(pageSize int64) string {
+	switch {
+	case pageSize >= giB && pageSize%giB == 0:
+		return fmt.Sprintf("%dGB", pageSize/giB)
+	case pageSize >= miB && pageSize%miB == 0:
+		return fmt.Sprintf("%dMB", pageSize/miB)
+	default:
+		return fmt.Sprintf("%dKB", pageSize/kiB)
+	}
+}
+
+// Args returns the command line args for the test.
+func (ph *PodHugepages) Args() []string {
+	return ph.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (ph *PodHugepages) GetIdentifier() identifier.Identifier {
+	return identifier.PodHugepagesIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (ph *PodHugepages) Timeout() time.Duration {
+	return ph.timeout
+}
+
+// Result returns the test result.
+func (ph *PodHugepages) Result() int {
+	return ph.result
+}
+
+// GetLimitBytes returns the hugetlb cgroup limit of the container, or -1 when it was not found.
+func (ph *PodHugepages) GetLimitBytes() int64 {
+	return ph.limitBytes
+}
+
+// GetUsageBytes returns the hugetlb cgroup usage of the container, or -1 when it was not found.
+func (ph *PodHugepages) GetUsageBytes() int64 {
+	return ph.usageBytes
+}
+
+// GetMeminfo returns the total and free number of hugepages of the default size reported by /proc/meminfo.
+func (ph *PodHugepages) GetMeminfo() (total, free int) {
+	return ph.total, ph.free
+}
+
+// ReelFirst returns a step which expects the meminfo and hugetlb output within the test timeout.
+func (ph *PodHugepages) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{phRegex},
+		Timeout: ph.timeout,
+	}
+}
+
+// ReelMatch checks that the hugetlb limit matches the request and that hugepages of the requested size are in use.
+// When the requested size is the default hugepage size, /proc/meminfo must also report enough hugepages.
+func (ph *PodHugepages) ReelMatch(_, _, match string) *reel.Step {
+	for _, line := range strings.Split(match, "\n") {
+		ph.parseLine(strings.TrimSpace(line))
+	}
+
+	if ph.limitBytes < 0 || ph.usageBytes < 0 {
+		ph.result = tnf.ERROR
+		return nil
+	}
+	ph.result = tnf.SUCCESS
+	if ph.limitBytes != ph.requestedBytes || ph.usageBytes == 0 {
+		ph.result = tnf.FAILURE
+	}
+	if ph.defaultSize == ph.pageSize && int64(ph.total)*ph.pageSize < ph.requestedBytes {
+		ph.result = tnf.FAILURE
+	}
+	return nil
+}
+
+func (ph *PodHugepages) parseLine(line string) {
+	fields := strings.Fields(line)
+	switch {
+	case len(fields) >= 2 && fields[0] == hugePagesTotal:
+		ph.total, _ = strconv.Atoi(fields[1])
+	case len(fields) >= 2 && fields[0] == hugePagesFree:
+		ph.free, _ = strconv.Atoi(fields[1])
+	case len(fields) >= 2 && fields[0] == hugepagesize:
+		size, _ := strconv.ParseInt(fields[1], decimalBase, bitSize)
+		ph.defaultSize = size * kiB
+	default:
+		// /sys/fs/cgroup/hugetlb/hugetlb.1GB.limit_in_bytes:4294967296
+		i := strings.LastIndex(line, ":")
+		if i < 0 {
+			return
+		}
+		file := line[:i]
+		value, err := strconv.ParseInt(line[i+1:], decimalBase, bitSize)
+		if err != nil {
+			return
+		}
+		switch file[strings.LastIndex(file, ".")+1:] {
+		case limitV1, limitV2:
+			ph.limitBytes = value
+		case usageV1, usageV2:
+			ph.usageBytes = value
+		}
+	}
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (ph *PodHugepages) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (ph *PodHugepages) ReelEOF() {
+}
@@ -0,0 +1,120 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package podhugepages_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	ph "github.com/test-network-function/test-network-function/pkg/tnf/handlers/podhugepages"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+func Test_NewPodHugepages(t *testing.T) {
+	newPh := ph.NewPodHugepages(testTimeoutDuration, testPageSize, testRequestedBytes)
+	assert.NotNil(t, newPh)
+	assert.Equal(t, testTimeoutDuration, newPh.Timeout())
+	assert.Equal(t, tnf.ERROR, newPh.Result())
+	assert.Equal(t, identifier.PodHugepagesIdentifier, newPh.GetIdentifier())
+	assert.Contains(t, newPh.Args()[0], "/sys/fs/cgroup/hugetlb/hugetlb.1GB.limit_in_bytes")
+	assert.Contains(t, newPh.Args()[0], "/sys/fs/cgroup/hugetlb.1GB.current")
+}
+
+func Test_PageSizeName(t *testing.T) {
+	assert.Equal(t, "1GB", ph.PageSizeName(1024*1024*1024))
+	assert.Equal(t, "2MB", ph.PageSizeName(2*1024*1024))
+	assert.Equal(t, "64KB", ph.PageSizeName(64*1024))
+}
+
+func Test_ReelFirst(t *testing.T) {
+	newPh := ph.NewPodHugepages(testTimeoutDuration, testPageSize, testRequestedBytes)
+	firstStep := newPh.ReelFirst()
+	re := regexp.MustCompile(firstStep.Expect[0])
+	assert.Len(t, re.FindStringSubmatch(testInputSuccessV1), 1)
+	assert.Len(t, re.FindStringSubmatch(testInputError), 0)
+}
+
+func Test_ReelMatchSuccessV1(t *testing.T) {
+	newPh := ph.NewPodHugepages(testTimeoutDuration, testPageSize, testRequestedBytes)
+	step := newPh.ReelMatch("", "", testInputSuccessV1)
+	assert.Nil(t, step)
+	assert.Equal(t, tnf.SUCCESS, newPh.Result())
+	assert.Equal(t, int64(testRequestedBytes), newPh.GetLimitBytes())
+	assert.Equal(t, int64(1073741824), newPh.GetUsageBytes())
+	total, free := newPh.GetMeminfo()
+	assert.Equal(t, 8, total)
+	assert.Equal(t, 6, free)
+}
+
+func Test_ReelMatchSuccessV2(t *testing.T) {
+	newPh := ph.NewPodHugepages(testTimeoutDuration, 2*1024*1024, 512*1024*1024)
+	step := newPh.ReelMatch("", "", testInputSuccessV2)
+	assert.Nil(t, step)
+	assert.Equal(t, tnf.SUCCESS, newPh.Result())
+}
+
+func Test_ReelMatchNotConsumed(t *testing.T) {
+	newPh := ph.NewPodHugepages(testTimeoutDuration, testPageSize, testRequestedBytes)
+	step := newPh.ReelMatch("", "", testInputNotConsumed)
+	assert.Nil(t, step)
+	assert.Equal(t, tnf.FAILURE, newPh.Result())
+}
+
+func Test_ReelMatchNotEnoughPages(t *testing.T) {
+	newPh := ph.NewPodHugepages(testTimeoutDuration, testPageSize, testRequestedBytes)
+	step := newPh.ReelMatch("", "", testInputNotEnoughPages)
+	assert.Nil(t, step)
+	assert.Equal(t, tnf.FAILURE, newPh.Result())
+}
+
+func Test_ReelMatchNoCgroup(t *testing.T) {
+	newPh := ph.NewPodHugepages(testTimeoutDuration, testPageSize, testRequestedBytes)
+	step := newPh.ReelMatch("", "", testInputNoCgroup)
+	assert.Nil(t, step)
+	assert.Equal(t, tnf.ERROR, newPh.Result())
+}
+
+// Just ensure there are no panics.
+func Test_ReelEof(t *testing.T) {
+	newPh := ph.NewPodHugepages(testTimeoutDuration, testPageSize, testRequestedBytes)
+	assert.Nil(t, newPh.ReelTimeout())
+	newPh.ReelEOF()
+}
+
+const (
+	testTimeoutDuration = time.Second * 2
+	testPageSize        = 1024 * 1024 * 1024
+	testRequestedBytes  = 4 * 1024 * 1024 * 1024
+	testInputError      = ""
+	testMeminfo         = "HugePages_Total:       8\nHugePages_Free:        6\nHugepagesize:    1048576 kB\n"
+	testInputSuccessV1  = testMeminfo +
+		"/sys/fs/cgroup/hugetlb/hugetlb.1GB.limit_in_bytes:4294967296\n" +
+		"/sys/fs/cgroup/hugetlb/hugetlb.1GB.usage_in_bytes:1073741824\n"
+	testInputSuccessV2 = "HugePages_Total:     512\nHugePages_Free:      256\nHugepagesize:       2048 kB\n" +
+		"/sys/fs/cgroup/hugetlb.2MB.max:536870912\n" +
+		"/sys/fs/cgroup/hugetlb.2MB.current:268435456\n"
+	testInputNotConsumed = testMeminfo +
+		"/sys/fs/cgroup/hugetlb/hugetlb.1GB.limit_in_bytes:4294967296\n" +
+		"/sys/fs/cgroup/hugetlb/hugetlb.1GB.usage_in_bytes:0\n"
+	testInputNotEnoughPages = "HugePages_Total:       2\nHugePages_Free:        1\nHugepagesize:    1048576 kB\n" +
+		"/sys/fs/cgroup/hugetlb/hugetlb.1GB.limit_in_bytes:4294967296\n" +
+		"/sys/fs/cgroup/hugetlb/hugetlb.1GB.usage_in_bytes:1073741824\n"
+	testInputNoCgroup = testMeminfo
+)
@@ -0,0 +1,285 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package podplacement
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	podPlacementRegex = "(?s).+"
+	// nodesMarker separates the pods manifest from the nodes manifest.
+	nodesMarker = "NODES"
+	// nodeRolePrefix prefixes the labels naming the roles of a node, e.g. node-role.kubernetes.io/worker-cnf.
+	nodeRolePrefix = "node-role.kubernetes.io/"
+	workerRole     = "worker"
+)
+
+// controlPlaneRoles are the roles of the control plane nodes.
+var controlPlaneRoles = []string{"master", "control-plane"}
+
+type nodeSelectorRequirement struct {
+	Key      string   `json:"key"`
+	Operator string   `json:"operator"`
+	Values   []string `json:"values"`
+}
+
+type pods struct {
+	Items []struct {
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+		Spec struct {
+			NodeName     string            `json:"nodeName"`
+			NodeSelector map[string]string `json:"nodeSelector"`
+			Affinity     *struct {
+				NodeAffinity *struct {
+					Required *struct {
+						NodeSelectorTerms []struct {
+							MatchExpressions []nodeSelectorRequirement `json:"matchExpressions"`
+						} `json:"nodeSelectorTerms"`
+					} `json:"requiredDuringSchedulingIgnoredDuringExecution"`
+				} `json:"nodeAffinity"`
+			} `json:"affinity"`
+		} `json:"spec"`
+	} `json:"items"`
+}
+
+type nodes struct {
+	Items []struct {
+		Metadata struct {
+			Name   string            `json:"name"`
+			Labels map[string]string `json:"labels"`
+		} `json:"metadata"`
+	} `json:"items"`
+}
+
+// Placement describes the node a pod was scheduled on, and how this node disagrees with the pod spec.
+type Placement struct {
+	Node       string
+	Roles      []string
+	Violations []string
+}
+
+// PodPlacement checks the pods of a namespace run on nodes matching their nodeSelector and required node affinity,
+// and not on a control plane node which is not also a worker.  Pods which are not scheduled yet are ignored.
+type PodPlacement struct {
+	placements map[string]Placement
+	result     int
+	timeout    time.Duration
+	args       []string
+}
+
+// NewPodPlacement creates a new PodPlacement tnf.Test.
+func NewPodPlacement(timeout time.Duration, namespace string) *PodPlacement {
+	return &PodPlacement{
+		timeout: timeout,
+		result:  tnf.ERROR,
+		args:    []string{fmt.Sprintf("oc get pods -n %s -o json; echo %s; oc get nodes -o json", namespace, nodesMarker)},
+	}
+}
+
+// Args returns the command line args for the test.
+func (pp *PodPlacement) Args() []string {
+	return pp.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (pp *PodPlacement) GetIdentifier() identifier.Identifier {
+	return identifier.PodPlacementIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (pp *PodPlacement) Timeout() time.Duration {
+	return pp.timeout
+}
+
+// Result returns the test result.
+func (pp *PodPlacement) Result() int {
+	return pp.result
+}
+
+// GetPlacements returns the placement of each scheduled pod of the namespace, indexed by pod name.
+func (pp *PodPlacement) GetPlacements() map[string]Placement {
+	return pp.placements
+}
+
+// ReelFirst returns a step which expects the pods and nodes manifests within the test timeout.
+func (pp *PodPlacement) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{podPlacementRegex},
+		Timeout: pp.timeout,
+	}
+}
+
+// ReelMatch parses the pods and nodes manifests and checks the placement of every scheduled pod.  The result is
+// FAILURE when any pod is misplaced.
+func (pp *PodPlacement) ReelMatch(_, _, match string) *reel.Step {
+	pp.placements = map[string]Placement{}
+	parts := strings.SplitN(match, nodesMarker, 2)
+	var podList pods
+	var nodeList nodes
+	if len(parts) != 2 || unmarshal(parts[0], &podList) != nil || unmarshal(parts[1], &nodeList) != nil {
+		pp.result = tnf.ERROR
+		return nil
+	}
+	nodeLabels := map[string]map[string]string{}
+	for i := range nodeList.Items {
+		nodeLabels[nodeList.Items[i].Metadata.Name] = nodeList.Items[i].Metadata.Labels
+	}
+	pp.result = tnf.SUCCESS
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if pod.Spec.NodeName == "" {
+			continue
+		}
+		labels := nodeLabels[pod.Spec.NodeName]
+		placement := Placement{Node: pod.Spec.NodeName, Roles: getRoles(labels)}
+		for _, key := range sortedKeys(pod.Spec.NodeSelector) {
+			if value, found := labels[key]; !found || value != pod.Spec.NodeSelector[key] {
+				placement.Violations = append(placement.Violations, fmt.Sprintf("node %s does not match nodeSelector %s=%s",
+					placement.Node, key, pod.Spec.NodeSelector[key]))
+			}
+		}
+		if affinity := pod.Spec.Affinity; affinity != nil && affinity.NodeAffinity != nil && affinity.NodeAffinity.Required != nil {
+			matched := false
+			for _, term := range affinity.NodeAffinity.Required.NodeSelectorTerms {
+				matched = matched || matchesAll(labels, term.MatchExpressions)
+			}
+			if !matched {
+				placement.Violations = append(placement.Violations, fmt.Sprintf("node %s matches none of the required node affinity terms",
+					placement.Node))
+			}
+		}
+		if isControlPlaneOnly(placement.Roles) {
+			placement.Violations = append(placement.Violations, fmt.Sprintf("node %s is a control plane node", placement.Node))
+		}
+		if len(placement.Violations) > 0 {
+			pp.result = tnf.FAILURE
+		}
+		pp.placements[pod.Metadata.Name] = placement
+	}
+	return nil
+}
+
+// getRoles returns the sorted roles of a node.
+func getRoles(labels map[string]string) []string {
+	var roles []string
+	for key := range labels {
+		if strings.HasPrefix(key, nodeRolePrefix) {
+			roles = append(roles, strings.TrimPrefix(key, nodeRolePrefix))
+		}
+	}
+	sort.Strings(roles)
+	return roles
+}
+
+// isControlPlaneOnly returns true for the roles of a control plane node which does not also schedule workloads.
+func isControlPlaneOnly(roles []string) bool {
+	controlPlane := false
+	for _, role := range roles {
+		if role == workerRole {
+			return false
+		}
+		for _, controlPlaneRole := range controlPlaneRoles {
+			controlPlane = controlPlane || role == controlPlaneRole
+		}
+	}
+	return controlPlane
+}
+
+func matchesAll(labels map[string]string, requirements []nodeSelectorRequirement) bool {
+	for _, requirement := range requirements {
+		if !matches(labels, requirement) {
+			return false
+		}
+	}
+	return true
+}
+
+// matches evaluates a node selector requirement against the labels of a node.
+func matches(labels map[string]string, requirement nodeSelectorRequirement) bool {
+	value, found := labels[requirement.Key]
+	switch requirement.Operator {
+	case "In":
+		return found && contains(requirement.Values, value)
+	case "NotIn":
+		return !found || !contains(requirement.Values, value)
+	case "Exists":
+		return found
+	case "DoesNotExist":
+		return !found
+	case "Gt", "Lt":
+		if !found || len(requirement.Values) != 1 {
+			return false
+		}
+		actual, err1 := strconv.ParseInt(value, 10, 64)
+		expected, err2 := strconv.ParseInt(requirement.Values[0], 10, 64)
+		if err1 != nil || err2 != nil {
+			return false
+		}
+		if requirement.Operator == "Gt" {
+			return actual > expected
+		}
+		return actual < expected
+	}
+	return false
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func unmarshal(output string, v interface{}) error {
+	start := strings.Index(output, "{")
+	if start < 0 {
+		return fmt.Errorf("no JSON output found")
+	}
+	return json.Unmarshal([]byte(strings.TrimSpace(output[start:])), v)
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (pp *PodPlacement) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (pp *PodPlacement) ReelEOF() {
+}
@@ -0,0 +1,95 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package podplacement_test
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/podplacement"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testDataDirectory   = "testdata"
+	testTimeoutDuration = time.Second * 2
+)
+
+func getMockOutput(t *testing.T, testName string) string {
+	b, err := os.ReadFile(path.Join(testDataDirectory, testName+".txt"))
+	assert.Nil(t, err)
+	return string(b)
+}
+
+func TestNewPodPlacement(t *testing.T) {
+	handler := podplacement.NewPodPlacement(testTimeoutDuration, "tnf")
+	assert.Equal(t, []string{"oc get pods -n tnf -o json; echo NODES; oc get nodes -o json"}, handler.Args())
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.PodPlacementIdentifier, handler.GetIdentifier())
+}
+
+func TestPodPlacement_ReelMatch(t *testing.T) {
+	testCases := map[string]struct {
+		expectedResult     int
+		expectedPlacements map[string]podplacement.Placement
+	}{
+		"placed": {
+			expectedResult: tnf.SUCCESS,
+			expectedPlacements: map[string]podplacement.Placement{
+				"test-0": {Node: "worker-0", Roles: []string{"worker", "worker-cnf"}},
+				"test-1": {Node: "master-0", Roles: []string{"master", "worker"}},
+			},
+		},
+		"misplaced": {
+			expectedResult: tnf.FAILURE,
+			expectedPlacements: map[string]podplacement.Placement{
+				"test-0": {Node: "worker-0", Roles: []string{"worker"}, Violations: []string{
+					"node worker-0 does not match nodeSelector node-role.kubernetes.io/worker-cnf=",
+					"node worker-0 does not match nodeSelector zone=a",
+				}},
+				"test-1": {Node: "master-0", Roles: []string{"master"}, Violations: []string{
+					"node master-0 matches none of the required node affinity terms",
+					"node master-0 is a control plane node",
+				}},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		handler := podplacement.NewPodPlacement(testTimeoutDuration, "tnf")
+		assert.Nil(t, handler.ReelMatch("", "", getMockOutput(t, name)), name)
+		assert.Equal(t, tc.expectedResult, handler.Result(), name)
+		assert.Equal(t, tc.expectedPlacements, handler.GetPlacements(), name)
+	}
+}
+
+func TestPodPlacement_ReelMatchError(t *testing.T) {
+	handler := podplacement.NewPodPlacement(testTimeoutDuration, "missing")
+	assert.Nil(t, handler.ReelMatch("", "", "Error from server (Forbidden): pods is forbidden\nNODES\n"))
+	assert.Equal(t, tnf.ERROR, handler.Result())
+}
+
+// Ensure there are no panics.
+func TestPodPlacement_ReelEof(t *testing.T) {
+	handler := podplacement.NewPodPlacement(testTimeoutDuration, "tnf")
+	handler.ReelEOF()
+	assert.Nil(t, handler.ReelTimeout())
+}
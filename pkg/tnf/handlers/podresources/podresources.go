@@ -0,0 +1,202 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package podresources
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/dependencies"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	prRegex   = "(?s).+"
+	numFields = 5
+	milli     = 1000
+	bitSize   = 64
+)
+
+// memorySuffixes maps the Kubernetes quantity suffixes to their multiplier.
+var memorySuffixes = map[string]float64{
+	"Ki": 1 << 10, "Mi": 1 << 20, "Gi": 1 << 30, "Ti": 1 << 40, "Pi": 1 << 50, "Ei": 1 << 60,
+	"k": 1e3, "M": 1e6, "G": 1e9, "T": 1e12, "P": 1e15, "E": 1e18,
+	"m": 1e-3,
+}
+
+// Resources holds the CPU and memory requests and limits of a container, as written in the pod spec.  Unset values
+// are empty.
+type Resources struct {
+	RequestsCPU    string
+	RequestsMemory string
+	LimitsCPU      string
+	LimitsMemory   string
+}
+
+// PodResources checks that every container of a pod sets CPU and memory requests and limits.
+type PodResources struct {
+	requireGuaranteed bool
+	resources         map[string]Resources
+	violations        []string
+	result            int
+	timeout           time.Duration
+	args              []string
+}
+
+// NewPodResources creates a new PodResources tnf.Test.  When requireGuaranteed is set, the requests must also equal
+// the limits so the pod gets the Guaranteed QoS class.
+func NewPodResources(timeout time.Duration, namespace, podName string, requireGuaranteed bool) *PodResources {
+	return &PodResources{
+		requireGuaranteed: requireGuaranteed,
+		timeout:           timeout,
+		result:            tnf.ERROR,
+		args: []string{dependencies.OcBinaryName, "get", "pod", "-n", namespace, podName, "-o",
+			`jsonpath='{range .spec.containers[*]}{.name},{.resources.requests.cpu},{.resources.requests.memory},` +
+				`{.resources.limits.cpu},{.resources.limits.memory}{"\n"}{end}'`},
+	}
+}
+
+// Args returns the command line args for the test.
+func (pr *PodResources) Args() []string {
+	return pr.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (pr *PodResources) GetIdentifier() identifier.Identifier {
+	return identifier.PodResourcesIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (pr *PodResources) Timeout() time.Duration {
+	return pr.timeout
+}
+
+// Result returns the test result.
+func (pr *PodResources) Result() int {
+	return pr.result
+}
+
+// GetResources returns the requests and limits of each container, keyed by container name.
+func (pr *PodResources) GetResources() map[string]Resources {
+	return pr.resources
+}
+
+// GetViolations returns a description of every missing or mismatching request and limit.
+func (pr *PodResources) GetViolations() []string {
+	return pr.violations
+}
+
+// ReelFirst returns a step which expects the container resources within the test timeout.
+func (pr *PodResources) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{prRegex},
+		Timeout: pr.timeout,
+	}
+}
+
+// ReelMatch parses the container resources and checks them.
+func (pr *PodResources) ReelMatch(_, _, match string) *reel.Step {
+	pr.resources = map[string]Resources{}
+	pr.violations = nil
+	var names []string
+	for _, line := range strings.Split(match, "\n") {
+		fields := strings.Split(strings.TrimSpace(line), ",")
+		if len(fields) != numFields {
+			continue
+		}
+		names = append(names, fields[0])
+		pr.resources[fields[0]] = Resources{RequestsCPU: fields[1], RequestsMemory: fields[2], LimitsCPU: fields[3], LimitsMemory: fields[4]}
+	}
+	if len(names) == 0 {
+		pr.result = tnf.ERROR
+		return nil
+	}
+	for _, name := range names {
+		pr.violations = append(pr.violations, pr.check(name, pr.resources[name])...)
+	}
+	if len(pr.violations) > 0 {
+		pr.result = tnf.FAILURE
+	} else {
+		pr.result = tnf.SUCCESS
+	}
+	return nil
+}
+
+func (pr *PodResources) check(name string, res Resources) []string {
+	var violations []string
+	for _, field := range []struct{ name, value string }{
+		{"requests.cpu", res.RequestsCPU},
+		{"requests.memory", res.RequestsMemory},
+		{"limits.cpu", res.LimitsCPU},
+		{"limits.memory", res.LimitsMemory},
+	} {
+		if field.value == "" {
+			violations = append(violations, fmt.Sprintf("container %s does not set %s", name, field.name))
+		}
+	}
+	if !pr.requireGuaranteed || len(violations) > 0 {
+		return violations
+	}
+	if !equalQuantities(res.RequestsCPU, res.LimitsCPU) {
+		violations = append(violations, fmt.Sprintf("container %s CPU request %s differs from its limit %s", name, res.RequestsCPU, res.LimitsCPU))
+	}
+	if !equalQuantities(res.RequestsMemory, res.LimitsMemory) {
+		violations = append(violations, fmt.Sprintf("container %s memory request %s differs from its limit %s", name, res.RequestsMemory, res.LimitsMemory))
+	}
+	return violations
+}
+
+func equalQuantities(a, b string) bool {
+	parsedA, errA := ParseQuantity(a)
+	parsedB, errB := ParseQuantity(b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return math.Abs(parsedA-parsedB) < 1/float64(milli)
+}
+
+// ParseQuantity converts a Kubernetes resource quantity such as "500m", "2" or "1Gi" to its numeric value, in cores
+// for CPU and in bytes for memory.
+func ParseQuantity(quantity string) (float64, error) {
+	number, multiplier := quantity, 1.0
+	for suffix, value := range memorySuffixes {
+		// No suffix is a suffix of another one, so at most one matches.
+		if strings.HasSuffix(quantity, suffix) {
+			number, multiplier = strings.TrimSuffix(quantity, suffix), value
+			break
+		}
+	}
+	parsed, err := strconv.ParseFloat(number, bitSize)
+	if err != nil {
+		return 0, fmt.Errorf("invalid quantity %q: %w", quantity, err)
+	}
+	return parsed * multiplier, nil
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (pr *PodResources) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (pr *PodResources) ReelEOF() {
+}
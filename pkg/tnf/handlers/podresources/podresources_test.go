@@ -0,0 +1,119 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package podresources_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/podresources"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testTimeoutDuration = time.Second * 2
+
+	outputGuaranteed = "app,1,1Gi,1000m,1024Mi\nsidecar,100m,64Mi,0.1,64Mi\n"
+	outputBurstable  = "app,500m,512Mi,1,1Gi\n"
+	outputMissing    = "app,500m,,1,\nsidecar,,,,\n"
+	outputNotFound   = `Error from server (NotFound): pods "test" not found`
+)
+
+func TestNewPodResources(t *testing.T) {
+	handler := podresources.NewPodResources(testTimeoutDuration, "tnf", "test", false)
+	assert.Equal(t, "oc", handler.Args()[0])
+	assert.Contains(t, handler.Args(), "test")
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.PodResourcesIdentifier, handler.GetIdentifier())
+}
+
+func TestPodResources_ReelMatch(t *testing.T) {
+	testCases := map[string]struct {
+		output             string
+		requireGuaranteed  bool
+		expectedResult     int
+		expectedViolations []string
+	}{
+		"guaranteed": {output: outputGuaranteed, requireGuaranteed: true, expectedResult: tnf.SUCCESS},
+		"burstable":  {output: outputBurstable, expectedResult: tnf.SUCCESS},
+		"burstable_guaranteed_required": {
+			output:            outputBurstable,
+			requireGuaranteed: true,
+			expectedResult:    tnf.FAILURE,
+			expectedViolations: []string{
+				"container app CPU request 500m differs from its limit 1",
+				"container app memory request 512Mi differs from its limit 1Gi",
+			},
+		},
+		"missing": {
+			output:         outputMissing,
+			expectedResult: tnf.FAILURE,
+			expectedViolations: []string{
+				"container app does not set requests.memory",
+				"container app does not set limits.memory",
+				"container sidecar does not set requests.cpu",
+				"container sidecar does not set requests.memory",
+				"container sidecar does not set limits.cpu",
+				"container sidecar does not set limits.memory",
+			},
+		},
+		"not_found": {output: outputNotFound, expectedResult: tnf.ERROR},
+	}
+	for name, tc := range testCases {
+		handler := podresources.NewPodResources(testTimeoutDuration, "tnf", "test", tc.requireGuaranteed)
+		assert.Nil(t, handler.ReelMatch("", "", tc.output), name)
+		assert.Equal(t, tc.expectedResult, handler.Result(), name)
+		assert.Equal(t, tc.expectedViolations, handler.GetViolations(), name)
+	}
+}
+
+func TestPodResources_GetResources(t *testing.T) {
+	handler := podresources.NewPodResources(testTimeoutDuration, "tnf", "test", false)
+	handler.ReelMatch("", "", outputBurstable)
+	assert.Equal(t, map[string]podresources.Resources{
+		"app": {RequestsCPU: "500m", RequestsMemory: "512Mi", LimitsCPU: "1", LimitsMemory: "1Gi"},
+	}, handler.GetResources())
+}
+
+func TestParseQuantity(t *testing.T) {
+	testCases := map[string]float64{
+		"500m": 0.5,
+		"2":    2,
+		"0.1":  0.1,
+		"1Gi":  1 << 30,
+		"64Mi": 64 << 20,
+		"1G":   1e9,
+		"128k": 128e3,
+	}
+	for quantity, expected := range testCases {
+		parsed, err := podresources.ParseQuantity(quantity)
+		assert.Nil(t, err, quantity)
+		assert.InDelta(t, expected, parsed, 1e-9, quantity)
+	}
+	_, err := podresources.ParseQuantity("one")
+	assert.NotNil(t, err)
+}
+
+// Just ensure there are no panics.
+func TestPodResources_ReelEOF(t *testing.T) {
+	handler := podresources.NewPodResources(testTimeoutDuration, "tnf", "test", false)
+	assert.Nil(t, handler.ReelTimeout())
+	handler.ReelEOF()
+}
@@ -0,0 +1,130 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package podstartup
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	podStartupRegex = "(?s).+"
+	readyCondition  = "Ready"
+	conditionTrue   = "True"
+)
+
+type pods struct {
+	Items []struct {
+		Metadata struct {
+			Name              string    `json:"name"`
+			CreationTimestamp time.Time `json:"creationTimestamp"`
+		} `json:"metadata"`
+		Status struct {
+			Conditions []struct {
+				Type               string    `json:"type"`
+				Status             string    `json:"status"`
+				LastTransitionTime time.Time `json:"lastTransitionTime"`
+			} `json:"conditions"`
+		} `json:"status"`
+	} `json:"items"`
+}
+
+// PodStartup measures, for every ready pod of a namespace, the time elapsed between its creation and its last
+// transition to ready.  Pods which are not ready are ignored.
+type PodStartup struct {
+	startupTimes map[string]time.Duration
+	result       int
+	timeout      time.Duration
+	args         []string
+}
+
+// NewPodStartup creates a new PodStartup tnf.Test.
+func NewPodStartup(timeout time.Duration, namespace string) *PodStartup {
+	return &PodStartup{
+		timeout: timeout,
+		result:  tnf.ERROR,
+		args:    []string{"oc", "get", "pods", "-n", namespace, "-o", "json"},
+	}
+}
+
+// Args returns the command line args for the test.
+func (ps *PodStartup) Args() []string {
+	return ps.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (ps *PodStartup) GetIdentifier() identifier.Identifier {
+	return identifier.PodStartupIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (ps *PodStartup) Timeout() time.Duration {
+	return ps.timeout
+}
+
+// Result returns the test result.
+func (ps *PodStartup) Result() int {
+	return ps.result
+}
+
+// GetStartupTimes returns the startup time of each ready pod of the namespace, indexed by pod name.
+func (ps *PodStartup) GetStartupTimes() map[string]time.Duration {
+	return ps.startupTimes
+}
+
+// ReelFirst returns a step which expects the pods manifest within the test timeout.
+func (ps *PodStartup) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{podStartupRegex},
+		Timeout: ps.timeout,
+	}
+}
+
+// ReelMatch parses the pods manifest and computes the startup time of every ready pod.
+func (ps *PodStartup) ReelMatch(_, _, match string) *reel.Step {
+	ps.startupTimes = map[string]time.Duration{}
+	start := strings.Index(match, "{")
+	var podList pods
+	if start < 0 || json.Unmarshal([]byte(strings.TrimSpace(match[start:])), &podList) != nil {
+		ps.result = tnf.ERROR
+		return nil
+	}
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == readyCondition && condition.Status == conditionTrue {
+				ps.startupTimes[pod.Metadata.Name] = condition.LastTransitionTime.Sub(pod.Metadata.CreationTimestamp)
+			}
+		}
+	}
+	ps.result = tnf.SUCCESS
+	return nil
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (ps *PodStartup) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (ps *PodStartup) ReelEOF() {
+}
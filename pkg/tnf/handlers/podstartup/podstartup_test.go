@@ -0,0 +1,68 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package podstartup_test
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/podstartup"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testDataDirectory   = "testdata"
+	testTimeoutDuration = time.Second * 2
+)
+
+func getMockOutput(t *testing.T, testName string) string {
+	b, err := os.ReadFile(path.Join(testDataDirectory, testName+".txt"))
+	assert.Nil(t, err)
+	return string(b)
+}
+
+func TestNewPodStartup(t *testing.T) {
+	handler := podstartup.NewPodStartup(testTimeoutDuration, "tnf")
+	assert.Equal(t, []string{"oc", "get", "pods", "-n", "tnf", "-o", "json"}, handler.Args())
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.PodStartupIdentifier, handler.GetIdentifier())
+}
+
+func TestPodStartup_ReelMatch(t *testing.T) {
+	handler := podstartup.NewPodStartup(testTimeoutDuration, "tnf")
+	assert.Nil(t, handler.ReelMatch("", "", getMockOutput(t, "pods")))
+	assert.Equal(t, tnf.SUCCESS, handler.Result())
+	assert.Equal(t, map[string]time.Duration{"test-0": 12 * time.Second, "test-1": 210 * time.Second}, handler.GetStartupTimes())
+}
+
+func TestPodStartup_ReelMatchError(t *testing.T) {
+	handler := podstartup.NewPodStartup(testTimeoutDuration, "missing")
+	assert.Nil(t, handler.ReelMatch("", "", "Error from server (NotFound): namespaces \"missing\" not found\n"))
+	assert.Equal(t, tnf.ERROR, handler.Result())
+}
+
+// Ensure there are no panics.
+func TestPodStartup_ReelEof(t *testing.T) {
+	handler := podstartup.NewPodStartup(testTimeoutDuration, "tnf")
+	handler.ReelEOF()
+	assert.Nil(t, handler.ReelTimeout())
+}
@@ -0,0 +1,200 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package podtermination
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	podTerminationRegex = "(?s).+"
+	notFound            = "NotFound"
+	// sigkillExitCode is the exit code of a container killed by SIGKILL.
+	sigkillExitCode = 128 + 9
+	// DefaultGracePeriod is the terminationGracePeriodSeconds of a pod which does not set it.
+	DefaultGracePeriod = 30 * time.Second
+)
+
+type pod struct {
+	Spec struct {
+		TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds"`
+		Containers                    []struct {
+			Name      string `json:"name"`
+			Lifecycle *struct {
+				PreStop *json.RawMessage `json:"preStop"`
+			} `json:"lifecycle"`
+		} `json:"containers"`
+	} `json:"spec"`
+	Status struct {
+		ContainerStatuses []struct {
+			Name  string `json:"name"`
+			State struct {
+				Terminated *struct {
+					ExitCode int    `json:"exitCode"`
+					Reason   string `json:"reason"`
+				} `json:"terminated"`
+			} `json:"state"`
+		} `json:"containerStatuses"`
+	} `json:"status"`
+}
+
+// PodTermination reports the grace period and preStop hooks of a pod, and which of its containers terminated and were
+// killed.  A pod which no longer exists is reported as gone.
+type PodTermination struct {
+	gone           bool
+	gracePeriod    time.Duration
+	missingPreStop []string
+	terminated     []string
+	killed         []string
+	numContainers  int
+	result         int
+	timeout        time.Duration
+	args           []string
+}
+
+// NewPodTermination creates a new PodTermination tnf.Test.
+func NewPodTermination(timeout time.Duration, namespace, podName string) *PodTermination {
+	return &PodTermination{
+		timeout: timeout,
+		result:  tnf.ERROR,
+		args:    []string{"oc", "get", "pod", "-n", namespace, podName, "-o", "json"},
+	}
+}
+
+// Args returns the command line args for the test.
+func (pt *PodTermination) Args() []string {
+	return pt.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (pt *PodTermination) GetIdentifier() identifier.Identifier {
+	return identifier.PodTerminationIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (pt *PodTermination) Timeout() time.Duration {
+	return pt.timeout
+}
+
+// Result returns the test result.
+func (pt *PodTermination) Result() int {
+	return pt.result
+}
+
+// IsGone returns true when the pod no longer exists.
+func (pt *PodTermination) IsGone() bool {
+	return pt.gone
+}
+
+// GetGracePeriod returns the terminationGracePeriodSeconds of the pod.
+func (pt *PodTermination) GetGracePeriod() time.Duration {
+	return pt.gracePeriod
+}
+
+// GetMissingPreStop returns the sorted names of the containers without a preStop hook.
+func (pt *PodTermination) GetMissingPreStop() []string {
+	return pt.missingPreStop
+}
+
+// GetTerminated returns the sorted names of the containers which terminated.
+func (pt *PodTermination) GetTerminated() []string {
+	return pt.terminated
+}
+
+// GetKilled returns the sorted names of the containers which were killed by SIGKILL.
+func (pt *PodTermination) GetKilled() []string {
+	return pt.killed
+}
+
+// AllTerminated returns true when the pod is gone or all its containers terminated.
+func (pt *PodTermination) AllTerminated() bool {
+	return pt.gone || (pt.numContainers > 0 && len(pt.terminated) == pt.numContainers)
+}
+
+// ReelFirst returns a step which expects the pod manifest within the test timeout.
+func (pt *PodTermination) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{podTerminationRegex},
+		Timeout: pt.timeout,
+	}
+}
+
+// ReelMatch parses the pod manifest.
+func (pt *PodTermination) ReelMatch(_, _, match string) *reel.Step {
+	pt.gone, pt.missingPreStop, pt.terminated, pt.killed = false, nil, nil, nil
+	if strings.Contains(match, notFound) {
+		pt.gone = true
+		pt.result = tnf.SUCCESS
+		return nil
+	}
+	var p pod
+	if err := unmarshal(match, &p); err != nil {
+		pt.result = tnf.ERROR
+		return nil
+	}
+	pt.gracePeriod = DefaultGracePeriod
+	if p.Spec.TerminationGracePeriodSeconds != nil {
+		pt.gracePeriod = time.Duration(*p.Spec.TerminationGracePeriodSeconds) * time.Second
+	}
+	pt.numContainers = len(p.Spec.Containers)
+	for i := range p.Spec.Containers {
+		container := &p.Spec.Containers[i]
+		if container.Lifecycle == nil || container.Lifecycle.PreStop == nil {
+			pt.missingPreStop = append(pt.missingPreStop, container.Name)
+		}
+	}
+	for i := range p.Status.ContainerStatuses {
+		status := &p.Status.ContainerStatuses[i]
+		if status.State.Terminated == nil {
+			continue
+		}
+		pt.terminated = append(pt.terminated, status.Name)
+		if status.State.Terminated.ExitCode == sigkillExitCode {
+			pt.killed = append(pt.killed, status.Name)
+		}
+	}
+	sort.Strings(pt.missingPreStop)
+	sort.Strings(pt.terminated)
+	sort.Strings(pt.killed)
+	pt.result = tnf.SUCCESS
+	return nil
+}
+
+func unmarshal(output string, v interface{}) error {
+	start := strings.Index(output, "{")
+	if start < 0 {
+		return fmt.Errorf("no JSON output found")
+	}
+	return json.Unmarshal([]byte(strings.TrimSpace(output[start:])), v)
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (pt *PodTermination) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (pt *PodTermination) ReelEOF() {
+}
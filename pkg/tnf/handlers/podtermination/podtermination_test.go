@@ -0,0 +1,91 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package podtermination_test
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/podtermination"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testDataDirectory   = "testdata"
+	testTimeoutDuration = time.Second * 2
+)
+
+func getMockOutput(t *testing.T, testName string) string {
+	b, err := os.ReadFile(path.Join(testDataDirectory, testName+".txt"))
+	assert.Nil(t, err)
+	return string(b)
+}
+
+func TestNewPodTermination(t *testing.T) {
+	handler := podtermination.NewPodTermination(testTimeoutDuration, "tnf", "test-0")
+	assert.Equal(t, []string{"oc", "get", "pod", "-n", "tnf", "test-0", "-o", "json"}, handler.Args())
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.PodTerminationIdentifier, handler.GetIdentifier())
+}
+
+func TestPodTermination_ReelMatchRunning(t *testing.T) {
+	handler := podtermination.NewPodTermination(testTimeoutDuration, "tnf", "test-0")
+	assert.Nil(t, handler.ReelMatch("", "", getMockOutput(t, "running")))
+	assert.Equal(t, tnf.SUCCESS, handler.Result())
+	assert.False(t, handler.IsGone())
+	assert.False(t, handler.AllTerminated())
+	assert.Equal(t, 10*time.Second, handler.GetGracePeriod())
+	assert.Equal(t, []string{"sidecar"}, handler.GetMissingPreStop())
+	assert.Nil(t, handler.GetTerminated())
+	assert.Nil(t, handler.GetKilled())
+}
+
+func TestPodTermination_ReelMatchTerminated(t *testing.T) {
+	handler := podtermination.NewPodTermination(testTimeoutDuration, "tnf", "test-0")
+	assert.Nil(t, handler.ReelMatch("", "", getMockOutput(t, "terminated")))
+	assert.Equal(t, tnf.SUCCESS, handler.Result())
+	assert.True(t, handler.AllTerminated())
+	assert.Equal(t, podtermination.DefaultGracePeriod, handler.GetGracePeriod())
+	assert.Equal(t, []string{"app", "sidecar"}, handler.GetTerminated())
+	assert.Equal(t, []string{"sidecar"}, handler.GetKilled())
+}
+
+func TestPodTermination_ReelMatchGone(t *testing.T) {
+	handler := podtermination.NewPodTermination(testTimeoutDuration, "tnf", "test-0")
+	assert.Nil(t, handler.ReelMatch("", "", "Error from server (NotFound): pods \"test-0\" not found\n"))
+	assert.Equal(t, tnf.SUCCESS, handler.Result())
+	assert.True(t, handler.IsGone())
+	assert.True(t, handler.AllTerminated())
+}
+
+func TestPodTermination_ReelMatchError(t *testing.T) {
+	handler := podtermination.NewPodTermination(testTimeoutDuration, "tnf", "test-0")
+	assert.Nil(t, handler.ReelMatch("", "", "Error from server (Forbidden): pods is forbidden\n"))
+	assert.Equal(t, tnf.ERROR, handler.Result())
+}
+
+// Ensure there are no panics.
+func TestPodTermination_ReelEof(t *testing.T) {
+	handler := podtermination.NewPodTermination(testTimeoutDuration, "tnf", "test-0")
+	handler.ReelEOF()
+	assert.Nil(t, handler.ReelTimeout())
+}
@@ -0,0 +1,247 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package rbacscope
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	rbacRegex = "(?s).+"
+	// rulesMarker separates the binding listing from the `oc auth can-i --list` output.
+	rulesMarker = "RBAC_RULES"
+	wildcard    = "*"
+
+	// ClusterAdminRole is the name of the cluster role granting every permission.
+	ClusterAdminRole = "cluster-admin"
+
+	numBindingFields     = 6
+	numSubjectFields     = 3
+	serviceAccountsGroup = "system:serviceaccounts"
+)
+
+// ruleLineRegex matches a row of `oc auth can-i --list`: the resource, and the non-resource URLs, resource names and
+// verbs lists.
+var ruleLineRegex = regexp.MustCompile(`^(\S*)\s+\[(.*?)\]\s+\[(.*?)\]\s+\[(.*?)\]\s*$`)
+
+// Binding describes a RoleBinding or ClusterRoleBinding granting a role to the service account.
+type Binding struct {
+	Kind      string
+	Namespace string
+	Name      string
+	RoleKind  string
+	RoleName  string
+}
+
+// Rule is a row of the effective permissions of the service account.
+type Rule struct {
+	Resource        string
+	NonResourceURLs []string
+	ResourceNames   []string
+	Verbs           []string
+}
+
+// RBACScope checks that a service account is not bound to cluster-admin and that none of its effective permissions
+// use a wildcard verb or resource.
+type RBACScope struct {
+	serviceAccount string
+	namespace      string
+	bindings       []Binding
+	rules          []Rule
+	violations     []string
+	result         int
+	timeout        time.Duration
+	args           []string
+}
+
+// NewRBACScope creates a new RBACScope tnf.Test.  The effective permissions are read with `oc auth can-i --list`
+// impersonating the service account, which requires the impersonate permission.
+func NewRBACScope(timeout time.Duration, serviceAccount, namespace string) *RBACScope {
+	return &RBACScope{
+		serviceAccount: serviceAccount,
+		namespace:      namespace,
+		timeout:        timeout,
+		result:         tnf.ERROR,
+		args: []string{fmt.Sprintf("oc get rolebindings,clusterrolebindings -A -o jsonpath='{range .items[*]}"+
+			`{.kind}|{.metadata.namespace}|{.metadata.name}|{.roleRef.kind}|{.roleRef.name}|{range .subjects[*]}{.kind}:{.namespace}:{.name} {end}{"\n"}{end}'; `+
+			"echo %s; oc auth can-i --list --as=system:serviceaccount:%s:%s -n %s",
+			rulesMarker, namespace, serviceAccount, namespace)},
+	}
+}
+
+// Args returns the command line args for the test.
+func (rs *RBACScope) Args() []string {
+	return rs.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (rs *RBACScope) GetIdentifier() identifier.Identifier {
+	return identifier.RBACScopeIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (rs *RBACScope) Timeout() time.Duration {
+	return rs.timeout
+}
+
+// Result returns the test result.
+func (rs *RBACScope) Result() int {
+	return rs.result
+}
+
+// GetBindings returns the bindings granting a role to the service account.
+func (rs *RBACScope) GetBindings() []Binding {
+	return rs.bindings
+}
+
+// GetRules returns the effective permissions of the service account.
+func (rs *RBACScope) GetRules() []Rule {
+	return rs.rules
+}
+
+// GetViolations returns a description of every cluster-admin binding and wildcard permission.
+func (rs *RBACScope) GetViolations() []string {
+	return rs.violations
+}
+
+// ReelFirst returns a step which expects the bindings and permissions within the test timeout.
+func (rs *RBACScope) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{rbacRegex},
+		Timeout: rs.timeout,
+	}
+}
+
+// ReelMatch parses the bindings and permissions of the service account and checks them.
+func (rs *RBACScope) ReelMatch(_, _, match string) *reel.Step {
+	rs.violations = nil
+	parts := strings.SplitN(match, rulesMarker, 2)
+	if len(parts) != 2 {
+		rs.result = tnf.ERROR
+		return nil
+	}
+	rs.bindings = rs.parseBindings(parts[0])
+	rs.rules = ParseRules(parts[1])
+	if len(rs.rules) == 0 {
+		rs.result = tnf.ERROR
+		return nil
+	}
+	for _, binding := range rs.bindings {
+		if binding.RoleName == ClusterAdminRole {
+			rs.violations = append(rs.violations, fmt.Sprintf("%s %s binds the %s role", binding.Kind, binding.Name, ClusterAdminRole))
+		}
+	}
+	for _, rule := range rs.rules {
+		if rule.hasWildcard() {
+			rs.violations = append(rs.violations, fmt.Sprintf("wildcard permission: resource %q non-resource URLs %v verbs %v",
+				rule.Resource, rule.NonResourceURLs, rule.Verbs))
+		}
+	}
+	if len(rs.violations) > 0 {
+		rs.result = tnf.FAILURE
+	} else {
+		rs.result = tnf.SUCCESS
+	}
+	return nil
+}
+
+func (rule *Rule) hasWildcard() bool {
+	if rule.Resource == wildcard || strings.HasPrefix(rule.Resource, wildcard+".") {
+		return true
+	}
+	for _, values := range [][]string{rule.NonResourceURLs, rule.Verbs} {
+		for _, value := range values {
+			if value == wildcard {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// parseBindings returns the bindings whose subjects include the service account, either directly or through the
+// service accounts groups.
+func (rs *RBACScope) parseBindings(output string) []Binding {
+	var bindings []Binding
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Split(strings.TrimSpace(line), "|")
+		if len(fields) != numBindingFields {
+			continue
+		}
+		binding := Binding{Kind: fields[0], Namespace: fields[1], Name: fields[2], RoleKind: fields[3], RoleName: fields[4]}
+		for _, subject := range strings.Fields(fields[5]) {
+			if rs.isSubject(subject, binding.Namespace) {
+				bindings = append(bindings, binding)
+				break
+			}
+		}
+	}
+	return bindings
+}
+
+// isSubject returns true when a "kind:namespace:name" subject of a binding designates the service account.
+func (rs *RBACScope) isSubject(subject, bindingNamespace string) bool {
+	fields := strings.SplitN(subject, ":", numSubjectFields)
+	if len(fields) != numSubjectFields {
+		return false
+	}
+	kind, namespace, name := fields[0], fields[1], fields[2]
+	switch kind {
+	case "ServiceAccount":
+		if namespace == "" {
+			namespace = bindingNamespace
+		}
+		return namespace == rs.namespace && name == rs.serviceAccount
+	case "Group":
+		return name == serviceAccountsGroup || name == serviceAccountsGroup+":"+rs.namespace
+	}
+	return false
+}
+
+// ParseRules parses the output of `oc auth can-i --list`.
+func ParseRules(output string) []Rule {
+	var rules []Rule
+	for _, line := range strings.Split(output, "\n") {
+		matched := ruleLineRegex.FindStringSubmatch(strings.TrimRight(line, "\r"))
+		if matched == nil {
+			continue
+		}
+		rules = append(rules, Rule{
+			Resource:        matched[1],
+			NonResourceURLs: strings.Fields(matched[2]),
+			ResourceNames:   strings.Fields(matched[3]),
+			Verbs:           strings.Fields(matched[4]),
+		})
+	}
+	return rules
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (rs *RBACScope) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (rs *RBACScope) ReelEOF() {
+}
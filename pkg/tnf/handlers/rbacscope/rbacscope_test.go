@@ -0,0 +1,115 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package rbacscope_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/rbacscope"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testTimeoutDuration = time.Second * 2
+
+	bindingsScoped = `RoleBinding|tnf|test-rb|Role|test-role|ServiceAccount:tnf:test-sa 
+RoleBinding|tnf|other-rb|Role|other-role|ServiceAccount:tnf:other-sa 
+ClusterRoleBinding||system:basic-user|ClusterRole|system:basic-user|Group::system:authenticated 
+`
+	bindingsAdmin = `RoleBinding|tnf|test-rb|Role|test-role|ServiceAccount::test-sa 
+ClusterRoleBinding||test-admin|ClusterRole|cluster-admin|User::admin ServiceAccount:tnf:test-sa 
+`
+	rulesScoped = `Resources                                       Non-Resource URLs   Resource Names   Verbs
+selfsubjectaccessreviews.authorization.k8s.io   []                  []               [create]
+pods                                            []                  []               [get list watch]
+configmaps                                      []                  [test-config]    [get]
+                                                [/version]          []               [get]
+`
+	rulesWildcard = `Resources                                       Non-Resource URLs   Resource Names   Verbs
+*.*                                             []                  []               [*]
+                                                [*]                 []               [*]
+pods                                            []                  []               [get list watch]
+`
+)
+
+func TestNewRBACScope(t *testing.T) {
+	handler := rbacscope.NewRBACScope(testTimeoutDuration, "test-sa", "tnf")
+	assert.Len(t, handler.Args(), 1)
+	assert.Contains(t, handler.Args()[0], "oc auth can-i --list --as=system:serviceaccount:tnf:test-sa -n tnf")
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.RBACScopeIdentifier, handler.GetIdentifier())
+}
+
+func TestRBACScope_ReelMatch(t *testing.T) {
+	testCases := map[string]struct {
+		output             string
+		expectedResult     int
+		expectedBindings   []string
+		expectedViolations []string
+	}{
+		"scoped": {
+			output:           bindingsScoped + "RBAC_RULES\n" + rulesScoped,
+			expectedResult:   tnf.SUCCESS,
+			expectedBindings: []string{"test-rb"},
+		},
+		"admin": {
+			output:           bindingsAdmin + "RBAC_RULES\n" + rulesWildcard,
+			expectedResult:   tnf.FAILURE,
+			expectedBindings: []string{"test-rb", "test-admin"},
+			expectedViolations: []string{
+				"ClusterRoleBinding test-admin binds the cluster-admin role",
+				`wildcard permission: resource "*.*" non-resource URLs [] verbs [*]`,
+				`wildcard permission: resource "" non-resource URLs [*] verbs [*]`,
+			},
+		},
+		"forbidden": {
+			output:           bindingsScoped + "RBAC_RULES\n" + `Error from server (Forbidden): users "system:serviceaccount:tnf:test-sa" is forbidden`,
+			expectedResult:   tnf.ERROR,
+			expectedBindings: []string{"test-rb"},
+		},
+	}
+	for name, tc := range testCases {
+		handler := rbacscope.NewRBACScope(testTimeoutDuration, "test-sa", "tnf")
+		assert.Nil(t, handler.ReelMatch("", "", tc.output), name)
+		assert.Equal(t, tc.expectedResult, handler.Result(), name)
+		assert.Equal(t, tc.expectedViolations, handler.GetViolations(), name)
+		var bindings []string
+		for _, binding := range handler.GetBindings() {
+			bindings = append(bindings, binding.Name)
+		}
+		assert.Equal(t, tc.expectedBindings, bindings, name)
+	}
+}
+
+func TestParseRules(t *testing.T) {
+	rules := rbacscope.ParseRules(rulesScoped)
+	assert.Len(t, rules, 4)
+	assert.Equal(t, rbacscope.Rule{Resource: "configmaps", NonResourceURLs: []string{}, ResourceNames: []string{"test-config"}, Verbs: []string{"get"}}, rules[2])
+	assert.Equal(t, []string{"/version"}, rules[3].NonResourceURLs)
+	assert.Equal(t, "", rules[3].Resource)
+}
+
+// Just ensure there are no panics.
+func TestRBACScope_ReelEOF(t *testing.T) {
+	handler := rbacscope.NewRBACScope(testTimeoutDuration, "test-sa", "tnf")
+	assert.Nil(t, handler.ReelTimeout())
+	handler.ReelEOF()
+}
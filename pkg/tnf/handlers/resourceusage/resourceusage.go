@@ -0,0 +1,161 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package resourceusage
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	ruRegex = "(?s).+"
+
+	// cgroup v2 and v1 files holding the accumulated CPU time and the current memory usage, relative to /sys/fs/cgroup.
+	cpuStatV2     = "cpu.stat"
+	cpuUsageV1    = "cpuacct/cpuacct.usage"
+	memoryUsageV2 = "memory.current"
+	memoryUsageV1 = "memory/memory.usage_in_bytes"
+
+	nanosecondsPerMicrosecond = 1000
+	millicoresPerCore         = 1000
+	decimalBase               = 10
+	bitSize                   = 64
+	numExpectedCPUSamples     = 2
+)
+
+// ResourceUsage samples the CPU and memory usage of a container over a short interval.
+type ResourceUsage struct {
+	interval         time.Duration
+	maxCPUMillicores int64
+	maxMemoryBytes   int64
+	cpuSamplesUsec   []int64
+	cpuMillicores    int64
+	memoryBytes      int64
+	result           int
+	timeout          time.Duration
+	args             []string
+}
+
+// NewResourceUsage creates a new ResourceUsage tnf.Test. The CPU time is sampled twice, interval apart (rounded to
+// whole seconds, at least one), and the average usage must not exceed maxCPUMillicores.  The memory usage must not
+// exceed maxMemoryBytes.  A zero ceiling is not checked.  The timeout must be larger than the interval.
+func NewResourceUsage(timeout, interval time.Duration, maxCPUMillicores, maxMemoryBytes int64) *ResourceUsage {
+	seconds := int(interval.Seconds())
+	if seconds < 1 {
+		seconds = 1
+	}
+	sample := fmt.Sprintf("for f in %s %s %s %s; do [ -f /sys/fs/cgroup/$f ] && echo $f $(head -1 /sys/fs/cgroup/$f); done",
+		cpuStatV2, cpuUsageV1, memoryUsageV2, memoryUsageV1)
+	return &ResourceUsage{
+		interval:         time.Duration(seconds) * time.Second,
+		maxCPUMillicores: maxCPUMillicores,
+		maxMemoryBytes:   maxMemoryBytes,
+		timeout:          timeout,
+		result:           tnf.ERROR,
+		args:             []string{"sh", "-c", fmt.Sprintf("'%s; sleep %d; %s'", sample, seconds, sample)},
+	}
+}
+
+// Args returns the command line args for the test.
+func (ru *ResourceUsage) Args() []string {
+	return ru.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (ru *ResourceUsage) GetIdentifier() identifier.Identifier {
+	return identifier.ResourceUsageIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (ru *ResourceUsage) Timeout() time.Duration {
+	return ru.timeout
+}
+
+// Result returns the test result.
+func (ru *ResourceUsage) Result() int {
+	return ru.result
+}
+
+// GetCPUMillicores returns the average CPU usage over the sampling interval, in millicores.
+func (ru *ResourceUsage) GetCPUMillicores() int64 {
+	return ru.cpuMillicores
+}
+
+// GetMemoryBytes returns the last sampled memory usage, in bytes.
+func (ru *ResourceUsage) GetMemoryBytes() int64 {
+	return ru.memoryBytes
+}
+
+// ReelFirst returns a step which expects the cgroup samples within the test timeout.
+func (ru *ResourceUsage) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{ruRegex},
+		Timeout: ru.timeout,
+	}
+}
+
+// ReelMatch computes the CPU and memory usage from the cgroup samples and compares them to the ceilings.
+func (ru *ResourceUsage) ReelMatch(_, _, match string) *reel.Step {
+	ru.cpuSamplesUsec = nil
+	for _, line := range strings.Split(strings.Trim(match, "\n"), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		value, err := strconv.ParseInt(fields[len(fields)-1], decimalBase, bitSize)
+		if err != nil {
+			continue
+		}
+		switch fields[0] {
+		case cpuStatV2:
+			ru.cpuSamplesUsec = append(ru.cpuSamplesUsec, value)
+		case cpuUsageV1:
+			ru.cpuSamplesUsec = append(ru.cpuSamplesUsec, value/nanosecondsPerMicrosecond)
+		case memoryUsageV2, memoryUsageV1:
+			ru.memoryBytes = value
+		}
+	}
+
+	if len(ru.cpuSamplesUsec) != numExpectedCPUSamples || ru.memoryBytes == 0 {
+		ru.result = tnf.ERROR
+		return nil
+	}
+	ru.cpuMillicores = (ru.cpuSamplesUsec[1] - ru.cpuSamplesUsec[0]) * millicoresPerCore / ru.interval.Microseconds()
+
+	if (ru.maxCPUMillicores > 0 && ru.cpuMillicores > ru.maxCPUMillicores) ||
+		(ru.maxMemoryBytes > 0 && ru.memoryBytes > ru.maxMemoryBytes) {
+		ru.result = tnf.FAILURE
+	} else {
+		ru.result = tnf.SUCCESS
+	}
+	return nil
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (ru *ResourceUsage) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (ru *ResourceUsage) ReelEOF() {
+}
@@ -0,0 +1,113 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package resourceusage_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	ru "github.com/test-network-function/test-network-function/pkg/tnf/handlers/resourceusage"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+func Test_NewResourceUsage(t *testing.T) {
+	newRu := ru.NewResourceUsage(testTimeoutDuration, testInterval, testMaxCPUMillicores, testMaxMemoryBytes)
+	assert.NotNil(t, newRu)
+	assert.Equal(t, testTimeoutDuration, newRu.Timeout())
+	assert.Equal(t, tnf.ERROR, newRu.Result())
+	assert.Equal(t, identifier.ResourceUsageIdentifier, newRu.GetIdentifier())
+	args := newRu.Args()
+	assert.Equal(t, []string{"sh", "-c"}, args[:2])
+	assert.Contains(t, args[2], "sleep 2")
+}
+
+func Test_ReelFirstPositive(t *testing.T) {
+	newRu := ru.NewResourceUsage(testTimeoutDuration, testInterval, testMaxCPUMillicores, testMaxMemoryBytes)
+	firstStep := newRu.ReelFirst()
+	re := regexp.MustCompile(firstStep.Expect[0])
+	matches := re.FindStringSubmatch(testInputSuccessV2)
+	assert.Len(t, matches, 1)
+	assert.Equal(t, testInputSuccessV2, matches[0])
+}
+
+func Test_ReelFirstNegative(t *testing.T) {
+	newRu := ru.NewResourceUsage(testTimeoutDuration, testInterval, testMaxCPUMillicores, testMaxMemoryBytes)
+	firstStep := newRu.ReelFirst()
+	re := regexp.MustCompile(firstStep.Expect[0])
+	matches := re.FindStringSubmatch(testInputError)
+	assert.Len(t, matches, 0)
+}
+
+func Test_ReelMatchSuccessV2(t *testing.T) {
+	newRu := ru.NewResourceUsage(testTimeoutDuration, testInterval, testMaxCPUMillicores, testMaxMemoryBytes)
+	step := newRu.ReelMatch("", "", testInputSuccessV2)
+	assert.Nil(t, step)
+	assert.Equal(t, tnf.SUCCESS, newRu.Result())
+	assert.Equal(t, int64(250), newRu.GetCPUMillicores())
+	assert.Equal(t, int64(52428800), newRu.GetMemoryBytes())
+}
+
+func Test_ReelMatchSuccessV1(t *testing.T) {
+	newRu := ru.NewResourceUsage(testTimeoutDuration, testInterval, testMaxCPUMillicores, testMaxMemoryBytes)
+	step := newRu.ReelMatch("", "", testInputSuccessV1)
+	assert.Nil(t, step)
+	assert.Equal(t, tnf.SUCCESS, newRu.Result())
+	assert.Equal(t, int64(100), newRu.GetCPUMillicores())
+	assert.Equal(t, int64(104857600), newRu.GetMemoryBytes())
+}
+
+func Test_ReelMatchFailure(t *testing.T) {
+	newRu := ru.NewResourceUsage(testTimeoutDuration, testInterval, 200, testMaxMemoryBytes)
+	step := newRu.ReelMatch("", "", testInputSuccessV2)
+	assert.Nil(t, step)
+	assert.Equal(t, tnf.FAILURE, newRu.Result())
+
+	newRu = ru.NewResourceUsage(testTimeoutDuration, testInterval, 0, 1024)
+	step = newRu.ReelMatch("", "", testInputSuccessV2)
+	assert.Nil(t, step)
+	assert.Equal(t, tnf.FAILURE, newRu.Result())
+}
+
+func Test_ReelMatchMissingSamples(t *testing.T) {
+	newRu := ru.NewResourceUsage(testTimeoutDuration, testInterval, testMaxCPUMillicores, testMaxMemoryBytes)
+	step := newRu.ReelMatch("", "", testInputMissingSamples)
+	assert.Nil(t, step)
+	assert.Equal(t, tnf.ERROR, newRu.Result())
+}
+
+// Just ensure there are no panics.
+func Test_ReelEof(t *testing.T) {
+	newRu := ru.NewResourceUsage(testTimeoutDuration, testInterval, testMaxCPUMillicores, testMaxMemoryBytes)
+	assert.Nil(t, newRu.ReelTimeout())
+	newRu.ReelEOF()
+}
+
+const (
+	testTimeoutDuration  = time.Second * 5
+	testInterval         = time.Second * 2
+	testMaxCPUMillicores = 500
+	testMaxMemoryBytes   = 256 * 1024 * 1024
+	testInputError       = ""
+	testInputSuccessV2   = "cpu.stat usage_usec 1000000\nmemory.current 41943040\n" +
+		"cpu.stat usage_usec 1500000\nmemory.current 52428800\n"
+	testInputSuccessV1 = "cpuacct/cpuacct.usage 3000000000\nmemory/memory.usage_in_bytes 94371840\n" +
+		"cpuacct/cpuacct.usage 3200000000\nmemory/memory.usage_in_bytes 104857600\n"
+	testInputMissingSamples = "memory.current 41943040\n"
+)
@@ -0,0 +1,195 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package rlimits
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/dependencies"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	limitsRegex = "(?s).+"
+	// Unlimited is the value reported for a limit set to "unlimited".
+	Unlimited = uint64(math.MaxUint64)
+
+	unlimitedValue = "unlimited"
+	decimalBase    = 10
+	bitSize        = 64
+)
+
+// limitLineRegex matches a /proc/<pid>/limits row: the description, soft limit, hard limit and optional units.
+var limitLineRegex = regexp.MustCompile(`(?m)^(Max [a-z ]+?)\s{2,}(\d+|unlimited)\s+(\d+|unlimited)(?:\s+(\w+))?\s*$`)
+
+// descriptions maps the setrlimit resource names to their /proc/<pid>/limits descriptions.
+var descriptions = map[string]string{
+	"cpu":        "Max cpu time",
+	"fsize":      "Max file size",
+	"data":       "Max data size",
+	"stack":      "Max stack size",
+	"core":       "Max core file size",
+	"rss":        "Max resident set",
+	"nproc":      "Max processes",
+	"nofile":     "Max open files",
+	"memlock":    "Max locked memory",
+	"as":         "Max address space",
+	"locks":      "Max file locks",
+	"sigpending": "Max pending signals",
+	"msgqueue":   "Max msgqueue size",
+	"nice":       "Max nice priority",
+	"rtprio":     "Max realtime priority",
+	"rttime":     "Max realtime timeout",
+}
+
+// Limit holds the soft and hard values of a resource limit.
+type Limit struct {
+	Soft  uint64
+	Hard  uint64
+	Units string
+}
+
+// RLimits checks that the soft resource limits of a container process meet the required minimums.
+type RLimits struct {
+	required   map[string]uint64
+	limits     map[string]Limit
+	violations []string
+	result     int
+	timeout    time.Duration
+	args       []string
+}
+
+// NewRLimits creates a new RLimits tnf.Test which reads the limits of process `pid` inside a container.  required maps
+// resource names as used by setrlimit and ulimit, such as "nofile" or "memlock", to their minimum soft limit.
+func NewRLimits(timeout time.Duration, pid int, required map[string]uint64) *RLimits {
+	return &RLimits{
+		required: required,
+		timeout:  timeout,
+		result:   tnf.ERROR,
+		args:     []string{dependencies.CatBinaryName, fmt.Sprintf("/proc/%d/limits", pid)},
+	}
+}
+
+// Args returns the command line args for the test.
+func (rl *RLimits) Args() []string {
+	return rl.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (rl *RLimits) GetIdentifier() identifier.Identifier {
+	return identifier.RLimitsIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (rl *RLimits) Timeout() time.Duration {
+	return rl.timeout
+}
+
+// Result returns the test result.
+func (rl *RLimits) Result() int {
+	return rl.result
+}
+
+// GetLimits returns the limits of the process, keyed by resource name.
+func (rl *RLimits) GetLimits() map[string]Limit {
+	return rl.limits
+}
+
+// GetViolations returns a description of every soft limit below its required minimum.
+func (rl *RLimits) GetViolations() []string {
+	return rl.violations
+}
+
+// ReelFirst returns a step which expects the process limits within the test timeout.
+func (rl *RLimits) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{limitsRegex},
+		Timeout: rl.timeout,
+	}
+}
+
+// ReelMatch parses the process limits and compares the soft limits to the required minimums.
+func (rl *RLimits) ReelMatch(_, _, match string) *reel.Step {
+	rl.limits = ParseLimits(match)
+	rl.violations = nil
+	if len(rl.limits) == 0 {
+		rl.result = tnf.ERROR
+		return nil
+	}
+	names := make([]string, 0, len(rl.required))
+	for name := range rl.required {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		limit, ok := rl.limits[name]
+		switch {
+		case !ok:
+			rl.violations = append(rl.violations, fmt.Sprintf("%s limit not found", name))
+		case limit.Soft < rl.required[name]:
+			rl.violations = append(rl.violations, fmt.Sprintf("%s soft limit %d is below %d", name, limit.Soft, rl.required[name]))
+		}
+	}
+	if len(rl.violations) > 0 {
+		rl.result = tnf.FAILURE
+	} else {
+		rl.result = tnf.SUCCESS
+	}
+	return nil
+}
+
+// ParseLimits parses the content of a /proc/<pid>/limits file, keying the limits by resource name.
+func ParseLimits(output string) map[string]Limit {
+	names := make(map[string]string, len(descriptions))
+	for name, description := range descriptions {
+		names[description] = name
+	}
+	limits := map[string]Limit{}
+	for _, matched := range limitLineRegex.FindAllStringSubmatch(output, -1) {
+		name, ok := names[matched[1]]
+		if !ok {
+			continue
+		}
+		limits[name] = Limit{Soft: parseValue(matched[2]), Hard: parseValue(matched[3]), Units: matched[4]}
+	}
+	return limits
+}
+
+func parseValue(value string) uint64 {
+	if value == unlimitedValue {
+		return Unlimited
+	}
+	// Ignore errors, as limitLineRegex only captures decimal numbers.
+	parsed, _ := strconv.ParseUint(value, decimalBase, bitSize)
+	return parsed
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (rl *RLimits) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (rl *RLimits) ReelEOF() {
+}
@@ -0,0 +1,92 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package rlimits_test
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/rlimits"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testDataDirectory   = "testdata"
+	testTimeoutDuration = time.Second * 2
+)
+
+func getMockOutput(t *testing.T) string {
+	b, err := os.ReadFile(path.Join(testDataDirectory, "limits.txt"))
+	assert.Nil(t, err)
+	return string(b)
+}
+
+func TestNewRLimits(t *testing.T) {
+	handler := rlimits.NewRLimits(testTimeoutDuration, 1, nil)
+	assert.Equal(t, []string{"cat", "/proc/1/limits"}, handler.Args())
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.RLimitsIdentifier, handler.GetIdentifier())
+}
+
+func TestRLimits_ReelMatch(t *testing.T) {
+	testCases := map[string]struct {
+		required           map[string]uint64
+		expectedResult     int
+		expectedViolations []string
+	}{
+		"limits_met": {
+			required:       map[string]uint64{"nofile": 65536, "memlock": 65536, "fsize": 1 << 30},
+			expectedResult: tnf.SUCCESS,
+		},
+		"limits_not_met": {
+			required:           map[string]uint64{"nofile": 2097152, "memlock": rlimits.Unlimited, "nproc": 1024},
+			expectedResult:     tnf.FAILURE,
+			expectedViolations: []string{"memlock soft limit 65536 is below 18446744073709551615", "nofile soft limit 1048576 is below 2097152"},
+		},
+	}
+	for testName, testCase := range testCases {
+		handler := rlimits.NewRLimits(testTimeoutDuration, 1, testCase.required)
+		assert.Nil(t, handler.ReelMatch("", "", getMockOutput(t)))
+		assert.Equal(t, testCase.expectedResult, handler.Result(), testName)
+		assert.Equal(t, testCase.expectedViolations, handler.GetViolations(), testName)
+	}
+}
+
+func TestRLimits_ReelMatchNoLimits(t *testing.T) {
+	handler := rlimits.NewRLimits(testTimeoutDuration, 1, nil)
+	assert.Nil(t, handler.ReelMatch("", "", "cat: /proc/1/limits: No such file or directory\n"))
+	assert.Equal(t, tnf.ERROR, handler.Result())
+}
+
+func TestParseLimits(t *testing.T) {
+	limits := rlimits.ParseLimits(getMockOutput(t))
+	assert.Len(t, limits, 16)
+	assert.Equal(t, rlimits.Limit{Soft: 8388608, Hard: rlimits.Unlimited, Units: "bytes"}, limits["stack"])
+	assert.Equal(t, rlimits.Limit{Soft: 0, Hard: 0}, limits["nice"])
+}
+
+// Ensure there are no panics.
+func TestRLimits_ReelEof(t *testing.T) {
+	handler := rlimits.NewRLimits(testTimeoutDuration, 1, nil)
+	assert.Nil(t, handler.ReelTimeout())
+	handler.ReelEOF()
+}
@@ -27,8 +27,10 @@ import (
 )
 
 const (
-	ocCommand = "oc scale --replicas=%d deployment %s -n %s"
-	regex     = "^deployment.*/%s scaled"
+	ocCommand = "oc scale --replicas=%d %s %s -n %s"
+	regex     = "^%s.*/%s scaled"
+
+	deploymentResource = "deployment"
 )
 
 // Scaling holds the Scaling handler parameters.
@@ -41,12 +43,19 @@ type Scaling struct {
 
 // NewScaling creates a new Scaling handler.
 func NewScaling(timeout time.Duration, namespace, deploymentName string, replicaCount int) *Scaling {
-	command := fmt.Sprintf(ocCommand, replicaCount, deploymentName, namespace)
+	return NewWorkloadScaling(timeout, namespace, deploymentResource, deploymentName, replicaCount)
+}
+
+// NewWorkloadScaling creates a new Scaling handler for a workload of any scalable kind, such as "deployment" or
+// "statefulset".
+func NewWorkloadScaling(timeout time.Duration, namespace, kind, name string, replicaCount int) *Scaling {
+	kind = strings.ToLower(kind)
+	command := fmt.Sprintf(ocCommand, replicaCount, kind, name, namespace)
 	return &Scaling{
 		timeout: timeout,
 		result:  tnf.ERROR,
 		args:    strings.Fields(command),
-		regex:   fmt.Sprintf(regex, deploymentName),
+		regex:   fmt.Sprintf(regex, kind, name),
 	}
 }
 
@@ -62,6 +62,14 @@ func Test_ReelMatchSuccess(t *testing.T) {
 	assert.Equal(t, tnf.SUCCESS, handler.Result())
 }
 
+func Test_NewWorkloadScaling(t *testing.T) {
+	handler := scaling.NewWorkloadScaling(testTimeoutDuration, testPodNamespace, "StatefulSet", testStatefulSetName, testReplicaCount)
+	assert.Equal(t, []string{"oc", "scale", "--replicas=2", "statefulset", testStatefulSetName, "-n", testPodNamespace}, handler.Args())
+	re := regexp.MustCompile(handler.ReelFirst().Expect[0])
+	assert.True(t, re.MatchString(fmt.Sprintf("statefulset.apps/%s scaled\n", testStatefulSetName)))
+	assert.False(t, re.MatchString(testInputSuccess))
+}
+
 // Just ensure there are no panics.
 func Test_ReelEof(t *testing.T) {
 	handler := scaling.NewScaling(testTimeoutDuration, testPodNamespace, testDeploymentName, testReplicaCount)
@@ -75,6 +83,7 @@ const (
 	testInputError      = ""
 	testPodNamespace    = "testPodNamespace"
 	testDeploymentName  = "testDeploymentName"
+	testStatefulSetName = "testStatefulSetName"
 )
 
 var (
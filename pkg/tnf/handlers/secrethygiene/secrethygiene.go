@@ -0,0 +1,329 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package secrethygiene
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	secretHygieneRegex = "(?s).+"
+
+	// defaultSecretVolumeMode is the mode Kubernetes gives the files of a secret volume without defaultMode.
+	defaultSecretVolumeMode = 0644
+	worldReadableBit        = 0004
+	// minLeakedValueLength is the shortest secret value searched for, so that values such as "true" or "admin" are
+	// not reported as leaked.
+	minLeakedValueLength = 8
+
+	serviceAccountTokenType = "kubernetes.io/service-account-token"
+)
+
+type keySelector struct {
+	Name string `json:"name"`
+	Key  string `json:"key"`
+}
+
+type container struct {
+	Name string `json:"name"`
+	Env  []struct {
+		Name      string `json:"name"`
+		ValueFrom *struct {
+			SecretKeyRef *keySelector `json:"secretKeyRef"`
+		} `json:"valueFrom"`
+	} `json:"env"`
+	EnvFrom []struct {
+		SecretRef *keySelector `json:"secretRef"`
+	} `json:"envFrom"`
+}
+
+type keyToPath struct {
+	Key  string `json:"key"`
+	Mode *int32 `json:"mode"`
+}
+
+type secretProjection struct {
+	Name  string      `json:"name"`
+	Items []keyToPath `json:"items"`
+}
+
+type volume struct {
+	Name   string `json:"name"`
+	Secret *struct {
+		SecretName  string      `json:"secretName"`
+		DefaultMode *int32      `json:"defaultMode"`
+		Items       []keyToPath `json:"items"`
+	} `json:"secret"`
+	Projected *struct {
+		DefaultMode *int32 `json:"defaultMode"`
+		Sources     []struct {
+			Secret *secretProjection `json:"secret"`
+		} `json:"sources"`
+	} `json:"projected"`
+}
+
+// item is the subset of a pod, secret or ConfigMap manifest used by the test.
+type item struct {
+	Kind     string `json:"kind"`
+	Metadata struct {
+		Name        string            `json:"name"`
+		Annotations map[string]string `json:"annotations"`
+	} `json:"metadata"`
+	Type string            `json:"type"`
+	Data map[string]string `json:"data"`
+	Spec struct {
+		InitContainers []container `json:"initContainers"`
+		Containers     []container `json:"containers"`
+		Volumes        []volume    `json:"volumes"`
+	} `json:"spec"`
+}
+
+// SecretHygiene checks that the pods consume secrets through volumes rather than environment variables, that the
+// secret volumes are not world-readable, and that no secret value is copied verbatim into a ConfigMap or a pod
+// annotation.  Secret values are never stored nor reported, only the names of the secrets and keys.
+type SecretHygiene struct {
+	pods          map[string]bool
+	exposures     []string
+	worldReadable []string
+	leaks         []string
+	result        int
+	timeout       time.Duration
+	args          []string
+}
+
+// NewSecretHygiene creates a new SecretHygiene tnf.Test checking the given pods of a namespace, and the ConfigMaps of
+// the namespace.
+func NewSecretHygiene(timeout time.Duration, namespace string, pods []string) *SecretHygiene {
+	podSet := map[string]bool{}
+	for _, pod := range pods {
+		podSet[pod] = true
+	}
+	return &SecretHygiene{
+		pods:    podSet,
+		timeout: timeout,
+		result:  tnf.ERROR,
+		args:    []string{"oc", "get", "pods,secrets,configmaps", "-n", namespace, "-o", "json"},
+	}
+}
+
+// Args returns the command line args for the test.
+func (sh *SecretHygiene) Args() []string {
+	return sh.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (sh *SecretHygiene) GetIdentifier() identifier.Identifier {
+	return identifier.SecretHygieneIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (sh *SecretHygiene) Timeout() time.Duration {
+	return sh.timeout
+}
+
+// Result returns the test result.
+func (sh *SecretHygiene) Result() int {
+	return sh.result
+}
+
+// GetEnvironmentExposures returns a description of every secret exposed through environment variables.
+func (sh *SecretHygiene) GetEnvironmentExposures() []string {
+	return sh.exposures
+}
+
+// GetWorldReadableMounts returns a description of every secret mounted with world-readable files.
+func (sh *SecretHygiene) GetWorldReadableMounts() []string {
+	return sh.worldReadable
+}
+
+// GetLeakedValues returns a description of every secret value found in a ConfigMap or a pod annotation.
+func (sh *SecretHygiene) GetLeakedValues() []string {
+	return sh.leaks
+}
+
+// ReelFirst returns a step which expects the pods, secrets and ConfigMaps manifests within the test timeout.
+func (sh *SecretHygiene) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{secretHygieneRegex},
+		Timeout: sh.timeout,
+	}
+}
+
+// ReelMatch parses the pods, secrets and ConfigMaps of the namespace and checks how the secrets are handled.
+func (sh *SecretHygiene) ReelMatch(_, _, match string) *reel.Step {
+	sh.exposures, sh.worldReadable, sh.leaks = nil, nil, nil
+	start := strings.Index(match, "{")
+	if start < 0 {
+		sh.result = tnf.ERROR
+		return nil
+	}
+	var list struct {
+		Items []item `json:"items"`
+	}
+	if err := json.Unmarshal([]byte(strings.TrimSpace(match[start:])), &list); err != nil {
+		sh.result = tnf.ERROR
+		return nil
+	}
+	var pods, configMaps []*item
+	secretValues := map[string]string{}
+	for i := range list.Items {
+		it := &list.Items[i]
+		switch it.Kind {
+		case "Pod":
+			if sh.pods[it.Metadata.Name] {
+				pods = append(pods, it)
+			}
+		case "ConfigMap":
+			configMaps = append(configMaps, it)
+		case "Secret":
+			addSecretValues(secretValues, it)
+		}
+	}
+	for _, pod := range pods {
+		sh.checkEnvironment(pod)
+		sh.checkVolumes(pod)
+		for _, name := range sortedKeys(pod.Metadata.Annotations) {
+			for _, secretKey := range findSecretValues(secretValues, pod.Metadata.Annotations[name]) {
+				sh.leaks = append(sh.leaks, fmt.Sprintf("annotation %s of pod %s contains the value of %s", name, pod.Metadata.Name, secretKey))
+			}
+		}
+	}
+	for _, configMap := range configMaps {
+		for _, key := range sortedKeys(configMap.Data) {
+			for _, secretKey := range findSecretValues(secretValues, configMap.Data[key]) {
+				sh.leaks = append(sh.leaks, fmt.Sprintf("key %s of ConfigMap %s contains the value of %s", key, configMap.Metadata.Name, secretKey))
+			}
+		}
+	}
+	if len(sh.exposures)+len(sh.worldReadable)+len(sh.leaks) > 0 {
+		sh.result = tnf.FAILURE
+	} else {
+		sh.result = tnf.SUCCESS
+	}
+	return nil
+}
+
+func (sh *SecretHygiene) checkEnvironment(pod *item) {
+	containers := append(pod.Spec.InitContainers, pod.Spec.Containers...)
+	for i := range containers {
+		c := &containers[i]
+		for _, env := range c.Env {
+			if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil {
+				sh.exposures = append(sh.exposures, fmt.Sprintf("container %s of pod %s exposes key %s of secret %s in environment variable %s",
+					c.Name, pod.Metadata.Name, env.ValueFrom.SecretKeyRef.Key, env.ValueFrom.SecretKeyRef.Name, env.Name))
+			}
+		}
+		for _, envFrom := range c.EnvFrom {
+			if envFrom.SecretRef != nil {
+				sh.exposures = append(sh.exposures, fmt.Sprintf("container %s of pod %s imports secret %s into its environment",
+					c.Name, pod.Metadata.Name, envFrom.SecretRef.Name))
+			}
+		}
+	}
+}
+
+func (sh *SecretHygiene) checkVolumes(pod *item) {
+	for _, v := range pod.Spec.Volumes {
+		if v.Secret != nil {
+			sh.checkModes(pod.Metadata.Name, v.Name, v.Secret.SecretName, v.Secret.DefaultMode, v.Secret.Items)
+		}
+		if v.Projected != nil {
+			for _, source := range v.Projected.Sources {
+				if source.Secret != nil {
+					sh.checkModes(pod.Metadata.Name, v.Name, source.Secret.Name, v.Projected.DefaultMode, source.Secret.Items)
+				}
+			}
+		}
+	}
+}
+
+func (sh *SecretHygiene) checkModes(pod, volumeName, secret string, defaultMode *int32, items []keyToPath) {
+	mode := int32(defaultSecretVolumeMode)
+	if defaultMode != nil {
+		mode = *defaultMode
+	}
+	if len(items) == 0 {
+		if mode&worldReadableBit != 0 {
+			sh.worldReadable = append(sh.worldReadable, fmt.Sprintf("volume %s of pod %s mounts secret %s with mode %04o",
+				volumeName, pod, secret, mode))
+		}
+		return
+	}
+	for _, it := range items {
+		itemMode := mode
+		if it.Mode != nil {
+			itemMode = *it.Mode
+		}
+		if itemMode&worldReadableBit != 0 {
+			sh.worldReadable = append(sh.worldReadable, fmt.Sprintf("volume %s of pod %s mounts key %s of secret %s with mode %04o",
+				volumeName, pod, it.Key, secret, itemMode))
+		}
+	}
+}
+
+// addSecretValues adds the decoded values of a secret, keyed by "key <key> of secret <name>".  Service account tokens
+// are ignored, as their CA certificate is also published in ConfigMaps by the cluster.
+func addSecretValues(values map[string]string, secret *item) {
+	if secret.Type == serviceAccountTokenType {
+		return
+	}
+	for key, encoded := range secret.Data {
+		decoded, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil || len(strings.TrimSpace(string(decoded))) < minLeakedValueLength {
+			continue
+		}
+		values[fmt.Sprintf("key %s of secret %s", key, secret.Metadata.Name)] = strings.TrimSpace(string(decoded))
+	}
+}
+
+// findSecretValues returns the secret keys whose value appears verbatim in text.
+func findSecretValues(values map[string]string, text string) []string {
+	var found []string
+	for _, secretKey := range sortedKeys(values) {
+		if strings.Contains(text, values[secretKey]) {
+			found = append(found, secretKey)
+		}
+	}
+	return found
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (sh *SecretHygiene) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (sh *SecretHygiene) ReelEOF() {
+}
@@ -0,0 +1,90 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package secrethygiene_test
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/secrethygiene"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testDataDirectory   = "testdata"
+	testTimeoutDuration = time.Second * 2
+)
+
+func getMockOutput(t *testing.T, testName string) string {
+	b, err := os.ReadFile(path.Join(testDataDirectory, testName+".txt"))
+	assert.Nil(t, err)
+	return string(b)
+}
+
+func TestNewSecretHygiene(t *testing.T) {
+	handler := secrethygiene.NewSecretHygiene(testTimeoutDuration, "tnf", []string{"test-0"})
+	assert.Equal(t, []string{"oc", "get", "pods,secrets,configmaps", "-n", "tnf", "-o", "json"}, handler.Args())
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.SecretHygieneIdentifier, handler.GetIdentifier())
+}
+
+func TestSecretHygiene_ReelMatch(t *testing.T) {
+	testCases := map[string]struct {
+		expectedResult        int
+		expectedExposures     []string
+		expectedWorldReadable []string
+		expectedLeaks         []string
+	}{
+		"hygienic": {
+			expectedResult: tnf.SUCCESS,
+		},
+		"leaky": {
+			expectedResult: tnf.FAILURE,
+			expectedExposures: []string{
+				"container init of pod test-0 imports secret credentials into its environment",
+				"container test of pod test-0 exposes key password of secret credentials in environment variable PASSWORD",
+			},
+			expectedWorldReadable: []string{
+				"volume credentials of pod test-0 mounts secret credentials with mode 0644",
+				"volume tls of pod test-0 mounts key tls.key of secret tls with mode 0644",
+			},
+			expectedLeaks: []string{
+				"annotation example.com/dsn of pod test-0 contains the value of key password of secret credentials",
+				"key password of ConfigMap settings contains the value of key password of secret credentials",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		handler := secrethygiene.NewSecretHygiene(testTimeoutDuration, "tnf", []string{"test-0"})
+		assert.Nil(t, handler.ReelMatch("", "", getMockOutput(t, name)), name)
+		assert.Equal(t, tc.expectedResult, handler.Result(), name)
+		assert.Equal(t, tc.expectedExposures, handler.GetEnvironmentExposures(), name)
+		assert.Equal(t, tc.expectedWorldReadable, handler.GetWorldReadableMounts(), name)
+		assert.Equal(t, tc.expectedLeaks, handler.GetLeakedValues(), name)
+	}
+}
+
+func TestSecretHygiene_ReelMatchError(t *testing.T) {
+	handler := secrethygiene.NewSecretHygiene(testTimeoutDuration, "missing", nil)
+	assert.Nil(t, handler.ReelMatch("", "", "Error from server (Forbidden): pods is forbidden\n"))
+	assert.Equal(t, tnf.ERROR, handler.Result())
+}
@@ -0,0 +1,227 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package securitycontext
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	securityContextRegex = "(?s).+"
+
+	// ReadOnlyRootFilesystemField, SeccompProfileField and DropCapabilitiesField name the checked securityContext
+	// fields.
+	ReadOnlyRootFilesystemField = "readOnlyRootFilesystem"
+	SeccompProfileField         = "seccompProfile"
+	DropCapabilitiesField       = "capabilities.drop"
+
+	// RuntimeDefaultProfile is the seccomp profile of the container runtime.
+	RuntimeDefaultProfile = "RuntimeDefault"
+	// AllCapabilities is the capability name dropping every capability.
+	AllCapabilities = "ALL"
+
+	// seccompPodAnnotation is the annotation setting the seccomp profile of a pod before Kubernetes 1.19.
+	seccompPodAnnotation       = "seccomp.security.alpha.kubernetes.io/pod"
+	runtimeDefaultAnnotation   = "runtime/default"
+	seccompContainerAnnotation = "container.seccomp.security.alpha.kubernetes.io/"
+)
+
+// Fields lists the checked securityContext fields.
+var Fields = []string{ReadOnlyRootFilesystemField, SeccompProfileField, DropCapabilitiesField}
+
+type seccompProfile struct {
+	Type string `json:"type"`
+}
+
+type container struct {
+	Name            string `json:"name"`
+	SecurityContext struct {
+		ReadOnlyRootFilesystem *bool           `json:"readOnlyRootFilesystem"`
+		SeccompProfile         *seccompProfile `json:"seccompProfile"`
+		Capabilities           struct {
+			Add  []string `json:"add"`
+			Drop []string `json:"drop"`
+		} `json:"capabilities"`
+	} `json:"securityContext"`
+}
+
+// pod is the subset of a pod manifest used by the test.
+type pod struct {
+	Metadata struct {
+		Annotations map[string]string `json:"annotations"`
+	} `json:"metadata"`
+	Spec struct {
+		SecurityContext struct {
+			SeccompProfile *seccompProfile `json:"seccompProfile"`
+		} `json:"securityContext"`
+		InitContainers []container `json:"initContainers"`
+		Containers     []container `json:"containers"`
+	} `json:"spec"`
+}
+
+// Container holds the effective securityContext settings of a container.
+type Container struct {
+	Name                   string
+	ReadOnlyRootFilesystem bool
+	// SeccompProfile is the seccomp profile type set for the container or inherited from the pod.
+	SeccompProfile string
+	Add            []string
+	Drop           []string
+}
+
+// SecurityContext checks that every container of a pod has a read-only root filesystem, uses the RuntimeDefault
+// seccomp profile, and drops all the capabilities, only adding back the ones it needs.
+type SecurityContext struct {
+	containers []Container
+	violations map[string][]string
+	result     int
+	timeout    time.Duration
+	args       []string
+}
+
+// NewSecurityContext creates a new SecurityContext tnf.Test.
+func NewSecurityContext(timeout time.Duration, namespace, podName string) *SecurityContext {
+	return &SecurityContext{
+		timeout: timeout,
+		result:  tnf.ERROR,
+		args:    []string{"oc", "get", "pod", "-n", namespace, podName, "-o", "json"},
+	}
+}
+
+// Args returns the command line args for the test.
+func (sc *SecurityContext) Args() []string {
+	return sc.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (sc *SecurityContext) GetIdentifier() identifier.Identifier {
+	return identifier.SecurityContextIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (sc *SecurityContext) Timeout() time.Duration {
+	return sc.timeout
+}
+
+// Result returns the test result.
+func (sc *SecurityContext) Result() int {
+	return sc.result
+}
+
+// GetContainers returns the effective securityContext settings of the init and regular containers.
+func (sc *SecurityContext) GetContainers() []Container {
+	return sc.containers
+}
+
+// GetViolations returns a description of every container breaking the check of a field.
+func (sc *SecurityContext) GetViolations(field string) []string {
+	return sc.violations[field]
+}
+
+// ReelFirst returns a step which expects the pod manifest within the test timeout.
+func (sc *SecurityContext) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{securityContextRegex},
+		Timeout: sc.timeout,
+	}
+}
+
+// ReelMatch parses the pod manifest and checks the securityContext of its containers.
+func (sc *SecurityContext) ReelMatch(_, _, match string) *reel.Step {
+	sc.containers = nil
+	sc.violations = map[string][]string{}
+	start := strings.Index(match, "{")
+	if start < 0 {
+		sc.result = tnf.ERROR
+		return nil
+	}
+	parsed := &pod{}
+	if err := json.Unmarshal([]byte(strings.TrimSpace(match[start:])), parsed); err != nil {
+		sc.result = tnf.ERROR
+		return nil
+	}
+	containers := append(parsed.Spec.InitContainers, parsed.Spec.Containers...)
+	for i := range containers {
+		sc.containers = append(sc.containers, effectiveSettings(parsed, &containers[i]))
+	}
+	for i := range sc.containers {
+		sc.check(&sc.containers[i])
+	}
+	sc.result = tnf.SUCCESS
+	if len(sc.violations) > 0 {
+		sc.result = tnf.FAILURE
+	}
+	return nil
+}
+
+func effectiveSettings(p *pod, c *container) Container {
+	settings := Container{
+		Name: c.Name,
+		Add:  c.SecurityContext.Capabilities.Add,
+		Drop: c.SecurityContext.Capabilities.Drop,
+	}
+	if c.SecurityContext.ReadOnlyRootFilesystem != nil {
+		settings.ReadOnlyRootFilesystem = *c.SecurityContext.ReadOnlyRootFilesystem
+	}
+	switch {
+	case c.SecurityContext.SeccompProfile != nil:
+		settings.SeccompProfile = c.SecurityContext.SeccompProfile.Type
+	case p.Spec.SecurityContext.SeccompProfile != nil:
+		settings.SeccompProfile = p.Spec.SecurityContext.SeccompProfile.Type
+	case p.Metadata.Annotations[seccompContainerAnnotation+c.Name] == runtimeDefaultAnnotation,
+		p.Metadata.Annotations[seccompPodAnnotation] == runtimeDefaultAnnotation:
+		settings.SeccompProfile = RuntimeDefaultProfile
+	}
+	return settings
+}
+
+func (sc *SecurityContext) check(c *Container) {
+	if !c.ReadOnlyRootFilesystem {
+		sc.violations[ReadOnlyRootFilesystemField] = append(sc.violations[ReadOnlyRootFilesystemField],
+			fmt.Sprintf("container %s does not have a read-only root filesystem", c.Name))
+	}
+	if c.SeccompProfile != RuntimeDefaultProfile {
+		sc.violations[SeccompProfileField] = append(sc.violations[SeccompProfileField],
+			fmt.Sprintf("container %s uses seccomp profile %q instead of %s", c.Name, c.SeccompProfile, RuntimeDefaultProfile))
+	}
+	dropsAll := false
+	for _, capability := range c.Drop {
+		if strings.EqualFold(capability, AllCapabilities) {
+			dropsAll = true
+		}
+	}
+	if !dropsAll {
+		sc.violations[DropCapabilitiesField] = append(sc.violations[DropCapabilitiesField],
+			fmt.Sprintf("container %s drops capabilities %v instead of %s", c.Name, c.Drop, AllCapabilities))
+	}
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (sc *SecurityContext) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (sc *SecurityContext) ReelEOF() {
+}
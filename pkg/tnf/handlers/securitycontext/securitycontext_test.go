@@ -0,0 +1,92 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package securitycontext_test
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/securitycontext"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testDataDirectory   = "testdata"
+	testTimeoutDuration = time.Second * 2
+)
+
+func getMockOutput(t *testing.T, testName string) string {
+	b, err := os.ReadFile(path.Join(testDataDirectory, testName+".txt"))
+	assert.Nil(t, err)
+	return string(b)
+}
+
+func TestNewSecurityContext(t *testing.T) {
+	handler := securitycontext.NewSecurityContext(testTimeoutDuration, "tnf", "test-0")
+	assert.Equal(t, []string{"oc", "get", "pod", "-n", "tnf", "test-0", "-o", "json"}, handler.Args())
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.SecurityContextIdentifier, handler.GetIdentifier())
+}
+
+func TestSecurityContext_ReelMatch(t *testing.T) {
+	testCases := map[string]struct {
+		expectedResult     int
+		expectedContainers []securitycontext.Container
+		expectedViolations map[string][]string
+	}{
+		"restricted": {
+			expectedResult: tnf.SUCCESS,
+			expectedContainers: []securitycontext.Container{
+				{Name: "init", ReadOnlyRootFilesystem: true, SeccompProfile: "RuntimeDefault", Drop: []string{"ALL"}},
+				{Name: "test", ReadOnlyRootFilesystem: true, SeccompProfile: "RuntimeDefault", Add: []string{"NET_BIND_SERVICE"}, Drop: []string{"ALL"}},
+			},
+			expectedViolations: map[string][]string{},
+		},
+		"unrestricted": {
+			expectedResult: tnf.FAILURE,
+			expectedContainers: []securitycontext.Container{
+				{Name: "test", SeccompProfile: "Unconfined", Drop: []string{"KILL", "MKNOD"}},
+				{Name: "legacy", ReadOnlyRootFilesystem: true, SeccompProfile: "RuntimeDefault", Drop: []string{"all"}},
+			},
+			expectedViolations: map[string][]string{
+				securitycontext.ReadOnlyRootFilesystemField: {"container test does not have a read-only root filesystem"},
+				securitycontext.SeccompProfileField:         {`container test uses seccomp profile "Unconfined" instead of RuntimeDefault`},
+				securitycontext.DropCapabilitiesField:       {"container test drops capabilities [KILL MKNOD] instead of ALL"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		handler := securitycontext.NewSecurityContext(testTimeoutDuration, "tnf", "test-0")
+		assert.Nil(t, handler.ReelMatch("", "", getMockOutput(t, name)), name)
+		assert.Equal(t, tc.expectedResult, handler.Result(), name)
+		assert.Equal(t, tc.expectedContainers, handler.GetContainers(), name)
+		for _, field := range securitycontext.Fields {
+			assert.Equal(t, tc.expectedViolations[field], handler.GetViolations(field), name+" "+field)
+		}
+	}
+}
+
+func TestSecurityContext_ReelMatchError(t *testing.T) {
+	handler := securitycontext.NewSecurityContext(testTimeoutDuration, "tnf", "missing")
+	assert.Nil(t, handler.ReelMatch("", "", "Error from server (NotFound): pods \"missing\" not found\n"))
+	assert.Equal(t, tnf.ERROR, handler.Result())
+}
@@ -0,0 +1,196 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package servicemesh
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	serviceMeshRegex = "(?s).+"
+	// policiesMarker separates the pods manifest from the PeerAuthentications manifest.
+	policiesMarker = "PEER_AUTHENTICATIONS"
+
+	// MeshRootNamespace is the namespace of the mesh control plane, whose policies without selector apply to the
+	// whole mesh.
+	MeshRootNamespace = "istio-system"
+	// SidecarContainerName is the name of the container injected by Istio and OpenShift Service Mesh.
+	SidecarContainerName    = "istio-proxy"
+	sidecarStatusAnnotation = "sidecar.istio.io/status"
+	strictMTLSMode          = "STRICT"
+)
+
+type pods struct {
+	Items []struct {
+		Metadata struct {
+			Name        string            `json:"name"`
+			Annotations map[string]string `json:"annotations"`
+		} `json:"metadata"`
+		Spec struct {
+			InitContainers []struct {
+				Name string `json:"name"`
+			} `json:"initContainers"`
+			Containers []struct {
+				Name string `json:"name"`
+			} `json:"containers"`
+		} `json:"spec"`
+	} `json:"items"`
+}
+
+type peerAuthentications struct {
+	Items []struct {
+		Metadata struct {
+			Name      string `json:"name"`
+			Namespace string `json:"namespace"`
+		} `json:"metadata"`
+		Spec struct {
+			Selector *struct {
+				MatchLabels map[string]string `json:"matchLabels"`
+			} `json:"selector"`
+			MTLS *struct {
+				Mode string `json:"mode"`
+			} `json:"mtls"`
+		} `json:"spec"`
+	} `json:"items"`
+}
+
+// ServiceMesh discovers which pods of a namespace run a mesh sidecar, and the PeerAuthentications enforcing strict
+// mutual TLS on the namespace, either from the namespace itself or mesh-wide from MeshRootNamespace.  The
+// PeerAuthentication resource being absent from clusters without a mesh is not an error.
+type ServiceMesh struct {
+	namespace string
+	sidecars  map[string]bool
+	policies  []string
+	result    int
+	timeout   time.Duration
+	args      []string
+}
+
+// NewServiceMesh creates a new ServiceMesh tnf.Test.
+func NewServiceMesh(timeout time.Duration, namespace string) *ServiceMesh {
+	return &ServiceMesh{
+		namespace: namespace,
+		timeout:   timeout,
+		result:    tnf.ERROR,
+		args: []string{fmt.Sprintf("oc get pods -n %s -o json; echo %s; oc get peerauthentications.security.istio.io -A -o json",
+			namespace, policiesMarker)},
+	}
+}
+
+// Args returns the command line args for the test.
+func (sm *ServiceMesh) Args() []string {
+	return sm.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (sm *ServiceMesh) GetIdentifier() identifier.Identifier {
+	return identifier.ServiceMeshIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (sm *ServiceMesh) Timeout() time.Duration {
+	return sm.timeout
+}
+
+// Result returns the test result.
+func (sm *ServiceMesh) Result() int {
+	return sm.result
+}
+
+// GetSidecars returns whether each pod of the namespace, indexed by name, runs a mesh sidecar.
+func (sm *ServiceMesh) GetSidecars() map[string]bool {
+	return sm.sidecars
+}
+
+// GetMTLSPolicies returns the "<namespace>/<name>" of the PeerAuthentications enforcing strict mutual TLS on the
+// namespace.
+func (sm *ServiceMesh) GetMTLSPolicies() []string {
+	return sm.policies
+}
+
+// ReelFirst returns a step which expects the pods and PeerAuthentications manifests within the test timeout.
+func (sm *ServiceMesh) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{serviceMeshRegex},
+		Timeout: sm.timeout,
+	}
+}
+
+// ReelMatch parses the pods and PeerAuthentications manifests.
+func (sm *ServiceMesh) ReelMatch(_, _, match string) *reel.Step {
+	sm.sidecars, sm.policies = map[string]bool{}, nil
+	parts := strings.SplitN(match, policiesMarker, 2)
+	if len(parts) != 2 {
+		sm.result = tnf.ERROR
+		return nil
+	}
+	var podList pods
+	if err := unmarshal(parts[0], &podList); err != nil {
+		sm.result = tnf.ERROR
+		return nil
+	}
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		_, injected := pod.Metadata.Annotations[sidecarStatusAnnotation]
+		for _, c := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+			injected = injected || c.Name == SidecarContainerName
+		}
+		sm.sidecars[pod.Metadata.Name] = injected
+	}
+	var policyList peerAuthentications
+	if strings.Contains(parts[1], "{") {
+		if err := unmarshal(parts[1], &policyList); err != nil {
+			sm.result = tnf.ERROR
+			return nil
+		}
+	}
+	for i := range policyList.Items {
+		policy := &policyList.Items[i]
+		if policy.Spec.MTLS == nil || policy.Spec.MTLS.Mode != strictMTLSMode {
+			continue
+		}
+		if policy.Metadata.Namespace == sm.namespace || (policy.Metadata.Namespace == MeshRootNamespace && policy.Spec.Selector == nil) {
+			sm.policies = append(sm.policies, policy.Metadata.Namespace+"/"+policy.Metadata.Name)
+		}
+	}
+	sm.result = tnf.SUCCESS
+	return nil
+}
+
+func unmarshal(output string, v interface{}) error {
+	start := strings.Index(output, "{")
+	if start < 0 {
+		return fmt.Errorf("no JSON output found")
+	}
+	return json.Unmarshal([]byte(strings.TrimSpace(output[start:])), v)
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (sm *ServiceMesh) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (sm *ServiceMesh) ReelEOF() {
+}
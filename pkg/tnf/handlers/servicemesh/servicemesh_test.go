@@ -0,0 +1,77 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package servicemesh_test
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/servicemesh"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testDataDirectory   = "testdata"
+	testTimeoutDuration = time.Second * 2
+)
+
+func getMockOutput(t *testing.T, testName string) string {
+	b, err := os.ReadFile(path.Join(testDataDirectory, testName+".txt"))
+	assert.Nil(t, err)
+	return string(b)
+}
+
+func TestNewServiceMesh(t *testing.T) {
+	handler := servicemesh.NewServiceMesh(testTimeoutDuration, "tnf")
+	assert.Equal(t, []string{"oc get pods -n tnf -o json; echo PEER_AUTHENTICATIONS; oc get peerauthentications.security.istio.io -A -o json"},
+		handler.Args())
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.ServiceMeshIdentifier, handler.GetIdentifier())
+}
+
+func TestServiceMesh_ReelMatch(t *testing.T) {
+	testCases := map[string]struct {
+		expectedSidecars map[string]bool
+		expectedPolicies []string
+	}{
+		"meshed": {
+			expectedSidecars: map[string]bool{"test-0": true, "test-1": true, "test-2": false},
+			expectedPolicies: []string{"tnf/default", "istio-system/default"},
+		},
+		"plain": {
+			expectedSidecars: map[string]bool{"test-0": false},
+		},
+	}
+	for name, tc := range testCases {
+		handler := servicemesh.NewServiceMesh(testTimeoutDuration, "tnf")
+		assert.Nil(t, handler.ReelMatch("", "", getMockOutput(t, name)), name)
+		assert.Equal(t, tnf.SUCCESS, handler.Result(), name)
+		assert.Equal(t, tc.expectedSidecars, handler.GetSidecars(), name)
+		assert.Equal(t, tc.expectedPolicies, handler.GetMTLSPolicies(), name)
+	}
+}
+
+func TestServiceMesh_ReelMatchError(t *testing.T) {
+	handler := servicemesh.NewServiceMesh(testTimeoutDuration, "missing")
+	assert.Nil(t, handler.ReelMatch("", "", "Error from server (Forbidden): pods is forbidden\nPEER_AUTHENTICATIONS\n"))
+	assert.Equal(t, tnf.ERROR, handler.Result())
+}
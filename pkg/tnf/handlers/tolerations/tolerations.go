@@ -0,0 +1,232 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package tolerations
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	tolerationsRegex = "(?s).+"
+	// taintsMarker separates the pod manifest from the node manifest.
+	taintsMarker = "NODE_TAINTS"
+
+	existsOperator        = "Exists"
+	preferNoScheduleTaint = "PreferNoSchedule"
+)
+
+// Toleration is a toleration of a pod spec.
+type Toleration struct {
+	Key      string `json:"key"`
+	Operator string `json:"operator"`
+	Value    string `json:"value"`
+	Effect   string `json:"effect"`
+}
+
+// Taint is a taint of a node spec.
+type Taint struct {
+	Key    string `json:"key"`
+	Value  string `json:"value"`
+	Effect string `json:"effect"`
+}
+
+func (t Taint) String() string {
+	if t.Value == "" {
+		return fmt.Sprintf("%s:%s", t.Key, t.Effect)
+	}
+	return fmt.Sprintf("%s=%s:%s", t.Key, t.Value, t.Effect)
+}
+
+// IsBroad returns true when the toleration tolerates every taint, at least for one effect.
+func (t *Toleration) IsBroad() bool {
+	return t.Key == "" && t.Operator == existsOperator
+}
+
+// Tolerates returns true when the toleration tolerates the taint.
+func (t *Toleration) Tolerates(taint Taint) bool {
+	if t.Effect != "" && t.Effect != taint.Effect {
+		return false
+	}
+	if t.IsBroad() {
+		return true
+	}
+	if t.Key != taint.Key {
+		return false
+	}
+	return t.Operator == existsOperator || t.Value == taint.Value
+}
+
+// manifest is the subset of a pod or node manifest used by the test.
+type manifest struct {
+	Spec struct {
+		NodeName    string       `json:"nodeName"`
+		Tolerations []Toleration `json:"tolerations"`
+		Taints      []Taint      `json:"taints"`
+	} `json:"spec"`
+}
+
+// Tolerations checks that a pod does not rely on a tolerate-everything toleration to run on its node: every taint of
+// the node preventing the scheduling or execution of pods must be tolerated by a toleration naming its key.
+type Tolerations struct {
+	node        string
+	tolerations []Toleration
+	taints      []Taint
+	violations  []string
+	result      int
+	timeout     time.Duration
+	args        []string
+}
+
+// NewTolerations creates a new Tolerations tnf.Test.
+func NewTolerations(timeout time.Duration, namespace, pod string) *Tolerations {
+	return &Tolerations{
+		timeout: timeout,
+		result:  tnf.ERROR,
+		args: []string{fmt.Sprintf("oc get pod -n %s %s -o json; echo %s; oc get node $(oc get pod -n %s %s -o jsonpath='{.spec.nodeName}') -o json",
+			namespace, pod, taintsMarker, namespace, pod)},
+	}
+}
+
+// Args returns the command line args for the test.
+func (tl *Tolerations) Args() []string {
+	return tl.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (tl *Tolerations) GetIdentifier() identifier.Identifier {
+	return identifier.TolerationsIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (tl *Tolerations) Timeout() time.Duration {
+	return tl.timeout
+}
+
+// Result returns the test result.
+func (tl *Tolerations) Result() int {
+	return tl.result
+}
+
+// GetNode returns the name of the node the pod is scheduled to.
+func (tl *Tolerations) GetNode() string {
+	return tl.node
+}
+
+// GetTolerations returns the tolerations of the pod.
+func (tl *Tolerations) GetTolerations() []Toleration {
+	return tl.tolerations
+}
+
+// GetTaints returns the taints of the node.
+func (tl *Tolerations) GetTaints() []Taint {
+	return tl.taints
+}
+
+// GetViolations returns a description of every taint only tolerated by a tolerate-everything toleration.
+func (tl *Tolerations) GetViolations() []string {
+	return tl.violations
+}
+
+// ReelFirst returns a step which expects the pod and node manifests within the test timeout.
+func (tl *Tolerations) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{tolerationsRegex},
+		Timeout: tl.timeout,
+	}
+}
+
+// ReelMatch parses the pod and node manifests and checks the taints of the node are tolerated explicitly.
+func (tl *Tolerations) ReelMatch(_, _, match string) *reel.Step {
+	tl.violations = nil
+	parts := strings.SplitN(match, taintsMarker, 2)
+	if len(parts) != 2 {
+		tl.result = tnf.ERROR
+		return nil
+	}
+	pod, err := parseManifest(parts[0])
+	if err != nil {
+		tl.result = tnf.ERROR
+		return nil
+	}
+	node, err := parseManifest(parts[1])
+	if err != nil {
+		tl.result = tnf.ERROR
+		return nil
+	}
+	tl.node = pod.Spec.NodeName
+	tl.tolerations = pod.Spec.Tolerations
+	tl.taints = node.Spec.Taints
+	for _, taint := range tl.taints {
+		if taint.Effect == preferNoScheduleTaint {
+			continue
+		}
+		if broad, explicit := tl.tolerated(taint); broad && !explicit {
+			tl.violations = append(tl.violations, fmt.Sprintf("taint %s of node %s is only tolerated by a tolerate-everything toleration", taint, tl.node))
+		}
+	}
+	if len(tl.violations) > 0 {
+		tl.result = tnf.FAILURE
+	} else {
+		tl.result = tnf.SUCCESS
+	}
+	return nil
+}
+
+// tolerated returns whether a taint is tolerated by a tolerate-everything toleration and by a toleration naming its
+// key.
+func (tl *Tolerations) tolerated(taint Taint) (broad, explicit bool) {
+	for i := range tl.tolerations {
+		toleration := &tl.tolerations[i]
+		if !toleration.Tolerates(taint) {
+			continue
+		}
+		if toleration.IsBroad() {
+			broad = true
+		} else {
+			explicit = true
+		}
+	}
+	return broad, explicit
+}
+
+func parseManifest(output string) (*manifest, error) {
+	start := strings.Index(output, "{")
+	if start < 0 {
+		return nil, fmt.Errorf("no JSON output found")
+	}
+	parsed := &manifest{}
+	if err := json.Unmarshal([]byte(strings.TrimSpace(output[start:])), parsed); err != nil {
+		return nil, err
+	}
+	return parsed, nil
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (tl *Tolerations) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (tl *Tolerations) ReelEOF() {
+}
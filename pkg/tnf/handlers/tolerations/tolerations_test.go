@@ -0,0 +1,101 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package tolerations_test
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/tolerations"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testDataDirectory   = "testdata"
+	testTimeoutDuration = time.Second * 2
+)
+
+func getMockOutput(t *testing.T, testName string) string {
+	b, err := os.ReadFile(path.Join(testDataDirectory, testName+".txt"))
+	assert.Nil(t, err)
+	return string(b)
+}
+
+func TestNewTolerations(t *testing.T) {
+	handler := tolerations.NewTolerations(testTimeoutDuration, "tnf", "test-0")
+	assert.Len(t, handler.Args(), 1)
+	assert.Contains(t, handler.Args()[0], "oc get pod -n tnf test-0 -o json")
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.TolerationsIdentifier, handler.GetIdentifier())
+}
+
+func TestTolerations_ReelMatch(t *testing.T) {
+	testCases := map[string]struct {
+		expectedResult     int
+		expectedNode       string
+		expectedViolations []string
+	}{
+		"explicit": {
+			expectedResult: tnf.SUCCESS,
+			expectedNode:   "worker-rt-0",
+		},
+		"broad": {
+			expectedResult: tnf.FAILURE,
+			expectedNode:   "master-0",
+			expectedViolations: []string{
+				"taint node-role.kubernetes.io/master:NoSchedule of node master-0 is only tolerated by a tolerate-everything toleration",
+				"taint node-role.kubernetes.io/rt=false:NoSchedule of node master-0 is only tolerated by a tolerate-everything toleration",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		handler := tolerations.NewTolerations(testTimeoutDuration, "tnf", "test-0")
+		assert.Nil(t, handler.ReelMatch("", "", getMockOutput(t, name)), name)
+		assert.Equal(t, tc.expectedResult, handler.Result(), name)
+		assert.Equal(t, tc.expectedNode, handler.GetNode(), name)
+		assert.Equal(t, tc.expectedViolations, handler.GetViolations(), name)
+	}
+}
+
+func TestTolerations_ReelMatchError(t *testing.T) {
+	handler := tolerations.NewTolerations(testTimeoutDuration, "tnf", "missing")
+	assert.Nil(t, handler.ReelMatch("", "", "Error from server (NotFound): pods \"missing\" not found\nNODE_TAINTS\n"))
+	assert.Equal(t, tnf.ERROR, handler.Result())
+}
+
+func TestToleration_Tolerates(t *testing.T) {
+	taint := tolerations.Taint{Key: "dedicated", Value: "cnf", Effect: "NoSchedule"}
+	testCases := []struct {
+		toleration tolerations.Toleration
+		expected   bool
+	}{
+		{tolerations.Toleration{Operator: "Exists"}, true},
+		{tolerations.Toleration{Operator: "Exists", Effect: "NoExecute"}, false},
+		{tolerations.Toleration{Key: "dedicated", Operator: "Exists"}, true},
+		{tolerations.Toleration{Key: "dedicated", Operator: "Equal", Value: "cnf", Effect: "NoSchedule"}, true},
+		{tolerations.Toleration{Key: "dedicated", Value: "other"}, false},
+		{tolerations.Toleration{Key: "other", Operator: "Exists"}, false},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, tc.toleration.Tolerates(taint), tc.toleration)
+	}
+}
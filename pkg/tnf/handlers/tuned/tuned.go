@@ -0,0 +1,116 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package tuned
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/dependencies"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	tunedRegex = "(?s).+"
+	// NoActiveProfileOutput is printed by tuned-adm when no profile is applied.
+	NoActiveProfileOutput = "No current active profile."
+)
+
+// activeProfileRegex extracts the profile name from the `tuned-adm active` output.
+var activeProfileRegex = regexp.MustCompile(`Current active profile: (\S+)`)
+
+// Tuned checks that the expected TuneD profile is active on a node.
+type Tuned struct {
+	expectedProfile string
+	activeProfile   string
+	result          int
+	timeout         time.Duration
+	args            []string
+}
+
+// NewTuned creates a new Tuned tnf.Test to run in a node debug pod.
+func NewTuned(timeout time.Duration, expectedProfile string) *Tuned {
+	return &Tuned{
+		expectedProfile: expectedProfile,
+		timeout:         timeout,
+		result:          tnf.ERROR,
+		args:            []string{"chroot", "/host", dependencies.TunedAdmBinaryName, "active"},
+	}
+}
+
+// Args returns the command line args for the test.
+func (td *Tuned) Args() []string {
+	return td.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (td *Tuned) GetIdentifier() identifier.Identifier {
+	return identifier.TunedIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (td *Tuned) Timeout() time.Duration {
+	return td.timeout
+}
+
+// Result returns the test result.
+func (td *Tuned) Result() int {
+	return td.result
+}
+
+// GetActiveProfile returns the active TuneD profile, or an empty string when none is applied.
+func (td *Tuned) GetActiveProfile() string {
+	return td.activeProfile
+}
+
+// ReelFirst returns a step which expects the `tuned-adm active` output within the test timeout.
+func (td *Tuned) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{tunedRegex},
+		Timeout: td.timeout,
+	}
+}
+
+// ReelMatch extracts the active profile and compares it to the expected one.  An output that is neither an active
+// profile nor the no-profile message is reported as an error, e.g. when the tuned daemon is not running.
+func (td *Tuned) ReelMatch(_, _, match string) *reel.Step {
+	td.activeProfile = ""
+	if matched := activeProfileRegex.FindStringSubmatch(match); matched != nil {
+		td.activeProfile = matched[1]
+	} else if !strings.Contains(match, NoActiveProfileOutput) {
+		td.result = tnf.ERROR
+		return nil
+	}
+	if td.activeProfile == td.expectedProfile {
+		td.result = tnf.SUCCESS
+	} else {
+		td.result = tnf.FAILURE
+	}
+	return nil
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (td *Tuned) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (td *Tuned) ReelEOF() {
+}
@@ -0,0 +1,70 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package tuned_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/tuned"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testTimeoutDuration = time.Second * 2
+	testExpectedProfile = "openshift-node-performance-performance"
+	testInputActive     = "Current active profile: openshift-node-performance-performance\n"
+	testInputOther      = "Current active profile: openshift-node\n"
+	testInputNone       = "No current active profile.\n"
+	testInputError      = "Cannot talk to TuneD daemon via DBus. Is TuneD daemon running?\n"
+)
+
+func TestNewTuned(t *testing.T) {
+	handler := tuned.NewTuned(testTimeoutDuration, testExpectedProfile)
+	assert.Equal(t, []string{"chroot", "/host", "tuned-adm", "active"}, handler.Args())
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.TunedIdentifier, handler.GetIdentifier())
+}
+
+func TestTuned_ReelMatch(t *testing.T) {
+	testCases := map[string]struct {
+		input           string
+		expectedResult  int
+		expectedProfile string
+	}{
+		"expected_profile": {testInputActive, tnf.SUCCESS, testExpectedProfile},
+		"other_profile":    {testInputOther, tnf.FAILURE, "openshift-node"},
+		"no_profile":       {testInputNone, tnf.FAILURE, ""},
+		"daemon_down":      {testInputError, tnf.ERROR, ""},
+	}
+	for testName, testCase := range testCases {
+		handler := tuned.NewTuned(testTimeoutDuration, testExpectedProfile)
+		assert.Nil(t, handler.ReelMatch("", "", testCase.input))
+		assert.Equal(t, testCase.expectedResult, handler.Result(), testName)
+		assert.Equal(t, testCase.expectedProfile, handler.GetActiveProfile(), testName)
+	}
+}
+
+// Ensure there are no panics.
+func TestTuned_ReelEof(t *testing.T) {
+	handler := tuned.NewTuned(testTimeoutDuration, testExpectedProfile)
+	assert.Nil(t, handler.ReelTimeout())
+	handler.ReelEOF()
+}
@@ -0,0 +1,200 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package users
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/dependencies"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	usersRegex = "(?s).+"
+	// UndeclaredUID is passed to NewUsers when the pod spec does not declare a runAsUser.
+	UndeclaredUID = -1
+
+	rootUID          = 0
+	rootName         = "root"
+	passwdSeparator  = ":"
+	numPasswdFields  = 7
+	passwdUIDField   = 2
+	passwdGIDField   = 3
+	passwdHomeField  = 5
+	passwdShellField = 6
+)
+
+// runningUIDRegex extracts the UID of the container process.
+var runningUIDRegex = regexp.MustCompile(`(?m)^RUNNING_UID=(\d+)$`)
+
+// User describes an /etc/passwd entry.
+type User struct {
+	Name  string
+	UID   int
+	GID   int
+	Home  string
+	Shell string
+}
+
+// Users checks that the container runs as an existing non-root user and that root is the only UID 0 user.
+type Users struct {
+	declaredUID int
+	runningUID  int
+	users       []User
+	violations  []string
+	result      int
+	timeout     time.Duration
+	args        []string
+}
+
+// NewUsers creates a new Users tnf.Test to run in a container.  declaredUID is the runAsUser of the pod spec, or
+// UndeclaredUID when none is set, in which case the UID reported by `id -u` is checked instead.
+func NewUsers(timeout time.Duration, declaredUID int) *Users {
+	return &Users{
+		declaredUID: declaredUID,
+		runningUID:  UndeclaredUID,
+		timeout:     timeout,
+		result:      tnf.ERROR,
+		args: []string{fmt.Sprintf("%s RUNNING_UID=$(%s -u); %s /etc/passwd",
+			dependencies.EchoBinaryName, dependencies.IDBinaryName, dependencies.CatBinaryName)},
+	}
+}
+
+// Args returns the command line args for the test.
+func (u *Users) Args() []string {
+	return u.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (u *Users) GetIdentifier() identifier.Identifier {
+	return identifier.UsersIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (u *Users) Timeout() time.Duration {
+	return u.timeout
+}
+
+// Result returns the test result.
+func (u *Users) Result() int {
+	return u.result
+}
+
+// GetUsers returns the users defined in /etc/passwd.
+func (u *Users) GetUsers() []User {
+	return u.users
+}
+
+// GetRunningUID returns the UID of the container process, or UndeclaredUID when it could not be read.
+func (u *Users) GetRunningUID() int {
+	return u.runningUID
+}
+
+// GetViolations returns a description of every failed check.
+func (u *Users) GetViolations() []string {
+	return u.violations
+}
+
+// ReelFirst returns a step which expects the UID and /etc/passwd content within the test timeout.
+func (u *Users) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{usersRegex},
+		Timeout: u.timeout,
+	}
+}
+
+// ReelMatch parses /etc/passwd and checks the user the container runs as.
+func (u *Users) ReelMatch(_, _, match string) *reel.Step {
+	u.violations = nil
+	matched := runningUIDRegex.FindStringSubmatch(match)
+	if matched == nil {
+		u.result = tnf.ERROR
+		return nil
+	}
+	u.runningUID, _ = strconv.Atoi(matched[1])
+	u.users = ParsePasswd(match)
+
+	uid := u.declaredUID
+	if uid == UndeclaredUID {
+		uid = u.runningUID
+	}
+	if uid == rootUID {
+		u.violations = append(u.violations, "container runs as root")
+	} else if !u.hasUID(uid) {
+		u.violations = append(u.violations, fmt.Sprintf("UID %d is not defined in /etc/passwd", uid))
+	}
+	for _, user := range u.users {
+		if user.UID == rootUID && user.Name != rootName {
+			u.violations = append(u.violations, fmt.Sprintf("user %s has UID 0", user.Name))
+		}
+	}
+
+	if len(u.violations) > 0 {
+		u.result = tnf.FAILURE
+	} else {
+		u.result = tnf.SUCCESS
+	}
+	return nil
+}
+
+func (u *Users) hasUID(uid int) bool {
+	for _, user := range u.users {
+		if user.UID == uid {
+			return true
+		}
+	}
+	return false
+}
+
+// ParsePasswd returns the users of the /etc/passwd lines found in output, ignoring any other line.
+func ParsePasswd(output string) []User {
+	var users []User
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Split(strings.TrimSpace(line), passwdSeparator)
+		if len(fields) != numPasswdFields {
+			continue
+		}
+		uid, err := strconv.Atoi(fields[passwdUIDField])
+		if err != nil {
+			continue
+		}
+		gid, _ := strconv.Atoi(fields[passwdGIDField])
+		users = append(users, User{
+			Name:  fields[0],
+			UID:   uid,
+			GID:   gid,
+			Home:  fields[passwdHomeField],
+			Shell: fields[passwdShellField],
+		})
+	}
+	return users
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (u *Users) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (u *Users) ReelEOF() {
+}
@@ -0,0 +1,109 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package users_test
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/users"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testDataDirectory   = "testdata"
+	testDataFileSuffix  = ".txt"
+	testTimeoutDuration = time.Second * 2
+)
+
+type TestCase struct {
+	fileName           string
+	declaredUID        int
+	expectedResult     int
+	expectedViolations []string
+}
+
+var testCases = map[string]TestCase{
+	"running_uid_exists": {
+		fileName:       "passwd",
+		declaredUID:    users.UndeclaredUID,
+		expectedResult: tnf.SUCCESS,
+	},
+	"declared_uid_exists": {
+		fileName:       "passwd",
+		declaredUID:    65534,
+		expectedResult: tnf.SUCCESS,
+	},
+	"declared_uid_missing": {
+		fileName:           "passwd",
+		declaredUID:        1001,
+		expectedResult:     tnf.FAILURE,
+		expectedViolations: []string{"UID 1001 is not defined in /etc/passwd"},
+	},
+	"root_and_alias": {
+		fileName:           "passwd_root_alias",
+		declaredUID:        users.UndeclaredUID,
+		expectedResult:     tnf.FAILURE,
+		expectedViolations: []string{"container runs as root", "user toor has UID 0"},
+	},
+}
+
+func getMockOutput(t *testing.T, fileName string) string {
+	b, err := os.ReadFile(path.Join(testDataDirectory, fileName+testDataFileSuffix))
+	assert.Nil(t, err)
+	return string(b)
+}
+
+func TestNewUsers(t *testing.T) {
+	handler := users.NewUsers(testTimeoutDuration, users.UndeclaredUID)
+	assert.Equal(t, []string{"echo RUNNING_UID=$(id -u); cat /etc/passwd"}, handler.Args())
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.UsersIdentifier, handler.GetIdentifier())
+}
+
+func TestUsers_ReelMatch(t *testing.T) {
+	for testName, testCase := range testCases {
+		handler := users.NewUsers(testTimeoutDuration, testCase.declaredUID)
+		assert.Nil(t, handler.ReelMatch("", "", getMockOutput(t, testCase.fileName)))
+		assert.Equal(t, testCase.expectedResult, handler.Result(), testName)
+		assert.Equal(t, testCase.expectedViolations, handler.GetViolations(), testName)
+	}
+}
+
+func TestUsers_ReelMatchNoUID(t *testing.T) {
+	handler := users.NewUsers(testTimeoutDuration, users.UndeclaredUID)
+	assert.Nil(t, handler.ReelMatch("", "", "sh: id: command not found\n"))
+	assert.Equal(t, tnf.ERROR, handler.Result())
+}
+
+func TestParsePasswd(t *testing.T) {
+	parsed := users.ParsePasswd(getMockOutput(t, "passwd"))
+	assert.Len(t, parsed, 5)
+	assert.Equal(t, users.User{Name: "cnf", UID: 1000680000, GID: 0, Home: "/home/cnf", Shell: "/sbin/nologin"}, parsed[4])
+}
+
+// Ensure there are no panics.
+func TestUsers_ReelEof(t *testing.T) {
+	handler := users.NewUsers(testTimeoutDuration, users.UndeclaredUID)
+	assert.Nil(t, handler.ReelTimeout())
+	handler.ReelEOF()
+}
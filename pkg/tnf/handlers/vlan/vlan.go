@@ -0,0 +1,164 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package vlan
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/dependencies"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	vlanRegex = "(?s).+"
+)
+
+var (
+	// linkHeaderRegex matches the first line of a link, e.g. "3: net1@if2: <BROADCAST,MULTICAST,UP,LOWER_UP> mtu 1500".
+	linkHeaderRegex = regexp.MustCompile(`^\d+: ([^:@\s]+)(?:@([^:\s]+))?:`)
+	// vlanDetailsRegex matches the vlan details of a link, e.g. "vlan protocol 802.1Q id 100 <REORDER_HDR>".
+	vlanDetailsRegex = regexp.MustCompile(`^vlan protocol (\S+) id (\d+)`)
+)
+
+// Interface describes a VLAN sub-interface.
+type Interface struct {
+	// ID is the VLAN id.
+	ID int
+	// Parent is the parent device as reported after the "@" by `ip link`; it shows as ifN when the parent device is
+	// in another network namespace.
+	Parent string
+	// Protocol is the VLAN protocol, i.e. 802.1Q or 802.1ad.
+	Protocol string
+}
+
+// VLAN checks that VLAN sub-interfaces exist with the expected VLAN ids and parent devices.
+type VLAN struct {
+	expected   map[string]Interface
+	interfaces map[string]Interface
+	mismatched []string
+	result     int
+	timeout    time.Duration
+	args       []string
+}
+
+// NewVLAN creates a new VLAN tnf.Test.  expected maps interface names to the expected VLAN sub-interface; an empty
+// Parent or Protocol is not compared.
+func NewVLAN(timeout time.Duration, expected map[string]Interface) *VLAN {
+	return &VLAN{
+		expected: expected,
+		timeout:  timeout,
+		result:   tnf.ERROR,
+		args:     []string{dependencies.IPBinaryName, "-d", "link", "show"},
+	}
+}
+
+// Args returns the command line args for the test.
+func (v *VLAN) Args() []string {
+	return v.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (v *VLAN) GetIdentifier() identifier.Identifier {
+	return identifier.VLANIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (v *VLAN) Timeout() time.Duration {
+	return v.timeout
+}
+
+// Result returns the test result.
+func (v *VLAN) Result() int {
+	return v.result
+}
+
+// GetInterfaces returns all the VLAN sub-interfaces found, keyed by interface name.
+func (v *VLAN) GetInterfaces() map[string]Interface {
+	return v.interfaces
+}
+
+// GetMismatches returns a description of every expected interface that is missing or differs.
+func (v *VLAN) GetMismatches() []string {
+	return v.mismatched
+}
+
+// ReelFirst returns a step which expects the link details within the test timeout.
+func (v *VLAN) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{vlanRegex},
+		Timeout: v.timeout,
+	}
+}
+
+// ReelMatch parses the `ip -d link show` output and compares the VLAN sub-interfaces to the expected ones.
+func (v *VLAN) ReelMatch(_, _, match string) *reel.Step {
+	v.interfaces = map[string]Interface{}
+	v.mismatched = nil
+	var name, parent string
+	for _, line := range strings.Split(match, "\n") {
+		line = strings.TrimSpace(line)
+		if matched := linkHeaderRegex.FindStringSubmatch(line); matched != nil {
+			name, parent = matched[1], matched[2]
+			continue
+		}
+		if matched := vlanDetailsRegex.FindStringSubmatch(line); matched != nil && name != "" {
+			id, _ := strconv.Atoi(matched[2])
+			v.interfaces[name] = Interface{ID: id, Parent: parent, Protocol: matched[1]}
+		}
+	}
+
+	names := make([]string, 0, len(v.expected))
+	for iface := range v.expected {
+		names = append(names, iface)
+	}
+	sort.Strings(names)
+	for _, iface := range names {
+		expected := v.expected[iface]
+		found, ok := v.interfaces[iface]
+		switch {
+		case !ok:
+			v.mismatched = append(v.mismatched, fmt.Sprintf("%s: not a vlan interface", iface))
+		case found.ID != expected.ID ||
+			(expected.Parent != "" && found.Parent != expected.Parent) ||
+			(expected.Protocol != "" && found.Protocol != expected.Protocol):
+			v.mismatched = append(v.mismatched, fmt.Sprintf("%s: vlan %d on %s", iface, found.ID, found.Parent))
+		}
+	}
+
+	if len(v.mismatched) > 0 {
+		v.result = tnf.FAILURE
+	} else {
+		v.result = tnf.SUCCESS
+	}
+	return nil
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (v *VLAN) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (v *VLAN) ReelEOF() {
+}
@@ -0,0 +1,107 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package vlan_test
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/vlan"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testDataDirectory   = "testdata"
+	testDataFileSuffix  = ".txt"
+	testTimeoutDuration = time.Second * 2
+	testLinks           = "links"
+)
+
+type TestCase struct {
+	expected           map[string]vlan.Interface
+	expectedResult     int
+	expectedMismatches []string
+}
+
+var testCases = map[string]TestCase{
+	"vlans_match": {
+		expected: map[string]vlan.Interface{
+			"net1":     {ID: 100},
+			"net2.200": {ID: 200, Parent: "net2", Protocol: "802.1ad"},
+		},
+		expectedResult: tnf.SUCCESS,
+	},
+	"wrong_id": {
+		expected: map[string]vlan.Interface{
+			"net1": {ID: 101},
+		},
+		expectedResult:     tnf.FAILURE,
+		expectedMismatches: []string{"net1: vlan 100 on if6"},
+	},
+	"not_a_vlan": {
+		expected: map[string]vlan.Interface{
+			"eth0": {ID: 100},
+		},
+		expectedResult:     tnf.FAILURE,
+		expectedMismatches: []string{"eth0: not a vlan interface"},
+	},
+}
+
+func getMockOutput(t *testing.T, testName string) string {
+	b, err := os.ReadFile(path.Join(testDataDirectory, fmt.Sprintf("%s%s", testName, testDataFileSuffix)))
+	assert.Nil(t, err)
+	return string(b)
+}
+
+func TestNewVLAN(t *testing.T) {
+	handler := vlan.NewVLAN(testTimeoutDuration, nil)
+	assert.Equal(t, []string{"ip", "-d", "link", "show"}, handler.Args())
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.VLANIdentifier, handler.GetIdentifier())
+}
+
+func TestVLAN_ReelMatch(t *testing.T) {
+	for testName, testCase := range testCases {
+		handler := vlan.NewVLAN(testTimeoutDuration, testCase.expected)
+		step := handler.ReelMatch("", "", getMockOutput(t, testLinks))
+		assert.Nil(t, step)
+		assert.Equal(t, testCase.expectedResult, handler.Result(), testName)
+		assert.Equal(t, testCase.expectedMismatches, handler.GetMismatches(), testName)
+	}
+}
+
+func TestVLAN_GetInterfaces(t *testing.T) {
+	handler := vlan.NewVLAN(testTimeoutDuration, nil)
+	handler.ReelMatch("", "", getMockOutput(t, testLinks))
+	assert.Equal(t, map[string]vlan.Interface{
+		"net1":     {ID: 100, Parent: "if6", Protocol: "802.1Q"},
+		"net2.200": {ID: 200, Parent: "net2", Protocol: "802.1ad"},
+	}, handler.GetInterfaces())
+}
+
+// Ensure there are no panics.
+func TestVLAN_ReelEof(t *testing.T) {
+	handler := vlan.NewVLAN(testTimeoutDuration, nil)
+	assert.Nil(t, handler.ReelTimeout())
+	handler.ReelEOF()
+}
@@ -0,0 +1,214 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package workloadspread
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+const (
+	wsRegex = "(?s).+"
+	// podsMarker separates the workload listing from the pod listing.
+	podsMarker = "WORKLOAD_PODS"
+	noneValue  = "<none>"
+
+	numWorkloadFields = 3
+	numPodFields      = 4
+	replicaSetKind    = "ReplicaSet"
+	// DeploymentKind is the kind of a Deployment workload.
+	DeploymentKind = "Deployment"
+	// StatefulSetKind is the kind of a StatefulSet workload.
+	StatefulSetKind = "StatefulSet"
+)
+
+// Workload describes a Deployment or StatefulSet and the nodes its pods run on.
+type Workload struct {
+	Kind     string
+	Name     string
+	Replicas int
+	// Nodes maps the node names to the number of pods of the workload they run.
+	Nodes map[string]int
+}
+
+// Key returns the "Kind/Name" key of the workload.
+func (w *Workload) Key() string {
+	return w.Kind + "/" + w.Name
+}
+
+// Pods returns the number of scheduled pods of the workload.
+func (w *Workload) Pods() int {
+	pods := 0
+	for _, count := range w.Nodes {
+		pods += count
+	}
+	return pods
+}
+
+// WorkloadSpread checks that the Deployments and StatefulSets of a namespace run at least a minimum number of replicas
+// and that their pods are not all scheduled on the same node.
+type WorkloadSpread struct {
+	minReplicas int
+	workloads   map[string]*Workload
+	violations  []string
+	result      int
+	timeout     time.Duration
+	args        []string
+}
+
+// NewWorkloadSpread creates a new WorkloadSpread tnf.Test.  Pods are related to their Deployment through the owning
+// ReplicaSet, whose name is the Deployment name followed by the pod template hash.
+func NewWorkloadSpread(timeout time.Duration, namespace string, minReplicas int) *WorkloadSpread {
+	return &WorkloadSpread{
+		minReplicas: minReplicas,
+		timeout:     timeout,
+		result:      tnf.ERROR,
+		args: []string{fmt.Sprintf("oc get deployments,statefulsets -n %s -o custom-columns=KIND:.kind,NAME:.metadata.name,REPLICAS:.spec.replicas; "+
+			"echo %s; "+
+			"oc get pods -n %s -o custom-columns=NAME:.metadata.name,NODE:.spec.nodeName,OWNERKIND:.metadata.ownerReferences[0].kind,OWNER:.metadata.ownerReferences[0].name",
+			namespace, podsMarker, namespace)},
+	}
+}
+
+// Args returns the command line args for the test.
+func (ws *WorkloadSpread) Args() []string {
+	return ws.args
+}
+
+// GetIdentifier returns the tnf.Test specific identifier.
+func (ws *WorkloadSpread) GetIdentifier() identifier.Identifier {
+	return identifier.WorkloadSpreadIdentifier
+}
+
+// Timeout returns the timeout in seconds for the test.
+func (ws *WorkloadSpread) Timeout() time.Duration {
+	return ws.timeout
+}
+
+// Result returns the test result.
+func (ws *WorkloadSpread) Result() int {
+	return ws.result
+}
+
+// GetWorkloads returns the workloads of the namespace, keyed by "Kind/Name".
+func (ws *WorkloadSpread) GetWorkloads() map[string]*Workload {
+	return ws.workloads
+}
+
+// GetViolations returns a description of every workload with too few replicas or all its pods on one node.
+func (ws *WorkloadSpread) GetViolations() []string {
+	return ws.violations
+}
+
+// ReelFirst returns a step which expects the workload and pod listings within the test timeout.
+func (ws *WorkloadSpread) ReelFirst() *reel.Step {
+	return &reel.Step{
+		Expect:  []string{wsRegex},
+		Timeout: ws.timeout,
+	}
+}
+
+// ReelMatch parses the workloads and their pods and checks the replicas and their spread.
+func (ws *WorkloadSpread) ReelMatch(_, _, match string) *reel.Step {
+	ws.violations = nil
+	parts := strings.SplitN(match, podsMarker, 2)
+	if len(parts) != 2 {
+		ws.result = tnf.ERROR
+		return nil
+	}
+	ws.workloads = ParseWorkloads(parts[0])
+	ws.assignPods(parts[1])
+
+	keys := make([]string, 0, len(ws.workloads))
+	for key := range ws.workloads {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		workload := ws.workloads[key]
+		if workload.Replicas < ws.minReplicas {
+			ws.violations = append(ws.violations, fmt.Sprintf("%s has %d replicas, fewer than %d", key, workload.Replicas, ws.minReplicas))
+			continue
+		}
+		if workload.Pods() > 1 && len(workload.Nodes) == 1 {
+			for node := range workload.Nodes {
+				ws.violations = append(ws.violations, fmt.Sprintf("%s runs all its %d pods on node %s", key, workload.Pods(), node))
+			}
+		}
+	}
+	if len(ws.violations) > 0 {
+		ws.result = tnf.FAILURE
+	} else {
+		ws.result = tnf.SUCCESS
+	}
+	return nil
+}
+
+// ParseWorkloads parses the KIND, NAME and REPLICAS columns of `oc get deployments,statefulsets`, keying the workloads
+// by "Kind/Name".
+func ParseWorkloads(output string) map[string]*Workload {
+	workloads := map[string]*Workload{}
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != numWorkloadFields || fields[0] == "KIND" {
+			continue
+		}
+		replicas, err := strconv.Atoi(fields[2])
+		if err != nil {
+			continue
+		}
+		workload := &Workload{Kind: fields[0], Name: fields[1], Replicas: replicas, Nodes: map[string]int{}}
+		workloads[workload.Key()] = workload
+	}
+	return workloads
+}
+
+// assignPods counts the scheduled pods of each workload per node.
+func (ws *WorkloadSpread) assignPods(output string) {
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != numPodFields || fields[0] == "NAME" || fields[1] == noneValue {
+			continue
+		}
+		kind, owner := fields[2], fields[3]
+		if kind == replicaSetKind {
+			// Strip the pod template hash to get the Deployment name.
+			if i := strings.LastIndex(owner, "-"); i > 0 {
+				kind, owner = DeploymentKind, owner[:i]
+			}
+		}
+		if workload, ok := ws.workloads[kind+"/"+owner]; ok {
+			workload.Nodes[fields[1]]++
+		}
+	}
+}
+
+// ReelTimeout does nothing;  no action is necessary upon timeout.
+func (ws *WorkloadSpread) ReelTimeout() *reel.Step {
+	return nil
+}
+
+// ReelEOF does nothing;  no action is necessary upon EOF.
+func (ws *WorkloadSpread) ReelEOF() {
+}
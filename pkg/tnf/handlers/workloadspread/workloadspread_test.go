@@ -0,0 +1,105 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package workloadspread_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/workloadspread"
+	"github.com/test-network-function/test-network-function/pkg/tnf/identifier"
+)
+
+const (
+	testTimeoutDuration = time.Second * 2
+
+	outputSpread = `KIND          NAME     REPLICAS
+Deployment    test     2
+StatefulSet   db       3
+WORKLOAD_PODS
+NAME                   NODE       OWNERKIND     OWNER
+test-7d9f8b6c5-abcde   worker-0   ReplicaSet    test-7d9f8b6c5
+test-7d9f8b6c5-fghij   worker-1   ReplicaSet    test-7d9f8b6c5
+db-0                   worker-0   StatefulSet   db
+db-1                   worker-1   StatefulSet   db
+db-2                   worker-2   StatefulSet   db
+debug                  worker-0   <none>        <none>
+`
+	outputSameNode = `KIND          NAME     REPLICAS
+Deployment    test     2
+Deployment    single   1
+WORKLOAD_PODS
+NAME                   NODE       OWNERKIND     OWNER
+test-7d9f8b6c5-abcde   worker-0   ReplicaSet    test-7d9f8b6c5
+test-7d9f8b6c5-fghij   worker-0   ReplicaSet    test-7d9f8b6c5
+single-5c4b8d7f9-klmno worker-1   ReplicaSet    single-5c4b8d7f9
+`
+	outputError = `error: the server doesn't have a resource type "statefulsets"`
+)
+
+func TestNewWorkloadSpread(t *testing.T) {
+	handler := workloadspread.NewWorkloadSpread(testTimeoutDuration, "tnf", 2)
+	assert.Len(t, handler.Args(), 1)
+	assert.Contains(t, handler.Args()[0], "oc get deployments,statefulsets -n tnf")
+	assert.Equal(t, tnf.ERROR, handler.Result())
+	assert.Equal(t, testTimeoutDuration, handler.Timeout())
+	assert.Equal(t, identifier.WorkloadSpreadIdentifier, handler.GetIdentifier())
+}
+
+func TestWorkloadSpread_ReelMatch(t *testing.T) {
+	testCases := map[string]struct {
+		output             string
+		expectedResult     int
+		expectedViolations []string
+	}{
+		"spread": {output: outputSpread, expectedResult: tnf.SUCCESS},
+		"same_node": {
+			output:         outputSameNode,
+			expectedResult: tnf.FAILURE,
+			expectedViolations: []string{
+				"Deployment/single has 1 replicas, fewer than 2",
+				"Deployment/test runs all its 2 pods on node worker-0",
+			},
+		},
+		"error": {output: outputError, expectedResult: tnf.ERROR},
+	}
+	for name, tc := range testCases {
+		handler := workloadspread.NewWorkloadSpread(testTimeoutDuration, "tnf", 2)
+		assert.Nil(t, handler.ReelMatch("", "", tc.output), name)
+		assert.Equal(t, tc.expectedResult, handler.Result(), name)
+		assert.Equal(t, tc.expectedViolations, handler.GetViolations(), name)
+	}
+}
+
+func TestWorkloadSpread_GetWorkloads(t *testing.T) {
+	handler := workloadspread.NewWorkloadSpread(testTimeoutDuration, "tnf", 2)
+	handler.ReelMatch("", "", outputSpread)
+	workloads := handler.GetWorkloads()
+	assert.Len(t, workloads, 2)
+	assert.Equal(t, map[string]int{"worker-0": 1, "worker-1": 1}, workloads["Deployment/test"].Nodes)
+	assert.Equal(t, 3, workloads["StatefulSet/db"].Pods())
+	assert.Equal(t, workloadspread.StatefulSetKind, workloads["StatefulSet/db"].Kind)
+}
+
+// Just ensure there are no panics.
+func TestWorkloadSpread_ReelEOF(t *testing.T) {
+	handler := workloadspread.NewWorkloadSpread(testTimeoutDuration, "tnf", 2)
+	assert.Nil(t, handler.ReelTimeout())
+	handler.ReelEOF()
+}
@@ -63,6 +63,60 @@ const (
 	clusterVersionIdentifierURL           = "http://test-network-function.com/tests/clusterVersion"
 	crdStatusExistenceIdentifierURL       = "http://test-network-function.com/tests/crdStatusExistence"
 	daemonSetIdentifierURL                = "http://test-network-function.com/tests/daemonset"
+	diskUsageIdentifierURL                = "http://test-network-function.com/tests/diskusage"
+	resourceUsageIdentifierURL            = "http://test-network-function.com/tests/resourceusage"
+	certExpiryIdentifierURL               = "http://test-network-function.com/tests/certexpiry"
+	firewallRulesIdentifierURL            = "http://test-network-function.com/tests/firewallrules"
+	imageInspectIdentifierURL             = "http://test-network-function.com/tests/imageinspect"
+	nodeOSIdentifierURL                   = "http://test-network-function.com/tests/nodeos"
+	cpuPinningIdentifierURL               = "http://test-network-function.com/tests/cpupinning"
+	numaAlignmentIdentifierURL            = "http://test-network-function.com/tests/numaalignment"
+	podHugepagesIdentifierURL             = "http://test-network-function.com/tests/podhugepages"
+	lldpIdentifierURL                     = "http://test-network-function.com/tests/lldp"
+	vlanIdentifierURL                     = "http://test-network-function.com/tests/vlan"
+	multusInterfacesIdentifierURL         = "http://test-network-function.com/tests/multusinterfaces"
+	conntrackIdentifierURL                = "http://test-network-function.com/tests/conntrack"
+	firewalldIdentifierURL                = "http://test-network-function.com/tests/firewalld"
+	kernelCmdlineIdentifierURL            = "http://test-network-function.com/tests/kernelcmdline"
+	tunedIdentifierURL                    = "http://test-network-function.com/tests/tuned"
+	nicDriverIdentifierURL                = "http://test-network-function.com/tests/nicdriver"
+	iperfIdentifierURL                    = "http://test-network-function.com/tests/iperf"
+	usersIdentifierURL                    = "http://test-network-function.com/tests/users"
+	rlimitsIdentifierURL                  = "http://test-network-function.com/tests/rlimits"
+	crictlVersionIdentifierURL            = "http://test-network-function.com/tests/crictlversion"
+	crictlPodsIdentifierURL               = "http://test-network-function.com/tests/crictlpods"
+	nodeKernelsIdentifierURL              = "http://test-network-function.com/tests/nodekernels"
+	packagesIdentifierURL                 = "http://test-network-function.com/tests/packages"
+	clockSkewIdentifierURL                = "http://test-network-function.com/tests/clockskew"
+	fdUsageIdentifierURL                  = "http://test-network-function.com/tests/fdusage"
+	listeningPortsIdentifierURL           = "http://test-network-function.com/tests/listeningports"
+	capabilitiesIdentifierURL             = "http://test-network-function.com/tests/capabilities"
+	podResourcesIdentifierURL             = "http://test-network-function.com/tests/podresources"
+	workloadSpreadIdentifierURL           = "http://test-network-function.com/tests/workloadspread"
+	pdbCoverageIdentifierURL              = "http://test-network-function.com/tests/pdbcoverage"
+	rbacScopeIdentifierURL                = "http://test-network-function.com/tests/rbacscope"
+	clusterRoleRulesIdentifierURL         = "http://test-network-function.com/tests/clusterrolerules"
+	olmInstallIdentifierURL               = "http://test-network-function.com/tests/olminstall"
+	crdConformanceIdentifierURL           = "http://test-network-function.com/tests/crdconformance"
+	helmReleasesIdentifierURL             = "http://test-network-function.com/tests/helmreleases"
+	helmHooksIdentifierURL                = "http://test-network-function.com/tests/helmhooks"
+	helmDriftIdentifierURL                = "http://test-network-function.com/tests/helmdrift"
+	nodeDrainIdentifierURL                = "http://test-network-function.com/tests/nodedrain"
+	tolerationsIdentifierURL              = "http://test-network-function.com/tests/tolerations"
+	securityContextIdentifierURL          = "http://test-network-function.com/tests/securitycontext"
+	secretHygieneIdentifierURL            = "http://test-network-function.com/tests/secret-hygiene"
+	configMapsIdentifierURL               = "http://test-network-function.com/tests/configmaps"
+	serviceMeshIdentifierURL              = "http://test-network-function.com/tests/service-mesh"
+	clusterPlatformIdentifierURL          = "http://test-network-function.com/tests/cluster-platform"
+	cniPluginsIdentifierURL               = "http://test-network-function.com/tests/cni-plugins"
+	nodePressureIdentifierURL             = "http://test-network-function.com/tests/node-pressure"
+	podPlacementIdentifierURL             = "http://test-network-function.com/tests/pod-placement"
+	podStartupIdentifierURL               = "http://test-network-function.com/tests/pod-startup"
+	networkPartitionIdentifierURL         = "http://test-network-function.com/tests/network-partition"
+	podTerminationIdentifierURL           = "http://test-network-function.com/tests/pod-termination"
+	curlIdentifierURL                     = "http://test-network-function.com/tests/curl"
+	fsRoundTripIdentifierURL              = "http://test-network-function.com/tests/fsroundtrip"
+	nodeServicesIdentifierURL             = "http://test-network-function.com/tests/nodeservices"
 	versionOne                            = "v1.0.0"
 )
 
@@ -661,6 +715,684 @@ var Catalog = map[string]TestCatalogEntry{
 			dependencies.OcBinaryName,
 		},
 	},
+	diskUsageIdentifierURL: {
+		Identifier:  DiskUsageIdentifier,
+		Description: "A generic test used to check that mount points exist and have enough free space.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.DfBinaryName,
+		},
+	},
+	resourceUsageIdentifierURL: {
+		Identifier:  ResourceUsageIdentifier,
+		Description: "A generic test used to sample the CPU and memory usage of a container from its cgroup and check them against ceilings.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.HeadBinaryName,
+			dependencies.SleepBinaryName,
+		},
+	},
+	certExpiryIdentifierURL: {
+		Identifier:  CertExpiryIdentifier,
+		Description: "A generic test used to check that a TLS certificate, served by an endpoint or read from a file, is valid for a minimum number of days.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.EchoBinaryName,
+			dependencies.OpensslBinaryName,
+		},
+	},
+	firewallRulesIdentifierURL: {
+		Identifier:  FirewallRulesIdentifier,
+		Description: "A generic test used to dump the iptables or nftables ruleset of a node or pod and check that given rules are present or absent.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.IptablesSaveBinaryName,
+			dependencies.NftBinaryName,
+		},
+	},
+	imageInspectIdentifierURL: {
+		Identifier:  ImageInspectIdentifier,
+		Description: "A generic test used to inspect a container image reference and extract its digest, labels and layers.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.SkopeoBinaryName,
+		},
+	},
+	nodeOSIdentifierURL: {
+		Identifier:  NodeOSIdentifier,
+		Description: "A generic test used to identify the operating system, kernel release and rpm-ostree deployment of a node.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.CatBinaryName,
+			dependencies.RpmOstreeBinaryName,
+		},
+	},
+	cpuPinningIdentifierURL: {
+		Identifier:  CPUPinningIdentifier,
+		Description: "A generic test used to check that the processes of a container are pinned to the exclusive CPUs of the container.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.CatBinaryName,
+			dependencies.GrepBinaryName,
+		},
+	},
+	numaAlignmentIdentifierURL: {
+		Identifier:  NUMAAlignmentIdentifier,
+		Description: "A generic test used to check that the CPUs, hugepages and PCI devices of a pod belong to the same NUMA node.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.LscpuBinaryName,
+			dependencies.GrepBinaryName,
+			dependencies.CatBinaryName,
+		},
+	},
+	podHugepagesIdentifierURL: {
+		Identifier:  PodHugepagesIdentifier,
+		Description: "A generic test used to verify that the hugepages requested by a container are available in its hugetlb cgroup and in use.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.GrepBinaryName,
+		},
+	},
+	lldpIdentifierURL: {
+		Identifier:  LLDPIdentifier,
+		Description: "A generic test used to report the LLDP switch port neighbors of node interfaces and compare them to the expected cabling.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.LldpctlBinaryName,
+		},
+	},
+	vlanIdentifierURL: {
+		Identifier:  VLANIdentifier,
+		Description: "A generic test used to verify that VLAN sub-interfaces exist with the expected VLAN ids and parent devices.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.IPBinaryName,
+		},
+	},
+	multusInterfacesIdentifierURL: {
+		Identifier:  MultusInterfacesIdentifier,
+		Description: "A generic test used to verify that every Multus attachment declared in the networks-status annotation of a pod has an interface with the declared IPs.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.IPBinaryName,
+		},
+	},
+	conntrackIdentifierURL: {
+		Identifier:  ConntrackIdentifier,
+		Description: "A generic test used to list the conntrack entries of a node, check that expected flows exist and that the table utilization is under a threshold.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.ConntrackBinaryName,
+			dependencies.CatBinaryName,
+		},
+	},
+	firewalldIdentifierURL: {
+		Identifier:  FirewalldIdentifier,
+		Description: "A generic test used to check that the ports and services required by a CNF are opened in an active firewalld zone of a node.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.FirewallCmdBinaryName,
+		},
+	},
+	kernelCmdlineIdentifierURL: {
+		Identifier:  KernelCmdlineIdentifier,
+		Description: "A generic test used to check that the boot parameters required by performance-tuned clusters, such as isolcpus, hugepages, intel_iommu=on and nosmt, are set in /proc/cmdline.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.CatBinaryName,
+		},
+	},
+	tunedIdentifierURL: {
+		Identifier:  TunedIdentifier,
+		Description: "A generic test used to check that the expected TuneD performance profile is active on a node before running latency-sensitive tests.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.TunedAdmBinaryName,
+		},
+	},
+	nicDriverIdentifierURL: {
+		Identifier:  NICDriverIdentifier,
+		Description: "A generic test used to check that the driver and firmware versions of node interfaces, collected with ethtool -i, are in the supported list.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.EchoBinaryName,
+			dependencies.EthtoolBinaryName,
+		},
+	},
+	iperfIdentifierURL: {
+		Identifier:  IperfIdentifier,
+		Description: "A generic test used to measure the jitter and packet loss of UDP traffic between two pods with iperf3, and to check them against the configured thresholds.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.Iperf3BinaryName,
+		},
+	},
+	usersIdentifierURL: {
+		Identifier:  UsersIdentifier,
+		Description: "A generic test used to check that a container runs as a non-root user defined in /etc/passwd, and that root is the only user with UID 0.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.EchoBinaryName,
+			dependencies.IDBinaryName,
+			dependencies.CatBinaryName,
+		},
+	},
+	rlimitsIdentifierURL: {
+		Identifier:  RLimitsIdentifier,
+		Description: "A generic test used to check that the soft resource limits of a container process, such as nofile and memlock, meet the requirements of the CNF.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.CatBinaryName,
+		},
+	},
+	crictlVersionIdentifierURL: {
+		Identifier:  CrictlVersionIdentifier,
+		Description: "A generic test used to check that the container runtime of a node is CRI-O, with the version shipped with the OpenShift release and the expected cgroup driver.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.CrictlBinaryName,
+			dependencies.CrioBinaryName,
+			dependencies.GrepBinaryName,
+		},
+	},
+	crictlPodsIdentifierURL: {
+		Identifier:  CrictlPodsIdentifier,
+		Description: "A generic test used to list the pods and containers known to the container runtime of a node, mapping Kubernetes containers to their runtime container IDs.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.CrictlBinaryName,
+			dependencies.EchoBinaryName,
+		},
+	},
+	nodeKernelsIdentifierURL: {
+		Identifier:  NodeKernelsIdentifier,
+		Description: "A generic test used to check that the cluster nodes run a kernel release within the allowed range, and to group them by kernel release.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.OcBinaryName,
+		},
+	},
+	packagesIdentifierURL: {
+		Identifier:  PackagesIdentifier,
+		Description: "A generic test used to check that the required packages are installed in a container image, and that forbidden ones, such as debugging tools, are not.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.RpmBinaryName,
+			dependencies.DpkgQueryBinaryName,
+			dependencies.ApkBinaryName,
+		},
+	},
+	clockSkewIdentifierURL: {
+		Identifier:  ClockSkewIdentifier,
+		Description: "A generic test used to check that the clock offset between a node and the test machine, sampled with date +%s%N, is below the configured bound.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.DateBinaryName,
+		},
+	},
+	fdUsageIdentifierURL: {
+		Identifier:  FDUsageIdentifier,
+		Description: "A generic test used to check that no process of a container is close to exhausting its open file descriptor soft limit.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.LsBinaryName,
+			dependencies.WcBinaryName,
+			dependencies.AwkBinaryName,
+			dependencies.EchoBinaryName,
+			dependencies.CatBinaryName,
+		},
+	},
+	listeningPortsIdentifierURL: {
+		Identifier:  ListeningPortsIdentifier,
+		Description: "A generic test used to check that a container listens on exactly the ports declared in its containerPorts, using ss.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.SsBinaryName,
+		},
+	},
+	capabilitiesIdentifierURL: {
+		Identifier:  CapabilitiesIdentifier,
+		Description: "A generic test used to decode the capability sets of a container process and check that no forbidden capability, such as NET_ADMIN or SYS_ADMIN, is effective.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.CatBinaryName,
+		},
+	},
+	podResourcesIdentifierURL: {
+		Identifier:  PodResourcesIdentifier,
+		Description: "A generic test used to check that every container of a pod sets CPU and memory requests and limits, and optionally that the requests equal the limits.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.OcBinaryName,
+		},
+	},
+	workloadSpreadIdentifierURL: {
+		Identifier:  WorkloadSpreadIdentifier,
+		Description: "A generic test used to check that the Deployments and StatefulSets of a namespace run a minimum number of replicas, spread across more than one node.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.OcBinaryName,
+		},
+	},
+	pdbCoverageIdentifierURL: {
+		Identifier:  PDBCoverageIdentifier,
+		Description: "A generic test used to check that the replicated Deployments and StatefulSets of a namespace are covered by a PodDisruptionBudget allowing some, but not all, replicas to be disrupted.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.OcBinaryName,
+		},
+	},
+	rbacScopeIdentifierURL: {
+		Identifier:  RBACScopeIdentifier,
+		Description: "A generic test used to check that a service account is not bound to cluster-admin and that its effective permissions use no wildcard verb or resource.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.OcBinaryName,
+		},
+	},
+	clusterRoleRulesIdentifierURL: {
+		Identifier:  ClusterRoleRulesIdentifier,
+		Description: "A generic test used to check that a cluster role grants no create, update, patch or delete access to the resources of the core API group.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.OcBinaryName,
+		},
+	},
+	olmInstallIdentifierURL: {
+		Identifier:  OLMInstallIdentifier,
+		Description: "A test that checks the Subscription, InstallPlan and ClusterServiceVersion of an operator reached their final phase.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.OcBinaryName,
+		},
+	},
+	crdConformanceIdentifierURL: {
+		Identifier:  CRDConformanceIdentifier,
+		Description: "A test that checks a CRD has a single served storage version, structural schemas and status subresources, and does not use the deprecated v1beta1 API.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.OcBinaryName,
+		},
+	},
+	helmReleasesIdentifierURL: {
+		Identifier:  HelmReleasesIdentifier,
+		Description: "A test that lists the deployed Helm releases of a namespace.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.OcBinaryName,
+		},
+	},
+	helmHooksIdentifierURL: {
+		Identifier:  HelmHooksIdentifier,
+		Description: "A test that checks the hooks of a Helm release do not create RBAC objects.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.OcBinaryName,
+			dependencies.Base64BinaryName,
+			dependencies.GzipBinaryName,
+		},
+	},
+	helmDriftIdentifierURL: {
+		Identifier:  HelmDriftIdentifier,
+		Description: "A test that compares the manifest of a Helm release with the live cluster state.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.OcBinaryName,
+			dependencies.Base64BinaryName,
+			dependencies.GzipBinaryName,
+			dependencies.JqBinaryName,
+		},
+	},
+	nodeDrainIdentifierURL: {
+		Identifier:  NodeDrainIdentifier,
+		Description: "A test that drains a node through the eviction API, respecting the PodDisruptionBudgets.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           true,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.OcBinaryName,
+		},
+	},
+	tolerationsIdentifierURL: {
+		Identifier:  TolerationsIdentifier,
+		Description: "A test that checks the taints of the node of a pod are tolerated by tolerations naming their key.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.OcBinaryName,
+		},
+	},
+	securityContextIdentifierURL: {
+		Identifier:  SecurityContextIdentifier,
+		Description: "A test that checks the containers of a pod have a read-only root filesystem, use the RuntimeDefault seccomp profile and drop all the capabilities.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.OcBinaryName,
+		},
+	},
+	secretHygieneIdentifierURL: {
+		Identifier:  SecretHygieneIdentifier,
+		Description: "A test that checks pods consume secrets through mounted volumes which are not world-readable, and that secret values do not appear in ConfigMaps or pod annotations.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.OcBinaryName,
+		},
+	},
+	configMapsIdentifierURL: {
+		Identifier:  ConfigMapsIdentifier,
+		Description: "A test that collects the data of the ConfigMaps of a namespace.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.OcBinaryName,
+		},
+	},
+	serviceMeshIdentifierURL: {
+		Identifier:  ServiceMeshIdentifier,
+		Description: "A test that discovers the Istio sidecars of the pods of a namespace and the mutual TLS policies applying to it.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.OcBinaryName,
+		},
+	},
+	clusterPlatformIdentifierURL: {
+		Identifier:  ClusterPlatformIdentifier,
+		Description: "A test that collects the OpenShift version, infrastructure platform, network type, MTU and node inventory of the cluster.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.OcBinaryName,
+		},
+	},
+	cniPluginsIdentifierURL: {
+		Identifier:  CNIPluginsIdentifier,
+		Description: "A test that detects the primary CNI of the cluster and the CNI plugins used by its Multus network attachments.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.OcBinaryName,
+		},
+	},
+	nodePressureIdentifierURL: {
+		Identifier:  NodePressureIdentifier,
+		Description: "A test that reads the pressure conditions of the nodes and compares their allocatable resources with the requests of their pods.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.OcBinaryName,
+		},
+	},
+	podPlacementIdentifierURL: {
+		Identifier:  PodPlacementIdentifier,
+		Description: "A test that compares the nodeSelector and required node affinity of the pods of a namespace with the labels of their nodes.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.OcBinaryName,
+		},
+	},
+	podStartupIdentifierURL: {
+		Identifier:  PodStartupIdentifier,
+		Description: "A test that measures the time the pods of a namespace took to become ready after their creation.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.OcBinaryName,
+		},
+	},
+	networkPartitionIdentifierURL: {
+		Identifier:  NetworkPartitionIdentifier,
+		Description: "A test that adds or removes the iptables rules dropping the traffic between a pod and a peer IP address, from the debug pod of its node.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           true,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.CrictlBinaryName,
+			dependencies.NsenterBinaryName,
+			dependencies.IptablesBinaryName,
+			dependencies.GrepBinaryName,
+		},
+	},
+	podTerminationIdentifierURL: {
+		Identifier:  PodTerminationIdentifier,
+		Description: "A test that reports the grace period, preStop hooks and termination state of the containers of a pod.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.OcBinaryName,
+		},
+	},
+	curlIdentifierURL: {
+		Identifier:  CurlIdentifier,
+		Description: "A test that opens a connection to a URL with curl and reports whether it was established.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.CurlBinaryName,
+		},
+	},
+	fsRoundTripIdentifierURL: {
+		Identifier:  FsRoundTripIdentifier,
+		Description: "A test that writes a file to a directory of a container and reads it back.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.EchoBinaryName,
+			dependencies.CatBinaryName,
+		},
+	},
+	nodeServicesIdentifierURL: {
+		Identifier:  NodeServicesIdentifier,
+		Description: "A test that reports the state of systemd services on a node.",
+		Type:        Normative,
+		IntrusionSettings: IntrusionSettings{
+			ModifiesSystem:           false,
+			ModificationIsPersistent: false,
+		},
+		BinaryDependencies: []string{
+			dependencies.SystemctlBinaryName,
+			dependencies.EchoBinaryName,
+		},
+	},
 }
 
 // CommandIdentifier is  the Identifier used to represent the generic command test case.
@@ -925,3 +1657,327 @@ var DaemonSetIdentifier = Identifier{
 	URL:             daemonSetIdentifierURL,
 	SemanticVersion: versionOne,
 }
+
+// DiskUsageIdentifier is the Identifier used to represent the disk usage test case.
+var DiskUsageIdentifier = Identifier{
+	URL:             diskUsageIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// ResourceUsageIdentifier is the Identifier used to represent the container CPU and memory usage test case.
+var ResourceUsageIdentifier = Identifier{
+	URL:             resourceUsageIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// CertExpiryIdentifier is the Identifier used to represent the TLS certificate expiry test case.
+var CertExpiryIdentifier = Identifier{
+	URL:             certExpiryIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// FirewallRulesIdentifier is the Identifier used to represent the firewall ruleset inspection test case.
+var FirewallRulesIdentifier = Identifier{
+	URL:             firewallRulesIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// ImageInspectIdentifier is the Identifier used to represent the container image inspection test case.
+var ImageInspectIdentifier = Identifier{
+	URL:             imageInspectIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// NodeOSIdentifier is the Identifier used to represent the node operating system identification test case.
+var NodeOSIdentifier = Identifier{
+	URL:             nodeOSIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// CPUPinningIdentifier is the Identifier used to represent the CPU pinning test case.
+var CPUPinningIdentifier = Identifier{
+	URL:             cpuPinningIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// NUMAAlignmentIdentifier is the Identifier used to represent the NUMA alignment test case.
+var NUMAAlignmentIdentifier = Identifier{
+	URL:             numaAlignmentIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// PodHugepagesIdentifier is the Identifier used to represent the container hugepages allocation test case.
+var PodHugepagesIdentifier = Identifier{
+	URL:             podHugepagesIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// LLDPIdentifier is the Identifier used to represent the LLDP neighbor discovery test case.
+var LLDPIdentifier = Identifier{
+	URL:             lldpIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// VLANIdentifier is the Identifier used to represent the VLAN sub-interface test case.
+var VLANIdentifier = Identifier{
+	URL:             vlanIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// MultusInterfacesIdentifier is the Identifier used to represent the Multus secondary interfaces test case.
+var MultusInterfacesIdentifier = Identifier{
+	URL:             multusInterfacesIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// ConntrackIdentifier is the Identifier used to represent the conntrack table test case.
+var ConntrackIdentifier = Identifier{
+	URL:             conntrackIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// FirewalldIdentifier is the Identifier used to represent the firewalld zone test case.
+var FirewalldIdentifier = Identifier{
+	URL:             firewalldIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// KernelCmdlineIdentifier is the Identifier used to represent the kernel command line boot parameters test case.
+var KernelCmdlineIdentifier = Identifier{
+	URL:             kernelCmdlineIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// TunedIdentifier is the Identifier used to represent the TuneD active profile test case.
+var TunedIdentifier = Identifier{
+	URL:             tunedIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// NICDriverIdentifier is the Identifier used to represent the NIC driver and firmware version test case.
+var NICDriverIdentifier = Identifier{
+	URL:             nicDriverIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// IperfIdentifier is the Identifier used to represent the iperf3 jitter and packet loss test case.
+var IperfIdentifier = Identifier{
+	URL:             iperfIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// UsersIdentifier is the Identifier used to represent the container users test case.
+var UsersIdentifier = Identifier{
+	URL:             usersIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// RLimitsIdentifier is the Identifier used to represent the process resource limits test case.
+var RLimitsIdentifier = Identifier{
+	URL:             rlimitsIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// CrictlVersionIdentifier is the Identifier used to represent the container runtime version test case.
+var CrictlVersionIdentifier = Identifier{
+	URL:             crictlVersionIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// CrictlPodsIdentifier is the Identifier used to represent the crictl pod and container listing test case.
+var CrictlPodsIdentifier = Identifier{
+	URL:             crictlPodsIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// NodeKernelsIdentifier is the Identifier used to represent the node kernel version compliance test case.
+var NodeKernelsIdentifier = Identifier{
+	URL:             nodeKernelsIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// PackagesIdentifier is the Identifier used to represent the installed package presence test case.
+var PackagesIdentifier = Identifier{
+	URL:             packagesIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// ClockSkewIdentifier is the Identifier used to represent the clock skew test case.
+var ClockSkewIdentifier = Identifier{
+	URL:             clockSkewIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// FDUsageIdentifier is the Identifier used to represent the open file descriptor usage test case.
+var FDUsageIdentifier = Identifier{
+	URL:             fdUsageIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// ListeningPortsIdentifier is the Identifier used to represent the listening ports test case.
+var ListeningPortsIdentifier = Identifier{
+	URL:             listeningPortsIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// CapabilitiesIdentifier is the Identifier used to represent the container process capabilities test case.
+var CapabilitiesIdentifier = Identifier{
+	URL:             capabilitiesIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// PodResourcesIdentifier is the Identifier used to represent the container resource requests and limits test case.
+var PodResourcesIdentifier = Identifier{
+	URL:             podResourcesIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// WorkloadSpreadIdentifier is the Identifier used to represent the workload replicas and node spread test case.
+var WorkloadSpreadIdentifier = Identifier{
+	URL:             workloadSpreadIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// PDBCoverageIdentifier is the Identifier used to represent the PodDisruptionBudget coverage test case.
+var PDBCoverageIdentifier = Identifier{
+	URL:             pdbCoverageIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// RBACScopeIdentifier is the Identifier used to represent the service account RBAC scoping test case.
+var RBACScopeIdentifier = Identifier{
+	URL:             rbacScopeIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// ClusterRoleRulesIdentifier is the Identifier used to represent the cluster role core write access test case.
+var ClusterRoleRulesIdentifier = Identifier{
+	URL:             clusterRoleRulesIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// OLMInstallIdentifier is the Identifier used to represent the OLM installation status test case.
+var OLMInstallIdentifier = Identifier{
+	URL:             olmInstallIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// CRDConformanceIdentifier is the Identifier used to represent the CRD conformance test case.
+var CRDConformanceIdentifier = Identifier{
+	URL:             crdConformanceIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// HelmReleasesIdentifier is the Identifier used to represent the Helm releases discovery test case.
+var HelmReleasesIdentifier = Identifier{
+	URL:             helmReleasesIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// HelmHooksIdentifier is the Identifier used to represent the Helm hooks test case.
+var HelmHooksIdentifier = Identifier{
+	URL:             helmHooksIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// HelmDriftIdentifier is the Identifier used to represent the Helm release drift test case.
+var HelmDriftIdentifier = Identifier{
+	URL:             helmDriftIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// NodeDrainIdentifier is the Identifier used to represent the node drain test case.
+var NodeDrainIdentifier = Identifier{
+	URL:             nodeDrainIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// TolerationsIdentifier is the Identifier used to represent the tolerations test case.
+var TolerationsIdentifier = Identifier{
+	URL:             tolerationsIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// SecurityContextIdentifier is the Identifier used to represent the container securityContext test case.
+var SecurityContextIdentifier = Identifier{
+	URL:             securityContextIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// SecretHygieneIdentifier is the Identifier used to represent the secret hygiene test case.
+var SecretHygieneIdentifier = Identifier{
+	URL:             secretHygieneIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// ConfigMapsIdentifier is the Identifier used to represent the ConfigMaps snapshot test case.
+var ConfigMapsIdentifier = Identifier{
+	URL:             configMapsIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// ServiceMeshIdentifier is the Identifier used to represent the service mesh discovery test case.
+var ServiceMeshIdentifier = Identifier{
+	URL:             serviceMeshIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// ClusterPlatformIdentifier is the Identifier used to represent the cluster platform test case.
+var ClusterPlatformIdentifier = Identifier{
+	URL:             clusterPlatformIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// CNIPluginsIdentifier is the Identifier used to represent the CNI plugins detection test case.
+var CNIPluginsIdentifier = Identifier{
+	URL:             cniPluginsIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// NodePressureIdentifier is the Identifier used to represent the node pressure test case.
+var NodePressureIdentifier = Identifier{
+	URL:             nodePressureIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// PodPlacementIdentifier is the Identifier used to represent the pod placement test case.
+var PodPlacementIdentifier = Identifier{
+	URL:             podPlacementIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// PodStartupIdentifier is the Identifier used to represent the pod startup time test case.
+var PodStartupIdentifier = Identifier{
+	URL:             podStartupIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// NetworkPartitionIdentifier is the Identifier used to represent the network partition test case.
+var NetworkPartitionIdentifier = Identifier{
+	URL:             networkPartitionIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// PodTerminationIdentifier is the Identifier used to represent the pod termination test case.
+var PodTerminationIdentifier = Identifier{
+	URL:             podTerminationIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// CurlIdentifier is the Identifier used to represent the curl test case.
+var CurlIdentifier = Identifier{
+	URL:             curlIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// FsRoundTripIdentifier is the Identifier used to represent the filesystem round trip test case.
+var FsRoundTripIdentifier = Identifier{
+	URL:             fsRoundTripIdentifierURL,
+	SemanticVersion: versionOne,
+}
+
+// NodeServicesIdentifier is the Identifier used to represent the node services test case.
+var NodeServicesIdentifier = Identifier{
+	URL:             nodeServicesIdentifierURL,
+	SemanticVersion: versionOne,
+}
@@ -28,6 +28,7 @@ const (
 	ocContainerArg = "-c"
 	ocRsh          = "rsh"
 	ocNamespaceArg = "-n"
+	ocShellArg     = "--shell"
 )
 
 // Oc provides an OpenShift Client designed to wrap the "oc" CLI.
@@ -57,7 +58,17 @@ type Oc struct {
 
 // SpawnOc creates an OpenShift Client subprocess, spawning the appropriate underlying PTY.
 func SpawnOc(spawner *Spawner, pod, container, namespace string, timeout time.Duration, opts ...Option) (*Oc, <-chan error, error) {
-	ocArgs := []string{ocRsh, ocNamespaceArg, namespace, ocContainerArg, container, pod}
+	return SpawnOcWithShell(spawner, pod, container, namespace, "", timeout, opts...)
+}
+
+// SpawnOcWithShell creates an OpenShift Client subprocess running the given shell in the container.  The default shell
+// of "oc rsh" is used when shell is empty.
+func SpawnOcWithShell(spawner *Spawner, pod, container, namespace, shell string, timeout time.Duration, opts ...Option) (*Oc, <-chan error, error) {
+	ocArgs := []string{ocRsh, ocNamespaceArg, namespace, ocContainerArg, container}
+	if shell != "" {
+		ocArgs = append(ocArgs, ocShellArg+"="+shell)
+	}
+	ocArgs = append(ocArgs, pod)
 	context, err := (*spawner).Spawn(ocCommand, ocArgs, timeout, opts...)
 	if err != nil {
 		return nil, context.GetErrorChannel(), err
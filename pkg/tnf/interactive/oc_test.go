@@ -89,3 +89,21 @@ func TestSpawnOc(t *testing.T) {
 		}
 	}
 }
+
+func TestSpawnOcWithShell(t *testing.T) {
+	testCases := map[string][]string{
+		"":          {"rsh", "-n", "default", "-c", "test", "testPod"},
+		"/bin/bash": {"rsh", "-n", "default", "-c", "test", "--shell=/bin/bash", "testPod"},
+	}
+	for shell, expectedArgs := range testCases {
+		ctrl := gomock.NewController(t)
+		mockSpawner := mock_interactive.NewMockSpawner(ctrl)
+		mockSpawner.EXPECT().Spawn("oc", expectedArgs, ocTestTimeoutDuration, gomock.Any()).Return(&interactive.Context{}, nil)
+
+		var spawner interactive.Spawner = mockSpawner
+		oc, _, err := interactive.SpawnOcWithShell(&spawner, "testPod", "test", "default", shell, ocTestTimeoutDuration, interactive.Verbose(true))
+		assert.Nil(t, err)
+		assert.Equal(t, "testPod", oc.GetPodName())
+		ctrl.Finish()
+	}
+}
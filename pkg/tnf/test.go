@@ -18,6 +18,7 @@ package tnf
 
 import (
 	"fmt"
+	"regexp"
 	"time"
 
 	expect "github.com/google/goexpect"
@@ -72,23 +73,78 @@ type Tester interface {
 	Timeout() time.Duration
 }
 
+// RunOverrides replaces the settings of the runs of a Test created by NewTestWithOverrides, e.g. for slow lab
+// environments.
+type RunOverrides struct {
+	// Timeout replaces the timeout of every step when it is set.
+	Timeout time.Duration
+	// Retries is the number of times a run ending in ERROR, such as on a timeout, is started again.
+	Retries int
+}
+
+// drainTimeout bounds the wait for the session to settle before a retry.
+const drainTimeout = 10 * time.Second
+
+// drainCommand marks the end of the output of an interrupted command.  The marker is split so that the echo of the
+// command itself does not match drainRegex.
+const drainCommand = "echo TNF_\"\"DRAINED\n"
+
+var drainRegex = regexp.MustCompile(`TNF_DRAINED\r?\n`)
+
 // Test runs a chain of Handlers.
 type Test struct {
-	runner *reel.Reel
-	tester Tester
-	chain  []reel.Handler
+	runner       *reel.Reel
+	tester       Tester
+	chain        []reel.Handler
+	expecter     *expect.Expecter
+	errorChannel <-chan error
+	opts         []reel.Option
+	newHandlers  func() (Tester, []reel.Handler)
+	overrides    RunOverrides
 }
 
-// Run performs a test, returning the result and any encountered errors.
+// Run performs a test, returning the result and any encountered errors.  A Test created by NewTestWithOverrides is
+// retried as many times as its overrides allow while it ends in ERROR.
 func (t *Test) Run() (int, error) {
 	err := t.runner.Run(t)
+	for attempt := 1; attempt <= t.overrides.Retries && t.tester.Result() == ERROR; attempt++ {
+		logrus.Warnf("Retrying %v (attempt %d of %d), the previous run errored: %v", t.tester.Args(), attempt,
+			t.overrides.Retries, err)
+		if drainErr := t.drain(); drainErr != nil {
+			return t.tester.Result(), drainErr
+		}
+		t.tester, t.chain = t.newHandlers()
+		runner, newErr := reel.NewReel(t.expecter, t.tester.Args(), t.errorChannel, t.opts...)
+		if newErr != nil {
+			return t.tester.Result(), newErr
+		}
+		t.runner = runner
+		err = t.runner.Run(t)
+	}
 	return t.tester.Result(), err
 }
 
+// drain interrupts the command of the previous run, which may still be running after a timeout, and waits until its
+// output is consumed so that it is not matched by the next run.
+func (t *Test) drain() error {
+	expecter := *t.expecter
+	if err := expecter.Send("\x03"); err != nil {
+		return err
+	}
+	if err := expecter.Send(drainCommand); err != nil {
+		return err
+	}
+	_, _, err := expecter.Expect(drainRegex, drainTimeout)
+	return err
+}
+
 func (t *Test) dispatch(fp reel.StepFunc) *reel.Step {
 	for _, handler := range t.chain {
 		step := fp(handler)
 		if step != nil {
+			if t.overrides.Timeout > 0 {
+				step.Timeout = t.overrides.Timeout
+			}
 			return step
 		}
 	}
@@ -168,5 +224,19 @@ func NewTest(expecter *expect.Expecter, tester Tester, chain []reel.Handler, err
 	if err != nil {
 		return nil, err
 	}
-	return &Test{runner: runner, tester: tester, chain: chain}, nil
+	return &Test{runner: runner, tester: tester, chain: chain, expecter: expecter, errorChannel: errorChannel, opts: opts}, nil
+}
+
+// NewTestWithOverrides creates a new Test run with the given overrides.  newHandlers builds the Tester and the chain of
+// Handlers, and is called again before every retry so that no state is carried over from an errored run.
+func NewTestWithOverrides(expecter *expect.Expecter, newHandlers func() (Tester, []reel.Handler), errorChannel <-chan error,
+	overrides RunOverrides, opts ...reel.Option) (*Test, error) {
+	tester, chain := newHandlers()
+	test, err := NewTest(expecter, tester, chain, errorChannel, opts...)
+	if err != nil {
+		return nil, err
+	}
+	test.newHandlers = newHandlers
+	test.overrides = overrides
+	return test, nil
 }
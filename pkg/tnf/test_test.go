@@ -293,3 +293,39 @@ func TestTest_ReelEof(t *testing.T) {
 	// just ensure there are no panics
 	test.ReelEOF()
 }
+
+func TestTest_RunWithOverrides(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockExpecter := mock_interactive.NewMockExpecter(ctrl)
+	// The command is sent once by NewTestWithOverrides, and each retry interrupts the previous run, drains the session
+	// and sends the command again.
+	mockExpecter.EXPECT().Send(gomock.Any()).Times(7)
+	mockExpecter.EXPECT().Expect(gomock.Any(), gomock.Any()).Return("TNF_DRAINED\n", nil, nil).Times(2)
+
+	handlersBuilt := 0
+	var mockHandler *mock_reel.MockHandler
+	newHandlers := func() (tnf.Tester, []reel.Handler) {
+		handlersBuilt++
+		mockTester := mock_tnf.NewMockTester(ctrl)
+		mockTester.EXPECT().Args().Return(defaultTestCommand).AnyTimes()
+		mockTester.EXPECT().Result().Return(tnf.ERROR).AnyTimes()
+		mockHandler = mock_reel.NewMockHandler(ctrl)
+		mockHandler.EXPECT().ReelFirst().Return(nil)
+		return mockTester, []reel.Handler{mockHandler}
+	}
+	var expecter expect.Expecter = mockExpecter
+	var errorChannel <-chan error
+
+	test, err := tnf.NewTestWithOverrides(&expecter, newHandlers, errorChannel, tnf.RunOverrides{Timeout: time.Minute, Retries: 2})
+	assert.Nil(t, err)
+	result, err := test.Run()
+	assert.Nil(t, err)
+	assert.Equal(t, tnf.ERROR, result)
+	// Each retry runs with fresh handlers.
+	assert.Equal(t, 3, handlersBuilt)
+
+	mockHandler.EXPECT().ReelFirst().Return(&reel.Step{Timeout: testTimeoutDuration})
+	assert.Equal(t, time.Minute, test.ReelFirst().Timeout)
+}
@@ -18,17 +18,28 @@ package accesscontrol
 
 import (
 	"fmt"
+	"path"
+	"strconv"
 	"strings"
 
 	"github.com/onsi/ginkgo"
 	"github.com/onsi/gomega"
 	log "github.com/sirupsen/logrus"
+	"github.com/test-network-function/test-network-function-claim/pkg/claim"
 	"github.com/test-network-function/test-network-function/pkg/config"
+	"github.com/test-network-function/test-network-function/pkg/config/autodiscover"
+	"github.com/test-network-function/test-network-function/pkg/config/configsections"
 	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/capabilities"
 	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/clusterrolebinding"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/clusterrolerules"
 	containerpkg "github.com/test-network-function/test-network-function/pkg/tnf/handlers/container"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/rbacscope"
 	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/rolebinding"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/secrethygiene"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/securitycontext"
 	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/serviceaccount"
+	"github.com/test-network-function/test-network-function/pkg/tnf/interactive"
 	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
 	"github.com/test-network-function/test-network-function/pkg/tnf/testcases"
 	"github.com/test-network-function/test-network-function/pkg/utils"
@@ -43,12 +54,71 @@ const (
 
 	// ocGetCrNamespaceFormat is the "oc get" format string to get the namespaced-only resources created for a given CRD.
 	ocGetCrNamespaceFormat = "oc get %s -A -o go-template='{{range .items}}{{if .metadata.namespace}}{{.metadata.name}},{{.metadata.namespace}}{{\"\n\"}}{{end}}{{end}}'"
+
+	// ocGetRunAsUserFormat prints the pod and container level runAsNonRoot and runAsUser settings, separated by commas.
+	ocGetRunAsUserFormat = `oc get pod -n %s %s -o jsonpath='{.spec.securityContext.runAsNonRoot},{.spec.securityContext.runAsUser},{.spec.containers[?(@.name=="%s")].securityContext.runAsNonRoot},{.spec.containers[?(@.name=="%s")].securityContext.runAsUser}'`
+
+	// ocGetPrivilegeFormat prints one "name,privileged,allowPrivilegeEscalation" line per init and regular container of a
+	// pod.
+	ocGetPrivilegeFormat = `oc get pod -n %s %s -o jsonpath='{range .spec.initContainers[*]}{.name},{.securityContext.privileged},{.securityContext.allowPrivilegeEscalation}{"\n"}{end}{range .spec.containers[*]}{.name},{.securityContext.privileged},{.securityContext.allowPrivilegeEscalation}{"\n"}{end}'`
+
+	// ocGetHostNamespacesFormat prints the hostNetwork, hostPID and hostIPC settings of a pod, separated by commas.
+	ocGetHostNamespacesFormat = "oc get pod -n %s %s -o jsonpath='{.spec.hostNetwork},{.spec.hostPID},{.spec.hostIPC}'"
+
+	// ocGetCapabilitiesFormat prints the capabilities added and dropped by a container spec, separated by a "|".
+	ocGetCapabilitiesFormat = `oc get pod -n %s %s -o jsonpath='{.spec.containers[?(@.name=="%s")].securityContext.capabilities.add}|{.spec.containers[?(@.name=="%s")].securityContext.capabilities.drop}'`
+
+	// ocGetNamespaceLabelsFormat prints one "name=value" line per label of a namespace.
+	ocGetNamespaceLabelsFormat = `oc get namespace %s -o go-template='{{range $name, $value := .metadata.labels}}{{$name}}={{$value}}{{"\n"}}{{end}}'`
+
+	// ocGetLabeledObjectsFormat prints the kind, namespace and name of the CNF objects carrying a label, in every
+	// namespace.
+	ocGetLabeledObjectsFormat = "oc get deployments,statefulsets,daemonsets,services -A -l %s -o custom-columns=KIND:.kind,NAMESPACE:.metadata.namespace,NAME:.metadata.name --no-headers"
+
+	// ocGetPodAutomountFormat prints the service account of a pod and its automountServiceAccountToken setting,
+	// separated by a "|".
+	ocGetPodAutomountFormat = "oc get pod -n %s %s -o jsonpath='{.spec.serviceAccountName}|{.spec.automountServiceAccountToken}'"
+
+	// ocGetServiceAccountAutomountFormat prints the automountServiceAccountToken setting of a service account.
+	ocGetServiceAccountAutomountFormat = "oc get serviceaccount -n %s %s -o jsonpath='{.automountServiceAccountToken}'"
+
+	// ocGetPodSCCFormat prints the SecurityContextConstraint a pod was admitted under.
+	ocGetPodSCCFormat = `oc get pod -n %s %s -o jsonpath='{.metadata.annotations.openshift\.io/scc}'`
+
+	// ocGetPodHostPathsFormat prints the name and host path of every hostPath volume of a pod, separated by a "|", one
+	// volume per line.
+	ocGetPodHostPathsFormat = `oc get pod -n %s %s -o jsonpath='{range .spec.volumes[?(@.hostPath)]}{.name}|{.hostPath.path}{"\n"}{end}'`
+
+	// containerMainPID is the PID of the main process of a container.
+	containerMainPID = 1
+
+	// getRunningUIDCommand prints the UID of the container process.
+	getRunningUIDCommand = "id -u"
 )
 
 var (
+	// hostNamespaceFields are the pod spec fields granting access to the host namespaces, in the order printed by
+	// ocGetHostNamespacesFormat.
+	hostNamespaceFields = []string{"hostNetwork", "hostPID", "hostIPC"}
+
+	// forbiddenCapabilities are the capabilities a container may only hold when allowed by the configuration.
+	forbiddenCapabilities = []string{"NET_ADMIN", "SYS_ADMIN", "NET_RAW", "IPC_LOCK"}
+
+	// forbiddenSCCs are the SecurityContextConstraints a pod may only be admitted under when allowed by the
+	// configuration.
+	forbiddenSCCs = []string{"anyuid", "privileged"}
+
+	// sensitiveHostPaths are the host directories and files a pod may only mount when explicitly allowed by the
+	// configuration.
+	sensitiveHostPaths = []string{"/", "/etc", "/proc", "/var/run/docker.sock", "/run/docker.sock"}
+
+	// defaultServiceAccount is the service account used by pods which do not set one.
+	defaultServiceAccount = "default"
+
 	invalidNamespacePrefixes = []string{
 		"default",
 		"openshift-",
+		"kube-",
 		"istio-",
 		"aspenmesh-",
 	}
@@ -68,8 +138,30 @@ var _ = ginkgo.Describe(common.AccessControlTestKey, func() {
 
 		testNamespace(env)
 
+		testNamespaceLabels(env)
+
+		testClusterNamespaceObjects(env)
+
 		testRoles(env)
 
+		testNonRootContainers(env)
+
+		testPrivilegedContainers(env)
+
+		testHostNamespaces(env)
+
+		testCapabilities(env)
+
+		testSecurityContext(env)
+
+		testSecretHygiene(env)
+
+		testAutomountServiceAccountToken(env)
+
+		testSCC(env)
+
+		testHostPaths(env)
+
 		defer ginkgo.GinkgoRecover()
 
 		// Run the tests that interact with the pods
@@ -260,10 +352,110 @@ func testNamespace(env *config.TestEnvironment) {
 	})
 }
 
+// getNamespaceLabels returns the labels of a namespace, keyed by their full name.
+func getNamespaceLabels(namespace string) map[string]string {
+	command := fmt.Sprintf(ocGetNamespaceLabelsFormat, namespace)
+	out := utils.ExecuteCommand(command, common.DefaultTimeout, common.GetContext(), func() {
+		tnf.ClaimFilePrintf("Failed to get the labels of namespace %s", namespace)
+	})
+	const numExpectedFields = 2
+	labels := map[string]string{}
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.SplitN(strings.TrimSpace(line), "=", numExpectedFields)
+		if len(fields) == numExpectedFields {
+			labels[fields[0]] = fields[1]
+		}
+	}
+	return labels
+}
+
+func testNamespaceLabels(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestNamespaceLabelsIdentifier)
+	ginkgo.It(testID, func() {
+		if len(env.Config.RequiredNamespaceLabels) == 0 {
+			ginkgo.Skip("No required namespace labels configured")
+		}
+		var failedNamespaces []string
+		for _, namespace := range env.NameSpacesUnderTest {
+			ginkgo.By(fmt.Sprintf("Checking the labels of namespace %s", namespace))
+			labels := getNamespaceLabels(namespace)
+			failed := false
+			for _, required := range env.Config.RequiredNamespaceLabels {
+				name := required.Name
+				if required.Prefix != "" {
+					name = required.Prefix + "/" + required.Name
+				}
+				value, found := labels[name]
+				if !found {
+					tnf.ClaimFilePrintf("Namespace %s is missing label %s", namespace, name)
+					failed = true
+				} else if required.Value != "" && value != required.Value {
+					tnf.ClaimFilePrintf("Namespace %s has label %s=%s, expected %s", namespace, name, value, required.Value)
+					failed = true
+				}
+			}
+			if failed {
+				failedNamespaces = append(failedNamespaces, namespace)
+			}
+		}
+		if n := len(failedNamespaces); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d namespaces missing required labels: %v", n, failedNamespaces))
+		}
+	})
+}
+
+// getInvalidNamespacePrefix returns the invalid prefix a namespace starts with, if any.
+func getInvalidNamespacePrefix(namespace string) (string, bool) {
+	for _, invalidPrefix := range invalidNamespacePrefixes {
+		if strings.HasPrefix(namespace, invalidPrefix) {
+			return invalidPrefix, true
+		}
+	}
+	return "", false
+}
+
+func testClusterNamespaceObjects(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestClusterNamespaceObjectsIdentifier)
+	ginkgo.It(testID, func() {
+		const numExpectedFields = 3
+		leaked := map[string]bool{}
+		var leakedObjects []string
+		for _, label := range env.Config.TargetPodLabels {
+			labelQuery := autodiscover.BuildLabelQuery(label)
+			ginkgo.By(fmt.Sprintf("Looking for objects labeled %s in cluster namespaces", labelQuery))
+			command := fmt.Sprintf(ocGetLabeledObjectsFormat, labelQuery)
+			out := utils.ExecuteCommand(command, common.DefaultTimeout, common.GetContext(), func() {
+				tnf.ClaimFilePrintf("Failed to list the objects labeled %s", labelQuery)
+			})
+			for _, line := range strings.Split(out, "\n") {
+				fields := strings.Fields(line)
+				if len(fields) != numExpectedFields {
+					continue
+				}
+				kind, namespace, name := fields[0], fields[1], fields[2]
+				invalidPrefix, invalid := getInvalidNamespacePrefix(namespace)
+				object := fmt.Sprintf("%s %s/%s", kind, namespace, name)
+				if !invalid || leaked[object] {
+					continue
+				}
+				leaked[object] = true
+				tnf.ClaimFilePrintf("%s is in namespace %s with invalid prefix %s", kind, namespace, invalidPrefix)
+				leakedObjects = append(leakedObjects, object)
+			}
+		}
+		if n := len(leakedObjects); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d CNF objects in cluster namespaces: %v", n, leakedObjects))
+		}
+	})
+}
+
 func testRoles(env *config.TestEnvironment) {
 	testServiceAccount(env)
 	testRoleBindings(env)
 	testClusterRoleBindings(env)
+	testDedicatedServiceAccount(env)
+	testServiceAccountRBACScope(env)
+	testClusterRoleBindingsAudit(env)
 }
 
 func testServiceAccount(env *config.TestEnvironment) {
@@ -328,3 +520,633 @@ func testClusterRoleBindings(env *config.TestEnvironment) {
 		}
 	})
 }
+
+// getDeclaredUser returns whether the pod spec forces a non-root user for a container, and the declared runAsUser when
+// set.  Container level settings take precedence over the pod level ones.
+func getDeclaredUser(id configsections.ContainerIdentifier) (runAsNonRoot bool, runAsUser string) {
+	const numFields = 4
+	command := fmt.Sprintf(ocGetRunAsUserFormat, id.Namespace, id.PodName, id.ContainerName, id.ContainerName)
+	out := utils.ExecuteCommand(command, common.DefaultTimeout, common.GetContext(), func() {
+		tnf.ClaimFilePrintf("Failed to get the securityContext of %s/%s/%s", id.Namespace, id.PodName, id.ContainerName)
+	})
+	fields := strings.Split(strings.TrimSpace(out), ",")
+	gomega.Expect(fields).To(gomega.HaveLen(numFields))
+	podNonRoot, podUser, containerNonRoot, containerUser := fields[0], fields[1], fields[2], fields[3]
+	if containerNonRoot == "" {
+		containerNonRoot = podNonRoot
+	}
+	if containerUser == "" {
+		containerUser = podUser
+	}
+	return containerNonRoot == "true", containerUser
+}
+
+func testNonRootContainers(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestNonRootContainerIdentifier)
+	ginkgo.It(testID, func() {
+		var failedContainers []string
+		for _, cut := range env.ContainersUnderTest {
+			id := cut.ContainerIdentifier
+			containerName := fmt.Sprintf("%s/%s/%s", id.Namespace, id.PodName, id.ContainerName)
+			ginkgo.By(fmt.Sprintf("Checking that container %s does not run as root", containerName))
+			failed := false
+
+			runAsNonRoot, runAsUser := getDeclaredUser(id)
+			switch {
+			case runAsUser == "0":
+				tnf.ClaimFilePrintf("Container %s declares runAsUser 0", containerName)
+				failed = true
+			case runAsUser == "" && !runAsNonRoot:
+				tnf.ClaimFilePrintf("Container %s declares neither runAsNonRoot nor a non-root runAsUser", containerName)
+				failed = true
+			}
+
+			context := interactive.NewContext(cut.Oc.GetExpecter(), cut.Oc.GetErrorChannel())
+			out := utils.ExecuteCommand(getRunningUIDCommand, common.DefaultTimeout, context, func() {
+				tnf.ClaimFilePrintf("Failed to get the running UID of container %s", containerName)
+			})
+			uid, err := strconv.Atoi(strings.TrimSpace(out))
+			if err != nil {
+				tnf.ClaimFilePrintf("Could not parse the running UID of container %s: %q", containerName, out)
+				failed = true
+			} else if uid == 0 {
+				tnf.ClaimFilePrintf("Container %s runs as root", containerName)
+				failed = true
+			}
+
+			if failed {
+				failedContainers = append(failedContainers, containerName)
+			}
+		}
+		if n := len(failedContainers); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d containers that may run as root: %v", n, failedContainers))
+		}
+	})
+}
+
+func testPrivilegedContainers(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestPrivilegedContainerIdentifier)
+	ginkgo.It(testID, func() {
+		const numFields = 3
+		var violations []string
+		for _, podUnderTest := range env.PodsUnderTest {
+			ginkgo.By(fmt.Sprintf("Checking the container privileges of pod %s/%s", podUnderTest.Namespace, podUnderTest.Name))
+			command := fmt.Sprintf(ocGetPrivilegeFormat, podUnderTest.Namespace, podUnderTest.Name)
+			out := utils.ExecuteCommand(command, common.DefaultTimeout, common.GetContext(), func() {
+				tnf.ClaimFilePrintf("Failed to get the securityContext of pod %s/%s", podUnderTest.Namespace, podUnderTest.Name)
+			})
+			for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+				fields := strings.Split(strings.TrimSpace(line), ",")
+				if len(fields) != numFields {
+					continue
+				}
+				containerName := fmt.Sprintf("%s/%s/%s", podUnderTest.Namespace, podUnderTest.Name, fields[0])
+				if fields[1] == "true" {
+					tnf.ClaimFilePrintf("Container %s sets securityContext.privileged: true", containerName)
+					violations = append(violations, containerName+" privileged")
+				}
+				if fields[2] == "true" {
+					tnf.ClaimFilePrintf("Container %s sets securityContext.allowPrivilegeEscalation: true", containerName)
+					violations = append(violations, containerName+" allowPrivilegeEscalation")
+				}
+			}
+		}
+		if n := len(violations); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d privileged container settings: %v", n, violations))
+		}
+	})
+}
+
+// isHostNamespaceAllowed returns true when one of the configured exceptions allows the pod to use the host namespace
+// field.
+func isHostNamespaceAllowed(exceptions []configsections.HostNamespaceException, podNamespace, podName, field string) bool {
+	for _, exception := range exceptions {
+		if exception.Namespace != podNamespace || !strings.HasPrefix(podName, exception.PodName) {
+			continue
+		}
+		if len(exception.HostNamespaces) == 0 {
+			return true
+		}
+		for _, allowed := range exception.HostNamespaces {
+			if allowed == field {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func testHostNamespaces(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestHostNamespacesIdentifier)
+	ginkgo.It(testID, func() {
+		var violations []string
+		for _, podUnderTest := range env.PodsUnderTest {
+			podName := fmt.Sprintf("%s/%s", podUnderTest.Namespace, podUnderTest.Name)
+			ginkgo.By(fmt.Sprintf("Checking the host namespaces used by pod %s", podName))
+			command := fmt.Sprintf(ocGetHostNamespacesFormat, podUnderTest.Namespace, podUnderTest.Name)
+			out := utils.ExecuteCommand(command, common.DefaultTimeout, common.GetContext(), func() {
+				tnf.ClaimFilePrintf("Failed to get the host namespace settings of pod %s", podName)
+			})
+			fields := strings.Split(strings.TrimSpace(out), ",")
+			gomega.Expect(fields).To(gomega.HaveLen(len(hostNamespaceFields)))
+			for i, field := range hostNamespaceFields {
+				if fields[i] != "true" {
+					continue
+				}
+				if isHostNamespaceAllowed(env.Config.HostNamespaceExceptions, podUnderTest.Namespace, podUnderTest.Name, field) {
+					tnf.ClaimFilePrintf("Pod %s sets %s: true, allowed by configuration", podName, field)
+					continue
+				}
+				tnf.ClaimFilePrintf("Pod %s sets %s: true", podName, field)
+				violations = append(violations, podName+" "+field)
+			}
+		}
+		if n := len(violations); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d unexpected host namespace settings: %v", n, violations))
+		}
+	})
+}
+
+// parseCapabilityList parses a capability list printed by jsonpath, e.g. ["NET_ADMIN","SYS_TIME"], stripping any CAP_
+// prefix.
+func parseCapabilityList(list string) []string {
+	var parsed []string
+	for _, capability := range strings.Split(strings.Trim(list, "[] \n"), ",") {
+		capability = strings.TrimPrefix(strings.Trim(capability, `" `), "CAP_")
+		if capability != "" {
+			parsed = append(parsed, strings.ToUpper(capability))
+		}
+	}
+	return parsed
+}
+
+// getDeniedCapabilities returns the forbidden capabilities not allowed by the configuration.
+func getDeniedCapabilities(allowed []string) []string {
+	var denied []string
+	for _, capability := range forbiddenCapabilities {
+		isAllowed := false
+		for _, allowedCapability := range allowed {
+			if strings.TrimPrefix(strings.ToUpper(allowedCapability), "CAP_") == capability {
+				isAllowed = true
+				break
+			}
+		}
+		if !isAllowed {
+			denied = append(denied, capability)
+		}
+	}
+	return denied
+}
+
+func testCapabilities(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestCapabilitiesIdentifier)
+	ginkgo.It(testID, func() {
+		const numFields = 2
+		denied := getDeniedCapabilities(env.Config.AllowedCapabilities)
+		var failedContainers []string
+		for _, cut := range env.ContainersUnderTest {
+			id := cut.ContainerIdentifier
+			containerName := fmt.Sprintf("%s/%s/%s", id.Namespace, id.PodName, id.ContainerName)
+			ginkgo.By(fmt.Sprintf("Checking the capabilities of container %s", containerName))
+			failed := false
+
+			command := fmt.Sprintf(ocGetCapabilitiesFormat, id.Namespace, id.PodName, id.ContainerName, id.ContainerName)
+			out := utils.ExecuteCommand(command, common.DefaultTimeout, common.GetContext(), func() {
+				tnf.ClaimFilePrintf("Failed to get the capabilities of container %s", containerName)
+			})
+			fields := strings.Split(strings.TrimSpace(out), "|")
+			gomega.Expect(fields).To(gomega.HaveLen(numFields))
+			added, dropped := parseCapabilityList(fields[0]), parseCapabilityList(fields[1])
+			tnf.ClaimFilePrintf("Container %s adds capabilities %v and drops %v", containerName, added, dropped)
+			for _, capability := range added {
+				for _, deniedCapability := range denied {
+					if capability == deniedCapability {
+						tnf.ClaimFilePrintf("Container %s adds the forbidden capability %s", containerName, capability)
+						failed = true
+					}
+				}
+			}
+
+			tester := capabilities.NewCapabilities(common.DefaultTimeout, containerMainPID, denied)
+			test, err := tnf.NewTest(cut.Oc.GetExpecter(), tester, []reel.Handler{tester}, cut.Oc.GetErrorChannel())
+			gomega.Expect(err).To(gomega.BeNil())
+			test.RunWithCallbacks(func() {
+				tnf.ClaimFilePrintf("Container %s has effective capabilities %v", containerName, tester.GetEffective())
+			}, func() {
+				tnf.ClaimFilePrintf("Container %s has the forbidden effective capabilities %v", containerName, tester.GetViolations())
+				failed = true
+			}, func(err error) {
+				tnf.ClaimFilePrintf("Failed to read the effective capabilities of container %s: %v", containerName, err)
+				failed = true
+			})
+
+			if failed {
+				failedContainers = append(failedContainers, containerName)
+			}
+		}
+		if n := len(failedContainers); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d containers with forbidden capabilities: %v", n, failedContainers))
+		}
+	})
+}
+
+func testSecurityContext(env *config.TestEnvironment) {
+	testSecurityContextField(env, identifiers.TestReadOnlyRootFilesystemIdentifier, securitycontext.ReadOnlyRootFilesystemField)
+	testSecurityContextField(env, identifiers.TestSeccompProfileIdentifier, securitycontext.SeccompProfileField)
+	testSecurityContextField(env, identifiers.TestDropAllCapabilitiesIdentifier, securitycontext.DropCapabilitiesField)
+}
+
+// testSecurityContextField checks a single securityContext field of the pods under test, so that each field is
+// reported as its own result in the claim.
+func testSecurityContextField(env *config.TestEnvironment, id claim.Identifier, field string) {
+	testID := identifiers.XformToGinkgoItIdentifier(id)
+	ginkgo.It(testID, func() {
+		context := common.GetContext()
+		var failedPods []string
+		for _, podUnderTest := range env.PodsUnderTest {
+			podName := fmt.Sprintf("%s/%s", podUnderTest.Namespace, podUnderTest.Name)
+			ginkgo.By(fmt.Sprintf("Checking the %s securityContext field of pod %s", field, podName))
+			tester := securitycontext.NewSecurityContext(common.DefaultTimeout, podUnderTest.Namespace, podUnderTest.Name)
+			test, err := tnf.NewTest(context.GetExpecter(), tester, []reel.Handler{tester}, context.GetErrorChannel())
+			gomega.Expect(err).To(gomega.BeNil())
+			_, err = test.Run()
+			if err != nil || tester.Result() == tnf.ERROR {
+				tnf.ClaimFilePrintf("Failed to get the securityContext of pod %s: %v", podName, err)
+				failedPods = append(failedPods, podName)
+				continue
+			}
+			if field == securitycontext.DropCapabilitiesField {
+				for _, c := range tester.GetContainers() {
+					if len(c.Add) > 0 {
+						tnf.ClaimFilePrintf("Pod %s container %s adds capabilities %v", podName, c.Name, c.Add)
+					}
+				}
+			}
+			if violations := tester.GetViolations(field); len(violations) > 0 {
+				for _, violation := range violations {
+					tnf.ClaimFilePrintf("Pod %s: %s", podName, violation)
+				}
+				failedPods = append(failedPods, podName)
+			}
+		}
+		if n := len(failedPods); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d pods violating the %s securityContext requirement: %v", n, field, failedPods))
+		}
+	})
+}
+
+// isAPIAccessAllowed returns true when a pod is declared to call the Kubernetes API.
+func isAPIAccessAllowed(exceptions []configsections.APIAccessException, podNamespace, podName string) bool {
+	for _, exception := range exceptions {
+		if exception.Namespace == podNamespace && strings.HasPrefix(podName, exception.PodName) {
+			return true
+		}
+	}
+	return false
+}
+
+// isServiceAccountTokenMounted returns whether the service account token is mounted in a pod, along with the service
+// account of the pod and the origin of the setting.
+func isServiceAccountTokenMounted(namespace, podName string) (mounted bool, serviceAccount, origin string) {
+	const numExpectedFields = 2
+	out := utils.ExecuteCommand(fmt.Sprintf(ocGetPodAutomountFormat, namespace, podName), common.DefaultTimeout, common.GetContext(), func() {
+		tnf.ClaimFilePrintf("Failed to get the automountServiceAccountToken setting of pod %s/%s", namespace, podName)
+	})
+	fields := strings.Split(strings.TrimSpace(out), "|")
+	gomega.Expect(fields).To(gomega.HaveLen(numExpectedFields))
+	serviceAccount = fields[0]
+	if serviceAccount == "" {
+		serviceAccount = defaultServiceAccount
+	}
+	if fields[1] != "" {
+		return fields[1] == "true", serviceAccount, "pod spec"
+	}
+	out = utils.ExecuteCommand(fmt.Sprintf(ocGetServiceAccountAutomountFormat, namespace, serviceAccount), common.DefaultTimeout, common.GetContext(), func() {
+		tnf.ClaimFilePrintf("Failed to get service account %s/%s", namespace, serviceAccount)
+	})
+	if setting := strings.TrimSpace(out); setting != "" {
+		return setting == "true", serviceAccount, "service account"
+	}
+	return true, serviceAccount, "default"
+}
+
+// testAutomountServiceAccountToken ensures the pods which do not call the Kubernetes API do not mount their service
+// account token.
+func testAutomountServiceAccountToken(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestAutomountServiceAccountTokenIdentifier)
+	ginkgo.It(testID, func() {
+		var failedPods []string
+		for _, podUnderTest := range env.PodsUnderTest {
+			podName := podUnderTest.Namespace + "/" + podUnderTest.Name
+			ginkgo.By(fmt.Sprintf("Checking whether pod %s mounts its service account token", podName))
+			mounted, serviceAccount, origin := isServiceAccountTokenMounted(podUnderTest.Namespace, podUnderTest.Name)
+			if !mounted {
+				continue
+			}
+			if isAPIAccessAllowed(env.Config.APIAccessExceptions, podUnderTest.Namespace, podUnderTest.Name) {
+				tnf.ClaimFilePrintf("Pod %s mounts the token of service account %s, allowed by configuration", podName, serviceAccount)
+				continue
+			}
+			tnf.ClaimFilePrintf("Pod %s mounts the token of service account %s (%s setting)", podName, serviceAccount, origin)
+			failedPods = append(failedPods, podName)
+		}
+		if n := len(failedPods); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d pods mounting a service account token without calling the API: %v", n, failedPods))
+		}
+	})
+}
+
+func isForbiddenSCC(scc string) bool {
+	for _, forbidden := range forbiddenSCCs {
+		if forbidden == scc {
+			return true
+		}
+	}
+	return false
+}
+
+// isSCCAllowed returns true when a pod is allowed to be admitted under a forbidden SecurityContextConstraint.
+func isSCCAllowed(exceptions []configsections.SCCException, podNamespace, podName, scc string) bool {
+	for _, exception := range exceptions {
+		if exception.Namespace != podNamespace || !strings.HasPrefix(podName, exception.PodName) {
+			continue
+		}
+		if len(exception.SCCs) == 0 {
+			return true
+		}
+		for _, allowed := range exception.SCCs {
+			if allowed == scc {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// testSCC ensures the pods under test were not admitted under a forbidden SecurityContextConstraint.
+func testSCC(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestSCCIdentifier)
+	ginkgo.It(testID, func() {
+		var violations []string
+		for _, podUnderTest := range env.PodsUnderTest {
+			podName := podUnderTest.Namespace + "/" + podUnderTest.Name
+			ginkgo.By(fmt.Sprintf("Checking the SecurityContextConstraint of pod %s", podName))
+			out := utils.ExecuteCommand(fmt.Sprintf(ocGetPodSCCFormat, podUnderTest.Namespace, podUnderTest.Name), common.DefaultTimeout, common.GetContext(), func() {
+				tnf.ClaimFilePrintf("Failed to get the SecurityContextConstraint of pod %s", podName)
+			})
+			scc := strings.TrimSpace(out)
+			if scc == "" {
+				tnf.ClaimFilePrintf("Pod %s has no SecurityContextConstraint annotation", podName)
+				continue
+			}
+			tnf.ClaimFilePrintf("Pod %s was admitted under SecurityContextConstraint %s", podName, scc)
+			if !isForbiddenSCC(scc) {
+				continue
+			}
+			if isSCCAllowed(env.Config.SCCExceptions, podUnderTest.Namespace, podUnderTest.Name, scc) {
+				tnf.ClaimFilePrintf("Pod %s uses SecurityContextConstraint %s, allowed by configuration", podName, scc)
+				continue
+			}
+			violations = append(violations, podName+" "+scc)
+		}
+		if n := len(violations); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d pods admitted under a forbidden SecurityContextConstraint: %v", n, violations))
+		}
+	})
+}
+
+// isUnderPath returns true when a path is a directory or is below it.
+func isUnderPath(p, dir string) bool {
+	p, dir = path.Clean(p), path.Clean(dir)
+	return p == dir || strings.HasPrefix(p, strings.TrimSuffix(dir, "/")+"/")
+}
+
+// isSensitiveHostPath returns true when a host path is, or is below, a sensitive host directory.  Mounting a directory
+// above one of them, such as /var/run, exposes it too.
+func isSensitiveHostPath(hostPath string) bool {
+	for _, sensitive := range sensitiveHostPaths {
+		if sensitive == "/" {
+			if path.Clean(hostPath) == sensitive {
+				return true
+			}
+			continue
+		}
+		if isUnderPath(hostPath, sensitive) || isUnderPath(sensitive, hostPath) {
+			return true
+		}
+	}
+	return false
+}
+
+// isHostPathAllowed returns true when a pod is allowed to mount a host path.
+func isHostPathAllowed(exceptions []configsections.HostPathException, podNamespace, podName, hostPath string) bool {
+	for _, exception := range exceptions {
+		if exception.Namespace != podNamespace || !strings.HasPrefix(podName, exception.PodName) {
+			continue
+		}
+		if len(exception.Paths) == 0 && !isSensitiveHostPath(hostPath) {
+			return true
+		}
+		for _, allowed := range exception.Paths {
+			if isUnderPath(hostPath, allowed) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// testHostPaths ensures the pods under test do not mount hostPath volumes.
+func testHostPaths(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestHostPathIdentifier)
+	ginkgo.It(testID, func() {
+		const numExpectedFields = 2
+		var violations []string
+		for _, podUnderTest := range env.PodsUnderTest {
+			podName := podUnderTest.Namespace + "/" + podUnderTest.Name
+			ginkgo.By(fmt.Sprintf("Checking the hostPath volumes of pod %s", podName))
+			out := utils.ExecuteCommand(fmt.Sprintf(ocGetPodHostPathsFormat, podUnderTest.Namespace, podUnderTest.Name), common.DefaultTimeout,
+				common.GetContext(), func() {
+					tnf.ClaimFilePrintf("Failed to get the hostPath volumes of pod %s", podName)
+				})
+			for _, line := range strings.Split(out, "\n") {
+				fields := strings.Split(strings.TrimSpace(line), "|")
+				if len(fields) != numExpectedFields {
+					continue
+				}
+				volume, hostPath := fields[0], fields[1]
+				sensitive := ""
+				if isSensitiveHostPath(hostPath) {
+					sensitive = "sensitive "
+				}
+				if isHostPathAllowed(env.Config.HostPathExceptions, podUnderTest.Namespace, podUnderTest.Name, hostPath) {
+					tnf.ClaimFilePrintf("Pod %s mounts %shost path %s as volume %s, allowed by configuration", podName, sensitive, hostPath, volume)
+					continue
+				}
+				tnf.ClaimFilePrintf("Pod %s mounts %shost path %s as volume %s", podName, sensitive, hostPath, volume)
+				violations = append(violations, podName+" "+hostPath)
+			}
+		}
+		if n := len(violations); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d hostPath volumes mounted by pods: %v", n, violations))
+		}
+	})
+}
+
+func testSecretHygiene(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestSecretHygieneIdentifier)
+	ginkgo.It(testID, func() {
+		context := common.GetContext()
+		podsByNamespace := map[string][]string{}
+		for _, podUnderTest := range env.PodsUnderTest {
+			podsByNamespace[podUnderTest.Namespace] = append(podsByNamespace[podUnderTest.Namespace], podUnderTest.Name)
+		}
+		var failedNamespaces []string
+		for _, ns := range env.NameSpacesUnderTest {
+			ginkgo.By(fmt.Sprintf("Checking the handling of the secrets of namespace %s", ns))
+			tester := secrethygiene.NewSecretHygiene(common.DefaultTimeout, ns, podsByNamespace[ns])
+			test, err := tnf.NewTest(context.GetExpecter(), tester, []reel.Handler{tester}, context.GetErrorChannel())
+			gomega.Expect(err).To(gomega.BeNil())
+			test.RunWithCallbacks(nil, func() {
+				for _, exposure := range tester.GetEnvironmentExposures() {
+					tnf.ClaimFilePrintf("Namespace %s: %s", ns, exposure)
+				}
+				for _, mount := range tester.GetWorldReadableMounts() {
+					tnf.ClaimFilePrintf("Namespace %s: %s", ns, mount)
+				}
+				for _, leak := range tester.GetLeakedValues() {
+					tnf.ClaimFilePrintf("Namespace %s: %s", ns, leak)
+				}
+				failedNamespaces = append(failedNamespaces, ns)
+			}, func(err error) {
+				tnf.ClaimFilePrintf("Failed to get the pods, secrets and ConfigMaps of namespace %s: %v", ns, err)
+				failedNamespaces = append(failedNamespaces, ns)
+			})
+		}
+		if n := len(failedNamespaces); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d namespaces with mishandled secrets: %v", n, failedNamespaces))
+		}
+	})
+}
+
+func testDedicatedServiceAccount(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestDedicatedServiceAccountIdentifier)
+	ginkgo.It(testID, func() {
+		var failedPods []string
+		for _, podUnderTest := range env.PodsUnderTest {
+			podName := fmt.Sprintf("%s/%s", podUnderTest.Namespace, podUnderTest.Name)
+			ginkgo.By(fmt.Sprintf("Checking that pod %s uses a dedicated service account", podName))
+			if podUnderTest.ServiceAccount == "" || podUnderTest.ServiceAccount == defaultServiceAccount {
+				tnf.ClaimFilePrintf("Pod %s uses the %s service account", podName, defaultServiceAccount)
+				failedPods = append(failedPods, podName)
+			}
+		}
+		if n := len(failedPods); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d pods using the %s service account: %v", n, defaultServiceAccount, failedPods))
+		}
+	})
+}
+
+func testServiceAccountRBACScope(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestServiceAccountRBACScopeIdentifier)
+	ginkgo.It(testID, func() {
+		context := common.GetContext()
+		checked := map[string]bool{}
+		var failedServiceAccounts []string
+		for _, podUnderTest := range env.PodsUnderTest {
+			serviceAccount := podUnderTest.ServiceAccount
+			if serviceAccount == "" {
+				serviceAccount = defaultServiceAccount
+			}
+			serviceAccountName := fmt.Sprintf("%s/%s", podUnderTest.Namespace, serviceAccount)
+			if checked[serviceAccountName] {
+				continue
+			}
+			checked[serviceAccountName] = true
+			ginkgo.By(fmt.Sprintf("Checking the roles bound to service account %s", serviceAccountName))
+			tester := rbacscope.NewRBACScope(common.DefaultTimeout, serviceAccount, podUnderTest.Namespace)
+			test, err := tnf.NewTest(context.GetExpecter(), tester, []reel.Handler{tester}, context.GetErrorChannel())
+			gomega.Expect(err).To(gomega.BeNil())
+			test.RunWithCallbacks(nil, func() {
+				for _, violation := range tester.GetViolations() {
+					tnf.ClaimFilePrintf("Service account %s: %s", serviceAccountName, violation)
+				}
+				failedServiceAccounts = append(failedServiceAccounts, serviceAccountName)
+			}, func(err error) {
+				tnf.ClaimFilePrintf("Failed to get the permissions of service account %s: %v", serviceAccountName, err)
+				failedServiceAccounts = append(failedServiceAccounts, serviceAccountName)
+			})
+			for _, binding := range tester.GetBindings() {
+				tnf.ClaimFilePrintf("Service account %s is bound to %s %s by %s %s", serviceAccountName, binding.RoleKind, binding.RoleName, binding.Kind, binding.Name)
+			}
+		}
+		if n := len(failedServiceAccounts); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d service accounts with cluster-admin or wildcard permissions: %v", n, failedServiceAccounts))
+		}
+	})
+}
+
+// getServiceAccountBindings returns the role bindings and cluster role bindings of a service account.
+func getServiceAccountBindings(serviceAccount, namespace string) []rbacscope.Binding {
+	context := common.GetContext()
+	tester := rbacscope.NewRBACScope(common.DefaultTimeout, serviceAccount, namespace)
+	test, err := tnf.NewTest(context.GetExpecter(), tester, []reel.Handler{tester}, context.GetErrorChannel())
+	gomega.Expect(err).To(gomega.BeNil())
+	_, err = test.Run()
+	gomega.Expect(err).To(gomega.BeNil())
+	return tester.GetBindings()
+}
+
+func isClusterRoleBindingAllowed(allowed []string, name string) bool {
+	for _, allowedName := range allowed {
+		if allowedName == name {
+			return true
+		}
+	}
+	return false
+}
+
+func testClusterRoleBindingsAudit(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestClusterRoleBindingsAuditIdentifier)
+	ginkgo.It(testID, func() {
+		context := common.GetContext()
+		checked := map[string]bool{}
+		var failedBindings []string
+		for _, podUnderTest := range env.PodsUnderTest {
+			serviceAccount := podUnderTest.ServiceAccount
+			if serviceAccount == "" {
+				serviceAccount = defaultServiceAccount
+			}
+			serviceAccountName := fmt.Sprintf("%s/%s", podUnderTest.Namespace, serviceAccount)
+			if checked[serviceAccountName] {
+				continue
+			}
+			checked[serviceAccountName] = true
+			ginkgo.By(fmt.Sprintf("Auditing the ClusterRoleBindings of service account %s", serviceAccountName))
+			for _, binding := range getServiceAccountBindings(serviceAccount, podUnderTest.Namespace) {
+				if binding.Kind != "ClusterRoleBinding" {
+					continue
+				}
+				tnf.ClaimFilePrintf("Service account %s is bound to ClusterRole %s by ClusterRoleBinding %s", serviceAccountName, binding.RoleName, binding.Name)
+				if isClusterRoleBindingAllowed(env.Config.AllowedClusterRoleBindings, binding.Name) {
+					continue
+				}
+				tester := clusterrolerules.NewClusterRoleRules(common.DefaultTimeout, binding.RoleName)
+				test, err := tnf.NewTest(context.GetExpecter(), tester, []reel.Handler{tester}, context.GetErrorChannel())
+				gomega.Expect(err).To(gomega.BeNil())
+				test.RunWithCallbacks(nil, func() {
+					for _, violation := range tester.GetViolations() {
+						tnf.ClaimFilePrintf("ClusterRoleBinding %s grants %s write access to core resources: %s", binding.Name, serviceAccountName, violation)
+					}
+					failedBindings = append(failedBindings, binding.Name)
+				}, func(err error) {
+					tnf.ClaimFilePrintf("Failed to get the rules of ClusterRole %s: %v", binding.RoleName, err)
+					failedBindings = append(failedBindings, binding.Name)
+				})
+			}
+		}
+		if n := len(failedBindings); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d ClusterRoleBindings granting cluster-wide write access to core resources: %v", n, failedBindings))
+		}
+	})
+}
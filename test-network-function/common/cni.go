@@ -0,0 +1,91 @@
+// Copyright (C) 2020-2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package common
+
+import (
+	"fmt"
+
+	"github.com/onsi/ginkgo"
+	log "github.com/sirupsen/logrus"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/cniplugins"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+// detectedCNIs caches the CNIs of the cluster, which are detected once per run.
+var detectedCNIs *cniplugins.CNIs
+
+// GetCNIs returns the primary CNI of the cluster and the CNI plugins used by its NetworkAttachmentDefinitions, or nil
+// when they could not be detected.
+func GetCNIs() *cniplugins.CNIs {
+	if detectedCNIs != nil {
+		return detectedCNIs
+	}
+	context := GetContext()
+	tester := cniplugins.NewCNIPlugins(DefaultTimeout)
+	test, err := tnf.NewTest(context.GetExpecter(), tester, []reel.Handler{tester}, context.GetErrorChannel())
+	if err != nil {
+		log.Errorf("Unable to detect the CNIs of the cluster: %v", err)
+		return nil
+	}
+	if result, err := test.Run(); result != tnf.SUCCESS || err != nil {
+		log.Errorf("Unable to detect the CNIs of the cluster: result=%d, err=%v", result, err)
+		return nil
+	}
+	cnis := tester.GetCNIs()
+	log.Infof("Detected primary CNI %q, Multus %t, Multus plugins %v", cnis.Primary, cnis.Multus, cnis.GetPluginTypes())
+	detectedCNIs = &cnis
+	return detectedCNIs
+}
+
+// skipWithReason records the reason of a skipped test in the claim before skipping it.
+func skipWithReason(reason string) {
+	tnf.ClaimFilePrintf("Skipped: %s", reason)
+	ginkgo.Skip(reason)
+}
+
+// SkipUnlessMultus skips the current test when Multus is not installed.  The test runs when the CNIs could not be
+// detected.
+func SkipUnlessMultus() {
+	if cnis := GetCNIs(); cnis != nil && !cnis.Multus {
+		skipWithReason("Multus is not installed in the cluster")
+	}
+}
+
+// SkipUnlessCNIPlugin skips the current test when no NetworkAttachmentDefinition uses the CNI plugin type, such as
+// macvlan or sriov.  The test runs when the CNIs could not be detected.
+func SkipUnlessCNIPlugin(pluginType string) {
+	SkipUnlessMultus()
+	if cnis := GetCNIs(); cnis != nil && !cnis.HasPlugin(pluginType) {
+		skipWithReason(fmt.Sprintf("no NetworkAttachmentDefinition uses the %s CNI plugin, found %v", pluginType, cnis.GetPluginTypes()))
+	}
+}
+
+// SkipUnlessPrimaryCNI skips the current test when the primary CNI of the cluster is none of the network types, such
+// as OVNKubernetes or OpenShiftSDN.  The test runs when the CNIs could not be detected.
+func SkipUnlessPrimaryCNI(networkTypes ...string) {
+	cnis := GetCNIs()
+	if cnis == nil {
+		return
+	}
+	for _, networkType := range networkTypes {
+		if cnis.Primary == networkType {
+			return
+		}
+	}
+	skipWithReason(fmt.Sprintf("the primary CNI %q of the cluster is not one of %v", cnis.Primary, networkTypes))
+}
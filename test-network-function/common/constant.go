@@ -28,5 +28,6 @@ const (
 	ObservabilityTestKey      = "observability"
 	OperatorTestKey           = "operator"
 	PlatformAlterationTestKey = "platform-alteration"
+	TopologyTestKey           = "topology"
 	CommonTestKey             = "common"
 )
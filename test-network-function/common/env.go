@@ -67,6 +67,12 @@ func Intrusive() bool {
 	return !b
 }
 
+// SkipPreflight is for running the tests even though the pre-flight checks found the cluster unhealthy
+func SkipPreflight() bool {
+	b, _ := strconv.ParseBool(os.Getenv("TNF_SKIP_PREFLIGHT"))
+	return b
+}
+
 // GetOcDebugImageID is for running oc debug commands in a disconnected environment with a specific oc debug pod image mirrored
 func GetOcDebugImageID() string {
 	return os.Getenv("TNF_OC_DEBUG_IMAGE_ID")
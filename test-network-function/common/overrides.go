@@ -0,0 +1,93 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package common
+
+import (
+	"time"
+
+	"github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+	configpkg "github.com/test-network-function/test-network-function/pkg/config"
+	"github.com/test-network-function/test-network-function/pkg/config/configsections"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/ping"
+	"github.com/test-network-function/test-network-function/pkg/tnf/interactive"
+	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+)
+
+// RunOverrides returns the timeout and retries overrides of the running test case, to be passed to
+// tnf.NewTestWithOverrides.
+func RunOverrides(testEnv *configpkg.TestEnvironment) tnf.RunOverrides {
+	override := currentTestOverride(testEnv)
+	return tnf.RunOverrides{
+		Timeout: time.Duration(override.TimeoutSeconds) * time.Second,
+		Retries: override.Retries,
+	}
+}
+
+// currentTestOverride returns the overrides of the config file for the running test case, keyed by its ginkgo.It text.
+func currentTestOverride(testEnv *configpkg.TestEnvironment) configsections.TestOverride {
+	return testEnv.Config.TestOverrides[ginkgo.CurrentSpecReport().LeafNodeText]
+}
+
+// PingCount returns the number of ICMP requests per ping of the running test case: its pingCount override, or
+// defaultCount.
+func PingCount(testEnv *configpkg.TestEnvironment, defaultCount int) int {
+	if count := currentTestOverride(testEnv).PingCount; count > 0 {
+		return count
+	}
+	return defaultCount
+}
+
+// PingThreshold returns the ping limits of the running test case, replacing those of threshold with the ones set by
+// its pingThreshold override.
+func PingThreshold(testEnv *configpkg.TestEnvironment, threshold configsections.PingThreshold) configsections.PingThreshold {
+	override := currentTestOverride(testEnv).PingThreshold
+	if override.MaxLossPercent > 0 {
+		threshold.MaxLossPercent = override.MaxLossPercent
+	}
+	if override.MaxAvgRTTMillis > 0 {
+		threshold.MaxAvgRTTMillis = override.MaxAvgRTTMillis
+	}
+	if override.MaxRTTMillis > 0 {
+		threshold.MaxRTTMillis = override.MaxRTTMillis
+	}
+	if override.MaxMdevMillis > 0 {
+		threshold.MaxMdevMillis = override.MaxMdevMillis
+	}
+	return threshold
+}
+
+// NewPingTest creates a Test pinging ipAddress from oc with count ICMP requests and the limits of threshold, run with
+// the given overrides.  A fresh ping handler is built for every retry, and the returned function returns the one of
+// the last run.
+func NewPingTest(oc *interactive.Oc, ipAddress string, count int, threshold configsections.PingThreshold,
+	overrides tnf.RunOverrides) (*tnf.Test, func() *ping.Ping) {
+	var tester *ping.Ping
+	newHandlers := func() (tnf.Tester, []reel.Handler) {
+		tester = ping.NewPingWithThresholds(DefaultTimeout, ipAddress, count, ping.Thresholds{
+			MaxLossPercent: threshold.MaxLossPercent,
+			MaxAvgRTT:      threshold.MaxAvgRTTMillis,
+			MaxRTT:         threshold.MaxRTTMillis,
+			MaxMdev:        threshold.MaxMdevMillis,
+		})
+		return tester, []reel.Handler{tester}
+	}
+	test, err := tnf.NewTestWithOverrides(oc.GetExpecter(), newHandlers, oc.GetErrorChannel(), overrides)
+	gomega.Expect(err).To(gomega.BeNil())
+	return test, func() *ping.Ping { return tester }
+}
@@ -0,0 +1,36 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package common
+
+// preflightChecks are run before any test case, in the order they were registered.
+var preflightChecks []func()
+
+// RegisterPreflightCheck adds a check to run before any test case.  A check aborts the suite by failing, when the
+// cluster or the CNF under test are not in a state where the results of the tests would be meaningful.
+func RegisterPreflightCheck(check func()) {
+	preflightChecks = append(preflightChecks, check)
+}
+
+// runPreflightChecks runs the registered pre-flight checks, unless they are disabled by TNF_SKIP_PREFLIGHT.
+func runPreflightChecks() {
+	if SkipPreflight() {
+		return
+	}
+	for _, check := range preflightChecks {
+		check()
+	}
+}
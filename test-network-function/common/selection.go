@@ -0,0 +1,44 @@
+// Copyright (C) 2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package common
+
+import (
+	"github.com/onsi/ginkgo"
+	log "github.com/sirupsen/logrus"
+	configpkg "github.com/test-network-function/test-network-function/pkg/config"
+)
+
+// Skip the test cases selected out by the includeTests and excludeTests lists of the config file, so that they are
+// reported as skipped in the claim rather than missing from it.
+var _ = ginkgo.BeforeEach(func() {
+	testEnv := configpkg.GetTestEnvironment()
+	testEnv.LoadConfig()
+	report := ginkgo.CurrentSpecReport()
+	if reason := testEnv.GetTestExclusion(report.LeafNodeText, currentTestLabels(report)); reason != "" {
+		log.Infof("Skipping %s: %s", report.LeafNodeText, reason)
+		ginkgo.Skip(configpkg.SkippedByConfigurationReason)
+	}
+})
+
+// currentTestLabels returns the labels a test case can be selected by: the name of its suite, and its ginkgo labels.
+func currentTestLabels(report ginkgo.SpecReport) []string {
+	var labels []string
+	if len(report.ContainerHierarchyTexts) > 0 {
+		labels = append(labels, report.ContainerHierarchyTexts[0])
+	}
+	return append(labels, report.Labels()...)
+}
@@ -29,6 +29,7 @@ var _ = ginkgo.BeforeSuite(func() {
 	for name := range autodiscover.GetNodesList() {
 		autodiscover.DeleteDebugLabel(name)
 	}
+	runPreflightChecks()
 })
 
 var _ = ginkgo.AfterSuite(func() {
@@ -2,7 +2,9 @@ package diagnostic
 
 import (
 	"encoding/json"
+	"fmt"
 	"path"
+	"strconv"
 	"strings"
 	"time"
 
@@ -12,18 +14,37 @@ import (
 
 	"github.com/onsi/ginkgo"
 	"github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
 	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/clusterplatform"
 	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/clusterversion"
 	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/generic"
 	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/nodedebug"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/nodepressure"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/nodeservices"
 	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
 	"github.com/test-network-function/test-network-function/pkg/tnf/testcases"
+	"github.com/test-network-function/test-network-function/pkg/utils"
 	"github.com/test-network-function/test-network-function/test-network-function/results"
 )
 
 const (
 	// defaultTimeoutSeconds contains the default timeout in secons.
 	defaultTimeoutSeconds = 20
+	// maxNodeRequestedPercent is the share of the allocatable CPU or memory of a node whose requests leave too little
+	// headroom for the lifecycle tests to reschedule pods.
+	maxNodeRequestedPercent = 90
+	// ocGetClusterOperatorsCommand prints the name and the Available and Degraded condition statuses of every
+	// ClusterOperator, separated by a "|", one ClusterOperator per line.
+	ocGetClusterOperatorsCommand = `oc get clusteroperators -o jsonpath='{range .items[*]}{.metadata.name}|{.status.conditions[?(@.type=="Available")].status}|{.status.conditions[?(@.type=="Degraded")].status}{"\n"}{end}'`
+	// ocGetNodesReadyCommand prints the name and the Ready condition status of every node, separated by a "|", one node
+	// per line.
+	ocGetNodesReadyCommand = `oc get nodes -o jsonpath='{range .items[*]}{.metadata.name}|{.status.conditions[?(@.type=="Ready")].status}{"\n"}{end}'`
+	// ocGetPodRestartsCommand prints the namespace, the name and the container restart counts of every pod, separated
+	// by a "|", one pod per line.
+	ocGetPodRestartsCommand = `oc get pods -A -o jsonpath='{range .items[*]}{.metadata.namespace}|{.metadata.name}|{.status.containerStatuses[*].restartCount}{"\n"}{end}'`
+	// maxSystemPodRestarts is the number of container restarts above which a system pod hints at an unstable node.
+	maxSystemPodRestarts = 10
 )
 
 var (
@@ -37,6 +58,16 @@ var (
 
 	versionsOcp clusterversion.ClusterVersion
 
+	clusterPlatform clusterplatform.Platform
+
+	environmentHealth = EnvironmentHealth{}
+
+	// nodeServices are the systemd services every node needs to run pods.
+	nodeServices = []string{"kubelet", "crio"}
+
+	// systemNamespacePrefixes are the prefixes of the namespaces of the cluster components.
+	systemNamespacePrefixes = []string{"openshift-", "kube-"}
+
 	nodesHwInfo = NodesHwInfo{}
 
 	// csiDriver stores the csi driver JSON output of `oc get csidriver -o json`
@@ -67,6 +98,10 @@ var (
 	env *config.TestEnvironment = config.GetTestEnvironment()
 )
 
+func init() {
+	common.RegisterPreflightCheck(checkClusterHealth)
+}
+
 var _ = ginkgo.Describe(common.DiagnosticTestKey, func() {
 	conf, _ := ginkgo.GinkgoConfiguration()
 	if testcases.IsInFocus(conf.FocusStrings, common.DiagnosticTestKey) {
@@ -118,6 +153,30 @@ var _ = ginkgo.Describe(common.DiagnosticTestKey, func() {
 		ginkgo.It(testID, func() {
 			listClusterCSIInfo()
 		})
+		testID = identifiers.XformToGinkgoItIdentifier(identifiers.TestClusterPlatformIdentifier)
+		ginkgo.It(testID, func() {
+			testClusterPlatform()
+		})
+		testID = identifiers.XformToGinkgoItIdentifier(identifiers.TestNodePressureIdentifier)
+		ginkgo.It(testID, func() {
+			testNodePressure()
+		})
+		testID = identifiers.XformToGinkgoItIdentifier(identifiers.TestClusterOperatorHealthIdentifier)
+		ginkgo.It(testID, func() {
+			testClusterOperatorHealth()
+		})
+		testID = identifiers.XformToGinkgoItIdentifier(identifiers.TestNodesReadyIdentifier)
+		ginkgo.It(testID, func() {
+			testNodesReady()
+		})
+		testID = identifiers.XformToGinkgoItIdentifier(identifiers.TestNodeServicesIdentifier)
+		ginkgo.It(testID, func() {
+			testNodeServices()
+		})
+		testID = identifiers.XformToGinkgoItIdentifier(identifiers.TestSystemPodRestartsIdentifier)
+		ginkgo.It(testID, func() {
+			testSystemPodRestarts()
+		})
 	}
 })
 
@@ -142,6 +201,13 @@ type NodesHwInfo struct {
 	Worker NodeHwInfo
 }
 
+// EnvironmentHealth records the health of the nodes, as failing CNF tests are not meaningful on unhealthy nodes.
+type EnvironmentHealth struct {
+	UnreadyNodes      []string                     // nodes whose Ready condition is not True
+	NodeServices      map[string]map[string]string // node name to the state of its kubelet and crio services
+	SystemPodRestarts map[string]int               // system pods with too many restarts, as namespace/name, to restarts
+}
+
 // GetNodeSummary returns the result of running `oc get nodes -o json`.
 func GetNodeSummary() map[string]interface{} {
 	return nodeSummary
@@ -167,6 +233,12 @@ func GetCsiDriverInfo() map[string]interface{} {
 	return csiDriver
 }
 
+// GetClusterPlatform returns the OpenShift version, infrastructure platform, network type, MTU and node inventory of
+// the cluster.
+func GetClusterPlatform() clusterplatform.Platform {
+	return clusterPlatform
+}
+
 func getMasterNodeName(env *config.TestEnvironment) string {
 	for _, node := range env.NodesUnderTest {
 		if node.IsMaster() && node.HasDebugPod() {
@@ -213,6 +285,177 @@ func testOcpVersion() {
 	versionsOcp = tester.GetVersions()
 }
 
+func testClusterPlatform() {
+	context := common.GetContext()
+	tester := clusterplatform.NewClusterPlatform(defaultTestTimeout)
+	test, err := tnf.NewTest(context.GetExpecter(), tester, []reel.Handler{tester}, context.GetErrorChannel())
+	gomega.Expect(err).To(gomega.BeNil())
+	test.RunAndValidate()
+	clusterPlatform = tester.GetPlatform()
+	tnf.ClaimFilePrintf("Cluster platform %s, network type %s with MTU %d, %d nodes", clusterPlatform.Platform,
+		clusterPlatform.NetworkType, clusterPlatform.MTU, len(clusterPlatform.Nodes))
+}
+
+func testNodePressure() {
+	context := common.GetContext()
+	tester := nodepressure.NewNodePressure(defaultTestTimeout, maxNodeRequestedPercent)
+	test, err := tnf.NewTest(context.GetExpecter(), tester, []reel.Handler{tester}, context.GetErrorChannel())
+	gomega.Expect(err).To(gomega.BeNil())
+	result, err := test.Run()
+	gomega.Expect(err).To(gomega.BeNil())
+	gomega.Expect(result).ToNot(gomega.Equal(tnf.ERROR))
+	for _, node := range tester.GetNodes() {
+		tnf.ClaimFilePrintf("Node %s: pressure %v, %.0f%% of CPU and %.0f%% of memory requested", node.Name, node.Pressure,
+			node.CPUPercent(), node.MemoryPercent())
+	}
+	for _, saturated := range tester.GetSaturated() {
+		log.Warnf("The cluster may be too saturated for the lifecycle tests to be meaningful: %s", saturated)
+		tnf.ClaimFilePrintf("Warning: %s", saturated)
+	}
+}
+
+// GetUnhealthyClusterOperators returns the ClusterOperators which are not Available or are Degraded, with their
+// conditions.  A failing CNF test is not meaningful while any of them is unhealthy.
+func GetUnhealthyClusterOperators() []string {
+	const numExpectedFields = 3
+	out := utils.ExecuteCommand(ocGetClusterOperatorsCommand, defaultTestTimeout, common.GetContext(), func() {
+		tnf.ClaimFilePrintf("Failed to get the ClusterOperators")
+	})
+	var unhealthy []string
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.Split(strings.TrimSpace(line), "|")
+		if len(fields) != numExpectedFields {
+			continue
+		}
+		if fields[1] != "True" || fields[2] == "True" {
+			unhealthy = append(unhealthy, fmt.Sprintf("%s (Available=%s, Degraded=%s)", fields[0], fields[1], fields[2]))
+		}
+	}
+	return unhealthy
+}
+
+// checkClusterHealth aborts the suite when a ClusterOperator is unhealthy, so an infrastructure failure is not
+// reported as failures of the CNF.
+func checkClusterHealth() {
+	if common.IsNonOcpCluster() {
+		return
+	}
+	if unhealthy := GetUnhealthyClusterOperators(); len(unhealthy) > 0 {
+		log.Errorf("Infrastructure failure, unhealthy ClusterOperators: %v", unhealthy)
+		ginkgo.Fail(fmt.Sprintf("Infrastructure failure, the CNF tests were not run as %d ClusterOperators are unhealthy: %v. "+
+			"Set TNF_SKIP_PREFLIGHT=true to run them anyway.", len(unhealthy), unhealthy))
+	}
+}
+
+func testClusterOperatorHealth() {
+	if common.IsNonOcpCluster() {
+		ginkgo.Skip("ClusterOperators only exist on OpenShift")
+	}
+	unhealthy := GetUnhealthyClusterOperators()
+	for _, operator := range unhealthy {
+		tnf.ClaimFilePrintf("Infrastructure failure: ClusterOperator %s", operator)
+	}
+	if n := len(unhealthy); n > 0 {
+		ginkgo.Fail(fmt.Sprintf("Found %d unhealthy ClusterOperators: %v", n, unhealthy))
+	}
+}
+
+// GetEnvironmentHealth returns the health of the nodes gathered by the diagnostic tests.
+func GetEnvironmentHealth() EnvironmentHealth {
+	return environmentHealth
+}
+
+func testNodesReady() {
+	const numExpectedFields = 2
+	out := utils.ExecuteCommand(ocGetNodesReadyCommand, defaultTestTimeout, common.GetContext(), func() {
+		tnf.ClaimFilePrintf("Failed to get the nodes")
+	})
+	environmentHealth.UnreadyNodes = nil
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.Split(strings.TrimSpace(line), "|")
+		if len(fields) != numExpectedFields {
+			continue
+		}
+		if fields[1] != "True" {
+			tnf.ClaimFilePrintf("Node %s is not Ready (Ready=%s)", fields[0], fields[1])
+			environmentHealth.UnreadyNodes = append(environmentHealth.UnreadyNodes, fields[0])
+		}
+	}
+	if n := len(environmentHealth.UnreadyNodes); n > 0 {
+		ginkgo.Fail(fmt.Sprintf("Found %d nodes which are not Ready: %v", n, environmentHealth.UnreadyNodes))
+	}
+}
+
+func testNodeServices() {
+	if common.IsNonOcpCluster() {
+		ginkgo.Skip("can't use 'oc debug' in minikube")
+	}
+	env = config.GetTestEnvironment()
+	environmentHealth.NodeServices = map[string]map[string]string{}
+	var failedNodes []string
+	for name, node := range env.NodesUnderTest {
+		if !node.HasDebugPod() {
+			continue
+		}
+		tester := nodeservices.NewNodeServices(defaultTestTimeout, nodeServices...)
+		test, err := tnf.NewTest(node.Oc.GetExpecter(), tester, []reel.Handler{tester}, node.Oc.GetErrorChannel())
+		gomega.Expect(err).To(gomega.BeNil())
+		result, err := test.Run()
+		gomega.Expect(err).To(gomega.BeNil())
+		gomega.Expect(result).ToNot(gomega.Equal(tnf.ERROR))
+		environmentHealth.NodeServices[name] = tester.GetStates()
+		if inactive := tester.GetInactive(); len(inactive) > 0 {
+			tnf.ClaimFilePrintf("Node %s: services %v are not active: %v", name, inactive, tester.GetStates())
+			failedNodes = append(failedNodes, name)
+		}
+	}
+	if len(environmentHealth.NodeServices) == 0 {
+		ginkgo.Skip("No node has a debug pod")
+	}
+	if n := len(failedNodes); n > 0 {
+		ginkgo.Fail(fmt.Sprintf("Found %d nodes whose %v services are not all active: %v", n, nodeServices, failedNodes))
+	}
+}
+
+func isSystemNamespace(namespace string) bool {
+	for _, prefix := range systemNamespacePrefixes {
+		if strings.HasPrefix(namespace, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func testSystemPodRestarts() {
+	const numExpectedFields = 3
+	out := utils.ExecuteCommand(ocGetPodRestartsCommand, defaultTestTimeout, common.GetContext(), func() {
+		tnf.ClaimFilePrintf("Failed to get the pods")
+	})
+	environmentHealth.SystemPodRestarts = map[string]int{}
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.Split(strings.TrimSpace(line), "|")
+		if len(fields) != numExpectedFields || !isSystemNamespace(fields[0]) {
+			continue
+		}
+		restarts := 0
+		for _, count := range strings.Fields(fields[2]) {
+			n, err := strconv.Atoi(count)
+			if err == nil {
+				restarts += n
+			}
+		}
+		if restarts > maxSystemPodRestarts {
+			pod := fields[0] + "/" + fields[1]
+			tnf.ClaimFilePrintf("System pod %s restarted %d times", pod, restarts)
+			environmentHealth.SystemPodRestarts[pod] = restarts
+		}
+	}
+	if n := len(environmentHealth.SystemPodRestarts); n > 0 {
+		ginkgo.Fail(fmt.Sprintf("Found %d system pods restarted more than %d times: %v", n, maxSystemPodRestarts,
+			environmentHealth.SystemPodRestarts))
+	}
+}
+
 func testCniPlugins() {
 	if common.IsNonOcpCluster() {
 		ginkgo.Skip("can't use 'oc debug' in minikube")
@@ -100,6 +100,72 @@ var (
 		Url:     formTestURL(common.AccessControlTestKey, "namespace"),
 		Version: versionOne,
 	}
+	// TestNamespaceLabelsIdentifier ensures the namespaces under test carry the required labels.
+	TestNamespaceLabelsIdentifier = claim.Identifier{
+		Url:     formTestURL(common.AccessControlTestKey, "namespace-labels"),
+		Version: versionOne,
+	}
+	// TestClusterNamespaceObjectsIdentifier ensures no CNF object lives in a default or cluster namespace.
+	TestClusterNamespaceObjectsIdentifier = claim.Identifier{
+		Url:     formTestURL(common.AccessControlTestKey, "cluster-namespace-objects"),
+		Version: versionOne,
+	}
+	// TestNonRootContainerIdentifier ensures containers do not run as root.
+	TestNonRootContainerIdentifier = claim.Identifier{
+		Url:     formTestURL(common.AccessControlTestKey, "non-root-container"),
+		Version: versionOne,
+	}
+	// TestPrivilegedContainerIdentifier ensures containers are neither privileged nor allowed to escalate privileges.
+	TestPrivilegedContainerIdentifier = claim.Identifier{
+		Url:     formTestURL(common.AccessControlTestKey, "privileged-container"),
+		Version: versionOne,
+	}
+	// TestHostNamespacesIdentifier ensures pods do not use the host network, PID or IPC namespaces.
+	TestHostNamespacesIdentifier = claim.Identifier{
+		Url:     formTestURL(common.AccessControlTestKey, "host-namespaces"),
+		Version: versionOne,
+	}
+	// TestCapabilitiesIdentifier ensures containers do not hold forbidden Linux capabilities.
+	TestCapabilitiesIdentifier = claim.Identifier{
+		Url:     formTestURL(common.AccessControlTestKey, "capabilities"),
+		Version: versionOne,
+	}
+	// TestReadOnlyRootFilesystemIdentifier ensures containers run with a read-only root filesystem.
+	TestReadOnlyRootFilesystemIdentifier = claim.Identifier{
+		Url:     formTestURL(common.AccessControlTestKey, "read-only-root-filesystem"),
+		Version: versionOne,
+	}
+	// TestSeccompProfileIdentifier ensures containers run with the RuntimeDefault seccomp profile.
+	TestSeccompProfileIdentifier = claim.Identifier{
+		Url:     formTestURL(common.AccessControlTestKey, "seccomp-profile"),
+		Version: versionOne,
+	}
+	// TestDropAllCapabilitiesIdentifier ensures containers drop all capabilities.
+	TestDropAllCapabilitiesIdentifier = claim.Identifier{
+		Url:     formTestURL(common.AccessControlTestKey, "drop-all-capabilities"),
+		Version: versionOne,
+	}
+	// TestSecretHygieneIdentifier ensures secrets are mounted privately and not copied into ConfigMaps or annotations.
+	TestSecretHygieneIdentifier = claim.Identifier{
+		Url:     formTestURL(common.AccessControlTestKey, "secret-hygiene"),
+		Version: versionOne,
+	}
+	// TestAutomountServiceAccountTokenIdentifier ensures pods which do not call the API do not mount a service account
+	// token.
+	TestAutomountServiceAccountTokenIdentifier = claim.Identifier{
+		Url:     formTestURL(common.AccessControlTestKey, "automount-service-account-token"),
+		Version: versionOne,
+	}
+	// TestSCCIdentifier ensures pods are not admitted under the anyuid or privileged SecurityContextConstraints.
+	TestSCCIdentifier = claim.Identifier{
+		Url:     formTestURL(common.AccessControlTestKey, "security-context-constraint"),
+		Version: versionOne,
+	}
+	// TestHostPathIdentifier ensures pods do not mount hostPath volumes.
+	TestHostPathIdentifier = claim.Identifier{
+		Url:     formTestURL(common.AccessControlTestKey, "host-path"),
+		Version: versionOne,
+	}
 	// TestNonDefaultGracePeriodIdentifier tests best grace period practices.
 	TestNonDefaultGracePeriodIdentifier = claim.Identifier{
 		Url:     formTestURL(common.LifecycleTestKey, "pod-termination-grace-period"),
@@ -125,12 +191,59 @@ var (
 		Url:     formTestURL(common.OperatorTestKey, "install-source"),
 		Version: versionOne,
 	}
+	// TestOperatorInstallPhaseIdentifier tests that the OLM objects of an Operator completed the installation.
+	TestOperatorInstallPhaseIdentifier = claim.Identifier{
+		Url:     formTestURL(common.OperatorTestKey, "install-phase"),
+		Version: versionOne,
+	}
+	// TestOperatorSubscriptionSourceIdentifier tests that an Operator is subscribed to an approved channel and catalog
+	// source.
+	TestOperatorSubscriptionSourceIdentifier = claim.Identifier{
+		Url:     formTestURL(common.OperatorTestKey, "subscription-source"),
+		Version: versionOne,
+	}
+	// TestCrdConformanceIdentifier tests that the CRDs of an Operator follow the apiextensions/v1 conventions.
+	TestCrdConformanceIdentifier = claim.Identifier{
+		Url:     formTestURL(common.OperatorTestKey, "crd-conformance"),
+		Version: versionOne,
+	}
+	// TestOperatorReconciliationIdentifier tests that an Operator recreates the Deployments it manages once deleted.
+	TestOperatorReconciliationIdentifier = claim.Identifier{
+		Url:     formTestURL(common.OperatorTestKey, "reconciliation"),
+		Version: versionOne,
+	}
+	// TestOperatorReinstallIdentifier tests that an Operator uninstalls without leaving resources behind and reinstalls.
+	TestOperatorReinstallIdentifier = claim.Identifier{
+		Url:     formTestURL(common.OperatorTestKey, "reinstall"),
+		Version: versionOne,
+	}
 	// TestPodNodeSelectorAndAffinityBestPractices is the test ensuring nodeSelector and nodeAffinity are not used by a
 	// Pod.
 	TestPodNodeSelectorAndAffinityBestPractices = claim.Identifier{
 		Url:     formTestURL(common.LifecycleTestKey, "pod-scheduling"),
 		Version: versionOne,
 	}
+	// TestPodPlacementIdentifier ensures Pods run on nodes matching their nodeSelector and node affinity.
+	TestPodPlacementIdentifier = claim.Identifier{
+		Url:     formTestURL(common.LifecycleTestKey, "pod-placement"),
+		Version: versionOne,
+	}
+	// TestPodStartupIdentifier ensures Pods become ready within the startup budget.
+	TestPodStartupIdentifier = claim.Identifier{
+		Url:     formTestURL(common.LifecycleTestKey, "pod-startup"),
+		Version: versionOne,
+	}
+	// TestPersistentVolumeClaimsIdentifier ensures CNF PersistentVolumeClaims are bound as declared to approved
+	// StorageClasses.
+	TestPersistentVolumeClaimsIdentifier = claim.Identifier{
+		Url:     formTestURL(common.LifecycleTestKey, "persistent-volume-claims"),
+		Version: versionOne,
+	}
+	// TestStorageRoundTripIdentifier ensures CNF containers can write to and read from their persistent volumes.
+	TestStorageRoundTripIdentifier = claim.Identifier{
+		Url:     formTestURL(common.LifecycleTestKey, "storage-round-trip"),
+		Version: versionOne,
+	}
 	// TestPodHighAvailabilityBestPractices is the test ensuring podAntiAffinity are used by a
 	// Pod when pod replica # are great than 1
 	TestPodHighAvailabilityBestPractices = claim.Identifier{
@@ -153,6 +266,151 @@ var (
 		Url:     formTestURL(common.LifecycleTestKey, "image-pull-policy"),
 		Version: versionOne,
 	}
+	// TestImageTagIdentifier ensures container images are pinned by digest.
+	TestImageTagIdentifier = claim.Identifier{
+		Url:     formTestURL(common.LifecycleTestKey, "image-tag"),
+		Version: versionOne,
+	}
+	// TestImageRegistryIdentifier ensures container images are pulled from approved registries.
+	TestImageRegistryIdentifier = claim.Identifier{
+		Url:     formTestURL(common.LifecycleTestKey, "image-registry"),
+		Version: versionOne,
+	}
+	// TestResourcesIdentifier ensures containers set resource requests and limits.
+	TestResourcesIdentifier = claim.Identifier{
+		Url:     formTestURL(common.LifecycleTestKey, "container-resources"),
+		Version: versionOne,
+	}
+	// TestWorkloadReplicasIdentifier ensures Deployments and StatefulSets run enough replicas on more than one node.
+	TestWorkloadReplicasIdentifier = claim.Identifier{
+		Url:     formTestURL(common.LifecycleTestKey, "workload-replicas"),
+		Version: versionOne,
+	}
+	// TestPodAntiAffinitySchedulingIdentifier ensures replicated workloads define and honor podAntiAffinity rules.
+	TestPodAntiAffinitySchedulingIdentifier = claim.Identifier{
+		Url:     formTestURL(common.LifecycleTestKey, "pod-anti-affinity-scheduling"),
+		Version: versionOne,
+	}
+	// TestTopologySpreadIdentifier ensures replicated workloads spread across nodes and zones as they declare.
+	TestTopologySpreadIdentifier = claim.Identifier{
+		Url:     formTestURL(common.LifecycleTestKey, "topology-spread"),
+		Version: versionOne,
+	}
+	// TestPodDisruptionBudgetIdentifier ensures replicated workloads are covered by a PodDisruptionBudget.
+	TestPodDisruptionBudgetIdentifier = claim.Identifier{
+		Url:     formTestURL(common.LifecycleTestKey, "pod-disruption-budget"),
+		Version: versionOne,
+	}
+	// TestHorizontalPodAutoscalerIdentifier ensures the HorizontalPodAutoscalers of the CNF get their metrics.
+	TestHorizontalPodAutoscalerIdentifier = claim.Identifier{
+		Url:     formTestURL(common.LifecycleTestKey, "horizontal-pod-autoscaler"),
+		Version: versionOne,
+	}
+	// TestHelmHooksIdentifier ensures the hooks of the CNF Helm releases do not create RBAC objects.
+	TestHelmHooksIdentifier = claim.Identifier{
+		Url:     formTestURL(common.LifecycleTestKey, "helm-hooks"),
+		Version: versionOne,
+	}
+	// TestHelmDriftIdentifier ensures the CNF Helm releases match the live cluster state.
+	TestHelmDriftIdentifier = claim.Identifier{
+		Url:     formTestURL(common.LifecycleTestKey, "helm-drift"),
+		Version: versionOne,
+	}
+	// TestPodDeletionRecoveryIdentifier ensures deleted CNF pods are recreated in time without disrupting the traffic.
+	TestPodDeletionRecoveryIdentifier = claim.Identifier{
+		Url:     formTestURL(common.LifecycleTestKey, "pod-deletion-recovery"),
+		Version: versionOne,
+	}
+	// TestGracefulTerminationIdentifier ensures deleted CNF pods exit on SIGTERM within their grace period.
+	TestGracefulTerminationIdentifier = claim.Identifier{
+		Url:     formTestURL(common.LifecycleTestKey, "graceful-termination"),
+		Version: versionOne,
+	}
+	// TestConnectionDrainingIdentifier ensures Services stop sending connections to terminating CNF pods.
+	TestConnectionDrainingIdentifier = claim.Identifier{
+		Url:     formTestURL(common.LifecycleTestKey, "connection-draining"),
+		Version: versionOne,
+	}
+	// TestDeploymentStrategyIdentifier ensures the CNF Deployments are updated without downtime.
+	TestDeploymentStrategyIdentifier = claim.Identifier{
+		Url:     formTestURL(common.LifecycleTestKey, "deployment-strategy"),
+		Version: versionOne,
+	}
+	// TestRolloutRestartIdentifier ensures a rollout of the CNF Deployments causes no downtime.
+	TestRolloutRestartIdentifier = claim.Identifier{
+		Url:     formTestURL(common.LifecycleTestKey, "rollout-restart"),
+		Version: versionOne,
+	}
+	// TestWorkloadElasticityIdentifier ensures the CNF Deployments and StatefulSets can be scaled up and down.
+	TestWorkloadElasticityIdentifier = claim.Identifier{
+		Url:     formTestURL(common.LifecycleTestKey, "workload-elasticity"),
+		Version: versionOne,
+	}
+	// TestNodeDrainToleranceIdentifier ensures the CNF workloads survive the drain of a node.
+	TestNodeDrainToleranceIdentifier = claim.Identifier{
+		Url:     formTestURL(common.LifecycleTestKey, "node-drain-tolerance"),
+		Version: versionOne,
+	}
+	// TestTolerationsIdentifier ensures CNF pods do not rely on tolerate-everything tolerations.
+	TestTolerationsIdentifier = claim.Identifier{
+		Url:     formTestURL(common.LifecycleTestKey, "tolerations"),
+		Version: versionOne,
+	}
+	// TestConfigMapDriftIdentifier ensures the CNF does not modify its ConfigMaps at runtime.
+	TestConfigMapDriftIdentifier = claim.Identifier{
+		Url:     formTestURL(common.LifecycleTestKey, "configmap-drift"),
+		Version: versionOne,
+	}
+	// TestServiceMeshInjectionIdentifier ensures the pods of a namespace are consistently injected with a mesh sidecar.
+	TestServiceMeshInjectionIdentifier = claim.Identifier{
+		Url:     formTestURL(common.NetworkingTestKey, "service-mesh-injection"),
+		Version: versionOne,
+	}
+	// TestServiceMeshMTLSIdentifier ensures strict mutual TLS is enforced on the namespaces of a meshed CNF.
+	TestServiceMeshMTLSIdentifier = claim.Identifier{
+		Url:     formTestURL(common.NetworkingTestKey, "service-mesh-mtls"),
+		Version: versionOne,
+	}
+	// TestICMPv4ConnectivityMatrixIdentifier ensures every pair of CNF pods can communicate on the default network.
+	TestICMPv4ConnectivityMatrixIdentifier = claim.Identifier{
+		Url:     formTestURL(common.NetworkingTestKey, "icmpv4-connectivity-matrix"),
+		Version: versionOne,
+	}
+	// TestMultusConnectivityMatrixIdentifier ensures the CNF pods sharing a Multus network attachment can communicate on it.
+	TestMultusConnectivityMatrixIdentifier = claim.Identifier{
+		Url:     formTestURL(common.NetworkingTestKey, "multus-connectivity-matrix"),
+		Version: versionOne,
+	}
+	// TestNetworkPartitionIdentifier ensures the CNF recovers from a network partition between two of its pods.
+	TestNetworkPartitionIdentifier = claim.Identifier{
+		Url:     formTestURL(common.NetworkingTestKey, "network-partition"),
+		Version: versionOne,
+	}
+	// TestDualStackAddressesIdentifier ensures CNF pods get both an IPv4 and an IPv6 address on dual-stack clusters.
+	TestDualStackAddressesIdentifier = claim.Identifier{
+		Url:     formTestURL(common.NetworkingTestKey, "dual-stack-addresses"),
+		Version: versionOne,
+	}
+	// TestDualStackServicesIdentifier ensures CNF Services request both IP families on dual-stack clusters.
+	TestDualStackServicesIdentifier = claim.Identifier{
+		Url:     formTestURL(common.NetworkingTestKey, "dual-stack-services"),
+		Version: versionOne,
+	}
+	// TestDualStackConnectivityIdentifier ensures CNF pods reach each other over both IP families.
+	TestDualStackConnectivityIdentifier = claim.Identifier{
+		Url:     formTestURL(common.NetworkingTestKey, "dual-stack-connectivity"),
+		Version: versionOne,
+	}
+	// TestHeadlessServiceResolutionIdentifier ensures StatefulSet pods resolve their peers through headless Services.
+	TestHeadlessServiceResolutionIdentifier = claim.Identifier{
+		Url:     formTestURL(common.NetworkingTestKey, "headless-service-resolution"),
+		Version: versionOne,
+	}
+	// TestDNSConfigIdentifier ensures the dnsConfig of CNF pods is applied inside their containers.
+	TestDNSConfigIdentifier = claim.Identifier{
+		Url:     formTestURL(common.NetworkingTestKey, "dns-config"),
+		Version: versionOne,
+	}
 	// TestPodRecreationIdentifier ensures recreation best practices.
 	TestPodRecreationIdentifier = claim.Identifier{
 		Url:     formTestURL(common.LifecycleTestKey, "pod-recreation"),
@@ -168,11 +426,31 @@ var (
 		Url:     formTestURL(common.AccessControlTestKey, "pod-service-account"),
 		Version: versionOne,
 	}
+	// TestDedicatedServiceAccountIdentifier ensures pods do not use the default service account.
+	TestDedicatedServiceAccountIdentifier = claim.Identifier{
+		Url:     formTestURL(common.AccessControlTestKey, "dedicated-service-account"),
+		Version: versionOne,
+	}
+	// TestServiceAccountRBACScopeIdentifier ensures service accounts have no cluster-admin or wildcard permissions.
+	TestServiceAccountRBACScopeIdentifier = claim.Identifier{
+		Url:     formTestURL(common.AccessControlTestKey, "service-account-rbac-scope"),
+		Version: versionOne,
+	}
+	// TestClusterRoleBindingsAuditIdentifier ensures ClusterRoleBindings grant no cluster-wide write access to core resources.
+	TestClusterRoleBindingsAuditIdentifier = claim.Identifier{
+		Url:     formTestURL(common.AccessControlTestKey, "cluster-role-bindings-audit"),
+		Version: versionOne,
+	}
 	// TestServicesDoNotUseNodeportsIdentifier ensures Services don't utilize NodePorts.
 	TestServicesDoNotUseNodeportsIdentifier = claim.Identifier{
 		Url:     formTestURL(common.NetworkingTestKey, "service-type"),
 		Version: versionOne,
 	}
+	// TestListeningAndDeclaredPortsIdentifier ensures containers listen on exactly their declared containerPorts.
+	TestListeningAndDeclaredPortsIdentifier = claim.Identifier{
+		Url:     formTestURL(common.NetworkingTestKey, "listening-and-declared-ports"),
+		Version: versionOne,
+	}
 	// TestUnalteredBaseImageIdentifier ensures the base image is not altered.
 	TestUnalteredBaseImageIdentifier = claim.Identifier{
 		Url:     formTestURL(common.PlatformAlterationTestKey, "base-image"),
@@ -188,6 +466,11 @@ var (
 		Url:     formTestURL(common.ObservabilityTestKey, "container-logging"),
 		Version: versionOne,
 	}
+	// TestLogFilesIdentifier ensures containers do not write logs to their filesystem.
+	TestLogFilesIdentifier = claim.Identifier{
+		Url:     formTestURL(common.ObservabilityTestKey, "container-log-files"),
+		Version: versionOne,
+	}
 	// TestCrdsStatusSubresourceIdentifier ensures all CRDs have a valid status subresource
 	TestCrdsStatusSubresourceIdentifier = claim.Identifier{
 		Url:     formTestURL(common.ObservabilityTestKey, "crd-status"),
@@ -223,6 +506,66 @@ var (
 		Url:     formTestURL(common.DiagnosticTestKey, "clusterversion"),
 		Version: versionOne,
 	}
+	// TestClusterPlatformIdentifier records the platform, network and node inventory of the cluster.
+	TestClusterPlatformIdentifier = claim.Identifier{
+		Url:     formTestURL(common.DiagnosticTestKey, "cluster-platform"),
+		Version: versionOne,
+	}
+	// TestNodePressureIdentifier records the pressure conditions and the requested resources of the nodes.
+	TestNodePressureIdentifier = claim.Identifier{
+		Url:     formTestURL(common.DiagnosticTestKey, "node-pressure"),
+		Version: versionOne,
+	}
+	// TestClusterOperatorHealthIdentifier ensures all the ClusterOperators are healthy.
+	TestClusterOperatorHealthIdentifier = claim.Identifier{
+		Url:     formTestURL(common.DiagnosticTestKey, "cluster-operator-health"),
+		Version: versionOne,
+	}
+	// TestNodesReadyIdentifier ensures all the nodes are Ready.
+	TestNodesReadyIdentifier = claim.Identifier{
+		Url:     formTestURL(common.DiagnosticTestKey, "nodes-ready"),
+		Version: versionOne,
+	}
+	// TestNodeServicesIdentifier ensures the kubelet and crio services are active on every node.
+	TestNodeServicesIdentifier = claim.Identifier{
+		Url:     formTestURL(common.DiagnosticTestKey, "node-services"),
+		Version: versionOne,
+	}
+	// TestSystemPodRestartsIdentifier ensures the system pods are not restarting repeatedly.
+	TestSystemPodRestartsIdentifier = claim.Identifier{
+		Url:     formTestURL(common.DiagnosticTestKey, "system-pod-restarts"),
+		Version: versionOne,
+	}
+	// TestTopologyPodCountIdentifier ensures the workloads run the expected number of pods.
+	TestTopologyPodCountIdentifier = claim.Identifier{
+		Url:     formTestURL(common.TopologyTestKey, "pod-count"),
+		Version: versionOne,
+	}
+	// TestTopologyNodePlacementIdentifier ensures the pods of the workloads run on the expected nodes.
+	TestTopologyNodePlacementIdentifier = claim.Identifier{
+		Url:     formTestURL(common.TopologyTestKey, "node-placement"),
+		Version: versionOne,
+	}
+	// TestTopologyNetworkAttachmentsIdentifier ensures the pods of the workloads have the expected network attachments.
+	TestTopologyNetworkAttachmentsIdentifier = claim.Identifier{
+		Url:     formTestURL(common.TopologyTestKey, "network-attachments"),
+		Version: versionOne,
+	}
+	// TestNICDriverVersionsIdentifier ensures the node interfaces use supported driver and firmware versions.
+	TestNICDriverVersionsIdentifier = claim.Identifier{
+		Url:     formTestURL(common.PlatformAlterationTestKey, "nic-driver-versions"),
+		Version: versionOne,
+	}
+	// TestNodeKernelVersionsIdentifier ensures the nodes run an allowed kernel release and the workers the same one.
+	TestNodeKernelVersionsIdentifier = claim.Identifier{
+		Url:     formTestURL(common.PlatformAlterationTestKey, "node-kernel-versions"),
+		Version: versionOne,
+	}
+	// TestNodeClockSkewIdentifier ensures the node clocks are in sync with the test machine clock.
+	TestNodeClockSkewIdentifier = claim.Identifier{
+		Url:     formTestURL(common.PlatformAlterationTestKey, "node-clock-skew"),
+		Version: versionOne,
+	}
 )
 
 func formDescription(identifier claim.Identifier, description string) string {
@@ -329,14 +672,35 @@ The test ensures that all CNF containers respond to ICMPv4 requests from the Par
 		Identifier: TestNamespaceBestPracticesIdentifier,
 		Type:       normativeResult,
 		Remediation: `Ensure that your CNF utilizes namespaces declared in the yaml config file. Additionally,
-the namespaces should not start with "default, openshift-, istio- or aspenmesh-", except in rare cases.`,
+the namespaces should not start with "default, openshift-, kube-, istio- or aspenmesh-", except in rare cases.`,
 		Description: formDescription(TestNamespaceBestPracticesIdentifier,
 			`tests that all CNF's resources (PUTs and CRs) belong to valid namespaces. A valid namespace meets
 the following conditions: (1) It was declared in the yaml config file under the targetNameSpaces
-tag. (2) It doesn't have any of the following prefixes: default, openshift-, istio- and aspenmesh-`),
+tag. (2) It doesn't have any of the following prefixes: default, openshift-, kube-, istio- and aspenmesh-`),
 		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2, 16.3.8 & 16.3.9",
 	},
 
+	TestNamespaceLabelsIdentifier: {
+		Identifier:  TestNamespaceLabelsIdentifier,
+		Type:        normativeResult,
+		Remediation: `Add the labels listed under requiredNamespaceLabels in the yaml config file to every CNF namespace.`,
+		Description: formDescription(TestNamespaceLabelsIdentifier,
+			`tests that every namespace under test carries the labels listed under requiredNamespaceLabels in the yaml
+config file.  A required label with an empty value accepts any value.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestClusterNamespaceObjectsIdentifier: {
+		Identifier: TestClusterNamespaceObjectsIdentifier,
+		Type:       normativeResult,
+		Remediation: `Deploy the CNF Deployments, StatefulSets, DaemonSets and Services in the partner namespaces only, never in
+the default namespace or in the kube- and openshift- cluster namespaces.`,
+		Description: formDescription(TestClusterNamespaceObjectsIdentifier,
+			`tests that no Deployment, StatefulSet, DaemonSet or Service carrying the CNF target labels lives in a
+namespace starting with default, openshift-, kube-, istio- or aspenmesh-.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
 	TestNonDefaultGracePeriodIdentifier: {
 		Identifier: TestNonDefaultGracePeriodIdentifier,
 		Type:       informativeResult,
@@ -393,6 +757,68 @@ with no resourceNames under its rules.`),
 		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2.12 and Section 6.3.3",
 	},
 
+	TestOperatorInstallPhaseIdentifier: {
+		Identifier: TestOperatorInstallPhaseIdentifier,
+		Type:       normativeResult,
+		Remediation: `Ensure that the Subscription of your Operator is AtLatestKnown, that its InstallPlan is approved and Complete, and
+that the installed CSV reaches the Succeeded phase.  The claim file lists the state and conditions of each object.`,
+		Description: formDescription(TestOperatorInstallPhaseIdentifier,
+			`tests that the Subscription of each CNF Operator reached the AtLatestKnown state, that the InstallPlan it
+references is Complete, and that its installed CSV is Succeeded.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2.12 and Section 6.3.3",
+	},
+
+	TestOperatorSubscriptionSourceIdentifier: {
+		Identifier: TestOperatorSubscriptionSourceIdentifier,
+		Type:       normativeResult,
+		Remediation: `Subscribe your Operator from a certified catalog source, or list its catalog source under approvedCatalogSources
+in the yaml config file.  When approvedChannels is configured, subscribe to one of the listed channels.`,
+		Description: formDescription(TestOperatorSubscriptionSourceIdentifier,
+			`tests that the Subscription of each CNF Operator uses an approved catalog source, by default certified-operators
+or redhat-operators, and one of the approvedChannels when they are configured.  Community and custom catalog sources
+fail unless configured.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2.12 and Section 6.3.3",
+	},
+
+	TestCrdConformanceIdentifier: {
+		Identifier: TestCrdConformanceIdentifier,
+		Type:       normativeResult,
+		Remediation: `Define your CRDs with the apiextensions.k8s.io/v1 API: serve the single storage version, give every served
+version a structural openAPIV3Schema of type object and a status subresource.`,
+		Description: formDescription(TestCrdConformanceIdentifier,
+			`tests that the CRDs owned by the CNF Operators and the autodiscovered CRDs have exactly one storage version,
+which is served, that every served version has a structural schema and a status subresource, and that none of them is
+managed through the deprecated apiextensions.k8s.io/v1beta1 API.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestOperatorReconciliationIdentifier: {
+		Identifier: TestOperatorReconciliationIdentifier,
+		Type:       normativeResult,
+		Remediation: `Make sure the Operator watches the Deployments it creates, either directly from its CSV or as operands of its
+custom resources, and recreates them when they are deleted or modified.`,
+		Description: formDescription(TestOperatorReconciliationIdentifier,
+			`tests that the CNF Operators actually control their workloads.  One Deployment managed by each Operator,
+preferably an operand owned by one of its custom resources, is deleted and must be recreated and become ready within
+reconcileTimeoutSeconds.  This test is intrusive.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestOperatorReinstallIdentifier: {
+		Identifier: TestOperatorReinstallIdentifier,
+		Type:       normativeResult,
+		Remediation: `Make sure every resource the Operator creates is owned by its CSV or labeled by OLM so it is garbage collected on
+uninstallation, that the Operator does not add finalizers it cannot remove once uninstalled, and that a reinstalled
+Operator adopts the existing custom resources.`,
+		Description: formDescription(TestOperatorReinstallIdentifier,
+			`tests that the CNF Operators can be uninstalled and reinstalled.  The Subscription and CSV of each Operator are
+deleted, after which no Deployment, Service, ServiceAccount or RBAC resource created by OLM for the CSV may remain, and
+no CSV, Subscription or custom resource owned by the Operator may be stuck being deleted.  The Subscription is then
+recreated, pinned to the same CSV, which must succeed, and every Deployment under test must be ready again, each within
+reconcileTimeoutSeconds.  This test is intrusive.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
 	TestPodNodeSelectorAndAffinityBestPractices: {
 		Identifier: TestPodNodeSelectorAndAffinityBestPractices,
 		Type:       informativeResult,
@@ -406,6 +832,51 @@ instantiation on any underlying Node.`),
 		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
 	},
 
+	TestPodPlacementIdentifier: {
+		Identifier: TestPodPlacementIdentifier,
+		Type:       normativeResult,
+		Remediation: `Label the nodes meant to host the CNF, e.g. with the worker-cnf role, and select them through the nodeSelector
+or required node affinity of the CNF Pods.  Do not schedule CNF Pods on control plane nodes.`,
+		Description: formDescription(TestPodPlacementIdentifier,
+			`tests that every Pod under test runs on a node whose labels match its nodeSelector and at least one of the
+terms of its required node affinity, and that it does not run on a control plane node which is not also a worker.  The
+node and node roles of every Pod are recorded in the claim.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestPodStartupIdentifier: {
+		Identifier: TestPodStartupIdentifier,
+		Type:       normativeResult,
+		Remediation: `Reduce the time the CNF containers need to start and pass their readiness probes, e.g. by shrinking the images,
+deferring the initialization which is not required to serve traffic, or tuning the initial delay of the probes.`,
+		Description: formDescription(TestPodStartupIdentifier,
+			`measures the time every Pod under test took to become ready after its creation, which is recorded in the
+claim, and tests it is at most maxStartupSeconds.  It runs after the intrusive lifecycle tests, so the Pods they
+recreated are measured too.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestPersistentVolumeClaimsIdentifier: {
+		Identifier: TestPersistentVolumeClaimsIdentifier,
+		Type:       normativeResult,
+		Remediation: `Use an approved StorageClass supporting the access modes the CNF PersistentVolumeClaims declare, and make sure
+the claims get bound.`,
+		Description: formDescription(TestPersistentVolumeClaimsIdentifier,
+			`checks that every PersistentVolumeClaim mounted by a Pod under test is Bound, has all the access modes it
+declares, and uses one of the approvedStorageClasses when they are configured.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestStorageRoundTripIdentifier: {
+		Identifier:  TestStorageRoundTripIdentifier,
+		Type:        normativeResult,
+		Remediation: `Make sure the persistent volumes of the CNF are writable by the user its containers run as.`,
+		Description: formDescription(TestStorageRoundTripIdentifier,
+			`writes a file to every path where a container under test mounts a PersistentVolumeClaim read-write, reads it
+back and removes it.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
 	TestPodHighAvailabilityBestPractices: {
 		Identifier:  TestPodHighAvailabilityBestPractices,
 		Type:        informativeResult,
@@ -442,6 +913,506 @@ ClusterRoleBindings, if possible.`,
 		BestPracticeReference: bestPracticeDocV1dot3URL + "  Section 15.6",
 	},
 
+	TestImageTagIdentifier: {
+		Identifier:  TestImageTagIdentifier,
+		Type:        normativeResult,
+		Remediation: `Reference container images by digest (image@sha256:...) instead of by a mutable tag such as latest.`,
+		Description: formDescription(TestImageTagIdentifier,
+			`tests that the containers under test reference their images by digest rather than by a mutable tag such as
+latest, so the deployed image cannot change without a spec update.  The digest resolved by the container runtime is
+recorded in the claim.`),
+		BestPracticeReference: bestPracticeDocV1dot3URL + " Section 15.6",
+	},
+
+	TestImageRegistryIdentifier: {
+		Identifier: TestImageRegistryIdentifier,
+		Type:       normativeResult,
+		Remediation: `Publish the CNF images to an approved registry, such as registry.connect.redhat.com for certified images, or add
+the registry to approvedRegistries.`,
+		Description: formDescription(TestImageRegistryIdentifier,
+			`tests that the containers under test run images pulled from one of the approvedRegistries, which default to
+the Red Hat registries.  Images pulled from docker.io, including references which do not name a registry, or from an
+unknown internal registry fail the test.`),
+		BestPracticeReference: bestPracticeDocV1dot3URL + " Section 15.6",
+	},
+
+	TestNonRootContainerIdentifier: {
+		Identifier:  TestNonRootContainerIdentifier,
+		Type:        normativeResult,
+		Remediation: `Set runAsNonRoot: true or a non-zero runAsUser in the pod or container securityContext, and build the image to run as a non-root user.`,
+		Description: formDescription(TestNonRootContainerIdentifier,
+			`tests that each CNF container declares a non-root user in its securityContext (runAsNonRoot or a non-zero
+runAsUser) and that the process actually runs with a non-zero UID, as reported by id -u inside the container.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestPrivilegedContainerIdentifier: {
+		Identifier:  TestPrivilegedContainerIdentifier,
+		Type:        normativeResult,
+		Remediation: `Remove privileged: true and set allowPrivilegeEscalation: false in the securityContext of every container.`,
+		Description: formDescription(TestPrivilegedContainerIdentifier,
+			`tests that no init or regular container of the CNF pods sets privileged: true or allowPrivilegeEscalation: true
+in its securityContext.  Each offending container and field is recorded in the claim.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestHostNamespacesIdentifier: {
+		Identifier: TestHostNamespacesIdentifier,
+		Type:       normativeResult,
+		Remediation: `Remove hostNetwork, hostPID and hostIPC from the pod spec.  Pods that legitimately require a host namespace
+can be listed in the hostNamespaceExceptions section of the configuration.`,
+		Description: formDescription(TestHostNamespacesIdentifier,
+			`tests that the CNF pods do not set hostNetwork, hostPID or hostIPC, unless allowed by the
+hostNamespaceExceptions configuration.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestCapabilitiesIdentifier: {
+		Identifier: TestCapabilitiesIdentifier,
+		Type:       normativeResult,
+		Remediation: `Remove NET_ADMIN, SYS_ADMIN, NET_RAW and IPC_LOCK from the capabilities added by the containers.  Capabilities
+the CNF legitimately requires can be listed in the allowedCapabilities section of the configuration.`,
+		Description: formDescription(TestCapabilitiesIdentifier,
+			`tests that the CNF containers neither add nor effectively hold the NET_ADMIN, SYS_ADMIN, NET_RAW or IPC_LOCK
+capabilities, unless allowed by the allowedCapabilities configuration.  The effective set is read from the status of the
+container main process, and the added and dropped capabilities are recorded in the claim.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestReadOnlyRootFilesystemIdentifier: {
+		Identifier:  TestReadOnlyRootFilesystemIdentifier,
+		Type:        normativeResult,
+		Remediation: `Set securityContext.readOnlyRootFilesystem to true and mount writable volumes where the containers need to write.`,
+		Description: formDescription(TestReadOnlyRootFilesystemIdentifier,
+			`tests that every init and regular container of the CNF pods sets readOnlyRootFilesystem in its securityContext,
+as required by the restricted SCC and Pod Security Admission profile.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestSeccompProfileIdentifier: {
+		Identifier:  TestSeccompProfileIdentifier,
+		Type:        normativeResult,
+		Remediation: `Set securityContext.seccompProfile.type to RuntimeDefault on the pods or on each of their containers.`,
+		Description: formDescription(TestSeccompProfileIdentifier,
+			`tests that every init and regular container of the CNF pods runs with the RuntimeDefault seccomp profile,
+set either on the container or inherited from the pod securityContext.  The legacy runtime/default seccomp annotations
+are accepted.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestDropAllCapabilitiesIdentifier: {
+		Identifier: TestDropAllCapabilitiesIdentifier,
+		Type:       normativeResult,
+		Remediation: `Set securityContext.capabilities.drop to ["ALL"] and add back only the capabilities the containers
+require.`,
+		Description: formDescription(TestDropAllCapabilitiesIdentifier,
+			`tests that every init and regular container of the CNF pods drops ALL capabilities, as required by the restricted
+SCC and Pod Security Admission profile.  The capabilities explicitly added back are recorded in the claim.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestSecretHygieneIdentifier: {
+		Identifier: TestSecretHygieneIdentifier,
+		Type:       normativeResult,
+		Remediation: `Mount secrets as volumes with a defaultMode that is not world-readable, such as 0440, instead of exposing
+them through environment variables.  Reference secrets from ConfigMaps and annotations instead of copying their values.`,
+		Description: formDescription(TestSecretHygieneIdentifier,
+			`tests that the CNF pods do not expose secrets through environment variables, that the secret volumes they
+mount are not world-readable, and that no secret value of the namespaces under test appears verbatim in a ConfigMap or a
+pod annotation.  Secret values are never recorded in the claim.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestAutomountServiceAccountTokenIdentifier: {
+		Identifier: TestAutomountServiceAccountTokenIdentifier,
+		Type:       normativeResult,
+		Remediation: `Set automountServiceAccountToken: false in the spec of the CNF pods which do not call the Kubernetes API, or
+in their service account.  Declare the pods which do call the API in apiAccessExceptions.`,
+		Description: formDescription(TestAutomountServiceAccountTokenIdentifier,
+			`tests that the CNF pods do not mount their service account token unless they are listed in
+apiAccessExceptions.  The setting of the pod spec takes precedence over the one of its service account, and the token is
+mounted when neither disables it.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestSCCIdentifier: {
+		Identifier: TestSCCIdentifier,
+		Type:       normativeResult,
+		Remediation: `Run the CNF pods as an arbitrary non-root UID without privileges, so they are admitted under the restricted
+SecurityContextConstraint, or declare the pods which need more in sccExceptions.`,
+		Description: formDescription(TestSCCIdentifier,
+			`records the SecurityContextConstraint each CNF pod was admitted under, from its openshift.io/scc annotation,
+and tests that none of them is anyuid or privileged unless allowed by sccExceptions.  Pods without the annotation, such
+as on non-OpenShift clusters, are not checked.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestHostPathIdentifier: {
+		Identifier: TestHostPathIdentifier,
+		Type:       normativeResult,
+		Remediation: `Replace the hostPath volumes of the CNF pods with ConfigMaps, Secrets, emptyDir or persistent volumes, or
+declare the pods which need them in hostPathExceptions.`,
+		Description: formDescription(TestHostPathIdentifier,
+			`records the hostPath volumes of every CNF pod in the claim, and tests that none is mounted unless allowed by
+hostPathExceptions.  The sensitive host directories /, /etc, /proc, /var/run/docker.sock and /run/docker.sock, as well
+as the directories containing them, are only allowed when listed explicitly in the paths of an exception.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestResourcesIdentifier: {
+		Identifier: TestResourcesIdentifier,
+		Type:       normativeResult,
+		Remediation: `Set CPU and memory requests and limits on every container.  When requireGuaranteedQoS is configured, set the
+requests equal to the limits.`,
+		Description: formDescription(TestResourcesIdentifier,
+			`tests that every CNF container sets CPU and memory requests and limits, and that the requests equal the limits
+when the requireGuaranteedQoS configuration is set.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestWorkloadReplicasIdentifier: {
+		Identifier: TestWorkloadReplicasIdentifier,
+		Type:       normativeResult,
+		Remediation: `Run at least minReplicas replicas of each Deployment and StatefulSet, and use pod anti-affinity or topology
+spread constraints so that the replicas are scheduled on different nodes.`,
+		Description: formDescription(TestWorkloadReplicasIdentifier,
+			`tests that each Deployment and StatefulSet in the CNF namespaces runs at least the configured minimum number of
+replicas (2 by default), and that its pods are not all scheduled on the same node.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestPodAntiAffinitySchedulingIdentifier: {
+		Identifier: TestPodAntiAffinitySchedulingIdentifier,
+		Type:       normativeResult,
+		Remediation: `Define a podAntiAffinity rule in the pod template of each Deployment and StatefulSet running more than one
+replica, so that no two replicas are scheduled on the same node.`,
+		Description: formDescription(TestPodAntiAffinitySchedulingIdentifier,
+			`tests that each Deployment and StatefulSet with more than one replica defines a podAntiAffinity rule, and that
+the scheduled pods honor it: no node runs more than one replica of the same workload.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestTopologySpreadIdentifier: {
+		Identifier: TestTopologySpreadIdentifier,
+		Type:       normativeResult,
+		Remediation: `Define topologySpreadConstraints over the topology.kubernetes.io/zone and kubernetes.io/hostname keys in the
+pod template of each Deployment and StatefulSet running more than one replica, or at least a podAntiAffinity rule.`,
+		Description: formDescription(TestTopologySpreadIdentifier,
+			`tests that each Deployment and StatefulSet with more than one replica defines topologySpreadConstraints or a
+podAntiAffinity rule.  The skew of the pods over the zones of the worker nodes, or over the nodes running the
+workload, must not exceed the maxSkew of each DoNotSchedule zone or hostname constraint; ScheduleAnyway constraints are
+only recorded in the claim.  On multi-zone clusters, the workloads relying on podAntiAffinity must run in more than
+one zone.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestPodDisruptionBudgetIdentifier: {
+		Identifier: TestPodDisruptionBudgetIdentifier,
+		Type:       normativeResult,
+		Remediation: `Create a PodDisruptionBudget selecting the pods of each Deployment and StatefulSet running more than one
+replica, with a minAvailable or maxUnavailable value that allows at least one, but not every, replica to be disrupted.`,
+		Description: formDescription(TestPodDisruptionBudgetIdentifier,
+			`tests that each Deployment and StatefulSet with more than one replica is covered by a PodDisruptionBudget whose
+matchLabels select its pod template, and that the budget lets node drains make progress without taking down every
+replica.  The workload to PodDisruptionBudget mapping is recorded in the claim.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestHorizontalPodAutoscalerIdentifier: {
+		Identifier: TestHorizontalPodAutoscalerIdentifier,
+		Type:       normativeResult,
+		Remediation: `Make sure the metrics the CNF HorizontalPodAutoscalers scale on are served, e.g. that the containers set
+resource requests for utilization targets, and that the custom or external metrics adapters are deployed.`,
+		Description: formDescription(TestHorizontalPodAutoscalerIdentifier,
+			`records the HorizontalPodAutoscalers of the namespaces under test, with their target and bounds, in the claim
+and tests that their ScalingActive condition is True, i.e. that they get the metrics they scale on.  The intrusive
+scaling tests pause the autoscaler of a workload while scaling it, and restore its bounds afterwards.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestDedicatedServiceAccountIdentifier: {
+		Identifier:  TestDedicatedServiceAccountIdentifier,
+		Type:        normativeResult,
+		Remediation: `Create a service account for the CNF and set serviceAccountName in the pod spec.`,
+		Description: formDescription(TestDedicatedServiceAccountIdentifier,
+			`tests that the CNF pods run under a dedicated service account rather than the default one of their namespace.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2.3 and 6.2.7",
+	},
+
+	TestServiceAccountRBACScopeIdentifier: {
+		Identifier: TestServiceAccountRBACScopeIdentifier,
+		Type:       normativeResult,
+		Remediation: `Bind the CNF service accounts to roles listing the exact verbs and resources the CNF needs, and never to
+cluster-admin.`,
+		Description: formDescription(TestServiceAccountRBACScopeIdentifier,
+			`tests that the service accounts of the CNF pods are not bound to the cluster-admin role, and that none of their
+effective permissions, as listed by oc auth can-i --list, use a wildcard verb or resource.  The role bindings of each
+service account are recorded in the claim.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.3.3 and 6.3.5",
+	},
+
+	TestClusterRoleBindingsAuditIdentifier: {
+		Identifier: TestClusterRoleBindingsAuditIdentifier,
+		Type:       normativeResult,
+		Remediation: `Replace the ClusterRoleBindings granting write access to core resources with RoleBindings in the CNF
+namespaces.  ClusterRoleBindings the CNF legitimately requires can be listed in the allowedClusterRoleBindings section
+of the configuration.`,
+		Description: formDescription(TestClusterRoleBindingsAuditIdentifier,
+			`lists the ClusterRoleBindings referencing the service accounts of the CNF pods, and tests that the bound
+ClusterRoles grant no create, update, patch or delete access to the resources of the core API group, unless allowed by
+the allowedClusterRoleBindings configuration.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2.10 and 6.3.6",
+	},
+
+	TestHelmHooksIdentifier: {
+		Identifier: TestHelmHooksIdentifier,
+		Type:       normativeResult,
+		Remediation: `Move the ServiceAccounts, Roles, ClusterRoles, RoleBindings, ClusterRoleBindings and SecurityContextConstraints
+created by Helm hooks to the regular templates of the chart, so that they are part of the release manifest.`,
+		Description: formDescription(TestHelmHooksIdentifier,
+			`tests that the hooks of the Helm releases deployed in the namespaces under test do not create RBAC objects.
+Hook resources are not part of the release manifest, so the permissions they grant escape the review and the lifecycle
+of the release.  The releases are discovered from their release secrets.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestHelmDriftIdentifier: {
+		Identifier: TestHelmDriftIdentifier,
+		Type:       normativeResult,
+		Remediation: `Change the CNF resources through Helm upgrades instead of editing them in the cluster, or upgrade the release
+to the current state.`,
+		Description: formDescription(TestHelmDriftIdentifier,
+			`tests that the manifest of every Helm release deployed in the namespaces under test matches the live cluster
+state, using oc diff.  The claim file holds the differences.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestPodDeletionRecoveryIdentifier: {
+		Identifier: TestPodDeletionRecoveryIdentifier,
+		Type:       normativeResult,
+		Remediation: `Deploy the CNF pods through Deployments, StatefulSets or DaemonSets, keep their startup time below
+podRecoveryTimeoutSeconds, and run enough replicas for the CNF to keep serving traffic while one of them is replaced.`,
+		Description: formDescription(TestPodDeletionRecoveryIdentifier,
+			`deletes the CNF pods one at a time and tests that their controller replaces each of them with a ready pod
+within podRecoveryTimeoutSeconds, 120 seconds by default, while the test orchestrator keeps pinging the remaining pods
+within the default network pingThresholds.  Pods without a controller are skipped.  This test is intrusive.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestGracefulTerminationIdentifier: {
+		Identifier: TestGracefulTerminationIdentifier,
+		Type:       normativeResult,
+		Remediation: `Handle SIGTERM in the CNF processes, or stop them from a preStop hook, so every container exits before
+terminationGracePeriodSeconds elapse instead of being killed.`,
+		Description: formDescription(TestGracefulTerminationIdentifier,
+			`deletes one pod of every CNF controller and follows its termination.  The pod fails the test when one of
+its containers is killed by SIGKILL, exiting with code 137, or when its containers are still running once
+terminationGracePeriodSeconds elapsed.  The containers without a preStop hook are flagged too.  The pod must then be
+replaced within podRecoveryTimeoutSeconds.  Pods without a controller are skipped.  This test is intrusive.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestConnectionDrainingIdentifier: {
+		Identifier: TestConnectionDrainingIdentifier,
+		Type:       normativeResult,
+		Remediation: `Keep serving the established and in-flight connections until the terminating pod is removed from the Service
+endpoints, e.g. with a preStop hook sleeping a few seconds before the process stops accepting connections.`,
+		Description: formDescription(TestConnectionDrainingIdentifier,
+			`deletes one CNF pod backing each ClusterIP Service of the namespaces under test which has at least two
+backends, and connects to the Service from the test orchestrator with curl until the pod is gone.  Every connection must
+succeed, showing the Service stopped routing new connections to the pod before it stopped accepting them.  The pod must
+then be replaced within podRecoveryTimeoutSeconds.  This test is intrusive.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestDeploymentStrategyIdentifier: {
+		Identifier: TestDeploymentStrategyIdentifier,
+		Type:       normativeResult,
+		Remediation: `Use the RollingUpdate strategy, which is the default, with a maxUnavailable lower than the number of replicas
+and a non-zero maxUnavailable or maxSurge.`,
+		Description: formDescription(TestDeploymentStrategyIdentifier,
+			`tests that each Deployment of the namespaces under test uses the RollingUpdate strategy rather than Recreate,
+and that its maxUnavailable and maxSurge, resolved against its replicas, keep at least one replica available while
+letting the rollout progress.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestRolloutRestartIdentifier: {
+		Identifier: TestRolloutRestartIdentifier,
+		Type:       normativeResult,
+		Remediation: `Use a RollingUpdate strategy and readiness probes so that the new pods only receive connections once ready, and
+drain the connections of the old pods before they stop, e.g. with a preStop hook.`,
+		Description: formDescription(TestRolloutRestartIdentifier,
+			`restarts each Deployment under test backing a ClusterIP Service reachable from the test orchestrator with
+"oc rollout restart", which changes nothing but a restart annotation, and connects to its Services with curl until the
+rollout is complete.  Every connection must succeed, and the rollout must complete within podRecoveryTimeoutSeconds per
+replica.  This test is intrusive.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestWorkloadElasticityIdentifier: {
+		Identifier: TestWorkloadElasticityIdentifier,
+		Type:       normativeResult,
+		Remediation: `Make sure the CNF Deployments and StatefulSets tolerate an additional replica, that the new pods are scheduled
+and become ready within podRecoveryTimeoutSeconds, and that the removed pods terminate within the same deadline.`,
+		Description: formDescription(TestWorkloadElasticityIdentifier,
+			`scales each Deployment and StatefulSet of the namespaces under test up by one replica and back down, and
+tests that all the replicas become ready within podRecoveryTimeoutSeconds after each step, and that no pod remains stuck
+terminating afterwards.  The HorizontalPodAutoscaler of a workload, if any, is paused at the requested number of
+replicas during each step, and its bounds are restored afterwards.  This test is intrusive.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestNodeDrainToleranceIdentifier: {
+		Identifier: TestNodeDrainToleranceIdentifier,
+		Type:       normativeResult,
+		Remediation: `Run enough replicas on distinct nodes, and set PodDisruptionBudgets that allow at least one replica to be
+evicted, so that a node can be drained during a maintenance window without disrupting the CNF.`,
+		Description: formDescription(TestNodeDrainToleranceIdentifier,
+			`cordons and drains the node hosting the most replicas of the CNF Deployments and StatefulSets through the
+eviction API, which respects the PodDisruptionBudgets, and tests that the drain completes and that every workload gets
+all its replicas ready again within podRecoveryTimeoutSeconds.  The node is uncordoned afterwards.  This test is
+intrusive.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestTolerationsIdentifier: {
+		Identifier: TestTolerationsIdentifier,
+		Type:       normativeResult,
+		Remediation: `Replace the tolerations with an empty key and the Exists operator by tolerations naming the keys of the taints
+the CNF pods need to tolerate.`,
+		Description: formDescription(TestTolerationsIdentifier,
+			`tests that every NoSchedule or NoExecute taint of the node a CNF pod runs on is tolerated by a toleration
+naming the taint key.  Pods which only run on their node because of a tolerate-everything toleration fail.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestConfigMapDriftIdentifier: {
+		Identifier: TestConfigMapDriftIdentifier,
+		Type:       normativeResult,
+		Remediation: `Keep the ConfigMaps of the CNF under the control of its deployment tooling, and store the runtime state of
+the CNF elsewhere, such as in its custom resources status or in a dedicated volume.`,
+		Description: formDescription(TestConfigMapDriftIdentifier,
+			`tests that the CNF does not modify nor delete its own ConfigMaps at runtime, which defeats GitOps tooling.  The
+ConfigMaps of the namespaces under test are recorded before the first test runs and compared with their content once at
+least configMapDriftIntervalSeconds have elapsed.  ConfigMaps created in the meantime are recorded in the claim.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestServiceMeshInjectionIdentifier: {
+		Identifier: TestServiceMeshInjectionIdentifier,
+		Type:       normativeResult,
+		Remediation: `Enable the sidecar injection for the whole namespace rather than for individual pods, and remove the
+sidecar.istio.io/inject annotations opting pods out of it.`,
+		Description: formDescription(TestServiceMeshInjectionIdentifier,
+			`discovers the Istio or OpenShift Service Mesh sidecars of the CNF pods and tests that the pods under test of
+a namespace are either all or none injected.  When usesServiceMesh is configured, every pod under test must run a
+sidecar.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestServiceMeshMTLSIdentifier: {
+		Identifier:  TestServiceMeshMTLSIdentifier,
+		Type:        normativeResult,
+		Remediation: `Create a PeerAuthentication with the STRICT mutual TLS mode in the CNF namespaces.`,
+		Description: formDescription(TestServiceMeshMTLSIdentifier,
+			`tests that a PeerAuthentication enforcing STRICT mutual TLS applies to every namespace under test, either
+from the namespace itself or mesh-wide from the istio-system namespace.  The test is skipped unless usesServiceMesh is
+configured.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestICMPv4ConnectivityMatrixIdentifier: {
+		Identifier: TestICMPv4ConnectivityMatrixIdentifier,
+		Type:       normativeResult,
+		Remediation: `Ensure that the CNF pods can reach each other on the default network, and that no NetworkPolicy denies the
+ICMP traffic between them.`,
+		Description: formDescription(TestICMPv4ConnectivityMatrixIdentifier,
+			`checks that every CNF pod can ping every other CNF pod on the default network within the pingThresholds.  The
+results are recorded in the claim as a matrix, and every failed source and destination pair is reported.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestMultusConnectivityMatrixIdentifier: {
+		Identifier: TestMultusConnectivityMatrixIdentifier,
+		Type:       normativeResult,
+		Remediation: `Ensure that the CNF pods attached to the same Multus network can reach each other on it, for instance that
+the underlying interfaces, VLANs and IPAM ranges of the network attachment are consistent across the nodes.`,
+		Description: formDescription(TestMultusConnectivityMatrixIdentifier,
+			`groups the CNF pods by Multus network attachment, as reported by their k8s.v1.cni.cncf.io/networks-status
+annotation, and checks that every pod can ping every other pod of the same attachment within the pingThresholds.  Pods on
+different attachments are not expected to reach each other.  One matrix per attachment is recorded in the claim.  The test
+is skipped when Multus is not installed.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestNetworkPartitionIdentifier: {
+		Identifier: TestNetworkPartitionIdentifier,
+		Type:       normativeResult,
+		Remediation: `Make sure the CNF tolerates losing the connectivity between its pods, e.g. by retrying with a backoff and
+reconnecting, and that its readiness probes reflect whether it can serve traffic.`,
+		Description: formDescription(TestNetworkPartitionIdentifier,
+			`blackholes the traffic between two CNF pods for networkPartitionSeconds, with iptables rules added to the
+network namespace of the first pod from the debug pod of its node.  The pods which became unready during the partition
+are recorded in the claim.  Once the rules are removed, every pod under test must be ready and the two pods must reach
+each other again within 120 seconds.  The rules are removed even when the test fails.  This test is intrusive, and only
+runs when networkPartitionSeconds is set.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestDualStackAddressesIdentifier: {
+		Identifier:  TestDualStackAddressesIdentifier,
+		Type:        normativeResult,
+		Remediation: `Make sure the CNF pods are attached to a dual-stack cluster network, and do not restrict their IP families.`,
+		Description: formDescription(TestDualStackAddressesIdentifier,
+			`checks that every pod under test has both an IPv4 and an IPv6 address in its status.podIPs.  The test is
+skipped unless the cluster network has both an IPv4 and an IPv6 CIDR.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestHeadlessServiceResolutionIdentifier: {
+		Identifier: TestHeadlessServiceResolutionIdentifier,
+		Type:       normativeResult,
+		Remediation: `Set the serviceName of the CNF StatefulSets to a headless Service (clusterIP: None) selecting their pods, so
+every pod gets a stable DNS name.`,
+		Description: formDescription(TestHeadlessServiceResolutionIdentifier,
+			`checks that the serviceName of every StatefulSet owning pods under test is a headless Service, and that every
+such pod resolves <peer>.<serviceName>.<namespace>.svc to the address of each of its peers with getent.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestDNSConfigIdentifier: {
+		Identifier:  TestDNSConfigIdentifier,
+		Type:        normativeResult,
+		Remediation: `Make sure the dnsPolicy and dnsConfig of the CNF pods are compatible, and that /etc/resolv.conf is not overwritten.`,
+		Description: formDescription(TestDNSConfigIdentifier,
+			`checks that the nameservers, searches and options of the dnsConfig of every pod under test declaring one
+appear in the /etc/resolv.conf of the pod.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestDualStackServicesIdentifier: {
+		Identifier: TestDualStackServicesIdentifier,
+		Type:       normativeResult,
+		Remediation: `Set the ipFamilyPolicy of the CNF Services to PreferDualStack or RequireDualStack so they are reachable over
+both IP families.`,
+		Description: formDescription(TestDualStackServicesIdentifier,
+			`checks that every Service of the namespaces under test, except ExternalName ones, declares a PreferDualStack
+or RequireDualStack ipFamilyPolicy.  The test is skipped unless the cluster network has both an IPv4 and an IPv6 CIDR.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
+	TestDualStackConnectivityIdentifier: {
+		Identifier:  TestDualStackConnectivityIdentifier,
+		Type:        normativeResult,
+		Remediation: `Make sure the CNF pods accept and answer ICMP traffic on both their IPv4 and IPv6 addresses.`,
+		Description: formDescription(TestDualStackConnectivityIdentifier,
+			`runs the default network connectivity matrix once per IP family, every CNF pod pinging the IPv4 and then the
+IPv6 address of every other CNF pod within the default network thresholds.  The test is skipped unless the cluster
+network has both an IPv4 and an IPv6 CIDR.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
 	TestPodRoleBindingsBestPracticesIdentifier: {
 		Identifier:  TestPodRoleBindingsBestPracticesIdentifier,
 		Type:        normativeResult,
@@ -469,6 +1440,15 @@ ClusterRoleBindings, if possible.`,
 		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.3.1",
 	},
 
+	TestListeningAndDeclaredPortsIdentifier: {
+		Identifier:  TestListeningAndDeclaredPortsIdentifier,
+		Type:        normativeResult,
+		Remediation: `Ensure every port a container listens on is declared in its containerPorts, and remove declared ports nothing listens on.`,
+		Description: formDescription(TestListeningAndDeclaredPortsIdentifier,
+			`tests that each CNF container listens on exactly the ports declared in its pod spec containerPorts.  Listeners bound to a loopback address are ignored.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+
 	TestUnalteredBaseImageIdentifier: {
 		Identifier: TestUnalteredBaseImageIdentifier,
 		Type:       normativeResult,
@@ -507,7 +1487,8 @@ that there are no changes to the following directories:
 		Remediation: `Ensure that boot parameters are set directly through the MachineConfigOperator, or indirectly through the PerformanceAddonOperator.  Boot parameters should not be changed directly through the Node, as OpenShift should manage
 the changes for you.`,
 		Description: formDescription(TestUnalteredStartupBootParamsIdentifier,
-			`tests that boot parameters are set through the MachineConfigOperator, and not set manually on the Node.`),
+			`tests that boot parameters are set through the MachineConfigOperator, and not set manually on the Node.  The
+nodes which do not run RHCOS, as identified from their /etc/os-release, are skipped.`),
 		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2.13 and 6.2.14",
 	},
 	TestListCniPluginsIdentifier: {
@@ -601,6 +1582,114 @@ the changes for you.`,
 			`Extracts OCP versions from the cluster.`),
 		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.3.6",
 	},
+	TestClusterPlatformIdentifier: {
+		Identifier: TestClusterPlatformIdentifier,
+		Type:       informativeResult,
+		Description: formDescription(TestClusterPlatformIdentifier,
+			`records the OpenShift version, infrastructure platform, network type, cluster network MTU and node inventory
+under the clusterPlatform key of the claim configurations, so that the results can be interpreted in context.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.3.6",
+	},
+	TestNodePressureIdentifier: {
+		Identifier: TestNodePressureIdentifier,
+		Type:       informativeResult,
+		Description: formDescription(TestNodePressureIdentifier,
+			`records the MemoryPressure, DiskPressure and PIDPressure conditions of the nodes and the share of their
+allocatable CPU and memory requested by their pods.  A warning is recorded for every node under pressure or with more
+than 90% of its allocatable CPU or memory requested, as the lifecycle tests may not be meaningful on a saturated cluster.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.3.6",
+	},
+	TestClusterOperatorHealthIdentifier: {
+		Identifier: TestClusterOperatorHealthIdentifier,
+		Type:       informativeResult,
+		Description: formDescription(TestClusterOperatorHealthIdentifier,
+			`tests that every ClusterOperator is Available and not Degraded, recording the unhealthy ones in the claim as an
+infrastructure failure.  The same check runs before the suite, which aborts when it fails unless TNF_SKIP_PREFLIGHT is
+set, so that an unhealthy cluster is not reported as failures of the CNF.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.3.6",
+	},
+	TestNodesReadyIdentifier: {
+		Identifier: TestNodesReadyIdentifier,
+		Type:       informativeResult,
+		Description: formDescription(TestNodesReadyIdentifier,
+			`tests that every node is Ready, recording the nodes which are not as environment health in the claim.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.3.6",
+	},
+	TestNodeServicesIdentifier: {
+		Identifier: TestNodeServicesIdentifier,
+		Type:       informativeResult,
+		Description: formDescription(TestNodeServicesIdentifier,
+			`tests that the kubelet and crio services are active on every node, through its debug pod, recording their
+state as environment health in the claim.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.3.6",
+	},
+	TestSystemPodRestartsIdentifier: {
+		Identifier: TestSystemPodRestartsIdentifier,
+		Type:       informativeResult,
+		Description: formDescription(TestSystemPodRestartsIdentifier,
+			`tests that no pod in the openshift-* and kube-* namespaces restarted more than 10 times, which hints at an
+unstable node, recording them as environment health in the claim.`),
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.3.6",
+	},
+	TestTopologyPodCountIdentifier: {
+		Identifier: TestTopologyPodCountIdentifier,
+		Type:       normativeResult,
+		Description: formDescription(TestTopologyPodCountIdentifier,
+			`tests that every workload of the expected topology of the config runs the declared number of pods.  The
+expected topology is also verified before the suite, which aborts when it does not match unless TNF_SKIP_PREFLIGHT is
+set, so that a CNF which is not deployed as intended is not reported as failing its functional tests.`),
+		Remediation:           `make sure the workloads are scaled as declared, and that none of their pods is pending or failing`,
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+	TestTopologyNodePlacementIdentifier: {
+		Identifier: TestTopologyNodePlacementIdentifier,
+		Type:       normativeResult,
+		Description: formDescription(TestTopologyNodePlacementIdentifier,
+			`tests that the pods of every workload of the expected topology of the config run on the declared nodes, or on
+nodes matching the declared node selector, and on distinct nodes when required.`),
+		Remediation:           `make sure the node selectors, affinities and anti-affinities of the workloads place their pods as declared`,
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+	TestTopologyNetworkAttachmentsIdentifier: {
+		Identifier: TestTopologyNetworkAttachmentsIdentifier,
+		Type:       normativeResult,
+		Description: formDescription(TestTopologyNetworkAttachmentsIdentifier,
+			`tests that every running pod of the workloads of the expected topology of the config is attached to the declared
+networks, as reported by the k8s.v1.cni.cncf.io/networks-status annotation.`),
+		Remediation:           `make sure the k8s.v1.cni.cncf.io/networks annotation of the workloads requests the declared NetworkAttachmentDefinitions`,
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+	TestNICDriverVersionsIdentifier: {
+		Identifier: TestNICDriverVersionsIdentifier,
+		Type:       normativeResult,
+		Description: formDescription(TestNICDriverVersionsIdentifier,
+			`tests that the interfaces listed in the nics section of the config use a driver, driver version and firmware
+version listed in its supportedDrivers on every worker node, as reported by ethtool -i.  The versions are only recorded
+when no supported driver is configured.`),
+		Remediation:           `update the drivers or the firmware of the node interfaces to a supported version`,
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+	TestNodeKernelVersionsIdentifier: {
+		Identifier: TestNodeKernelVersionsIdentifier,
+		Type:       normativeResult,
+		Description: formDescription(TestNodeKernelVersionsIdentifier,
+			`tests that every node runs a kernel release, as reported by uname -r, within the allowedKernelVersions range
+of the config, and that all the worker nodes run the same kernel release.  The nodes running each kernel release are
+recorded.`),
+		Remediation:           `update the nodes out of the allowed range, and make sure all the worker nodes are updated to the same release`,
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
+	TestNodeClockSkewIdentifier: {
+		Identifier: TestNodeClockSkewIdentifier,
+		Type:       normativeResult,
+		Description: formDescription(TestNodeClockSkewIdentifier,
+			`tests that the clock of every node, sampled with date +%s%N from its debug pod, is within maxClockSkewMillis
+of the test machine clock.  The offset is measured against the middle of the round trip, and a node fails only when
+its offset exceeds the bound by more than half of the round trip.  The test is skipped when maxClockSkewMillis is not
+set.`),
+		Remediation:           `make sure the nodes synchronize their clocks, e.g. with chronyd, from the same time source as the test machine`,
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 6.2",
+	},
 	TestCrdsStatusSubresourceIdentifier: {
 		Identifier: TestCrdsStatusSubresourceIdentifier,
 		Type:       informativeResult,
@@ -617,4 +1706,14 @@ the changes for you.`,
 		Remediation:           `make sure containers are not redirecting stdout/stderr`,
 		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 11.1",
 	},
+	TestLogFilesIdentifier: {
+		Identifier: TestLogFilesIdentifier,
+		Type:       normativeResult,
+		Description: formDescription(TestLogFilesIdentifier,
+			`checks that no process of the containers under test holds open a file ending in ".log" or located under
+/var/log, which would mean the container logs to its filesystem instead of stdout/stderr.  Containers also leaving
+"oc logs" empty are reported as logging only to files.`),
+		Remediation:           `make sure containers write their logs to stdout/stderr instead of files inside the container`,
+		BestPracticeReference: bestPracticeDocV1dot2URL + " Section 11.1",
+	},
 }
@@ -19,10 +19,13 @@ package lifecycle
 import (
 	"fmt"
 	"path"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/test-network-function/test-network-function/pkg/config"
+	"github.com/test-network-function/test-network-function/pkg/config/autodiscover"
 	"github.com/test-network-function/test-network-function/pkg/config/configsections"
 	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/scaling"
 	"github.com/test-network-function/test-network-function/pkg/tnf/testcases"
@@ -35,11 +38,25 @@ import (
 	"github.com/onsi/gomega"
 	log "github.com/sirupsen/logrus"
 	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/curl"
 	dp "github.com/test-network-function/test-network-function/pkg/tnf/handlers/deployments"
 	dd "github.com/test-network-function/test-network-function/pkg/tnf/handlers/deploymentsdrain"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/fsroundtrip"
 	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/graceperiod"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/helmdrift"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/helmhooks"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/helmreleases"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/imageinspect"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/nodedrain"
 	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/nodeselector"
 	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/owners"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/pdbcoverage"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/podplacement"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/podresources"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/podstartup"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/podtermination"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/tolerations"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/workloadspread"
 	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
 	"github.com/test-network-function/test-network-function/test-network-function/results"
 )
@@ -49,9 +66,92 @@ const (
 	drainTimeoutMinutes           = 5
 	scalingTimeout                = 60 * time.Second
 	scalingPollingPeriod          = 1 * time.Second
+	defaultMinReplicas            = 2
+	defaultPodRecoveryTimeout     = 120 * time.Second
+	podRecoveryPollingPeriod      = 5 * time.Second
+	recoveryPingCount             = 3
+	defaultConfigMapDriftInterval = 60 * time.Second
+	defaultMaxStartup             = 120 * time.Second
+	terminationPollingPeriod      = 1 * time.Second
+	drainConnectTimeout           = 2 * time.Second
+
+	// ocGetServicesFormat prints the name, cluster IP, first port and protocol of every Service of a namespace,
+	// separated by a "|", one Service per line.
+	ocGetServicesFormat = `oc get services -n %s -o jsonpath='{range .items[*]}{.metadata.name}|{.spec.clusterIP}|{.spec.ports[0].port}|{.spec.ports[0].protocol}{"\n"}{end}'`
+
+	// ocGetEndpointPodsFormat prints the names of the ready pods backing a Service.
+	ocGetEndpointPodsFormat = "oc get endpoints -n %s %s -o jsonpath='{.subsets[*].addresses[*].targetRef.name}'"
+
+	// ocGetPodOwnerFormat prints the UID of a pod and the kind and name of its controller, separated by a "|".
+	ocGetPodOwnerFormat = "oc get pod -n %s %s -o jsonpath='{.metadata.uid}|{.metadata.ownerReferences[?(@.controller==true)].kind}|{.metadata.ownerReferences[?(@.controller==true)].name}'"
+
+	// ocDeletePodFormat deletes a pod without waiting for its termination.
+	ocDeletePodFormat = "oc delete pod -n %s %s --wait=false"
+
+	// ocGetPodUIDsFormat prints the UIDs of the pods of a namespace.
+	ocGetPodUIDsFormat = "oc get pods -n %s -o jsonpath='{.items[*].metadata.uid}'"
+
+	// ocGetTerminatingPodsFormat prints the names of the pods of a namespace which are being deleted.
+	ocGetTerminatingPodsFormat = `oc get pods -n %s -o jsonpath='{range .items[?(@.metadata.deletionTimestamp)]}{.metadata.name}{" "}{end}'`
+
+	// ocGetControllerReadinessFormat prints the desired and ready replicas of a ReplicaSet, StatefulSet or DaemonSet,
+	// separated by a "|".
+	ocGetControllerReadinessFormat = "oc get %s -n %s %s -o jsonpath='{.spec.replicas}{.status.desiredNumberScheduled}|{.status.readyReplicas}{.status.numberReady}'"
+
+	// ocGetPodAntiAffinityCommand prints the podAntiAffinity of the pod template of a Deployment or StatefulSet.
+	ocGetPodAntiAffinityCommand = "oc get %s %s -n %s -o jsonpath='{.spec.template.spec.affinity.podAntiAffinity}'"
+
+	// ocGetContainerImageCommand prints the image reference declared in the pod spec and the image ID resolved by the
+	// container runtime for a single container.
+	ocGetContainerImageCommand = `oc get pod -n %s %s -o jsonpath='{.spec.containers[?(@.name=="%s")].image} {.status.containerStatuses[?(@.name=="%s")].imageID}'`
+
+	// ocGetTopologySpreadCommand prints the topology key, maximum skew and unsatisfiable policy of every
+	// topologySpreadConstraint of the pod template of a Deployment or StatefulSet, separated by a "|", one constraint
+	// per line.
+	ocGetTopologySpreadCommand = `oc get %s %s -n %s -o jsonpath='{range .spec.template.spec.topologySpreadConstraints[*]}{.topologyKey}|{.maxSkew}|{.whenUnsatisfiable}{"\n"}{end}'`
+
+	// ocGetWorkerZonesCommand prints the name and zone of every worker node, separated by a "|", one node per line.
+	ocGetWorkerZonesCommand = `oc get nodes -l node-role.kubernetes.io/worker -o jsonpath='{range .items[*]}{.metadata.name}|{.metadata.labels.topology\.kubernetes\.io/zone}{"\n"}{end}'`
+
+	// zoneTopologyKey and hostnameTopologyKey are the well-known topology keys of the zones and nodes.
+	zoneTopologyKey     = "topology.kubernetes.io/zone"
+	hostnameTopologyKey = "kubernetes.io/hostname"
+
+	// ocGetAutoscalersFormat prints the name, target kind, target name, minReplicas, maxReplicas and ScalingActive
+	// condition status and reason of every HorizontalPodAutoscaler of a namespace, separated by a "|", one
+	// autoscaler per line.
+	ocGetAutoscalersFormat = `oc get hpa -n %s -o jsonpath='{range .items[*]}{.metadata.name}|{.spec.scaleTargetRef.kind}|{.spec.scaleTargetRef.name}|{.spec.minReplicas}|{.spec.maxReplicas}|{.status.conditions[?(@.type=="ScalingActive")].status}|{.status.conditions[?(@.type=="ScalingActive")].reason}{"\n"}{end}'`
+
+	// ocGetPodClaimsFormat prints the name and PersistentVolumeClaim of every volume of a pod, separated by a "|", one
+	// volume per line.
+	ocGetPodClaimsFormat = `oc get pod -n %s %s -o jsonpath='{range .spec.volumes[*]}{.name}|{.persistentVolumeClaim.claimName}{"\n"}{end}'`
+
+	// ocGetPVCFormat prints the StorageClass, phase, declared access modes and actual access modes of a
+	// PersistentVolumeClaim, separated by a "|".
+	ocGetPVCFormat = "oc get pvc -n %s %s -o jsonpath='{.spec.storageClassName}|{.status.phase}|{.spec.accessModes[*]}|{.status.accessModes[*]}'"
+
+	// ocGetContainerMountsFormat prints the volume, path and read-only flag of every mount of a container, separated by
+	// a "|", one mount per line.
+	ocGetContainerMountsFormat = `oc get pod -n %s %s -o jsonpath='{range .spec.containers[?(@.name=="%s")].volumeMounts[*]}{.name}|{.mountPath}|{.readOnly}{"\n"}{end}'`
+
+	// ocGetDeploymentStrategiesFormat prints the name, replicas, strategy type, maxUnavailable and maxSurge of every
+	// Deployment of a namespace, separated by a "|", one Deployment per line.
+	ocGetDeploymentStrategiesFormat = `oc get deployments -n %s -o jsonpath='{range .items[*]}{.metadata.name}|{.spec.replicas}|{.spec.strategy.type}|{.spec.strategy.rollingUpdate.maxUnavailable}|{.spec.strategy.rollingUpdate.maxSurge}{"\n"}{end}'`
+
+	// ocRolloutRestartFormat triggers a rollout of a Deployment which changes nothing but a restart annotation.
+	ocRolloutRestartFormat = "oc rollout restart deployment/%s -n %s"
+
+	// ocRolloutStatusFormat prints whether the rollout of a Deployment is complete, without waiting for it.
+	ocRolloutStatusFormat = "oc rollout status deployment/%s -n %s --watch=false"
+
+	// defaultRollingUpdateParameter is the default maxUnavailable and maxSurge of a RollingUpdate strategy.
+	defaultRollingUpdateParameter = "25%"
 )
 
 var (
+	// defaultApprovedRegistries are the registries accepted when none is configured.
+	defaultApprovedRegistries = []string{"registry.redhat.io", "registry.access.redhat.com", "registry.connect.redhat.com"}
+
 	// nodeUncordonTestPath is the file location of the uncordon.json test case relative to the project root.
 	nodeUncordonTestPath = path.Join("pkg", "tnf", "handlers", "nodeuncordon", "uncordon.json")
 
@@ -101,21 +201,64 @@ var _ = ginkgo.Describe(common.LifecycleTestKey, func() {
 
 		testImagePolicy(env)
 
+		testImageTags(env)
+		testImageRegistries(env)
+
+		testResources(env)
+
 		testNodeSelector(env)
 
+		testPodPlacement(env)
+
 		testGracePeriod(env)
 
 		testShutdown(env)
 
 		testPodAntiAffinity(env)
 
+		testWorkloadSpread(env)
+
+		testPodAntiAffinityScheduling(env)
+
+		testTopologySpread(env)
+
+		testPodDisruptionBudgets(env)
+
+		testDeploymentStrategy(env)
+
+		testHorizontalPodAutoscalers(env)
+
+		testHelmReleases(env)
+
+		testTolerations(env)
+
+		testPersistentVolumeClaims(env)
+
+		testStorageRoundTrip(env)
+
 		if common.Intrusive() {
 			testPodsRecreation(env)
 
+			testPodDeletionRecovery(env)
+
+			testGracefulTermination(env)
+
+			testConnectionDraining(env)
+
+			testRolloutRestart(env)
+
+			testWorkloadElasticity(env)
+
+			testNodeDrainTolerance(env)
+
 			testScaling(env)
 		}
 
 		testOwner(env)
+
+		testPodStartup(env)
+
+		testConfigMapDrift(env)
 	}
 })
 
@@ -165,6 +308,19 @@ func closeOcSessionsByDeployment(containers map[configsections.ContainerIdentifi
 	}
 }
 
+// closeOcSessionsByPod closes the oc sessions to the containers of a pod before it is deleted, as a broken session
+// aborts the run.
+func closeOcSessionsByPod(containers map[configsections.ContainerIdentifier]*config.Container, namespace, podName string) {
+	for cid, c := range containers {
+		if cid.Namespace == namespace && cid.PodName == podName {
+			log.Infof("Closing session to %s %s", cid.PodName, cid.ContainerName)
+			c.Oc.Close()
+			c.Oc = nil
+			delete(containers, cid)
+		}
+	}
+}
+
 // runScalingTest Runs a Scaling handler TC and waits for all the deployments to be ready.
 func runScalingTest(deployment configsections.Deployment) {
 	handler := scaling.NewScaling(common.DefaultTimeout, deployment.Namespace, deployment.Name, deployment.Replicas)
@@ -247,6 +403,240 @@ func testNodeSelector(env *config.TestEnvironment) {
 	})
 }
 
+// testPodPlacement ensures the pods under test run on nodes matching their nodeSelector and node affinity, and not on a
+// control plane node.
+func testPodPlacement(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestPodPlacementIdentifier)
+	ginkgo.It(testID, func() {
+		context := common.GetContext()
+		var failedPods []string
+		for _, namespace := range env.NameSpacesUnderTest {
+			ginkgo.By(fmt.Sprintf("Checking the placement of the pods of namespace %s", namespace))
+			tester := podplacement.NewPodPlacement(common.DefaultTimeout, namespace)
+			test, err := tnf.NewTest(context.GetExpecter(), tester, []reel.Handler{tester}, context.GetErrorChannel())
+			gomega.Expect(err).To(gomega.BeNil())
+			checkPlacements := func() {
+				placements := tester.GetPlacements()
+				for _, podUnderTest := range env.PodsUnderTest {
+					placement, found := placements[podUnderTest.Name]
+					if podUnderTest.Namespace != namespace || !found {
+						continue
+					}
+					podName := fmt.Sprintf("%s/%s", podUnderTest.Namespace, podUnderTest.Name)
+					tnf.ClaimFilePrintf("Pod %s runs on node %s with roles %v", podName, placement.Node, placement.Roles)
+					for _, violation := range placement.Violations {
+						tnf.ClaimFilePrintf("Pod %s: %s", podName, violation)
+					}
+					if len(placement.Violations) > 0 {
+						failedPods = append(failedPods, podName)
+					}
+				}
+			}
+			test.RunWithCallbacks(checkPlacements, checkPlacements, func(err error) {
+				tnf.ClaimFilePrintf("Failed to get the placement of the pods of namespace %s: %v", namespace, err)
+				failedPods = append(failedPods, namespace)
+			})
+		}
+		if n := len(failedPods); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d misplaced pods: %v", n, failedPods))
+		}
+	})
+}
+
+// maxStartup returns the configured startup budget, or defaultMaxStartup when none is configured.
+func maxStartup(env *config.TestEnvironment) time.Duration {
+	if env.Config.MaxStartupSeconds > 0 {
+		return time.Duration(env.Config.MaxStartupSeconds) * time.Second
+	}
+	return defaultMaxStartup
+}
+
+// getPodClaims returns the PersistentVolumeClaims mounted by a pod, indexed by volume name.
+func getPodClaims(namespace, podName string) map[string]string {
+	const numExpectedFields = 2
+	out := utils.ExecuteCommand(fmt.Sprintf(ocGetPodClaimsFormat, namespace, podName), common.DefaultTimeout, common.GetContext(), func() {
+		tnf.ClaimFilePrintf("Failed to get the volumes of pod %s/%s", namespace, podName)
+	})
+	claims := map[string]string{}
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.Split(strings.TrimSpace(line), "|")
+		if len(fields) == numExpectedFields && fields[1] != "" {
+			claims[fields[0]] = fields[1]
+		}
+	}
+	return claims
+}
+
+// getPVCViolations returns why a PersistentVolumeClaim is not bound with its declared access modes to an approved
+// StorageClass.
+func getPVCViolations(namespace, claimName string, approvedStorageClasses []string) []string {
+	const numExpectedFields = 4
+	out := utils.ExecuteCommand(fmt.Sprintf(ocGetPVCFormat, namespace, claimName), common.DefaultTimeout, common.GetContext(), func() {
+		tnf.ClaimFilePrintf("Failed to get persistentvolumeclaim %s/%s", namespace, claimName)
+	})
+	fields := strings.Split(strings.TrimSpace(out), "|")
+	if len(fields) != numExpectedFields {
+		return []string{fmt.Sprintf("unexpected output %q", out)}
+	}
+	storageClass, phase, declaredModes, actualModes := fields[0], fields[1], strings.Fields(fields[2]), strings.Fields(fields[3])
+	tnf.ClaimFilePrintf("PersistentVolumeClaim %s/%s: storage class %q, phase %s, access modes %v, declared %v", namespace, claimName,
+		storageClass, phase, actualModes, declaredModes)
+	var violations []string
+	if phase != "Bound" {
+		violations = append(violations, "phase "+phase)
+	}
+	if len(approvedStorageClasses) > 0 && !stringInSlice(approvedStorageClasses, storageClass) {
+		violations = append(violations, fmt.Sprintf("storage class %q not approved", storageClass))
+	}
+	for _, mode := range declaredModes {
+		if !stringInSlice(actualModes, mode) {
+			violations = append(violations, "missing access mode "+mode)
+		}
+	}
+	return violations
+}
+
+// stringInSlice returns whether a slice contains a string.
+func stringInSlice(slice []string, s string) bool {
+	for _, item := range slice {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// testPersistentVolumeClaims ensures the PersistentVolumeClaims of the pods under test are bound with their declared
+// access modes to approved StorageClasses.
+func testPersistentVolumeClaims(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestPersistentVolumeClaimsIdentifier)
+	ginkgo.It(testID, func() {
+		checked := map[string]bool{}
+		var failedClaims []string
+		for _, podUnderTest := range env.PodsUnderTest {
+			for _, claimName := range getPodClaims(podUnderTest.Namespace, podUnderTest.Name) {
+				name := podUnderTest.Namespace + "/" + claimName
+				if checked[name] {
+					continue
+				}
+				checked[name] = true
+				ginkgo.By(fmt.Sprintf("Checking persistentvolumeclaim %s", name))
+				if violations := getPVCViolations(podUnderTest.Namespace, claimName, env.Config.ApprovedStorageClasses); len(violations) > 0 {
+					tnf.ClaimFilePrintf("PersistentVolumeClaim %s: %v", name, violations)
+					failedClaims = append(failedClaims, name)
+				}
+			}
+		}
+		if len(checked) == 0 {
+			ginkgo.Skip("No pod under test mounts a PersistentVolumeClaim")
+		}
+		if n := len(failedClaims); n > 0 {
+			sort.Strings(failedClaims)
+			ginkgo.Fail(fmt.Sprintf("Found %d persistentvolumeclaims not bound as declared to an approved storage class: %v", n, failedClaims))
+		}
+	})
+}
+
+// getWritableClaimMounts returns the paths where a container mounts a PersistentVolumeClaim read-write.
+func getWritableClaimMounts(id configsections.ContainerIdentifier, claims map[string]string) []string {
+	const numExpectedFields = 3
+	command := fmt.Sprintf(ocGetContainerMountsFormat, id.Namespace, id.PodName, id.ContainerName)
+	out := utils.ExecuteCommand(command, common.DefaultTimeout, common.GetContext(), func() {
+		tnf.ClaimFilePrintf("Failed to get the volume mounts of container %s/%s/%s", id.Namespace, id.PodName, id.ContainerName)
+	})
+	var mounts []string
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.Split(strings.TrimSpace(line), "|")
+		if len(fields) != numExpectedFields || fields[2] == "true" {
+			continue
+		}
+		if _, ok := claims[fields[0]]; ok {
+			mounts = append(mounts, fields[1])
+		}
+	}
+	return mounts
+}
+
+// testStorageRoundTrip ensures the containers under test can write to and read back from the PersistentVolumeClaims
+// they mount read-write.
+func testStorageRoundTrip(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestStorageRoundTripIdentifier)
+	ginkgo.It(testID, func() {
+		claimsByPod := map[string]map[string]string{}
+		tested := false
+		var failedMounts []string
+		for _, cut := range env.ContainersUnderTest {
+			id := cut.ContainerIdentifier
+			podName := id.Namespace + "/" + id.PodName
+			if _, ok := claimsByPod[podName]; !ok {
+				claimsByPod[podName] = getPodClaims(id.Namespace, id.PodName)
+			}
+			if len(claimsByPod[podName]) == 0 {
+				continue
+			}
+			for _, mountPath := range getWritableClaimMounts(id, claimsByPod[podName]) {
+				tested = true
+				mount := fmt.Sprintf("%s/%s:%s", podName, id.ContainerName, mountPath)
+				ginkgo.By(fmt.Sprintf("Writing and reading back %s", mount))
+				tester := fsroundtrip.NewFsRoundTrip(common.DefaultTimeout, mountPath)
+				test, err := tnf.NewTest(cut.Oc.GetExpecter(), tester, []reel.Handler{tester}, cut.Oc.GetErrorChannel())
+				gomega.Expect(err).To(gomega.BeNil())
+				test.RunWithCallbacks(nil, func() {
+					tnf.ClaimFilePrintf("Failed to write and read back %s", mount)
+					failedMounts = append(failedMounts, mount)
+				}, func(err error) {
+					tnf.ClaimFilePrintf("Failed to run the filesystem round trip on %s: %v", mount, err)
+					failedMounts = append(failedMounts, mount)
+				})
+			}
+		}
+		if !tested {
+			ginkgo.Skip("No container under test mounts a PersistentVolumeClaim read-write")
+		}
+		if n := len(failedMounts); n > 0 {
+			sort.Strings(failedMounts)
+			ginkgo.Fail(fmt.Sprintf("Found %d persistent volume mounts failing a write/read round trip: %v", n, failedMounts))
+		}
+	})
+}
+
+// testPodStartup ensures the pods under test became ready within the startup budget after their creation.
+func testPodStartup(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestPodStartupIdentifier)
+	ginkgo.It(testID, func() {
+		context := common.GetContext()
+		budget := maxStartup(env)
+		var failedPods []string
+		for _, namespace := range env.NameSpacesUnderTest {
+			ginkgo.By(fmt.Sprintf("Measuring the startup time of the pods of namespace %s", namespace))
+			tester := podstartup.NewPodStartup(common.DefaultTimeout, namespace)
+			test, err := tnf.NewTest(context.GetExpecter(), tester, []reel.Handler{tester}, context.GetErrorChannel())
+			gomega.Expect(err).To(gomega.BeNil())
+			test.RunWithCallbacks(func() {
+				startupTimes := tester.GetStartupTimes()
+				for _, podUnderTest := range env.PodsUnderTest {
+					startupTime, found := startupTimes[podUnderTest.Name]
+					if podUnderTest.Namespace != namespace || !found {
+						continue
+					}
+					podName := fmt.Sprintf("%s/%s", podUnderTest.Namespace, podUnderTest.Name)
+					tnf.ClaimFilePrintf("Pod %s became ready %s after its creation", podName, startupTime)
+					if startupTime > budget {
+						tnf.ClaimFilePrintf("Pod %s exceeded the startup budget of %s", podName, budget)
+						failedPods = append(failedPods, podName)
+					}
+				}
+			}, nil, func(err error) {
+				tnf.ClaimFilePrintf("Failed to get the pods of namespace %s: %v", namespace, err)
+				failedPods = append(failedPods, namespace)
+			})
+		}
+		if n := len(failedPods); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d pods exceeding the startup budget of %s: %v", n, budget, failedPods))
+		}
+	})
+}
+
 func testGracePeriod(env *config.TestEnvironment) {
 	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestNonDefaultGracePeriodIdentifier)
 	ginkgo.It(testID, func() {
@@ -488,3 +878,1336 @@ func testImagePolicy(env *config.TestEnvironment) {
 		}
 	})
 }
+
+// testImageTags ensures the containers under test run images pinned by digest rather than by a mutable tag.
+func testImageTags(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestImageTagIdentifier)
+	ginkgo.It(testID, func() {
+		var failedContainers []string
+		for _, cut := range env.ContainersUnderTest {
+			id := cut.ContainerIdentifier
+			containerName := fmt.Sprintf("%s/%s/%s", id.Namespace, id.PodName, id.ContainerName)
+			ginkgo.By(fmt.Sprintf("Checking the image reference of container %s", containerName))
+			command := fmt.Sprintf(ocGetContainerImageCommand, id.Namespace, id.PodName, id.ContainerName, id.ContainerName)
+			out := utils.ExecuteCommand(command, common.DefaultTimeout, common.GetContext(), func() {
+				tnf.ClaimFilePrintf("Failed to get the image of container %s", containerName)
+			})
+			fields := strings.Fields(out)
+			gomega.Expect(fields).ToNot(gomega.BeEmpty())
+			image := fields[0]
+			resolved := ""
+			if len(fields) > 1 {
+				resolved = fields[1]
+			}
+			tnf.ClaimFilePrintf("Container %s uses image %s resolved to %s", containerName, image, resolved)
+			switch {
+			case imageinspect.IsLatestTag(image):
+				tnf.ClaimFilePrintf("Container %s uses the mutable latest tag in image %s", containerName, image)
+				failedContainers = append(failedContainers, containerName)
+			case imageinspect.IsFloatingTag(image):
+				tnf.ClaimFilePrintf("Container %s image %s is not pinned by digest", containerName, image)
+				failedContainers = append(failedContainers, containerName)
+			}
+		}
+		if n := len(failedContainers); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d containers not using digest-pinned images: %v", n, failedContainers))
+		}
+	})
+}
+
+// testImageRegistries ensures the containers under test run images pulled from an approved registry.
+func testImageRegistries(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestImageRegistryIdentifier)
+	ginkgo.It(testID, func() {
+		approvedRegistries := env.Config.ApprovedRegistries
+		if len(approvedRegistries) == 0 {
+			approvedRegistries = defaultApprovedRegistries
+		}
+		var failedContainers []string
+		for _, cut := range env.ContainersUnderTest {
+			id := cut.ContainerIdentifier
+			containerName := fmt.Sprintf("%s/%s/%s", id.Namespace, id.PodName, id.ContainerName)
+			ginkgo.By(fmt.Sprintf("Checking the image registry of container %s", containerName))
+			command := fmt.Sprintf(ocGetContainerImageCommand, id.Namespace, id.PodName, id.ContainerName, id.ContainerName)
+			out := utils.ExecuteCommand(command, common.DefaultTimeout, common.GetContext(), func() {
+				tnf.ClaimFilePrintf("Failed to get the image of container %s", containerName)
+			})
+			fields := strings.Fields(out)
+			gomega.Expect(fields).ToNot(gomega.BeEmpty())
+			image := fields[0]
+			if !imageinspect.IsFromRegistry(image, approvedRegistries) {
+				tnf.ClaimFilePrintf("Container %s uses image %s from registry %s, which is not one of %v", containerName, image, imageinspect.GetRegistry(image), approvedRegistries)
+				failedContainers = append(failedContainers, containerName)
+			}
+		}
+		if n := len(failedContainers); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d containers using images from unapproved registries: %v", n, failedContainers))
+		}
+	})
+}
+
+// testResources ensures the containers under test declare CPU and memory requests and limits.
+func testResources(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestResourcesIdentifier)
+	ginkgo.It(testID, func() {
+		context := common.GetContext()
+		var failedPods []string
+		for _, podUnderTest := range env.PodsUnderTest {
+			podName := fmt.Sprintf("%s/%s", podUnderTest.Namespace, podUnderTest.Name)
+			ginkgo.By(fmt.Sprintf("Checking the resource requests and limits of pod %s", podName))
+			tester := podresources.NewPodResources(common.DefaultTimeout, podUnderTest.Namespace, podUnderTest.Name, env.Config.RequireGuaranteedQoS)
+			test, err := tnf.NewTest(context.GetExpecter(), tester, []reel.Handler{tester}, context.GetErrorChannel())
+			gomega.Expect(err).To(gomega.BeNil())
+			test.RunWithCallbacks(nil, func() {
+				for _, violation := range tester.GetViolations() {
+					tnf.ClaimFilePrintf("Pod %s: %s", podName, violation)
+				}
+				failedPods = append(failedPods, podName)
+			}, func(err error) {
+				tnf.ClaimFilePrintf("Failed to get the resources of pod %s: %v", podName, err)
+				failedPods = append(failedPods, podName)
+			})
+		}
+		if n := len(failedPods); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d pods with missing or mismatching resource requests and limits: %v", n, failedPods))
+		}
+	})
+}
+
+// testWorkloadSpread ensures the Deployments and StatefulSets of the CNF run enough replicas spread across nodes.
+func testWorkloadSpread(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestWorkloadReplicasIdentifier)
+	ginkgo.It(testID, func() {
+		minReplicas := env.Config.MinReplicas
+		if minReplicas == 0 {
+			minReplicas = defaultMinReplicas
+		}
+		context := common.GetContext()
+		var violations []string
+		for _, namespace := range env.NameSpacesUnderTest {
+			ginkgo.By(fmt.Sprintf("Checking the replicas of the Deployments and StatefulSets in namespace %s", namespace))
+			tester := workloadspread.NewWorkloadSpread(common.DefaultTimeout, namespace, minReplicas)
+			test, err := tnf.NewTest(context.GetExpecter(), tester, []reel.Handler{tester}, context.GetErrorChannel())
+			gomega.Expect(err).To(gomega.BeNil())
+			test.RunWithCallbacks(nil, func() {
+				for _, violation := range tester.GetViolations() {
+					tnf.ClaimFilePrintf("Namespace %s: %s", namespace, violation)
+					violations = append(violations, namespace+": "+violation)
+				}
+			}, func(err error) {
+				tnf.ClaimFilePrintf("Failed to get the workloads of namespace %s: %v", namespace, err)
+				violations = append(violations, namespace+": "+err.Error())
+			})
+			for key, workload := range tester.GetWorkloads() {
+				tnf.ClaimFilePrintf("Namespace %s: %s has %d replicas on nodes %v", namespace, key, workload.Replicas, workload.Nodes)
+			}
+		}
+		if n := len(violations); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d workloads that are not highly available: %v", n, violations))
+		}
+	})
+}
+
+// testPodAntiAffinityScheduling ensures the replicated workloads of the CNF keep their pods on separate nodes.
+func testPodAntiAffinityScheduling(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestPodAntiAffinitySchedulingIdentifier)
+	ginkgo.It(testID, func() {
+		context := common.GetContext()
+		var violations []string
+		for _, namespace := range env.NameSpacesUnderTest {
+			ginkgo.By(fmt.Sprintf("Checking the pod anti-affinity of the replicated workloads in namespace %s", namespace))
+			tester := workloadspread.NewWorkloadSpread(common.DefaultTimeout, namespace, 0)
+			test, err := tnf.NewTest(context.GetExpecter(), tester, []reel.Handler{tester}, context.GetErrorChannel())
+			gomega.Expect(err).To(gomega.BeNil())
+			result, err := test.Run()
+			gomega.Expect(err).To(gomega.BeNil())
+			gomega.Expect(result).ToNot(gomega.Equal(tnf.ERROR))
+			for key, workload := range tester.GetWorkloads() {
+				if workload.Replicas <= 1 {
+					continue
+				}
+				command := fmt.Sprintf(ocGetPodAntiAffinityCommand, strings.ToLower(workload.Kind), workload.Name, namespace)
+				antiAffinity := utils.ExecuteCommand(command, common.DefaultTimeout, context, func() {
+					tnf.ClaimFilePrintf("Failed to get the podAntiAffinity of %s in namespace %s", key, namespace)
+				})
+				if strings.TrimSpace(antiAffinity) == "" {
+					tnf.ClaimFilePrintf("Namespace %s: %s has %d replicas but no podAntiAffinity rule", namespace, key, workload.Replicas)
+					violations = append(violations, namespace+": "+key+" has no podAntiAffinity")
+				}
+				for node, pods := range workload.Nodes {
+					if pods > 1 {
+						tnf.ClaimFilePrintf("Namespace %s: %s runs %d replicas on node %s", namespace, key, pods, node)
+						violations = append(violations, fmt.Sprintf("%s: %s runs %d replicas on node %s", namespace, key, pods, node))
+					}
+				}
+			}
+		}
+		if n := len(violations); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d pod anti-affinity violations: %v", n, violations))
+		}
+	})
+}
+
+// topologySpreadConstraint is a topologySpreadConstraint of a pod template.
+type topologySpreadConstraint struct {
+	topologyKey       string
+	maxSkew           int
+	whenUnsatisfiable string
+}
+
+// getTopologySpreadConstraints returns the topologySpreadConstraints of the pod template of a Deployment or
+// StatefulSet.
+func getTopologySpreadConstraints(kind, name, namespace string) []topologySpreadConstraint {
+	const numExpectedFields = 3
+	out := utils.ExecuteCommand(fmt.Sprintf(ocGetTopologySpreadCommand, strings.ToLower(kind), name, namespace), common.DefaultTimeout,
+		common.GetContext(), func() {
+			tnf.ClaimFilePrintf("Failed to get the topologySpreadConstraints of %s/%s in namespace %s", kind, name, namespace)
+		})
+	var constraints []topologySpreadConstraint
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.Split(strings.TrimSpace(line), "|")
+		if len(fields) != numExpectedFields {
+			continue
+		}
+		maxSkew, err := strconv.Atoi(fields[1])
+		if err != nil {
+			continue
+		}
+		constraints = append(constraints, topologySpreadConstraint{topologyKey: fields[0], maxSkew: maxSkew, whenUnsatisfiable: fields[2]})
+	}
+	return constraints
+}
+
+// getWorkerZones returns the zone of every worker node labelled with one.
+func getWorkerZones() map[string]string {
+	const numExpectedFields = 2
+	out := utils.ExecuteCommand(ocGetWorkerZonesCommand, common.DefaultTimeout, common.GetContext(), func() {
+		tnf.ClaimFilePrintf("Failed to get the zones of the worker nodes")
+	})
+	zones := map[string]string{}
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.Split(strings.TrimSpace(line), "|")
+		if len(fields) == numExpectedFields && fields[1] != "" {
+			zones[fields[0]] = fields[1]
+		}
+	}
+	return zones
+}
+
+// getSkew returns the difference between the highest and lowest number of pods in the topology domains.  Domains
+// without pods count as zero.
+func getSkew(pods map[string]int, domains []string) int {
+	if len(domains) == 0 {
+		return 0
+	}
+	lowest, highest := pods[domains[0]], pods[domains[0]]
+	for _, domain := range domains[1:] {
+		if pods[domain] < lowest {
+			lowest = pods[domain]
+		}
+		if pods[domain] > highest {
+			highest = pods[domain]
+		}
+	}
+	return highest - lowest
+}
+
+// getTopologySpreadViolations returns how the placement of a replicated workload does not honor its
+// topologySpreadConstraints, or its podAntiAffinity on a multi-zone cluster.  nodeZones maps the worker nodes to their
+// zone, and zones lists the distinct zones.
+func getTopologySpreadViolations(namespace string, workload *workloadspread.Workload, nodeZones map[string]string, zones []string) []string {
+	key := workload.Key()
+	podsByZone := map[string]int{}
+	for node, pods := range workload.Nodes {
+		if zone, ok := nodeZones[node]; ok {
+			podsByZone[zone] += pods
+		}
+	}
+	tnf.ClaimFilePrintf("Namespace %s: %s has %d replicas on nodes %v and zones %v", namespace, key, workload.Replicas, workload.Nodes, podsByZone)
+	constraints := getTopologySpreadConstraints(workload.Kind, workload.Name, namespace)
+	if len(constraints) == 0 {
+		command := fmt.Sprintf(ocGetPodAntiAffinityCommand, strings.ToLower(workload.Kind), workload.Name, namespace)
+		antiAffinity := utils.ExecuteCommand(command, common.DefaultTimeout, common.GetContext(), func() {
+			tnf.ClaimFilePrintf("Failed to get the podAntiAffinity of %s in namespace %s", key, namespace)
+		})
+		if strings.TrimSpace(antiAffinity) == "" {
+			return []string{key + " defines neither topologySpreadConstraints nor podAntiAffinity"}
+		}
+		if len(zones) > 1 && len(podsByZone) == 1 {
+			return []string{fmt.Sprintf("%s runs all its replicas in zone %v of a multi-zone cluster", key, podsByZone)}
+		}
+		return nil
+	}
+	var violations []string
+	for _, constraint := range constraints {
+		var skew int
+		switch constraint.topologyKey {
+		case zoneTopologyKey:
+			skew = getSkew(podsByZone, zones)
+		case hostnameTopologyKey:
+			nodes := make([]string, 0, len(workload.Nodes))
+			for node := range workload.Nodes {
+				nodes = append(nodes, node)
+			}
+			skew = getSkew(workload.Nodes, nodes)
+		default:
+			continue
+		}
+		if skew <= constraint.maxSkew {
+			continue
+		}
+		violation := fmt.Sprintf("%s has a skew of %d over %s, above its maxSkew %d", key, skew, constraint.topologyKey, constraint.maxSkew)
+		if constraint.whenUnsatisfiable == "ScheduleAnyway" {
+			tnf.ClaimFilePrintf("Namespace %s: %s, allowed by ScheduleAnyway", namespace, violation)
+			continue
+		}
+		violations = append(violations, violation)
+	}
+	return violations
+}
+
+// testTopologySpread ensures the replicated workloads spread their pods across the nodes and zones, and that their
+// placement honors the declared constraints.
+func testTopologySpread(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestTopologySpreadIdentifier)
+	ginkgo.It(testID, func() {
+		context := common.GetContext()
+		nodeZones := getWorkerZones()
+		distinctZones := map[string]bool{}
+		for _, zone := range nodeZones {
+			distinctZones[zone] = true
+		}
+		zones := make([]string, 0, len(distinctZones))
+		for zone := range distinctZones {
+			zones = append(zones, zone)
+		}
+		sort.Strings(zones)
+		tnf.ClaimFilePrintf("The worker nodes are spread over the zones %v", zones)
+		tested := false
+		var violations []string
+		for _, namespace := range env.NameSpacesUnderTest {
+			ginkgo.By(fmt.Sprintf("Checking the topology spread of the replicated workloads in namespace %s", namespace))
+			tester := workloadspread.NewWorkloadSpread(common.DefaultTimeout, namespace, 0)
+			test, err := tnf.NewTest(context.GetExpecter(), tester, []reel.Handler{tester}, context.GetErrorChannel())
+			gomega.Expect(err).To(gomega.BeNil())
+			result, err := test.Run()
+			gomega.Expect(err).To(gomega.BeNil())
+			gomega.Expect(result).ToNot(gomega.Equal(tnf.ERROR))
+			for _, workload := range tester.GetWorkloads() {
+				if workload.Replicas <= 1 {
+					continue
+				}
+				tested = true
+				for _, violation := range getTopologySpreadViolations(namespace, workload, nodeZones, zones) {
+					tnf.ClaimFilePrintf("Namespace %s: %s", namespace, violation)
+					violations = append(violations, namespace+": "+violation)
+				}
+			}
+		}
+		if !tested {
+			ginkgo.Skip("No Deployment or StatefulSet runs more than one replica")
+		}
+		if n := len(violations); n > 0 {
+			sort.Strings(violations)
+			ginkgo.Fail(fmt.Sprintf("Found %d topology spread violations: %v", n, violations))
+		}
+	})
+}
+
+// resolveRollingUpdateParameter returns the number of pods a maxUnavailable or maxSurge value, either absolute or a
+// percentage, stands for.  Percentages are rounded up for maxSurge and down for maxUnavailable, as the Deployment
+// controller does.
+func resolveRollingUpdateParameter(value string, replicas int, roundUp bool) (int, error) {
+	const hundred = 100
+	if value == "" {
+		value = defaultRollingUpdateParameter
+	}
+	if !strings.HasSuffix(value, "%") {
+		return strconv.Atoi(value)
+	}
+	percent, err := strconv.Atoi(strings.TrimSuffix(value, "%"))
+	if err != nil {
+		return 0, err
+	}
+	if roundUp {
+		return (replicas*percent + hundred - 1) / hundred, nil
+	}
+	return replicas * percent / hundred, nil
+}
+
+// getDeploymentStrategyViolations returns why the update strategy of a Deployment may cause downtime, if it does.
+func getDeploymentStrategyViolations(replicas int, strategy, maxUnavailable, maxSurge string) []string {
+	if strategy != "" && strategy != "RollingUpdate" {
+		return []string{fmt.Sprintf("uses the %s strategy instead of RollingUpdate", strategy)}
+	}
+	unavailable, err := resolveRollingUpdateParameter(maxUnavailable, replicas, false)
+	if err != nil {
+		return []string{fmt.Sprintf("has an invalid maxUnavailable %q", maxUnavailable)}
+	}
+	surge, err := resolveRollingUpdateParameter(maxSurge, replicas, true)
+	if err != nil {
+		return []string{fmt.Sprintf("has an invalid maxSurge %q", maxSurge)}
+	}
+	var violations []string
+	if unavailable >= replicas {
+		violations = append(violations, fmt.Sprintf("maxUnavailable %q allows all of its %d replicas to be unavailable", maxUnavailable, replicas))
+	}
+	if unavailable == 0 && surge == 0 {
+		violations = append(violations, "maxUnavailable and maxSurge are both zero, so a rollout cannot progress")
+	}
+	return violations
+}
+
+// testDeploymentStrategy ensures the Deployments of the CNF are updated by a RollingUpdate which keeps some of their
+// replicas available.
+func testDeploymentStrategy(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestDeploymentStrategyIdentifier)
+	ginkgo.It(testID, func() {
+		const numExpectedFields = 5
+		tested := false
+		var failedDeployments []string
+		for _, namespace := range env.NameSpacesUnderTest {
+			ginkgo.By(fmt.Sprintf("Checking the update strategy of the Deployments in namespace %s", namespace))
+			command := fmt.Sprintf(ocGetDeploymentStrategiesFormat, namespace)
+			out := utils.ExecuteCommand(command, common.DefaultTimeout, common.GetContext(), func() {
+				tnf.ClaimFilePrintf("Failed to get the Deployments of namespace %s", namespace)
+			})
+			for _, line := range strings.Split(out, "\n") {
+				fields := strings.Split(strings.TrimSpace(line), "|")
+				if len(fields) != numExpectedFields {
+					continue
+				}
+				tested = true
+				deployment := namespace + "/" + fields[0]
+				replicas, err := strconv.Atoi(fields[1])
+				if err != nil {
+					replicas = 1
+				}
+				violations := getDeploymentStrategyViolations(replicas, fields[2], fields[3], fields[4])
+				for _, violation := range violations {
+					tnf.ClaimFilePrintf("Deployment %s %s", deployment, violation)
+				}
+				if len(violations) > 0 {
+					failedDeployments = append(failedDeployments, deployment)
+				}
+			}
+		}
+		if !tested {
+			ginkgo.Skip("No Deployment found in the namespaces under test")
+		}
+		if n := len(failedDeployments); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d Deployments whose update strategy may cause downtime: %v", n, failedDeployments))
+		}
+	})
+}
+
+// testPodDisruptionBudgets ensures the replicated workloads of the CNF are covered by a PodDisruptionBudget.
+func testPodDisruptionBudgets(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestPodDisruptionBudgetIdentifier)
+	ginkgo.It(testID, func() {
+		context := common.GetContext()
+		var violations []string
+		for _, namespace := range env.NameSpacesUnderTest {
+			ginkgo.By(fmt.Sprintf("Checking the PodDisruptionBudgets of the workloads in namespace %s", namespace))
+			tester := pdbcoverage.NewPDBCoverage(common.DefaultTimeout, namespace)
+			test, err := tnf.NewTest(context.GetExpecter(), tester, []reel.Handler{tester}, context.GetErrorChannel())
+			gomega.Expect(err).To(gomega.BeNil())
+			test.RunWithCallbacks(nil, func() {
+				for _, violation := range tester.GetViolations() {
+					tnf.ClaimFilePrintf("Namespace %s: %s", namespace, violation)
+					violations = append(violations, namespace+": "+violation)
+				}
+			}, func(err error) {
+				tnf.ClaimFilePrintf("Failed to get the PodDisruptionBudgets of namespace %s: %v", namespace, err)
+				violations = append(violations, namespace+": "+err.Error())
+			})
+			for workload, budgets := range tester.GetCoverage() {
+				tnf.ClaimFilePrintf("Namespace %s: %s is covered by PodDisruptionBudgets %v", namespace, workload, budgets)
+			}
+		}
+		if n := len(violations); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d PodDisruptionBudget issues: %v", n, violations))
+		}
+	})
+}
+
+// getHelmReleases returns the deployed Helm releases of the namespaces under test.
+func getHelmReleases(env *config.TestEnvironment) []helmreleases.Release {
+	context := common.GetContext()
+	var releases []helmreleases.Release
+	for _, namespace := range env.NameSpacesUnderTest {
+		tester := helmreleases.NewHelmReleases(common.DefaultTimeout, namespace)
+		test, err := tnf.NewTest(context.GetExpecter(), tester, []reel.Handler{tester}, context.GetErrorChannel())
+		gomega.Expect(err).To(gomega.BeNil())
+		test.RunWithCallbacks(nil, nil, func(err error) {
+			tnf.ClaimFilePrintf("Failed to list the Helm releases of namespace %s: %v", namespace, err)
+		})
+		for _, release := range tester.GetReleases() {
+			tnf.ClaimFilePrintf("Found Helm release %s/%s revision %d", release.Namespace, release.Name, release.Revision)
+		}
+		releases = append(releases, tester.GetReleases()...)
+	}
+	return releases
+}
+
+// testHelmReleases runs the Helm release tests when the CNF is deployed with Helm.
+func testHelmReleases(env *config.TestEnvironment) {
+	ginkgo.When("the CNF is deployed with Helm", func() {
+		var releases []helmreleases.Release
+		ginkgo.BeforeEach(func() {
+			releases = getHelmReleases(env)
+			if len(releases) == 0 {
+				ginkgo.Skip("No Helm release found in the namespaces under test")
+			}
+		})
+		testHelmHooks(&releases)
+		testHelmDrift(&releases)
+	})
+}
+
+// testHelmHooks ensures the hooks of the Helm releases of the CNF do not create RBAC objects.
+func testHelmHooks(releases *[]helmreleases.Release) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestHelmHooksIdentifier)
+	ginkgo.It(testID, func() {
+		context := common.GetContext()
+		var failedReleases []string
+		for _, release := range *releases {
+			releaseName := release.Namespace + "/" + release.Name
+			ginkgo.By(fmt.Sprintf("Checking the hooks of Helm release %s", releaseName))
+			tester := helmhooks.NewHelmHooks(common.DefaultTimeout, release.Namespace, release.Secret)
+			test, err := tnf.NewTest(context.GetExpecter(), tester, []reel.Handler{tester}, context.GetErrorChannel())
+			gomega.Expect(err).To(gomega.BeNil())
+			test.RunWithCallbacks(nil, func() {
+				for _, violation := range tester.GetViolations() {
+					tnf.ClaimFilePrintf("Helm release %s: %s", releaseName, violation)
+				}
+				failedReleases = append(failedReleases, releaseName)
+			}, func(err error) {
+				tnf.ClaimFilePrintf("Failed to decode Helm release %s: %v", releaseName, err)
+				failedReleases = append(failedReleases, releaseName)
+			})
+		}
+		if n := len(failedReleases); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d Helm releases with hooks creating RBAC objects: %v", n, failedReleases))
+		}
+	})
+}
+
+// testHelmDrift ensures the objects of the Helm releases of the CNF match their rendered manifests.
+func testHelmDrift(releases *[]helmreleases.Release) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestHelmDriftIdentifier)
+	ginkgo.It(testID, func() {
+		context := common.GetContext()
+		var failedReleases []string
+		for _, release := range *releases {
+			releaseName := release.Namespace + "/" + release.Name
+			ginkgo.By(fmt.Sprintf("Comparing Helm release %s with the cluster", releaseName))
+			tester := helmdrift.NewHelmDrift(common.DefaultTimeout, release.Namespace, release.Secret)
+			test, err := tnf.NewTest(context.GetExpecter(), tester, []reel.Handler{tester}, context.GetErrorChannel())
+			gomega.Expect(err).To(gomega.BeNil())
+			test.RunWithCallbacks(nil, func() {
+				tnf.ClaimFilePrintf("Helm release %s differs from the cluster in %v:\n%s", releaseName, tester.GetDrifted(), tester.GetDiff())
+				failedReleases = append(failedReleases, releaseName)
+			}, func(err error) {
+				tnf.ClaimFilePrintf("Failed to compare Helm release %s with the cluster: %v", releaseName, err)
+				failedReleases = append(failedReleases, releaseName)
+			})
+		}
+		if n := len(failedReleases); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d Helm releases which differ from the cluster: %v", n, failedReleases))
+		}
+	})
+}
+
+// testTolerations ensures the pods under test do not rely on a tolerate-everything toleration to run on their node.
+func testTolerations(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestTolerationsIdentifier)
+	ginkgo.It(testID, func() {
+		context := common.GetContext()
+		var failedPods []string
+		for _, podUnderTest := range env.PodsUnderTest {
+			podName := podUnderTest.Namespace + "/" + podUnderTest.Name
+			ginkgo.By(fmt.Sprintf("Comparing the tolerations of pod %s with the taints of its node", podName))
+			tester := tolerations.NewTolerations(common.DefaultTimeout, podUnderTest.Namespace, podUnderTest.Name)
+			test, err := tnf.NewTest(context.GetExpecter(), tester, []reel.Handler{tester}, context.GetErrorChannel())
+			gomega.Expect(err).To(gomega.BeNil())
+			test.RunWithCallbacks(func() {
+				for _, toleration := range tester.GetTolerations() {
+					if toleration.IsBroad() {
+						tnf.ClaimFilePrintf("Pod %s on node %s has a tolerate-everything toleration %+v", podName, tester.GetNode(), toleration)
+					}
+				}
+			}, func() {
+				for _, violation := range tester.GetViolations() {
+					tnf.ClaimFilePrintf("Pod %s: %s", podName, violation)
+				}
+				failedPods = append(failedPods, podName)
+			}, func(err error) {
+				tnf.ClaimFilePrintf("Failed to get the tolerations of pod %s: %v", podName, err)
+				failedPods = append(failedPods, podName)
+			})
+		}
+		if n := len(failedPods); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d pods which only run on their node because of a tolerate-everything toleration: %v", n, failedPods))
+		}
+	})
+}
+
+// podRecoveryTimeout returns the configured pod recovery deadline, or defaultPodRecoveryTimeout when none is configured.
+func podRecoveryTimeout(env *config.TestEnvironment) time.Duration {
+	if env.Config.PodRecoveryTimeoutSeconds > 0 {
+		return time.Duration(env.Config.PodRecoveryTimeoutSeconds) * time.Second
+	}
+	return defaultPodRecoveryTimeout
+}
+
+// getPodController returns the UID of a pod and the kind and name of its controller, which are empty for a bare pod.
+func getPodController(namespace, podName string) (uid, kind, name string) {
+	const numExpectedFields = 3
+	command := fmt.Sprintf(ocGetPodOwnerFormat, namespace, podName)
+	out := utils.ExecuteCommand(command, common.DefaultTimeout, common.GetContext(), func() {
+		tnf.ClaimFilePrintf("Failed to get the controller of pod %s/%s", namespace, podName)
+	})
+	fields := strings.Split(strings.TrimSpace(out), "|")
+	if len(fields) != numExpectedFields {
+		return "", "", ""
+	}
+	return fields[0], fields[1], fields[2]
+}
+
+// isPodRecovered returns true once the pod with the given UID is gone and its controller has all its replicas ready.
+func isPodRecovered(namespace, uid, kind, name string) bool {
+	out := utils.ExecuteCommand(fmt.Sprintf(ocGetPodUIDsFormat, namespace), common.DefaultTimeout, common.GetContext(), func() {
+		log.Errorf("Failed to list the pods of namespace %s", namespace)
+	})
+	for _, podUID := range strings.Fields(out) {
+		if podUID == uid {
+			return false
+		}
+	}
+	return isControllerReady(kind, namespace, name)
+}
+
+// isControllerReady returns true when all the desired replicas of a ReplicaSet, Deployment, StatefulSet or DaemonSet
+// are ready.
+func isControllerReady(kind, namespace, name string) bool {
+	const numExpectedFields = 2
+	out := utils.ExecuteCommand(fmt.Sprintf(ocGetControllerReadinessFormat, kind, namespace, name), common.DefaultTimeout, common.GetContext(), func() {
+		log.Errorf("Failed to get the readiness of %s %s/%s", kind, namespace, name)
+	})
+	fields := strings.Split(strings.TrimSpace(out), "|")
+	return len(fields) == numExpectedFields && fields[0] != "" && fields[0] == fields[1]
+}
+
+// waitFor polls a condition until it is true or the timeout expires, and returns its last value.
+func waitFor(timeout time.Duration, condition func() bool) bool {
+	for start := time.Now(); time.Since(start) < timeout; time.Sleep(podRecoveryPollingPeriod) {
+		if condition() {
+			return true
+		}
+	}
+	return condition()
+}
+
+// pingOtherContainers pings the containers of every pod but the given one from the test orchestrator and returns a
+// description of each failed ping.
+func pingOtherContainers(env *config.TestEnvironment, namespace, podName string) []string {
+	var failures []string
+	if env.TestOrchestrator == nil {
+		return nil
+	}
+	context := env.TestOrchestrator.Oc
+	threshold := common.PingThreshold(env, env.Config.PingThresholds.DefaultNetwork)
+	count := common.PingCount(env, recoveryPingCount)
+	overrides := common.RunOverrides(env)
+	for _, cut := range env.ContainersUnderTest {
+		if cut.ContainerIdentifier.Namespace == namespace && cut.ContainerIdentifier.PodName == podName {
+			continue
+		}
+		if _, excluded := env.ContainersToExcludeFromConnectivityTests[cut.ContainerIdentifier]; excluded {
+			continue
+		}
+		test, lastPing := common.NewPingTest(context, cut.DefaultNetworkIPAddress, count, threshold, overrides)
+		target := fmt.Sprintf("%s/%s %s", cut.ContainerIdentifier.Namespace, cut.ContainerIdentifier.PodName, cut.DefaultNetworkIPAddress)
+		test.RunWithCallbacks(nil, func() {
+			failures = append(failures, fmt.Sprintf("ping to %s failed: %v", target, lastPing().GetViolations()))
+		}, func(err error) {
+			failures = append(failures, fmt.Sprintf("ping to %s failed: %v", target, err))
+		})
+	}
+	return failures
+}
+
+// testPodDeletionRecovery ensures every controlled pod under test is recreated and ready again once deleted.
+func testPodDeletionRecovery(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestPodDeletionRecoveryIdentifier)
+	ginkgo.It(testID, func() {
+		defer env.SetNeedsRefresh()
+		timeout := podRecoveryTimeout(env)
+		var failedPods []string
+		for _, podUnderTest := range env.PodsUnderTest {
+			podName := podUnderTest.Namespace + "/" + podUnderTest.Name
+			uid, kind, name := getPodController(podUnderTest.Namespace, podUnderTest.Name)
+			if kind == "" {
+				tnf.ClaimFilePrintf("Pod %s has no controller, skipping its deletion", podName)
+				continue
+			}
+			ginkgo.By(fmt.Sprintf("Deleting pod %s, which should be recreated by %s %s within %s", podName, kind, name, timeout))
+			closeOcSessionsByPod(env.ContainersUnderTest, podUnderTest.Namespace, podUnderTest.Name)
+			utils.ExecuteCommand(fmt.Sprintf(ocDeletePodFormat, podUnderTest.Namespace, podUnderTest.Name), common.DefaultTimeout, common.GetContext(), func() {
+				tnf.ClaimFilePrintf("Failed to delete pod %s", podName)
+			})
+			start := time.Now()
+			recovered := false
+			var pingFailures []string
+			for time.Since(start) < timeout {
+				pingFailures = append(pingFailures, pingOtherContainers(env, podUnderTest.Namespace, podUnderTest.Name)...)
+				if recovered = isPodRecovered(podUnderTest.Namespace, uid, kind, name); recovered {
+					break
+				}
+				time.Sleep(podRecoveryPollingPeriod)
+			}
+			for _, failure := range pingFailures {
+				tnf.ClaimFilePrintf("While pod %s was recovering, %s", podName, failure)
+			}
+			if !recovered {
+				tnf.ClaimFilePrintf("%s %s did not replace pod %s within %s", kind, name, podName, timeout)
+				failedPods = append(failedPods, podName)
+				continue
+			}
+			tnf.ClaimFilePrintf("%s %s replaced pod %s in %s", kind, name, podName, time.Since(start).Round(time.Second))
+			if len(pingFailures) > 0 {
+				failedPods = append(failedPods, podName)
+			}
+		}
+		if n := len(failedPods); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d pods whose deletion was not recovered from: %v", n, failedPods))
+		}
+	})
+}
+
+// getPodTermination returns the termination state of a pod.
+func getPodTermination(namespace, podName string) *podtermination.PodTermination {
+	context := common.GetContext()
+	tester := podtermination.NewPodTermination(common.DefaultTimeout, namespace, podName)
+	test, err := tnf.NewTest(context.GetExpecter(), tester, []reel.Handler{tester}, context.GetErrorChannel())
+	gomega.Expect(err).To(gomega.BeNil())
+	test.RunAndValidate()
+	return tester
+}
+
+// checkGracefulTermination deletes a pod and follows its termination, and returns the reasons it did not terminate
+// gracefully.
+func checkGracefulTermination(namespace, podName string) []string {
+	var failures []string
+	before := getPodTermination(namespace, podName)
+	gracePeriod := before.GetGracePeriod()
+	for _, container := range before.GetMissingPreStop() {
+		failures = append(failures, fmt.Sprintf("container %s has no preStop hook", container))
+	}
+	utils.ExecuteCommand(fmt.Sprintf(ocDeletePodFormat, namespace, podName), common.DefaultTimeout, common.GetContext(), func() {
+		tnf.ClaimFilePrintf("Failed to delete pod %s/%s", namespace, podName)
+	})
+	start := time.Now()
+	killed := map[string]bool{}
+	terminated := false
+	// Allow the kubelet some time past the grace period to report the containers it killed.
+	for time.Since(start) < gracePeriod+podRecoveryPollingPeriod {
+		state := getPodTermination(namespace, podName)
+		for _, container := range state.GetKilled() {
+			killed[container] = true
+		}
+		if terminated = state.AllTerminated(); terminated {
+			break
+		}
+		time.Sleep(terminationPollingPeriod)
+	}
+	elapsed := time.Since(start).Round(time.Second)
+	if terminated && elapsed <= gracePeriod+terminationPollingPeriod {
+		tnf.ClaimFilePrintf("Pod %s/%s terminated in %s, within its grace period of %s", namespace, podName, elapsed, gracePeriod)
+	} else {
+		failures = append(failures, fmt.Sprintf("containers still running %s after the deletion, past the grace period of %s",
+			elapsed, gracePeriod))
+	}
+	var killedContainers []string
+	for container := range killed {
+		killedContainers = append(killedContainers, container)
+	}
+	sort.Strings(killedContainers)
+	for _, container := range killedContainers {
+		failures = append(failures, fmt.Sprintf("container %s was killed by SIGKILL", container))
+	}
+	return failures
+}
+
+// testGracefulTermination ensures one pod of every CNF controller terminates gracefully once deleted, and is replaced.
+func testGracefulTermination(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestGracefulTerminationIdentifier)
+	ginkgo.It(testID, func() {
+		defer env.SetNeedsRefresh()
+		// The deleted pods break the oc sessions to their containers, which would abort the run.
+		env.ResetOc()
+		timeout := podRecoveryTimeout(env)
+		tested := map[string]bool{}
+		var failedPods []string
+		for _, podUnderTest := range env.PodsUnderTest {
+			podName := podUnderTest.Namespace + "/" + podUnderTest.Name
+			uid, kind, name := getPodController(podUnderTest.Namespace, podUnderTest.Name)
+			if kind == "" {
+				tnf.ClaimFilePrintf("Pod %s has no controller, skipping its deletion", podName)
+				continue
+			}
+			controller := fmt.Sprintf("%s %s/%s", kind, podUnderTest.Namespace, name)
+			if tested[controller] {
+				continue
+			}
+			tested[controller] = true
+			ginkgo.By(fmt.Sprintf("Deleting pod %s of %s, which should terminate gracefully", podName, controller))
+			failures := checkGracefulTermination(podUnderTest.Namespace, podUnderTest.Name)
+			for _, failure := range failures {
+				tnf.ClaimFilePrintf("Pod %s: %s", podName, failure)
+			}
+			if !waitFor(timeout, func() bool { return isPodRecovered(podUnderTest.Namespace, uid, kind, name) }) {
+				tnf.ClaimFilePrintf("%s did not replace pod %s within %s", controller, podName, timeout)
+				failures = append(failures, "not replaced")
+			}
+			if len(failures) > 0 {
+				failedPods = append(failedPods, podName)
+			}
+		}
+		if n := len(failedPods); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d pods which did not terminate gracefully: %v", n, failedPods))
+		}
+	})
+}
+
+// drainedService is a ClusterIP Service backed by at least two pods.
+type drainedService struct {
+	namespace string
+	name      string
+	url       string
+	backends  []string
+}
+
+// getDrainedServices returns the TCP ClusterIP Services of the namespaces under test backed by at least two pods.
+func getDrainedServices(env *config.TestEnvironment) []drainedService {
+	const numExpectedFields = 4
+	var services []drainedService
+	for _, namespace := range env.NameSpacesUnderTest {
+		out := utils.ExecuteCommand(fmt.Sprintf(ocGetServicesFormat, namespace), common.DefaultTimeout, common.GetContext(), func() {
+			tnf.ClaimFilePrintf("Failed to list the services of namespace %s", namespace)
+		})
+		for _, line := range strings.Split(out, "\n") {
+			fields := strings.Split(strings.TrimSpace(line), "|")
+			if len(fields) != numExpectedFields {
+				continue
+			}
+			name, clusterIP, port, protocol := fields[0], fields[1], fields[2], fields[3]
+			if clusterIP == "" || clusterIP == "None" || port == "" || (protocol != "" && protocol != "TCP") {
+				continue
+			}
+			command := fmt.Sprintf(ocGetEndpointPodsFormat, namespace, name)
+			backends := strings.Fields(utils.ExecuteCommand(command, common.DefaultTimeout, common.GetContext(), func() {
+				tnf.ClaimFilePrintf("Failed to get the endpoints of service %s/%s", namespace, name)
+			}))
+			if len(backends) < 2 {
+				continue
+			}
+			if strings.Contains(clusterIP, ":") {
+				clusterIP = "[" + clusterIP + "]"
+			}
+			services = append(services, drainedService{namespace: namespace, name: name, url: fmt.Sprintf("http://%s:%s", clusterIP, port), backends: backends})
+		}
+	}
+	return services
+}
+
+// connectsTo returns whether the test orchestrator can open a connection to a URL.
+func connectsTo(env *config.TestEnvironment, url string) bool {
+	context := env.TestOrchestrator.Oc
+	tester := curl.NewCurl(common.DefaultTimeout, url, drainConnectTimeout)
+	test, err := tnf.NewTest(context.GetExpecter(), tester, []reel.Handler{tester}, context.GetErrorChannel())
+	gomega.Expect(err).To(gomega.BeNil())
+	result, err := test.Run()
+	return err == nil && result == tnf.SUCCESS
+}
+
+// testConnectionDraining ensures the Services of the CNF keep accepting connections while one of their pods is being
+// deleted.
+func testConnectionDraining(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestConnectionDrainingIdentifier)
+	ginkgo.It(testID, func() {
+		if env.TestOrchestrator == nil {
+			ginkgo.Skip("Orchestrator is not deployed, skip this test")
+		}
+		defer env.SetNeedsRefresh()
+		timeout := podRecoveryTimeout(env)
+		podsUnderTest := map[string]bool{}
+		for _, podUnderTest := range env.PodsUnderTest {
+			podsUnderTest[podUnderTest.Namespace+"/"+podUnderTest.Name] = true
+		}
+		tested := false
+		var failedServices []string
+		for _, service := range getDrainedServices(env) {
+			serviceName := service.namespace + "/" + service.name
+			var podName, uid, kind, name string
+			for _, backend := range service.backends {
+				if !podsUnderTest[service.namespace+"/"+backend] {
+					continue
+				}
+				if uid, kind, name = getPodController(service.namespace, backend); kind != "" {
+					podName = backend
+					break
+				}
+			}
+			if podName == "" {
+				continue
+			}
+			if !connectsTo(env, service.url) {
+				tnf.ClaimFilePrintf("Service %s is not reachable at %s from the test orchestrator, skipping it", serviceName, service.url)
+				continue
+			}
+			tested = true
+			ginkgo.By(fmt.Sprintf("Deleting pod %s/%s while connecting to service %s", service.namespace, podName, serviceName))
+			closeOcSessionsByPod(env.ContainersUnderTest, service.namespace, podName)
+			utils.ExecuteCommand(fmt.Sprintf(ocDeletePodFormat, service.namespace, podName), common.DefaultTimeout, common.GetContext(), func() {
+				tnf.ClaimFilePrintf("Failed to delete pod %s/%s", service.namespace, podName)
+			})
+			attempts, failures := 0, 0
+			for start := time.Now(); time.Since(start) < timeout; {
+				attempts++
+				if !connectsTo(env, service.url) {
+					failures++
+				}
+				if getPodTermination(service.namespace, podName).IsGone() {
+					break
+				}
+			}
+			tnf.ClaimFilePrintf("Service %s: %d of %d connections failed while pod %s was terminating", serviceName, failures, attempts, podName)
+			failed := failures > 0
+			if !waitFor(timeout, func() bool { return isPodRecovered(service.namespace, uid, kind, name) }) {
+				tnf.ClaimFilePrintf("%s %s did not replace pod %s/%s within %s", kind, name, service.namespace, podName, timeout)
+				failed = true
+			}
+			if failed {
+				failedServices = append(failedServices, serviceName)
+			}
+		}
+		if !tested {
+			ginkgo.Skip("No Service is backed by two CNF pods reachable from the test orchestrator")
+		}
+		if n := len(failedServices); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d services which did not drain the connections of a terminating pod: %v", n, failedServices))
+		}
+	})
+}
+
+// isRolledOut returns whether the latest rollout of a Deployment is complete.
+func isRolledOut(namespace, name string) bool {
+	out := utils.ExecuteCommand(fmt.Sprintf(ocRolloutStatusFormat, name, namespace), common.DefaultTimeout, common.GetContext(), func() {
+		log.Errorf("Failed to get the rollout status of Deployment %s/%s", namespace, name)
+	})
+	return strings.Contains(out, "successfully rolled out")
+}
+
+// getDeploymentServices returns the reachable Services backed by the pods of a Deployment.
+func getDeploymentServices(env *config.TestEnvironment, services []drainedService, deployment configsections.Deployment) []drainedService {
+	var backed []drainedService
+	for _, service := range services {
+		if service.namespace != deployment.Namespace {
+			continue
+		}
+		for _, backend := range service.backends {
+			_, kind, name := getPodController(service.namespace, backend)
+			if kind == "ReplicaSet" && strings.HasPrefix(name, deployment.Name+"-") &&
+				!strings.Contains(strings.TrimPrefix(name, deployment.Name+"-"), "-") {
+				backed = append(backed, service)
+				break
+			}
+		}
+	}
+	var reachable []drainedService
+	for _, service := range backed {
+		if connectsTo(env, service.url) {
+			reachable = append(reachable, service)
+		} else {
+			tnf.ClaimFilePrintf("Service %s/%s is not reachable at %s from the test orchestrator, skipping it", service.namespace, service.name, service.url)
+		}
+	}
+	return reachable
+}
+
+// testRolloutRestart ensures a rollout of each CNF Deployment which changes nothing causes no downtime of the Services
+// it backs.
+func testRolloutRestart(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestRolloutRestartIdentifier)
+	ginkgo.It(testID, func() {
+		if env.TestOrchestrator == nil {
+			ginkgo.Skip("Orchestrator is not deployed, skip this test")
+		}
+		defer env.SetNeedsRefresh()
+		services := getDrainedServices(env)
+		tested := false
+		var failedDeployments []string
+		for _, deployment := range env.DeploymentsUnderTest {
+			deploymentName := deployment.Namespace + "/" + deployment.Name
+			backed := getDeploymentServices(env, services, deployment)
+			if len(backed) == 0 {
+				continue
+			}
+			tested = true
+			ginkgo.By(fmt.Sprintf("Restarting Deployment %s while connecting to its %d services", deploymentName, len(backed)))
+			closeOcSessionsByDeployment(env.ContainersUnderTest, deployment)
+			utils.ExecuteCommand(fmt.Sprintf(ocRolloutRestartFormat, deployment.Name, deployment.Namespace), common.DefaultTimeout, common.GetContext(), func() {
+				tnf.ClaimFilePrintf("Failed to restart Deployment %s", deploymentName)
+			})
+			replicas := deployment.Replicas
+			if replicas < 1 {
+				replicas = 1
+			}
+			timeout := podRecoveryTimeout(env) * time.Duration(replicas)
+			attempts, failures := 0, 0
+			rolledOut := false
+			for start := time.Now(); !rolledOut && time.Since(start) < timeout; {
+				for _, service := range backed {
+					attempts++
+					if !connectsTo(env, service.url) {
+						failures++
+					}
+				}
+				rolledOut = isRolledOut(deployment.Namespace, deployment.Name)
+			}
+			tnf.ClaimFilePrintf("Deployment %s: %d of %d connections failed during its rollout", deploymentName, failures, attempts)
+			if !rolledOut {
+				tnf.ClaimFilePrintf("Deployment %s was not rolled out within %s", deploymentName, timeout)
+			}
+			if failures > 0 || !rolledOut {
+				failedDeployments = append(failedDeployments, deploymentName)
+			}
+		}
+		if !tested {
+			ginkgo.Skip("No Deployment backs a Service reachable from the test orchestrator")
+		}
+		if n := len(failedDeployments); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d Deployments whose rollout caused downtime: %v", n, failedDeployments))
+		}
+	})
+}
+
+// getTerminatingPods returns the names of the pods of a namespace which are being deleted.
+func getTerminatingPods(namespace string) []string {
+	out := utils.ExecuteCommand(fmt.Sprintf(ocGetTerminatingPodsFormat, namespace), common.DefaultTimeout, common.GetContext(), func() {
+		log.Errorf("Failed to list the pods of namespace %s", namespace)
+	})
+	return strings.Fields(out)
+}
+
+// autoscaler is a HorizontalPodAutoscaler.
+type autoscaler struct {
+	name          string
+	targetKind    string
+	targetName    string
+	minReplicas   int
+	maxReplicas   int
+	scalingActive string
+	reason        string
+}
+
+// getAutoscalers returns the HorizontalPodAutoscalers of a namespace.
+func getAutoscalers(namespace string) []autoscaler {
+	const numExpectedFields = 7
+	out := utils.ExecuteCommand(fmt.Sprintf(ocGetAutoscalersFormat, namespace), common.DefaultTimeout, common.GetContext(), func() {
+		tnf.ClaimFilePrintf("Failed to get the horizontalpodautoscalers of namespace %s", namespace)
+	})
+	var autoscalers []autoscaler
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.Split(strings.TrimSpace(line), "|")
+		if len(fields) != numExpectedFields {
+			continue
+		}
+		minReplicas, err := strconv.Atoi(fields[3])
+		if err != nil {
+			// minReplicas defaults to 1 when omitted.
+			minReplicas = 1
+		}
+		maxReplicas, _ := strconv.Atoi(fields[4])
+		autoscalers = append(autoscalers, autoscaler{name: fields[0], targetKind: fields[1], targetName: fields[2], minReplicas: minReplicas,
+			maxReplicas: maxReplicas, scalingActive: fields[5], reason: fields[6]})
+	}
+	return autoscalers
+}
+
+// getWorkloadAutoscaler returns the HorizontalPodAutoscaler scaling a workload, if any.
+func getWorkloadAutoscaler(namespace string, workload *workloadspread.Workload) *autoscaler {
+	autoscalers := getAutoscalers(namespace)
+	for i := range autoscalers {
+		if autoscalers[i].targetKind == workload.Kind && autoscalers[i].targetName == workload.Name {
+			return &autoscalers[i]
+		}
+	}
+	return nil
+}
+
+// setAutoscalerBounds patches the minReplicas and maxReplicas of a HorizontalPodAutoscaler.  Setting both to the same
+// value pauses the autoscaling at that number of replicas.
+func setAutoscalerBounds(namespace, name string, minReplicas, maxReplicas int) bool {
+	context := common.GetContext()
+	handler := scaling.NewHpaScaling(common.DefaultTimeout, namespace, name, minReplicas, maxReplicas)
+	test, err := tnf.NewTest(context.GetExpecter(), handler, []reel.Handler{handler}, context.GetErrorChannel())
+	gomega.Expect(err).To(gomega.BeNil())
+	result, err := test.Run()
+	if err != nil || result != tnf.SUCCESS {
+		tnf.ClaimFilePrintf("Failed to set the bounds of horizontalpodautoscaler %s/%s to [%d, %d]: %v", namespace, name, minReplicas, maxReplicas, err)
+		return false
+	}
+	return true
+}
+
+// scaleAutoscaledWorkload scales a workload like scaleWorkload, first pausing its HorizontalPodAutoscaler, if any, at
+// the requested number of replicas so it does not scale the workload back.
+func scaleAutoscaledWorkload(namespace string, workload *workloadspread.Workload, hpa *autoscaler, replicas int, timeout time.Duration) bool {
+	if hpa != nil && !setAutoscalerBounds(namespace, hpa.name, replicas, replicas) {
+		return false
+	}
+	return scaleWorkload(namespace, workload, replicas, timeout)
+}
+
+// testHorizontalPodAutoscalers ensures the HorizontalPodAutoscalers of the CNF get the metrics they scale on.
+func testHorizontalPodAutoscalers(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestHorizontalPodAutoscalerIdentifier)
+	ginkgo.It(testID, func() {
+		tested := false
+		var failedAutoscalers []string
+		for _, namespace := range env.NameSpacesUnderTest {
+			ginkgo.By(fmt.Sprintf("Checking the horizontalpodautoscalers of namespace %s", namespace))
+			for _, hpa := range getAutoscalers(namespace) {
+				tested = true
+				hpaName := namespace + "/" + hpa.name
+				tnf.ClaimFilePrintf("HorizontalPodAutoscaler %s scales %s/%s between %d and %d replicas, ScalingActive %q (%s)", hpaName,
+					hpa.targetKind, hpa.targetName, hpa.minReplicas, hpa.maxReplicas, hpa.scalingActive, hpa.reason)
+				if hpa.scalingActive != "True" {
+					failedAutoscalers = append(failedAutoscalers, hpaName)
+				}
+			}
+		}
+		if !tested {
+			ginkgo.Skip("No HorizontalPodAutoscaler found in the namespaces under test")
+		}
+		if n := len(failedAutoscalers); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d horizontalpodautoscalers unable to get their metrics: %v", n, failedAutoscalers))
+		}
+	})
+}
+
+// scaleWorkload scales a workload and waits for all its replicas to be ready.
+func scaleWorkload(namespace string, workload *workloadspread.Workload, replicas int, timeout time.Duration) bool {
+	context := common.GetContext()
+	handler := scaling.NewWorkloadScaling(common.DefaultTimeout, namespace, workload.Kind, workload.Name, replicas)
+	test, err := tnf.NewTest(context.GetExpecter(), handler, []reel.Handler{handler}, context.GetErrorChannel())
+	gomega.Expect(err).To(gomega.BeNil())
+	result, err := test.Run()
+	if err != nil || result != tnf.SUCCESS {
+		tnf.ClaimFilePrintf("Failed to scale %s %s/%s to %d replicas: %v", workload.Kind, namespace, workload.Name, replicas, err)
+		return false
+	}
+	if !waitFor(timeout, func() bool { return isControllerReady(workload.Kind, namespace, workload.Name) }) {
+		tnf.ClaimFilePrintf("%s %s/%s did not get %d ready replicas within %s", workload.Kind, namespace, workload.Name, replicas, timeout)
+		return false
+	}
+	return true
+}
+
+// scaleWorkloadUpAndDown scales a workload up by one replica and back, and waits for the removed pod to terminate.
+// The HorizontalPodAutoscaler of the workload, if any, is paused meanwhile and its bounds are restored afterwards.
+func scaleWorkloadUpAndDown(namespace string, workload *workloadspread.Workload, hpa *autoscaler, timeout time.Duration) bool {
+	if hpa != nil {
+		defer setAutoscalerBounds(namespace, hpa.name, hpa.minReplicas, hpa.maxReplicas)
+	}
+	workloadName := namespace + ": " + workload.Key()
+	succeeded := scaleAutoscaledWorkload(namespace, workload, hpa, workload.Replicas+1, timeout)
+	if !scaleAutoscaledWorkload(namespace, workload, hpa, workload.Replicas, timeout) {
+		return false
+	}
+	if !waitFor(timeout, func() bool { return len(getTerminatingPods(namespace)) == 0 }) {
+		tnf.ClaimFilePrintf("%s left terminating pods %v after scaling down", workloadName, getTerminatingPods(namespace))
+		return false
+	}
+	return succeeded
+}
+
+// testWorkloadElasticity ensures the Deployments and StatefulSets of the CNF scale up and back down.
+func testWorkloadElasticity(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestWorkloadElasticityIdentifier)
+	ginkgo.It(testID, func() {
+		defer env.SetNeedsRefresh()
+		context := common.GetContext()
+		timeout := podRecoveryTimeout(env)
+		var failedWorkloads []string
+		for _, namespace := range env.NameSpacesUnderTest {
+			tester := workloadspread.NewWorkloadSpread(common.DefaultTimeout, namespace, 0)
+			test, err := tnf.NewTest(context.GetExpecter(), tester, []reel.Handler{tester}, context.GetErrorChannel())
+			gomega.Expect(err).To(gomega.BeNil())
+			_, err = test.Run()
+			gomega.Expect(err).To(gomega.BeNil())
+			for key, workload := range tester.GetWorkloads() {
+				workloadName := namespace + ": " + key
+				hpa := getWorkloadAutoscaler(namespace, workload)
+				if hpa != nil {
+					tnf.ClaimFilePrintf("%s is scaled by horizontalpodautoscaler %s, pausing it while scaling", workloadName, hpa.name)
+				}
+				ginkgo.By(fmt.Sprintf("Scaling %s from %d to %d replicas and back", workloadName, workload.Replicas, workload.Replicas+1))
+				// Scaling down may remove a pod under test, whose broken sessions would abort the run.
+				closeOcSessionsByDeployment(env.ContainersUnderTest, configsections.Deployment{Name: workload.Name, Namespace: namespace})
+				if !scaleWorkloadUpAndDown(namespace, workload, hpa, timeout) {
+					failedWorkloads = append(failedWorkloads, workloadName)
+				}
+			}
+		}
+		if n := len(failedWorkloads); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d workloads which failed to scale up and down: %v", n, failedWorkloads))
+		}
+	})
+}
+
+// getNamespaceWorkloads returns the Deployments and StatefulSets of the namespaces under test, keyed by namespace.
+func getNamespaceWorkloads(env *config.TestEnvironment) map[string]map[string]*workloadspread.Workload {
+	context := common.GetContext()
+	workloads := map[string]map[string]*workloadspread.Workload{}
+	for _, namespace := range env.NameSpacesUnderTest {
+		tester := workloadspread.NewWorkloadSpread(common.DefaultTimeout, namespace, 0)
+		test, err := tnf.NewTest(context.GetExpecter(), tester, []reel.Handler{tester}, context.GetErrorChannel())
+		gomega.Expect(err).To(gomega.BeNil())
+		_, err = test.Run()
+		gomega.Expect(err).To(gomega.BeNil())
+		workloads[namespace] = tester.GetWorkloads()
+	}
+	return workloads
+}
+
+// getDrainCandidate returns the node hosting the most CNF workload replicas, or an empty string when no replica is
+// scheduled.
+func getDrainCandidate(workloads map[string]map[string]*workloadspread.Workload) string {
+	replicas := map[string]int{}
+	var nodes []string
+	for _, namespaceWorkloads := range workloads {
+		for _, workload := range namespaceWorkloads {
+			for node, count := range workload.Nodes {
+				if _, found := replicas[node]; !found {
+					nodes = append(nodes, node)
+				}
+				replicas[node] += count
+			}
+		}
+	}
+	sort.Strings(nodes)
+	candidate := ""
+	for _, node := range nodes {
+		if candidate == "" || replicas[node] > replicas[candidate] {
+			candidate = node
+		}
+	}
+	return candidate
+}
+
+// testNodeDrainTolerance ensures the CNF workloads recover once a node hosting their replicas is drained.
+func testNodeDrainTolerance(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestNodeDrainToleranceIdentifier)
+	ginkgo.It(testID, func() {
+		defer env.SetNeedsRefresh()
+		workloads := getNamespaceWorkloads(env)
+		node := getDrainCandidate(workloads)
+		if node == "" {
+			ginkgo.Skip("No node hosts a CNF Deployment or StatefulSet replica")
+		}
+		ginkgo.By(fmt.Sprintf("Draining node %s, respecting the PodDisruptionBudgets", node))
+		// The drain often evicts the oauth-openshift pod, which breaks the oc sessions.
+		env.ResetOc()
+		defer uncordonNode(node)
+		context := common.GetContext()
+		tester := nodedrain.NewNodeDrain(drainTimeout, node)
+		test, err := tnf.NewTest(context.GetExpecter(), tester, []reel.Handler{tester}, context.GetErrorChannel())
+		gomega.Expect(err).To(gomega.BeNil())
+		result, err := test.Run()
+		tnf.ClaimFilePrintf("Evicted pods from node %s: %v", node, tester.GetEvicted())
+		for _, blocked := range tester.GetBlocked() {
+			tnf.ClaimFilePrintf("Eviction refused by a PodDisruptionBudget: %s", blocked)
+		}
+		if err != nil || result != tnf.SUCCESS {
+			ginkgo.Fail(fmt.Sprintf("Failed to drain node %s within %s", node, drainTimeout))
+		}
+		timeout := podRecoveryTimeout(env)
+		var failedWorkloads []string
+		for namespace, namespaceWorkloads := range workloads {
+			for key, workload := range namespaceWorkloads {
+				workload := workload
+				if !waitFor(timeout, func() bool { return isControllerReady(workload.Kind, namespace, workload.Name) }) {
+					tnf.ClaimFilePrintf("%s %s/%s was not rescheduled within %s after draining node %s", workload.Kind, namespace, workload.Name, timeout, node)
+					failedWorkloads = append(failedWorkloads, namespace+": "+key)
+				}
+			}
+		}
+		if n := len(failedWorkloads); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d workloads which did not recover from the drain of node %s: %v", n, node, failedWorkloads))
+		}
+	})
+}
+
+// configMapDriftInterval returns the configured ConfigMap observation interval, or defaultConfigMapDriftInterval when
+// none is configured.
+func configMapDriftInterval(env *config.TestEnvironment) time.Duration {
+	if env.Config.ConfigMapDriftIntervalSeconds > 0 {
+		return time.Duration(env.Config.ConfigMapDriftIntervalSeconds) * time.Second
+	}
+	return defaultConfigMapDriftInterval
+}
+
+// testConfigMapDrift ensures the ConfigMaps of the namespaces under test did not change during the test run.
+func testConfigMapDrift(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestConfigMapDriftIdentifier)
+	ginkgo.It(testID, func() {
+		if remaining := configMapDriftInterval(env) - time.Since(env.ConfigMapsSnapshotTime); remaining > 0 {
+			ginkgo.By(fmt.Sprintf("Waiting %s for the ConfigMaps observation interval to elapse", remaining.Round(time.Second)))
+			time.Sleep(remaining)
+		}
+		var driftedConfigMaps []string
+		for _, ns := range env.NameSpacesUnderTest {
+			ginkgo.By(fmt.Sprintf("Comparing the ConfigMaps of namespace %s with their snapshot", ns))
+			before, ok := env.ConfigMapsSnapshot[ns]
+			if !ok {
+				tnf.ClaimFilePrintf("No snapshot of the ConfigMaps of namespace %s was taken", ns)
+				driftedConfigMaps = append(driftedConfigMaps, ns)
+				continue
+			}
+			after, err := autodiscover.FindConfigMaps(ns)
+			if err != nil {
+				tnf.ClaimFilePrintf("Failed to get the ConfigMaps of namespace %s: %v", ns, err)
+				driftedConfigMaps = append(driftedConfigMaps, ns)
+				continue
+			}
+			names := make([]string, 0, len(before))
+			for name := range before {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				configMapName := ns + "/" + name
+				current, ok := after[name]
+				if !ok {
+					tnf.ClaimFilePrintf("ConfigMap %s was deleted at runtime", configMapName)
+					driftedConfigMaps = append(driftedConfigMaps, configMapName)
+				} else if !before[name].Equal(current) {
+					tnf.ClaimFilePrintf("ConfigMap %s was modified at runtime, changed keys: %v", configMapName, before[name].ChangedKeys(current))
+					driftedConfigMaps = append(driftedConfigMaps, configMapName)
+				}
+			}
+			for name := range after {
+				if _, ok := before[name]; !ok {
+					tnf.ClaimFilePrintf("ConfigMap %s/%s was created at runtime", ns, name)
+				}
+			}
+		}
+		if n := len(driftedConfigMaps); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d ConfigMaps modified at runtime: %v", n, driftedConfigMaps))
+		}
+	})
+}
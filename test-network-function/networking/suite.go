@@ -18,8 +18,13 @@ package networking
 
 import (
 	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/test-network-function/test-network-function/pkg/config"
+	"github.com/test-network-function/test-network-function/pkg/config/configsections"
 	"github.com/test-network-function/test-network-function/pkg/tnf/testcases"
 
 	"github.com/test-network-function/test-network-function/test-network-function/common"
@@ -29,15 +34,47 @@ import (
 	"github.com/onsi/gomega"
 	log "github.com/sirupsen/logrus"
 	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/listeningports"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/netpartition"
 	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/nodeport"
-	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/ping"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/servicemesh"
 	"github.com/test-network-function/test-network-function/pkg/tnf/interactive"
 	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
+	"github.com/test-network-function/test-network-function/pkg/utils"
 	"github.com/test-network-function/test-network-function/test-network-function/results"
 )
 
 const (
 	defaultNumPings = 5
+	// ocGetContainerPortsCommand prints the "<containerPort>/<protocol>" ports declared by a container.
+	ocGetContainerPortsCommand = `oc get pod -n %s %s -o jsonpath='{range .spec.containers[?(@.name=="%s")].ports[*]}{.containerPort}/{.protocol} {end}'`
+	// ocGetPodReadinessCommand prints the name and Ready condition status of every pod of a namespace, separated by a
+	// "|", one pod per line.
+	ocGetPodReadinessCommand = `oc get pods -n %s -o jsonpath='{range .items[*]}{.metadata.name}|{.status.conditions[?(@.type=="Ready")].status}{"\n"}{end}'`
+	// partitionRecoveryTimeout is the deadline for the CNF to recover once a network partition is removed.
+	partitionRecoveryTimeout = 120 * time.Second
+	// partitionPollingPeriod is the interval between two checks of the recovery from a network partition.
+	partitionPollingPeriod = 5 * time.Second
+	// ocGetClusterNetworkCommand prints the CIDRs of the cluster network.
+	ocGetClusterNetworkCommand = "oc get network.config cluster -o jsonpath='{.status.clusterNetwork[*].cidr}'"
+	// ocGetPodIPsCommand prints the addresses of a pod.
+	ocGetPodIPsCommand = "oc get pod -n %s %s -o jsonpath='{.status.podIPs[*].ip}'"
+	// ocGetStatefulSetOwnerCommand prints the name of the StatefulSet owning a pod.
+	ocGetStatefulSetOwnerCommand = `oc get pod -n %s %s -o jsonpath='{.metadata.ownerReferences[?(@.kind=="StatefulSet")].name}'`
+	// ocGetStatefulSetServiceCommand prints the governing Service of a StatefulSet.
+	ocGetStatefulSetServiceCommand = "oc get statefulset -n %s %s -o jsonpath='{.spec.serviceName}'"
+	// ocGetServiceClusterIPCommand prints the cluster IP of a Service.
+	ocGetServiceClusterIPCommand = "oc get service -n %s %s -o jsonpath='{.spec.clusterIP}'"
+	// ocResolveHostCommand resolves a host name from inside a pod.
+	ocResolveHostCommand = "oc exec -n %s %s -- getent hosts %s 2>&1"
+	// ocGetDNSConfigCommand prints the nameservers, searches and option names of the dnsConfig of a pod, separated by
+	// a "|".
+	ocGetDNSConfigCommand = "oc get pod -n %s %s -o jsonpath='{.spec.dnsConfig.nameservers[*]}|{.spec.dnsConfig.searches[*]}|{.spec.dnsConfig.options[*].name}'"
+	// ocGetResolvConfCommand prints the resolver configuration of a pod.
+	ocGetResolvConfCommand = "oc exec -n %s %s -- cat /etc/resolv.conf 2>&1"
+	// ocGetServiceIPFamiliesCommand prints the name, type, ipFamilyPolicy and ipFamilies of every Service of a
+	// namespace, separated by a "|", one Service per line.
+	ocGetServiceIPFamiliesCommand = `oc get services -n %s -o jsonpath='{range .items[*]}{.metadata.name}|{.spec.type}|{.spec.ipFamilyPolicy}|{.spec.ipFamilies[*]}{"\n"}{end}'`
 )
 
 //
@@ -59,23 +96,51 @@ var _ = ginkgo.Describe(common.NetworkingTestKey, func() {
 
 		ginkgo.Context("Both Pods are on the Default network", func() {
 			// for each container under test, ensure bidirectional ICMP traffic between the container and the orchestrator.
-			testDefaultNetworkConnectivity(env, defaultNumPings)
+			testDefaultNetworkConnectivity(env)
+		})
+
+		ginkgo.Context("Every CNF Pod is on the Default network", func() {
+			// for each pair of CNF pods, ensure ICMP traffic from the first pod to the second one.
+			testDefaultNetworkConnectivityMatrix(env)
 		})
 
 		ginkgo.Context("Both Pods are connected via a Multus Overlay Network", func() {
 			// Unidirectional test;  for each container under test, attempt to ping the target Multus IP addresses.
-			testMultusNetworkConnectivity(env, defaultNumPings)
+			testMultusNetworkConnectivity(env)
+		})
+		ginkgo.Context("CNF Pods sharing a Multus network attachment", func() {
+			// for each Multus network attachment, ensure ICMP traffic between every pair of CNF pods attached to it.
+			testMultusNetworkConnectivityMatrix(env)
 		})
 		ginkgo.Context("Should not have type of nodePort", func() {
 			testNodePort(env)
 		})
+		ginkgo.Context("Listening ports should match the declared containerPorts", func() {
+			testListeningAndDeclaredPorts(env)
+		})
+		ginkgo.Context("Service mesh sidecars and policies", func() {
+			testServiceMesh(env)
+		})
+		ginkgo.Context("Dual-stack clusters", func() {
+			testDualStack(env)
+		})
+		ginkgo.Context("DNS resolution", func() {
+			testHeadlessServiceResolution(env)
+			testDNSConfig(env)
+		})
+		if common.Intrusive() {
+			ginkgo.Context("Network partition between two CNF Pods", func() {
+				testNetworkPartition(env)
+			})
+		}
 	}
 })
 
-func testDefaultNetworkConnectivity(env *config.TestEnvironment, count int) {
+func testDefaultNetworkConnectivity(env *config.TestEnvironment) {
 	ginkgo.When("Testing network connectivity", func() {
 		testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestICMPv4ConnectivityIdentifier)
 		ginkgo.It(testID, func() {
+			count := pingCount(env)
 			if env.TestOrchestrator == nil {
 				ginkgo.Skip("Orchestrator is not deployed, skip this test")
 			}
@@ -92,11 +157,11 @@ func testDefaultNetworkConnectivity(env *config.TestEnvironment, count int) {
 				ginkgo.By(fmt.Sprintf("a Ping is issued from %s(%s) to %s(%s) %s", testOrchestrator.Oc.GetPodName(),
 					testOrchestrator.Oc.GetPodContainerName(), cut.Oc.GetPodName(), cut.Oc.GetPodContainerName(),
 					cut.DefaultNetworkIPAddress))
-				testPing(testOrchestrator.Oc, cut.DefaultNetworkIPAddress, count)
+				testPing(testOrchestrator.Oc, cut.DefaultNetworkIPAddress, count, defaultNetworkThreshold(env), common.RunOverrides(env))
 				ginkgo.By(fmt.Sprintf("a Ping is issued from %s(%s) to %s(%s) %s", cut.Oc.GetPodName(),
 					cut.Oc.GetPodContainerName(), testOrchestrator.Oc.GetPodName(), testOrchestrator.Oc.GetPodContainerName(),
 					testOrchestrator.DefaultNetworkIPAddress))
-				testPing(context, testOrchestrator.DefaultNetworkIPAddress, count)
+				testPing(context, testOrchestrator.DefaultNetworkIPAddress, count, defaultNetworkThreshold(env), common.RunOverrides(env))
 			}
 			if !found {
 				ginkgo.Skip("No container found suitable for connectivity test")
@@ -105,13 +170,15 @@ func testDefaultNetworkConnectivity(env *config.TestEnvironment, count int) {
 	})
 }
 
-func testMultusNetworkConnectivity(env *config.TestEnvironment, count int) {
+func testMultusNetworkConnectivity(env *config.TestEnvironment) {
 	ginkgo.When("Testing network connectivity", func() {
 		testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestICMPv4ConnectivityIdentifier)
 		ginkgo.It(testID, func() {
+			count := pingCount(env)
 			if env.TestOrchestrator == nil {
 				ginkgo.Skip("Orchestrator is not deployed, skip this test")
 			}
+			common.SkipUnlessMultus()
 			found := false
 			for _, cut := range env.ContainersUnderTest {
 				if _, ok := env.ContainersToExcludeFromConnectivityTests[cut.ContainerIdentifier]; ok {
@@ -129,7 +196,7 @@ func testMultusNetworkConnectivity(env *config.TestEnvironment, count int) {
 					ginkgo.By(fmt.Sprintf("a Ping is issued from %s(%s) to %s(%s) %s", testOrchestrator.Oc.GetPodName(),
 						testOrchestrator.Oc.GetPodContainerName(), cut.Oc.GetPodName(), cut.Oc.GetPodContainerName(),
 						multusIPAddress))
-					testPing(testOrchestrator.Oc, multusIPAddress, count)
+					testPing(testOrchestrator.Oc, multusIPAddress, count, multusNetworkThreshold(env), common.RunOverrides(env))
 				}
 			}
 			if !found {
@@ -139,15 +206,606 @@ func testMultusNetworkConnectivity(env *config.TestEnvironment, count int) {
 	})
 }
 
-// Test that a container can ping a target IP address.
-func testPing(initiatingPodOc *interactive.Oc, targetPodIPAddress string, count int) {
-	log.Infof("Sending ICMP traffic(%s to %s)", initiatingPodOc.GetPodName(), targetPodIPAddress)
-	pingTester := ping.NewPing(common.DefaultTimeout, targetPodIPAddress, count)
-	test, err := tnf.NewTest(initiatingPodOc.GetExpecter(), pingTester, []reel.Handler{pingTester}, initiatingPodOc.GetErrorChannel())
+// connectivityEndpoint is a pod taking part in a connectivity matrix, pinging from oc and reached at ip.
+type connectivityEndpoint struct {
+	name string
+	oc   *interactive.Oc
+	ip   string
+}
+
+// getDefaultNetworkEndpoints returns one endpoint per CNF pod on the default network, sorted by pod name.  The first
+// container of a pod which is not excluded from the connectivity tests is used.
+func getDefaultNetworkEndpoints(env *config.TestEnvironment) []connectivityEndpoint {
+	endpoints := map[string]connectivityEndpoint{}
+	for _, cut := range env.ContainersUnderTest {
+		if _, ok := env.ContainersToExcludeFromConnectivityTests[cut.ContainerIdentifier]; ok {
+			continue
+		}
+		name := cut.ContainerIdentifier.Namespace + "/" + cut.ContainerIdentifier.PodName
+		if existing, ok := endpoints[name]; ok && existing.oc.GetPodContainerName() < cut.ContainerIdentifier.ContainerName {
+			continue
+		}
+		endpoints[name] = connectivityEndpoint{name: name, oc: cut.Oc, ip: cut.DefaultNetworkIPAddress}
+	}
+	return sortEndpoints(endpoints)
+}
+
+func sortEndpoints(endpoints map[string]connectivityEndpoint) []connectivityEndpoint {
+	sorted := make([]connectivityEndpoint, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		sorted = append(sorted, endpoint)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].name < sorted[j].name })
+	return sorted
+}
+
+// runConnectivityMatrix pings every endpoint from every other endpoint, and returns the formatted matrix of the
+// results along with the "<source> -> <destination>" pairs that failed.
+func runConnectivityMatrix(endpoints []connectivityEndpoint, count int, threshold configsections.PingThreshold,
+	overrides tnf.RunOverrides) (matrix string, failedPairs []string) {
+	results := make([][]string, len(endpoints))
+	for i, source := range endpoints {
+		results[i] = make([]string, len(endpoints))
+		for j, destination := range endpoints {
+			if i == j {
+				results[i][j] = "-"
+				continue
+			}
+			pair := fmt.Sprintf("%s -> %s", source.name, destination.name)
+			ginkgo.By(fmt.Sprintf("a Ping is issued from %s to %s %s", source.name, destination.name, destination.ip))
+			test, lastPing := common.NewPingTest(source.oc, destination.ip, count, threshold, overrides)
+			results[i][j] = "ok"
+			test.RunWithCallbacks(nil, func() {
+				tnf.ClaimFilePrintf("Ping %s (%s) exceeded the thresholds: %v", pair, destination.ip, lastPing().GetViolations())
+				results[i][j] = "FAIL"
+			}, func(err error) {
+				tnf.ClaimFilePrintf("Ping %s (%s) failed: %v", pair, destination.ip, err)
+				results[i][j] = "FAIL"
+			})
+			if results[i][j] != "ok" {
+				failedPairs = append(failedPairs, pair)
+			}
+		}
+	}
+	return formatConnectivityMatrix(endpoints, results), failedPairs
+}
+
+// formatConnectivityMatrix renders the results with one row per source and one column per destination.  The endpoints
+// are numbered to keep the columns narrow.
+func formatConnectivityMatrix(endpoints []connectivityEndpoint, results [][]string) string {
+	var b strings.Builder
+	for i, endpoint := range endpoints {
+		fmt.Fprintf(&b, "[%d] %s %s\n", i+1, endpoint.name, endpoint.ip)
+	}
+	fmt.Fprintf(&b, "%6s", "")
+	for i := range endpoints {
+		fmt.Fprintf(&b, "%6s", fmt.Sprintf("[%d]", i+1))
+	}
+	for i, row := range results {
+		fmt.Fprintf(&b, "\n%6s", fmt.Sprintf("[%d]", i+1))
+		for _, result := range row {
+			fmt.Fprintf(&b, "%6s", result)
+		}
+	}
+	return b.String()
+}
+
+// getMultusNetworkEndpoints returns the endpoints of the CNF pods on each Multus network attachment, indexed by the
+// "<namespace>/<name>" of the attachment and sorted by pod name.  The first IP of a pod on an attachment is used.
+func getMultusNetworkEndpoints(env *config.TestEnvironment) map[string][]connectivityEndpoint {
+	byNetwork := map[string]map[string]connectivityEndpoint{}
+	for _, cut := range env.ContainersUnderTest {
+		if _, ok := env.ContainersToExcludeFromConnectivityTests[cut.ContainerIdentifier]; ok {
+			continue
+		}
+		name := cut.ContainerIdentifier.Namespace + "/" + cut.ContainerIdentifier.PodName
+		for network, ips := range cut.ContainerConfiguration.MultusNetworks {
+			if byNetwork[network] == nil {
+				byNetwork[network] = map[string]connectivityEndpoint{}
+			}
+			if _, ok := byNetwork[network][name]; !ok {
+				byNetwork[network][name] = connectivityEndpoint{name: name, oc: cut.Oc, ip: ips[0]}
+			}
+		}
+	}
+	endpoints := map[string][]connectivityEndpoint{}
+	for network, networkEndpoints := range byNetwork {
+		endpoints[network] = sortEndpoints(networkEndpoints)
+	}
+	return endpoints
+}
+
+func testMultusNetworkConnectivityMatrix(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestMultusConnectivityMatrixIdentifier)
+	ginkgo.It(testID, func() {
+		count := pingCount(env)
+		common.SkipUnlessMultus()
+		endpointsByNetwork := getMultusNetworkEndpoints(env)
+		networks := make([]string, 0, len(endpointsByNetwork))
+		for network := range endpointsByNetwork {
+			networks = append(networks, network)
+		}
+		sort.Strings(networks)
+		tested := false
+		var failedPairs []string
+		for _, network := range networks {
+			endpoints := endpointsByNetwork[network]
+			if len(endpoints) < 2 {
+				tnf.ClaimFilePrintf("Skipping network attachment %s, only pod %s is attached to it", network, endpoints[0].name)
+				continue
+			}
+			tested = true
+			matrix, failed := runConnectivityMatrix(endpoints, count, multusNetworkThreshold(env), common.RunOverrides(env))
+			tnf.ClaimFilePrintf("Network attachment %s connectivity matrix, rows ping columns:\n%s", network, matrix)
+			for _, pair := range failed {
+				failedPairs = append(failedPairs, fmt.Sprintf("%s: %s", network, pair))
+			}
+		}
+		if !tested {
+			ginkgo.Skip("No Multus network attachment is shared by two CNF pods")
+		}
+		if n := len(failedPairs); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d pod pairs without connectivity on their Multus network attachments: %v", n, failedPairs))
+		}
+	})
+}
+
+func testDefaultNetworkConnectivityMatrix(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestICMPv4ConnectivityMatrixIdentifier)
+	ginkgo.It(testID, func() {
+		count := pingCount(env)
+		endpoints := getDefaultNetworkEndpoints(env)
+		if len(endpoints) < 2 {
+			ginkgo.Skip("Less than two CNF pods are suitable for the connectivity matrix test")
+		}
+		matrix, failedPairs := runConnectivityMatrix(endpoints, count, defaultNetworkThreshold(env), common.RunOverrides(env))
+		tnf.ClaimFilePrintf("Default network connectivity matrix, rows ping columns:\n%s", matrix)
+		if n := len(failedPairs); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d pod pairs without connectivity on the default network: %v", n, failedPairs))
+		}
+	})
+}
+
+// parseIP parses an address or a CIDR, and returns nil when it is neither.
+func parseIP(address string) net.IP {
+	if ip, _, err := net.ParseCIDR(address); err == nil {
+		return ip
+	}
+	return net.ParseIP(address)
+}
+
+// splitIPFamilies returns the first IPv4 and the first IPv6 address of a list of addresses or CIDRs.
+func splitIPFamilies(addresses []string) (ipv4, ipv6 string) {
+	for _, address := range addresses {
+		ip := parseIP(address)
+		switch {
+		case ip == nil:
+			continue
+		case ip.To4() != nil:
+			if ipv4 == "" {
+				ipv4 = address
+			}
+		case ipv6 == "":
+			ipv6 = address
+		}
+	}
+	return ipv4, ipv6
+}
+
+// skipUnlessDualStack skips the current test unless the cluster network has both an IPv4 and an IPv6 CIDR.
+func skipUnlessDualStack() {
+	out := utils.ExecuteCommand(ocGetClusterNetworkCommand, common.DefaultTimeout, common.GetContext(), func() {
+		tnf.ClaimFilePrintf("Failed to get the cluster network CIDRs")
+	})
+	if ipv4, ipv6 := splitIPFamilies(strings.Fields(out)); ipv4 == "" || ipv6 == "" {
+		ginkgo.Skip(fmt.Sprintf("The cluster network %v is not dual-stack", strings.Fields(out)))
+	}
+}
+
+// getPodIPs returns the IPv4 and IPv6 addresses of a pod.
+func getPodIPs(namespace, podName string) (ipv4, ipv6 string) {
+	out := utils.ExecuteCommand(fmt.Sprintf(ocGetPodIPsCommand, namespace, podName), common.DefaultTimeout, common.GetContext(), func() {
+		tnf.ClaimFilePrintf("Failed to get the addresses of pod %s/%s", namespace, podName)
+	})
+	return splitIPFamilies(strings.Fields(out))
+}
+
+func testDualStack(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestDualStackAddressesIdentifier)
+	ginkgo.It(testID, func() {
+		skipUnlessDualStack()
+		var failedPods []string
+		for _, podUnderTest := range env.PodsUnderTest {
+			podName := podUnderTest.Namespace + "/" + podUnderTest.Name
+			ipv4, ipv6 := getPodIPs(podUnderTest.Namespace, podUnderTest.Name)
+			tnf.ClaimFilePrintf("Pod %s: IPv4 address %q, IPv6 address %q", podName, ipv4, ipv6)
+			if ipv4 == "" || ipv6 == "" {
+				failedPods = append(failedPods, podName)
+			}
+		}
+		if n := len(failedPods); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d pods without both an IPv4 and an IPv6 address: %v", n, failedPods))
+		}
+	})
+
+	testID = identifiers.XformToGinkgoItIdentifier(identifiers.TestDualStackServicesIdentifier)
+	ginkgo.It(testID, func() {
+		skipUnlessDualStack()
+		const numExpectedFields = 4
+		var failedServices []string
+		for _, ns := range env.NameSpacesUnderTest {
+			out := utils.ExecuteCommand(fmt.Sprintf(ocGetServiceIPFamiliesCommand, ns), common.DefaultTimeout, common.GetContext(), func() {
+				tnf.ClaimFilePrintf("Failed to list the services of namespace %s", ns)
+			})
+			for _, line := range strings.Split(out, "\n") {
+				fields := strings.Split(strings.TrimSpace(line), "|")
+				if len(fields) != numExpectedFields || fields[1] == "ExternalName" {
+					continue
+				}
+				serviceName, policy, families := ns+"/"+fields[0], fields[2], fields[3]
+				tnf.ClaimFilePrintf("Service %s: ipFamilyPolicy %q, ipFamilies %q", serviceName, policy, families)
+				if policy != "PreferDualStack" && policy != "RequireDualStack" {
+					failedServices = append(failedServices, serviceName)
+				}
+			}
+		}
+		if n := len(failedServices); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d services not requesting both IP families: %v", n, failedServices))
+		}
+	})
+
+	testID = identifiers.XformToGinkgoItIdentifier(identifiers.TestDualStackConnectivityIdentifier)
+	ginkgo.It(testID, func() {
+		skipUnlessDualStack()
+		endpoints := getDefaultNetworkEndpoints(env)
+		if len(endpoints) < 2 {
+			ginkgo.Skip("Less than two CNF pods are suitable for the connectivity matrix test")
+		}
+		ipv4Endpoints := make([]connectivityEndpoint, 0, len(endpoints))
+		ipv6Endpoints := make([]connectivityEndpoint, 0, len(endpoints))
+		for _, endpoint := range endpoints {
+			ipv4, ipv6 := getPodIPs(endpoint.oc.GetPodNamespace(), endpoint.oc.GetPodName())
+			if ipv4 != "" {
+				ipv4Endpoints = append(ipv4Endpoints, connectivityEndpoint{name: endpoint.name, oc: endpoint.oc, ip: ipv4})
+			}
+			if ipv6 != "" {
+				ipv6Endpoints = append(ipv6Endpoints, connectivityEndpoint{name: endpoint.name, oc: endpoint.oc, ip: ipv6})
+			}
+		}
+		var failedPairs []string
+		for _, family := range []struct {
+			name      string
+			endpoints []connectivityEndpoint
+		}{{"IPv4", ipv4Endpoints}, {"IPv6", ipv6Endpoints}} {
+			if len(family.endpoints) < 2 {
+				tnf.ClaimFilePrintf("Skipping the %s connectivity matrix, less than two CNF pods have an %s address", family.name, family.name)
+				continue
+			}
+			matrix, failed := runConnectivityMatrix(family.endpoints, pingCount(env), defaultNetworkThreshold(env), common.RunOverrides(env))
+			tnf.ClaimFilePrintf("%s connectivity matrix, rows ping columns:\n%s", family.name, matrix)
+			for _, pair := range failed {
+				failedPairs = append(failedPairs, fmt.Sprintf("%s: %s", family.name, pair))
+			}
+		}
+		if n := len(failedPairs); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d pod pairs without dual-stack connectivity: %v", n, failedPairs))
+		}
+	})
+}
+
+// runOcCommand runs an oc command, and returns its trimmed output.
+func runOcCommand(command, failureMessage string) string {
+	return strings.TrimSpace(utils.ExecuteCommand(command, common.DefaultTimeout, common.GetContext(), func() {
+		tnf.ClaimFilePrintf("%s", failureMessage)
+	}))
+}
+
+// statefulSetPeers is a StatefulSet owning pods under test, along with its governing Service.
+type statefulSetPeers struct {
+	namespace string
+	name      string
+	service   string
+	pods      []string
+}
+
+// getStatefulSetPeers returns the StatefulSets owning pods under test, sorted by name.
+func getStatefulSetPeers(env *config.TestEnvironment) []statefulSetPeers {
+	peers := map[string]*statefulSetPeers{}
+	for _, podUnderTest := range env.PodsUnderTest {
+		ns, podName := podUnderTest.Namespace, podUnderTest.Name
+		owner := runOcCommand(fmt.Sprintf(ocGetStatefulSetOwnerCommand, ns, podName), fmt.Sprintf("Failed to get the owner of pod %s/%s", ns, podName))
+		if owner == "" {
+			continue
+		}
+		key := ns + "/" + owner
+		if _, ok := peers[key]; !ok {
+			service := runOcCommand(fmt.Sprintf(ocGetStatefulSetServiceCommand, ns, owner), fmt.Sprintf("Failed to get the service of statefulset %s", key))
+			peers[key] = &statefulSetPeers{namespace: ns, name: owner, service: service}
+		}
+		peers[key].pods = append(peers[key].pods, podName)
+	}
+	sorted := make([]statefulSetPeers, 0, len(peers))
+	for _, p := range peers {
+		sort.Strings(p.pods)
+		sorted = append(sorted, *p)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].namespace+"/"+sorted[i].name < sorted[j].namespace+"/"+sorted[j].name
+	})
+	return sorted
+}
+
+// resolvesTo returns whether a host name resolves to one of the addresses of a pod from inside another pod.
+func resolvesTo(namespace, podName, host, peerName string) bool {
+	out := runOcCommand(fmt.Sprintf(ocResolveHostCommand, namespace, podName, host), fmt.Sprintf("Failed to resolve %s from pod %s/%s", host, namespace, podName))
+	ipv4, ipv6 := getPodIPs(namespace, peerName)
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) > 0 && (fields[0] == ipv4 || fields[0] == ipv6) {
+			return true
+		}
+	}
+	tnf.ClaimFilePrintf("Pod %s/%s resolved %s to %q instead of the addresses %q %q of pod %s", namespace, podName, host, out, ipv4, ipv6, peerName)
+	return false
+}
+
+func testHeadlessServiceResolution(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestHeadlessServiceResolutionIdentifier)
+	ginkgo.It(testID, func() {
+		statefulSets := getStatefulSetPeers(env)
+		if len(statefulSets) == 0 {
+			ginkgo.Skip("No pod under test is owned by a StatefulSet")
+		}
+		var failures []string
+		for _, statefulSet := range statefulSets {
+			statefulSetName := statefulSet.namespace + "/" + statefulSet.name
+			if statefulSet.service == "" {
+				tnf.ClaimFilePrintf("StatefulSet %s has no governing service", statefulSetName)
+				failures = append(failures, statefulSetName)
+				continue
+			}
+			clusterIP := runOcCommand(fmt.Sprintf(ocGetServiceClusterIPCommand, statefulSet.namespace, statefulSet.service),
+				fmt.Sprintf("Failed to get service %s/%s", statefulSet.namespace, statefulSet.service))
+			if clusterIP != "None" {
+				tnf.ClaimFilePrintf("Service %s/%s of statefulset %s is not headless, its cluster IP is %q", statefulSet.namespace,
+					statefulSet.service, statefulSetName, clusterIP)
+				failures = append(failures, statefulSetName)
+				continue
+			}
+			for _, source := range statefulSet.pods {
+				for _, peer := range statefulSet.pods {
+					if peer == source {
+						continue
+					}
+					host := fmt.Sprintf("%s.%s.%s.svc", peer, statefulSet.service, statefulSet.namespace)
+					ginkgo.By(fmt.Sprintf("Resolving %s from pod %s/%s", host, statefulSet.namespace, source))
+					if !resolvesTo(statefulSet.namespace, source, host, peer) {
+						failures = append(failures, fmt.Sprintf("%s/%s -> %s", statefulSet.namespace, source, host))
+					}
+				}
+			}
+		}
+		if n := len(failures); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d statefulsets or pods failing to resolve their peers through a headless service: %v", n, failures))
+		}
+	})
+}
+
+// getMissingDNSConfig returns whether a pod declares a dnsConfig, and the entries of that dnsConfig missing from its
+// /etc/resolv.conf.
+func getMissingDNSConfig(namespace, podName string) (declared bool, missing []string) {
+	const numExpectedFields = 3
+	pod := namespace + "/" + podName
+	fields := strings.Split(runOcCommand(fmt.Sprintf(ocGetDNSConfigCommand, namespace, podName), fmt.Sprintf("Failed to get the dnsConfig of pod %s", pod)), "|")
+	if len(fields) != numExpectedFields || strings.TrimSpace(strings.Join(fields, "")) == "" {
+		return false, nil
+	}
+	resolvConf := map[string]map[string]bool{"nameserver": {}, "search": {}, "options": {}}
+	out := runOcCommand(fmt.Sprintf(ocGetResolvConfCommand, namespace, podName), fmt.Sprintf("Failed to read /etc/resolv.conf of pod %s", pod))
+	for _, line := range strings.Split(out, "\n") {
+		words := strings.Fields(line)
+		if len(words) == 0 {
+			continue
+		}
+		if entries, ok := resolvConf[words[0]]; ok {
+			for _, word := range words[1:] {
+				entries[strings.SplitN(word, ":", 2)[0]] = true
+			}
+		}
+	}
+	for i, keyword := range []string{"nameserver", "search", "options"} {
+		for _, entry := range strings.Fields(fields[i]) {
+			if !resolvConf[keyword][entry] {
+				missing = append(missing, keyword+" "+entry)
+			}
+		}
+	}
+	return true, missing
+}
+
+func testDNSConfig(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestDNSConfigIdentifier)
+	ginkgo.It(testID, func() {
+		tested := false
+		var failedPods []string
+		for _, podUnderTest := range env.PodsUnderTest {
+			podName := podUnderTest.Namespace + "/" + podUnderTest.Name
+			ginkgo.By(fmt.Sprintf("Checking the dnsConfig of pod %s", podName))
+			declared, missing := getMissingDNSConfig(podUnderTest.Namespace, podUnderTest.Name)
+			tested = tested || declared
+			if len(missing) > 0 {
+				tnf.ClaimFilePrintf("Pod %s is missing the dnsConfig entries %v from its /etc/resolv.conf", podName, missing)
+				failedPods = append(failedPods, podName)
+			}
+		}
+		if !tested {
+			ginkgo.Skip("No pod under test declares a dnsConfig")
+		}
+		if n := len(failedPods); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d pods whose dnsConfig is not applied: %v", n, failedPods))
+		}
+	})
+}
+
+// pingPasses pings an endpoint from another one, and returns whether the ping met the thresholds.
+func pingPasses(source, destination connectivityEndpoint, count int, threshold configsections.PingThreshold,
+	overrides tnf.RunOverrides) bool {
+	test, _ := common.NewPingTest(source.oc, destination.ip, count, threshold, overrides)
+	passed := false
+	test.RunWithCallbacks(func() {
+		passed = true
+	}, nil, nil)
+	return passed
+}
+
+// getUnreadyPods returns the pods under test which are not ready.
+func getUnreadyPods(env *config.TestEnvironment) []string {
+	const numExpectedFields = 2
+	ready := map[string]bool{}
+	for _, namespace := range env.NameSpacesUnderTest {
+		out := utils.ExecuteCommand(fmt.Sprintf(ocGetPodReadinessCommand, namespace), common.DefaultTimeout, common.GetContext(), func() {
+			tnf.ClaimFilePrintf("Failed to get the readiness of the pods of namespace %s", namespace)
+		})
+		for _, line := range strings.Split(out, "\n") {
+			fields := strings.Split(strings.TrimSpace(line), "|")
+			if len(fields) == numExpectedFields {
+				ready[namespace+"/"+fields[0]] = fields[1] == "True"
+			}
+		}
+	}
+	var unready []string
+	for _, podUnderTest := range env.PodsUnderTest {
+		podName := podUnderTest.Namespace + "/" + podUnderTest.Name
+		if !ready[podName] {
+			unready = append(unready, podName)
+		}
+	}
+	return unready
+}
+
+// setNetworkPartition adds or removes the network partition between source and destination from the debug pod of the
+// node of source, and returns whether it succeeded.
+func setNetworkPartition(nodeOc *interactive.Oc, source, destination connectivityEndpoint, partition bool) bool {
+	tester := netpartition.NewNetworkPartition(common.DefaultTimeout, source.oc.GetPodNamespace(), source.oc.GetPodName(), destination.ip, partition)
+	test, err := tnf.NewTest(nodeOc.GetExpecter(), tester, []reel.Handler{tester}, nodeOc.GetErrorChannel())
 	gomega.Expect(err).To(gomega.BeNil())
-	test.RunAndValidate()
+	succeeded := false
+	test.RunWithCallbacks(func() {
+		succeeded = true
+	}, func() {
+		tnf.ClaimFilePrintf("Found %d network partition rules in pod %s, partitioned: %t", tester.GetRules(), source.name, partition)
+	}, func(err error) {
+		tnf.ClaimFilePrintf("Failed to set the network partition of pod %s to %t: %v", source.name, partition, err)
+	})
+	return succeeded
+}
+
+// testNetworkPartition blackholes the traffic between two CNF pods for networkPartitionSeconds, records how the CNF
+// reacted, and ensures it recovers once the partition is removed.  The partition is removed even when the test fails.
+func testNetworkPartition(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestNetworkPartitionIdentifier)
+	ginkgo.It(testID, func() {
+		count := pingCount(env)
+		if env.Config.NetworkPartitionSeconds <= 0 {
+			ginkgo.Skip("networkPartitionSeconds is not set, skip the network partition test")
+		}
+		endpoints := getDefaultNetworkEndpoints(env)
+		if len(endpoints) < 2 {
+			ginkgo.Skip("Less than two CNF pods are suitable for the network partition test")
+		}
+		source, destination := endpoints[0], endpoints[1]
+		var nodeOc *interactive.Oc
+		for _, cut := range env.ContainersUnderTest {
+			if cut.ContainerIdentifier.Namespace+"/"+cut.ContainerIdentifier.PodName == source.name {
+				if node, ok := env.NodesUnderTest[cut.ContainerConfiguration.NodeName]; ok && node.HasDebugPod() {
+					nodeOc = node.Oc
+				}
+			}
+		}
+		if nodeOc == nil {
+			ginkgo.Skip(fmt.Sprintf("No debug pod runs on the node of pod %s", source.name))
+		}
+		pair := fmt.Sprintf("%s <-> %s", source.name, destination.name)
+		threshold := defaultNetworkThreshold(env)
+		overrides := common.RunOverrides(env)
+
+		healed := false
+		defer func() {
+			if !healed && !setNetworkPartition(nodeOc, source, destination, false) {
+				log.Errorf("Failed to remove the network partition %s, remove the iptables rules tagged %s from pod %s",
+					pair, netpartition.RuleComment, source.name)
+			}
+		}()
+		ginkgo.By(fmt.Sprintf("Partitioning %s for %d seconds", pair, env.Config.NetworkPartitionSeconds))
+		if !setNetworkPartition(nodeOc, source, destination, true) {
+			ginkgo.Fail(fmt.Sprintf("Failed to partition %s", pair))
+		}
+		if pingPasses(source, destination, count, threshold, overrides) {
+			ginkgo.Fail(fmt.Sprintf("Pod %s still reaches %s through the network partition", source.name, destination.name))
+		}
+		time.Sleep(time.Duration(env.Config.NetworkPartitionSeconds) * time.Second)
+		if unready := getUnreadyPods(env); len(unready) > 0 {
+			tnf.ClaimFilePrintf("The CNF detected the network partition %s, pods %v are not ready", pair, unready)
+		} else {
+			tnf.ClaimFilePrintf("No pod under test became unready during the network partition %s", pair)
+		}
+
+		ginkgo.By(fmt.Sprintf("Removing the network partition %s", pair))
+		healed = setNetworkPartition(nodeOc, source, destination, false)
+		if !healed {
+			ginkgo.Fail(fmt.Sprintf("Failed to remove the network partition %s, remove the iptables rules tagged %s from pod %s",
+				pair, netpartition.RuleComment, source.name))
+		}
+		start := time.Now()
+		var unready []string
+		recovered := false
+		for ; time.Since(start) < partitionRecoveryTimeout; time.Sleep(partitionPollingPeriod) {
+			if unready = getUnreadyPods(env); len(unready) == 0 && pingPasses(source, destination, count, threshold, overrides) {
+				recovered = true
+				break
+			}
+		}
+		if !recovered {
+			ginkgo.Fail(fmt.Sprintf("The CNF did not recover within %s of the removal of the network partition %s, unready pods: %v",
+				partitionRecoveryTimeout, pair, unready))
+		}
+		tnf.ClaimFilePrintf("The CNF recovered %s after the removal of the network partition %s", time.Since(start).Round(time.Second), pair)
+	})
+}
+
+// pingCount returns the number of ICMP requests per ping of the running test case, or defaultNumPings when none is
+// configured.  The pingCount override of the test case takes precedence over the count of the pingThresholds section.
+func pingCount(env *config.TestEnvironment) int {
+	if env.Config.PingThresholds.Count > 0 {
+		return common.PingCount(env, env.Config.PingThresholds.Count)
+	}
+	return common.PingCount(env, defaultNumPings)
+}
+
+// defaultNetworkThreshold returns the ping limits of the running test case on the default network.
+func defaultNetworkThreshold(env *config.TestEnvironment) configsections.PingThreshold {
+	return common.PingThreshold(env, env.Config.PingThresholds.DefaultNetwork)
+}
+
+// multusNetworkThreshold returns the ping limits of the running test case on the Multus networks.
+func multusNetworkThreshold(env *config.TestEnvironment) configsections.PingThreshold {
+	return common.PingThreshold(env, env.Config.PingThresholds.MultusNetwork)
+}
+
+// Test that a container can ping a target IP address within the configured packet loss and latency thresholds.
+func testPing(initiatingPodOc *interactive.Oc, targetPodIPAddress string, count int, threshold configsections.PingThreshold,
+	overrides tnf.RunOverrides) {
+	log.Infof("Sending ICMP traffic(%s to %s)", initiatingPodOc.GetPodName(), targetPodIPAddress)
+	test, lastPing := common.NewPingTest(initiatingPodOc, targetPodIPAddress, count, threshold, overrides)
+	test.RunAndValidateWithFailureCallback(func() {
+		tnf.ClaimFilePrintf("Ping to %s exceeded the thresholds: %v", targetPodIPAddress, lastPing().GetViolations())
+	})
+	pingTester := lastPing()
 	transmitted, received, errors := pingTester.GetStats()
-	gomega.Expect(received).To(gomega.Equal(transmitted))
+	log.Debugf("Ping to %s: rtt=%+v loss=%.1f%%", targetPodIPAddress, pingTester.GetRTT(), pingTester.GetLossPercent())
+	if threshold.MaxLossPercent == 0 {
+		gomega.Expect(received).To(gomega.Equal(transmitted))
+	}
 	gomega.Expect(errors).To(gomega.BeZero())
 }
 
@@ -164,3 +822,99 @@ func testNodePort(env *config.TestEnvironment) {
 		}
 	})
 }
+
+func testListeningAndDeclaredPorts(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestListeningAndDeclaredPortsIdentifier)
+	ginkgo.It(testID, func() {
+		var failedContainers []string
+		for _, cut := range env.ContainersUnderTest {
+			id := cut.ContainerIdentifier
+			ginkgo.By(fmt.Sprintf("Comparing the listening and declared ports of %s/%s/%s", id.Namespace, id.PodName, id.ContainerName))
+			command := fmt.Sprintf(ocGetContainerPortsCommand, id.Namespace, id.PodName, id.ContainerName)
+			out := utils.ExecuteCommand(command, common.DefaultTimeout, common.GetContext(), func() {
+				tnf.ClaimFilePrintf("Failed to get the declared ports of %s/%s/%s", id.Namespace, id.PodName, id.ContainerName)
+			})
+			declared, err := listeningports.ParsePorts(out)
+			gomega.Expect(err).To(gomega.BeNil())
+
+			tester := listeningports.NewListeningPorts(common.DefaultTimeout, declared)
+			test, err := tnf.NewTest(cut.Oc.GetExpecter(), tester, []reel.Handler{tester}, cut.Oc.GetErrorChannel())
+			gomega.Expect(err).To(gomega.BeNil())
+			containerName := fmt.Sprintf("%s/%s/%s", id.Namespace, id.PodName, id.ContainerName)
+			test.RunWithCallbacks(nil, func() {
+				tnf.ClaimFilePrintf("Container %s listens on undeclared ports %v and declares unused ports %v",
+					containerName, tester.GetUndeclared(), tester.GetNotListening())
+				failedContainers = append(failedContainers, containerName)
+			}, func(err error) {
+				tnf.ClaimFilePrintf("Failed to list the listening ports of container %s: %v", containerName, err)
+				failedContainers = append(failedContainers, containerName)
+			})
+		}
+		if n := len(failedContainers); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d containers whose listening ports do not match their containerPorts: %v", n, failedContainers))
+		}
+	})
+}
+
+// getServiceMesh returns the service mesh sidecars and strict mutual TLS policies of a namespace.
+func getServiceMesh(namespace string) *servicemesh.ServiceMesh {
+	context := common.GetContext()
+	tester := servicemesh.NewServiceMesh(common.DefaultTimeout, namespace)
+	test, err := tnf.NewTest(context.GetExpecter(), tester, []reel.Handler{tester}, context.GetErrorChannel())
+	gomega.Expect(err).To(gomega.BeNil())
+	result, err := test.Run()
+	gomega.Expect(err).To(gomega.BeNil())
+	gomega.Expect(result).To(gomega.Equal(tnf.SUCCESS))
+	return tester
+}
+
+func testServiceMesh(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestServiceMeshInjectionIdentifier)
+	ginkgo.It(testID, func() {
+		podsByNamespace := map[string][]string{}
+		for _, podUnderTest := range env.PodsUnderTest {
+			podsByNamespace[podUnderTest.Namespace] = append(podsByNamespace[podUnderTest.Namespace], podUnderTest.Name)
+		}
+		var failedNamespaces []string
+		for _, ns := range env.NameSpacesUnderTest {
+			ginkgo.By(fmt.Sprintf("Discovering the service mesh sidecars of namespace %s", ns))
+			sidecars := getServiceMesh(ns).GetSidecars()
+			var injected, notInjected []string
+			for _, podName := range podsByNamespace[ns] {
+				if sidecars[podName] {
+					injected = append(injected, podName)
+				} else {
+					notInjected = append(notInjected, podName)
+				}
+			}
+			tnf.ClaimFilePrintf("Namespace %s: pods with a sidecar %v, pods without a sidecar %v", ns, injected, notInjected)
+			if len(notInjected) > 0 && (len(injected) > 0 || env.Config.UsesServiceMesh) {
+				failedNamespaces = append(failedNamespaces, ns)
+			}
+		}
+		if n := len(failedNamespaces); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d namespaces with pods missing a service mesh sidecar: %v", n, failedNamespaces))
+		}
+	})
+
+	testID = identifiers.XformToGinkgoItIdentifier(identifiers.TestServiceMeshMTLSIdentifier)
+	ginkgo.It(testID, func() {
+		if !env.Config.UsesServiceMesh {
+			ginkgo.Skip("The CNF does not declare the use of a service mesh")
+		}
+		var failedNamespaces []string
+		for _, ns := range env.NameSpacesUnderTest {
+			ginkgo.By(fmt.Sprintf("Checking strict mutual TLS is enforced on namespace %s", ns))
+			policies := getServiceMesh(ns).GetMTLSPolicies()
+			if len(policies) == 0 {
+				tnf.ClaimFilePrintf("No PeerAuthentication enforces strict mutual TLS on namespace %s", ns)
+				failedNamespaces = append(failedNamespaces, ns)
+				continue
+			}
+			tnf.ClaimFilePrintf("Namespace %s: strict mutual TLS is enforced by %v", ns, policies)
+		}
+		if n := len(failedNamespaces); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d namespaces without strict mutual TLS: %v", n, failedNamespaces))
+		}
+	})
+}
@@ -19,6 +19,8 @@ package observability
 import (
 	"fmt"
 	"path"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/onsi/ginkgo"
@@ -51,6 +53,14 @@ var (
 	// retrieve the singleton instance of test environment
 	env *config.TestEnvironment = config.GetTestEnvironment()
 )
+
+const (
+	// ocListOpenFilesFormat lists the file descriptors held by the processes of a container.
+	ocListOpenFilesFormat = "oc exec -n %s %s -c %s -- sh -c 'ls -l /proc/[0-9]*/fd 2>/dev/null' 2>&1"
+	// ocLogsLineCountFormat counts the last lines a container wrote to stdout/stderr.
+	ocLogsLineCountFormat = "oc logs -n %s %s -c %s --tail 5 | wc -l"
+)
+
 var _ = ginkgo.Describe(common.ObservabilityTestKey, func() {
 	conf, _ := ginkgo.GinkgoConfiguration()
 
@@ -62,6 +72,7 @@ var _ = ginkgo.Describe(common.ObservabilityTestKey, func() {
 		})
 		ginkgo.ReportAfterEach(results.RecordResult)
 		testLogging()
+		testLogFiles()
 		testCrds()
 	}
 })
@@ -90,6 +101,65 @@ func loggingTest(c configsections.ContainerIdentifier) {
 	test.RunAndValidate()
 }
 
+// getLogFiles returns the log files held open by the processes of a container, i.e. the files ending in ".log" or under
+// /var/log.
+func getLogFiles(c configsections.ContainerIdentifier) []string {
+	out := utils.ExecuteCommand(fmt.Sprintf(ocListOpenFilesFormat, c.Namespace, c.PodName, c.ContainerName), common.DefaultTimeout,
+		common.GetContext(), func() {
+			tnf.ClaimFilePrintf("Failed to list the open files of container %s/%s/%s", c.Namespace, c.PodName, c.ContainerName)
+		})
+	files := map[string]bool{}
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.SplitN(strings.TrimSpace(line), " -> ", 2)
+		if len(fields) != 2 || !strings.HasPrefix(fields[1], "/") {
+			continue
+		}
+		file := strings.TrimSuffix(fields[1], " (deleted)")
+		if strings.HasPrefix(file, "/dev/") || strings.HasPrefix(file, "/proc/") {
+			continue
+		}
+		if strings.HasSuffix(file, ".log") || strings.HasPrefix(file, "/var/log/") {
+			files[file] = true
+		}
+	}
+	var logFiles []string
+	for file := range files {
+		logFiles = append(logFiles, file)
+	}
+	sort.Strings(logFiles)
+	return logFiles
+}
+
+// testLogFiles ensures the containers under test do not write their logs to files of the container filesystem.
+func testLogFiles() {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestLogFilesIdentifier)
+	ginkgo.It(testID, func() {
+		var failedContainers []string
+		for _, cut := range env.ContainersUnderTest {
+			c := cut.ContainerIdentifier
+			containerName := fmt.Sprintf("%s/%s/%s", c.Namespace, c.PodName, c.ContainerName)
+			ginkgo.By(fmt.Sprintf("Checking the log files of container %s", containerName))
+			logFiles := getLogFiles(c)
+			if len(logFiles) == 0 {
+				continue
+			}
+			out := utils.ExecuteCommand(fmt.Sprintf(ocLogsLineCountFormat, c.Namespace, c.PodName, c.ContainerName), common.DefaultTimeout,
+				common.GetContext(), func() {
+					tnf.ClaimFilePrintf("Failed to get the logs of container %s", containerName)
+				})
+			if strings.TrimSpace(out) == "0" {
+				tnf.ClaimFilePrintf("Container %s only logs to files %v", containerName, logFiles)
+			} else {
+				tnf.ClaimFilePrintf("Container %s logs to files %v", containerName, logFiles)
+			}
+			failedContainers = append(failedContainers, containerName)
+		}
+		if n := len(failedContainers); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d containers writing logs to the container filesystem: %v", n, failedContainers))
+		}
+	})
+}
+
 func testCrds() {
 	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestCrdsStatusSubresourceIdentifier)
 	ginkgo.It(testID, func() {
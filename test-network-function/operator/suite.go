@@ -17,9 +17,11 @@
 package operator
 
 import (
+	"encoding/json"
 	"fmt"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/test-network-function/test-network-function/pkg/utils"
 
@@ -29,7 +31,10 @@ import (
 	"github.com/onsi/ginkgo"
 	"github.com/onsi/gomega"
 	"github.com/test-network-function/test-network-function/pkg/config"
+	"github.com/test-network-function/test-network-function/pkg/config/configsections"
 	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/crdconformance"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/olminstall"
 	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/operator"
 	"github.com/test-network-function/test-network-function/pkg/tnf/reel"
 	"github.com/test-network-function/test-network-function/pkg/tnf/testcases"
@@ -40,10 +45,70 @@ const (
 	configuredTestFile = "testconfigure.yml"
 	// The default test timeout.
 	testSpecName = "operator"
+
+	// ocGetSubscriptionSourceFormat prints the channel, catalog source and catalog source namespace of a subscription,
+	// separated by a "|".
+	ocGetSubscriptionSourceFormat = "oc get subscription -n %s %s -o jsonpath='{.spec.channel}|{.spec.source}|{.spec.sourceNamespace}'"
+
+	// ocGetOwnedCrdsFormat prints the names of the CRDs owned by a CSV.
+	ocGetOwnedCrdsFormat = "oc get csv -n %s %s -o jsonpath='{.spec.customresourcedefinitions.owned[*].name}'"
+
+	// ocGetOwnedKindsFormat prints the kinds of the custom resources owned by a CSV.
+	ocGetOwnedKindsFormat = "oc get csv -n %s %s -o jsonpath='{.spec.customresourcedefinitions.owned[*].kind}'"
+
+	// ocGetDeploymentOwnersFormat prints the name, owner kind and owner name of every Deployment of a namespace,
+	// separated by a "|", one Deployment per line.
+	ocGetDeploymentOwnersFormat = `oc get deployments -n %s -o jsonpath='{range .items[*]}{.metadata.name}|{.metadata.ownerReferences[0].kind}|{.metadata.ownerReferences[0].name}{"\n"}{end}'`
+
+	// ocGetDeploymentStatusFormat prints the UID, desired replicas and ready replicas of a Deployment, separated by a
+	// "|".  Nothing is printed while the Deployment does not exist.
+	ocGetDeploymentStatusFormat = "oc get deployment -n %s %s --ignore-not-found -o jsonpath='{.metadata.uid}|{.spec.replicas}|{.status.readyReplicas}'"
+
+	// ocDeleteDeploymentFormat deletes a Deployment without waiting for its termination.
+	ocDeleteDeploymentFormat = "oc delete deployment -n %s %s --wait=false"
+
+	// ocGetSubscriptionFormat prints a subscription as JSON.
+	ocGetSubscriptionFormat = "oc get subscription -n %s %s -o json"
+
+	// ocGetSubscriptionInstallPlanFormat prints the name of the install plan of a subscription.
+	ocGetSubscriptionInstallPlanFormat = "oc get subscription -n %s %s --ignore-not-found -o jsonpath='{.status.installPlanRef.name}'"
+
+	// ocApproveInstallPlanFormat approves a manual install plan.
+	ocApproveInstallPlanFormat = `oc patch installplan -n %s %s --type merge -p '{"spec":{"approved":true}}'`
+
+	// ocCreateFromStdinFormat creates the resource given as a JSON manifest.
+	ocCreateFromStdinFormat = "echo '%s' | oc create -f -"
+
+	// ocDeleteSubscriptionFormat deletes a subscription.
+	ocDeleteSubscriptionFormat = "oc delete subscription -n %s %s --ignore-not-found --wait=false"
+
+	// ocDeleteCsvFormat deletes a CSV.
+	ocDeleteCsvFormat = "oc delete csv -n %s %s --ignore-not-found --wait=false"
+
+	// ocGetCsvPhaseFormat prints the phase of a CSV.  Nothing is printed while the CSV does not exist.
+	ocGetCsvPhaseFormat = "oc get csv -n %s %s --ignore-not-found -o jsonpath='{.status.phase}'"
+
+	// ocGetOlmOwnedFormat prints the namespaced resources OLM created in a namespace for a CSV.
+	ocGetOlmOwnedFormat = "oc get deployments,services,serviceaccounts,roles,rolebindings -n %s -l olm.owner=%s -o name"
+
+	// ocGetOlmOwnedClusterRbacFormat prints the cluster wide RBAC resources OLM created for a CSV.
+	ocGetOlmOwnedClusterRbacFormat = "oc get clusterroles,clusterrolebindings -l olm.owner=%s -o name"
+
+	// ocGetTerminatingFormat prints the kind and name of the resources of the given types which are being deleted.
+	ocGetTerminatingFormat = `oc get %s -A -o jsonpath='{range .items[?(@.metadata.deletionTimestamp)]}{.kind}/{.metadata.namespace}/{.metadata.name}{" "}{end}'`
+
+	// defaultReconcileTimeout is the deadline for an operator to recreate a deleted Deployment when none is configured.
+	defaultReconcileTimeout = 120 * time.Second
+
+	// reconcilePollingPeriod is the interval between two checks of a recreated Deployment.
+	reconcilePollingPeriod = 5 * time.Second
 )
 
 var (
 
+	// defaultApprovedCatalogSources are the catalog sources accepted when none is configured.
+	defaultApprovedCatalogSources = []string{"certified-operators", "redhat-operators"}
+
 	// checkSubscriptionTestPath is the file location of the uncordon.json test case relative to the project root.
 	checkSubscriptionTestPath = path.Join("pkg", "tnf", "handlers", "checksubscription", "check-subscription.json")
 
@@ -76,6 +141,13 @@ var _ = ginkgo.Describe(testSpecName, func() {
 			itRunsTestsOnOperator(env)
 		})
 		testOperatorsAreInstalledViaOLM(env)
+		testOperatorsInstallPhase(env)
+		testOperatorsSubscriptionSource(env)
+		testOperatorCrdsConformance(env)
+		if common.Intrusive() {
+			testOperatorReconciliation(env)
+			testOperatorReinstall(env)
+		}
 	}
 })
 
@@ -104,6 +176,415 @@ func testOperatorIsInstalledViaOLM(subscriptionName, subscriptionNamespace strin
 	test.RunAndValidate()
 }
 
+// testOperatorsInstallPhase ensures the Subscription, InstallPlan and CSV of all configured operators completed the
+// installation.
+func testOperatorsInstallPhase(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestOperatorInstallPhaseIdentifier)
+	ginkgo.It(testID, func() {
+		var failedOperators []string
+		for _, operatorInTest := range env.OperatorsUnderTest {
+			ginkgo.By(fmt.Sprintf("Subscription %s in namespace %s should be installed", operatorInTest.SubscriptionName, operatorInTest.Namespace))
+			tester := olminstall.NewOLMInstall(common.DefaultTimeout, operatorInTest.SubscriptionName, operatorInTest.Namespace)
+			context := common.GetContext()
+			test, err := tnf.NewTest(context.GetExpecter(), tester, []reel.Handler{tester}, context.GetErrorChannel())
+			gomega.Expect(err).To(gomega.BeNil())
+			operatorName := fmt.Sprintf("%s/%s", operatorInTest.Namespace, operatorInTest.SubscriptionName)
+			test.RunWithCallbacks(nil, func() {
+				for _, status := range tester.GetStatuses() {
+					tnf.ClaimFilePrintf("Operator %s: %s %s is %s, conditions: %v", operatorName, status.Kind, status.Name, status.Phase, status.Conditions)
+				}
+				for _, kind := range tester.GetMissing() {
+					tnf.ClaimFilePrintf("Operator %s: %s not found", operatorName, kind)
+				}
+				failedOperators = append(failedOperators, operatorName)
+			}, func(err error) {
+				tnf.ClaimFilePrintf("Failed to get the installation status of operator %s: %v", operatorName, err)
+				failedOperators = append(failedOperators, operatorName)
+			})
+		}
+		if n := len(failedOperators); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d operators which did not complete their installation: %v", n, failedOperators))
+		}
+	})
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+// testOperatorsSubscriptionSource ensures all configured operators are subscribed to an approved channel and catalog
+// source.
+func testOperatorsSubscriptionSource(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestOperatorSubscriptionSourceIdentifier)
+	ginkgo.It(testID, func() {
+		const numExpectedFields = 3
+		approvedSources := env.Config.ApprovedCatalogSources
+		if len(approvedSources) == 0 {
+			approvedSources = defaultApprovedCatalogSources
+		}
+		var failedOperators []string
+		for _, operatorInTest := range env.OperatorsUnderTest {
+			operatorName := fmt.Sprintf("%s/%s", operatorInTest.Namespace, operatorInTest.SubscriptionName)
+			ginkgo.By(fmt.Sprintf("Subscription %s should use an approved channel and catalog source", operatorName))
+			command := fmt.Sprintf(ocGetSubscriptionSourceFormat, operatorInTest.Namespace, operatorInTest.SubscriptionName)
+			out := utils.ExecuteCommand(command, common.DefaultTimeout, common.GetContext(), func() {
+				tnf.ClaimFilePrintf("Failed to get subscription %s", operatorName)
+			})
+			fields := strings.Split(strings.TrimSpace(out), "|")
+			if len(fields) != numExpectedFields {
+				tnf.ClaimFilePrintf("Failed to parse subscription %s: %q", operatorName, out)
+				failedOperators = append(failedOperators, operatorName)
+				continue
+			}
+			channel, source, sourceNamespace := fields[0], fields[1], fields[2]
+			tnf.ClaimFilePrintf("Subscription %s uses channel %s of catalog source %s/%s", operatorName, channel, sourceNamespace, source)
+			failed := false
+			if !contains(approvedSources, source) {
+				tnf.ClaimFilePrintf("Subscription %s uses catalog source %s, which is not one of %v", operatorName, source, approvedSources)
+				failed = true
+			}
+			if len(env.Config.ApprovedChannels) > 0 && !contains(env.Config.ApprovedChannels, channel) {
+				tnf.ClaimFilePrintf("Subscription %s uses channel %s, which is not one of %v", operatorName, channel, env.Config.ApprovedChannels)
+				failed = true
+			}
+			if failed {
+				failedOperators = append(failedOperators, operatorName)
+			}
+		}
+		if n := len(failedOperators); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d operators subscribed to an unapproved channel or catalog source: %v", n, failedOperators))
+		}
+	})
+}
+
+// getOperatorCrds returns the CRDs owned by the configured operators, followed by the autodiscovered CRDs.
+func getOperatorCrds(env *config.TestEnvironment) []string {
+	var crdNames []string
+	for _, operatorInTest := range env.OperatorsUnderTest {
+		command := fmt.Sprintf(ocGetOwnedCrdsFormat, operatorInTest.Namespace, operatorInTest.Name)
+		out := utils.ExecuteCommand(command, common.DefaultTimeout, common.GetContext(), func() {
+			tnf.ClaimFilePrintf("Failed to get the CRDs owned by CSV %s/%s", operatorInTest.Namespace, operatorInTest.Name)
+		})
+		crdNames = append(crdNames, strings.Fields(out)...)
+	}
+	crdNames = append(crdNames, env.CrdNames...)
+	var uniqueCrdNames []string
+	seen := map[string]bool{}
+	for _, crdName := range crdNames {
+		if !seen[crdName] {
+			seen[crdName] = true
+			uniqueCrdNames = append(uniqueCrdNames, crdName)
+		}
+	}
+	return uniqueCrdNames
+}
+
+// testOperatorCrdsConformance ensures the CRDs of the configured operators follow the apiextensions/v1 conventions.
+func testOperatorCrdsConformance(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestCrdConformanceIdentifier)
+	ginkgo.It(testID, func() {
+		context := common.GetContext()
+		var failedCrds []string
+		for _, crdName := range getOperatorCrds(env) {
+			ginkgo.By(fmt.Sprintf("CRD %s should follow the apiextensions/v1 conventions", crdName))
+			tester := crdconformance.NewCRDConformance(common.DefaultTimeout, crdName)
+			test, err := tnf.NewTest(context.GetExpecter(), tester, []reel.Handler{tester}, context.GetErrorChannel())
+			gomega.Expect(err).To(gomega.BeNil())
+			test.RunWithCallbacks(nil, func() {
+				for _, violation := range tester.GetViolations() {
+					tnf.ClaimFilePrintf("CRD %s: %s", crdName, violation)
+				}
+				failedCrds = append(failedCrds, crdName)
+			}, func(err error) {
+				tnf.ClaimFilePrintf("Failed to get CRD %s: %v", crdName, err)
+				failedCrds = append(failedCrds, crdName)
+			})
+		}
+		if n := len(failedCrds); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d non conformant CRDs: %v", n, failedCrds))
+		}
+	})
+}
+
+// managedDeployment is a Deployment created by an operator, either from its CSV or as the operand of a custom resource.
+type managedDeployment struct {
+	namespace string
+	name      string
+	operand   bool
+}
+
+// getManagedDeployment returns the Deployment to delete for an operator, preferring an operand owned by one of the
+// custom resources of the operator to a Deployment owned by its CSV.
+func getManagedDeployment(env *config.TestEnvironment, operatorInTest configsections.Operator) (managedDeployment, bool) {
+	const numExpectedFields = 3
+	command := fmt.Sprintf(ocGetOwnedKindsFormat, operatorInTest.Namespace, operatorInTest.Name)
+	out := utils.ExecuteCommand(command, common.DefaultTimeout, common.GetContext(), func() {
+		tnf.ClaimFilePrintf("Failed to get the kinds owned by CSV %s/%s", operatorInTest.Namespace, operatorInTest.Name)
+	})
+	ownedKinds := strings.Fields(out)
+	namespaces := []string{operatorInTest.Namespace}
+	for _, namespace := range env.NameSpacesUnderTest {
+		if !contains(namespaces, namespace) {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	var csvDeployment *managedDeployment
+	for _, namespace := range namespaces {
+		command := fmt.Sprintf(ocGetDeploymentOwnersFormat, namespace)
+		out := utils.ExecuteCommand(command, common.DefaultTimeout, common.GetContext(), func() {
+			tnf.ClaimFilePrintf("Failed to list the deployments of namespace %s", namespace)
+		})
+		for _, line := range strings.Split(out, "\n") {
+			fields := strings.Split(strings.TrimSpace(line), "|")
+			if len(fields) != numExpectedFields {
+				continue
+			}
+			name, ownerKind, ownerName := fields[0], fields[1], fields[2]
+			if contains(ownedKinds, ownerKind) {
+				return managedDeployment{namespace: namespace, name: name, operand: true}, true
+			}
+			if csvDeployment == nil && ownerKind == "ClusterServiceVersion" && ownerName == operatorInTest.Name {
+				csvDeployment = &managedDeployment{namespace: namespace, name: name}
+			}
+		}
+	}
+	if csvDeployment == nil {
+		return managedDeployment{}, false
+	}
+	return *csvDeployment, true
+}
+
+// getDeploymentStatus returns the UID of a Deployment and whether all its replicas are ready.  The UID is empty when
+// the Deployment does not exist.
+func getDeploymentStatus(namespace, name string) (uid string, ready bool) {
+	const numExpectedFields = 3
+	command := fmt.Sprintf(ocGetDeploymentStatusFormat, namespace, name)
+	out := utils.ExecuteCommand(command, common.DefaultTimeout, common.GetContext(), func() {
+		tnf.ClaimFilePrintf("Failed to get deployment %s/%s", namespace, name)
+	})
+	fields := strings.Split(strings.TrimSpace(out), "|")
+	if len(fields) != numExpectedFields {
+		return "", false
+	}
+	return fields[0], fields[1] != "" && fields[1] == fields[2]
+}
+
+// reconcileTimeout returns the configured reconciliation deadline, or defaultReconcileTimeout when none is configured.
+func reconcileTimeout(env *config.TestEnvironment) time.Duration {
+	if env.Config.ReconcileTimeoutSeconds > 0 {
+		return time.Duration(env.Config.ReconcileTimeoutSeconds) * time.Second
+	}
+	return defaultReconcileTimeout
+}
+
+// testOperatorReconciliation ensures the configured operators recreate a deleted Deployment they manage.
+func testOperatorReconciliation(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestOperatorReconciliationIdentifier)
+	ginkgo.It(testID, func() {
+		timeout := reconcileTimeout(env)
+		var failedOperators []string
+		for _, operatorInTest := range env.OperatorsUnderTest {
+			operatorName := fmt.Sprintf("%s/%s", operatorInTest.Namespace, operatorInTest.Name)
+			deployment, found := getManagedDeployment(env, operatorInTest)
+			if !found {
+				tnf.ClaimFilePrintf("Operator %s does not manage any deployment, skipping", operatorName)
+				continue
+			}
+			deploymentName := fmt.Sprintf("%s/%s", deployment.namespace, deployment.name)
+			ginkgo.By(fmt.Sprintf("Operator %s should recreate deployment %s", operatorName, deploymentName))
+			uid, _ := getDeploymentStatus(deployment.namespace, deployment.name)
+			if uid == "" {
+				tnf.ClaimFilePrintf("Failed to get the UID of deployment %s", deploymentName)
+				failedOperators = append(failedOperators, operatorName)
+				continue
+			}
+			tnf.ClaimFilePrintf("Deleting deployment %s managed by operator %s (operand: %t)", deploymentName, operatorName, deployment.operand)
+			utils.ExecuteCommand(fmt.Sprintf(ocDeleteDeploymentFormat, deployment.namespace, deployment.name), common.DefaultTimeout, common.GetContext(), func() {
+				tnf.ClaimFilePrintf("Failed to delete deployment %s", deploymentName)
+			})
+			start := time.Now()
+			reconciled := false
+			for ; time.Since(start) < timeout; time.Sleep(reconcilePollingPeriod) {
+				newUID, ready := getDeploymentStatus(deployment.namespace, deployment.name)
+				if newUID != "" && newUID != uid && ready {
+					reconciled = true
+					break
+				}
+			}
+			if !reconciled {
+				tnf.ClaimFilePrintf("Operator %s did not recreate deployment %s within %s", operatorName, deploymentName, timeout)
+				failedOperators = append(failedOperators, operatorName)
+				continue
+			}
+			tnf.ClaimFilePrintf("Operator %s recreated deployment %s in %s", operatorName, deploymentName, time.Since(start).Round(time.Second))
+		}
+		if n := len(failedOperators); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d operators which did not reconcile a deleted deployment: %v", n, failedOperators))
+		}
+	})
+}
+
+// subscriptionManifest is the part of a subscription needed to recreate it.
+type subscriptionManifest struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Metadata   struct {
+		Name        string            `json:"name"`
+		Namespace   string            `json:"namespace"`
+		Labels      map[string]string `json:"labels,omitempty"`
+		Annotations map[string]string `json:"annotations,omitempty"`
+	} `json:"metadata"`
+	Spec map[string]interface{} `json:"spec"`
+}
+
+// getSubscriptionManifest returns the manifest recreating a subscription, pinned to the given CSV.
+func getSubscriptionManifest(operatorInTest configsections.Operator) (string, error) {
+	command := fmt.Sprintf(ocGetSubscriptionFormat, operatorInTest.Namespace, operatorInTest.SubscriptionName)
+	out := utils.ExecuteCommand(command, common.DefaultTimeout, common.GetContext(), func() {
+		tnf.ClaimFilePrintf("Failed to get subscription %s/%s", operatorInTest.Namespace, operatorInTest.SubscriptionName)
+	})
+	start := strings.Index(out, "{")
+	if start < 0 {
+		return "", fmt.Errorf("no JSON output in %q", out)
+	}
+	var subscription subscriptionManifest
+	if err := json.Unmarshal([]byte(out[start:]), &subscription); err != nil {
+		return "", err
+	}
+	if subscription.Spec == nil {
+		return "", fmt.Errorf("subscription %s/%s has no spec", operatorInTest.Namespace, operatorInTest.SubscriptionName)
+	}
+	subscription.Spec["startingCSV"] = operatorInTest.Name
+	manifest, err := json.Marshal(subscription)
+	return string(manifest), err
+}
+
+// getUninstallLeftovers returns the resources left behind by an uninstalled operator: the resources OLM created for its
+// CSV and the resources, including the custom resources it owns, which are stuck being deleted.
+func getUninstallLeftovers(operatorInTest configsections.Operator, ownedCrds []string) []string {
+	var leftovers []string
+	commands := []string{
+		fmt.Sprintf(ocGetOlmOwnedFormat, operatorInTest.Namespace, operatorInTest.Name),
+		fmt.Sprintf(ocGetOlmOwnedClusterRbacFormat, operatorInTest.Name),
+		fmt.Sprintf(ocGetTerminatingFormat, strings.Join(append([]string{"csv", "subscriptions"}, ownedCrds...), ",")),
+	}
+	for _, command := range commands {
+		out := utils.ExecuteCommand(command, common.DefaultTimeout, common.GetContext(), func() {
+			tnf.ClaimFilePrintf("Failed to run %q", command)
+		})
+		leftovers = append(leftovers, strings.Fields(out)...)
+	}
+	return leftovers
+}
+
+// waitForReinstall waits until the CSV of a recreated subscription succeeded, approving its install plan when the
+// subscription requires a manual approval.
+func waitForReinstall(operatorInTest configsections.Operator, timeout time.Duration) bool {
+	start := time.Now()
+	approved := false
+	for ; time.Since(start) < timeout; time.Sleep(reconcilePollingPeriod) {
+		if !approved {
+			command := fmt.Sprintf(ocGetSubscriptionInstallPlanFormat, operatorInTest.Namespace, operatorInTest.SubscriptionName)
+			installPlan := strings.TrimSpace(utils.ExecuteCommand(command, common.DefaultTimeout, common.GetContext(), nil))
+			if installPlan != "" {
+				utils.ExecuteCommand(fmt.Sprintf(ocApproveInstallPlanFormat, operatorInTest.Namespace, installPlan), common.DefaultTimeout, common.GetContext(), nil)
+				approved = true
+			}
+		}
+		command := fmt.Sprintf(ocGetCsvPhaseFormat, operatorInTest.Namespace, operatorInTest.Name)
+		if strings.TrimSpace(utils.ExecuteCommand(command, common.DefaultTimeout, common.GetContext(), nil)) == "Succeeded" {
+			return true
+		}
+	}
+	return false
+}
+
+// getUnreadyDeployments returns the deployments under test which do not have all their replicas ready.
+func getUnreadyDeployments(env *config.TestEnvironment) []string {
+	var unready []string
+	for _, deployment := range env.DeploymentsUnderTest {
+		if _, ready := getDeploymentStatus(deployment.Namespace, deployment.Name); !ready {
+			unready = append(unready, fmt.Sprintf("%s/%s", deployment.Namespace, deployment.Name))
+		}
+	}
+	return unready
+}
+
+// testOperatorReinstall ensures the configured operators uninstall without leaving resources behind, and that the CNF
+// is healthy again once they are reinstalled.
+func testOperatorReinstall(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestOperatorReinstallIdentifier)
+	ginkgo.It(testID, func() {
+		timeout := reconcileTimeout(env)
+		var failedOperators []string
+		for _, operatorInTest := range env.OperatorsUnderTest {
+			operatorName := fmt.Sprintf("%s/%s", operatorInTest.Namespace, operatorInTest.Name)
+			ginkgo.By(fmt.Sprintf("Operator %s should uninstall cleanly and reinstall", operatorName))
+			manifest, err := getSubscriptionManifest(operatorInTest)
+			if err != nil {
+				tnf.ClaimFilePrintf("Failed to parse subscription %s/%s: %v", operatorInTest.Namespace, operatorInTest.SubscriptionName, err)
+				failedOperators = append(failedOperators, operatorName)
+				continue
+			}
+			command := fmt.Sprintf(ocGetOwnedCrdsFormat, operatorInTest.Namespace, operatorInTest.Name)
+			ownedCrds := strings.Fields(utils.ExecuteCommand(command, common.DefaultTimeout, common.GetContext(), func() {
+				tnf.ClaimFilePrintf("Failed to get the CRDs owned by CSV %s", operatorName)
+			}))
+
+			tnf.ClaimFilePrintf("Uninstalling operator %s", operatorName)
+			utils.ExecuteCommand(fmt.Sprintf(ocDeleteSubscriptionFormat, operatorInTest.Namespace, operatorInTest.SubscriptionName), common.DefaultTimeout, common.GetContext(), func() {
+				tnf.ClaimFilePrintf("Failed to delete subscription %s/%s", operatorInTest.Namespace, operatorInTest.SubscriptionName)
+			})
+			utils.ExecuteCommand(fmt.Sprintf(ocDeleteCsvFormat, operatorInTest.Namespace, operatorInTest.Name), common.DefaultTimeout, common.GetContext(), func() {
+				tnf.ClaimFilePrintf("Failed to delete CSV %s", operatorName)
+			})
+			var leftovers []string
+			start := time.Now()
+			for ; time.Since(start) < timeout; time.Sleep(reconcilePollingPeriod) {
+				if leftovers = getUninstallLeftovers(operatorInTest, ownedCrds); len(leftovers) == 0 {
+					break
+				}
+			}
+			failed := false
+			if len(leftovers) > 0 {
+				tnf.ClaimFilePrintf("Operator %s left %d resources behind after its uninstallation: %v", operatorName, len(leftovers), leftovers)
+				failed = true
+			}
+
+			tnf.ClaimFilePrintf("Reinstalling operator %s", operatorName)
+			utils.ExecuteCommand(fmt.Sprintf(ocCreateFromStdinFormat, manifest), common.DefaultTimeout, common.GetContext(), func() {
+				tnf.ClaimFilePrintf("Failed to recreate subscription %s/%s", operatorInTest.Namespace, operatorInTest.SubscriptionName)
+			})
+			if !waitForReinstall(operatorInTest, timeout) {
+				tnf.ClaimFilePrintf("CSV %s did not succeed within %s of its reinstallation", operatorName, timeout)
+				failedOperators = append(failedOperators, operatorName)
+				continue
+			}
+			var unready []string
+			for start = time.Now(); time.Since(start) < timeout; time.Sleep(reconcilePollingPeriod) {
+				if unready = getUnreadyDeployments(env); len(unready) == 0 {
+					break
+				}
+			}
+			if len(unready) > 0 {
+				tnf.ClaimFilePrintf("Deployments %v are not ready within %s of the reinstallation of operator %s", unready, timeout, operatorName)
+				failed = true
+			}
+			if failed {
+				failedOperators = append(failedOperators, operatorName)
+			}
+		}
+		if n := len(failedOperators); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d operators which did not uninstall cleanly or reinstall: %v", n, failedOperators))
+		}
+	})
+}
+
 func itRunsTestsOnOperator(env *config.TestEnvironment) {
 	for _, testType := range testcases.GetConfiguredOperatorTests() {
 		testFile, err := testcases.LoadConfiguredTestFile(configuredTestFile)
@@ -28,6 +28,7 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/test-network-function/test-network-function/pkg/config"
+	"github.com/test-network-function/test-network-function/pkg/config/configsections"
 	"github.com/test-network-function/test-network-function/pkg/tnf/testcases"
 
 	"github.com/test-network-function/test-network-function/test-network-function/common"
@@ -37,11 +38,15 @@ import (
 	"github.com/onsi/gomega"
 	"github.com/test-network-function/test-network-function/pkg/tnf"
 	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/base/redhat"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/clockskew"
 	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/cnffsdiff"
 	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/containerid"
 	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/currentkernelcmdlineargs"
 	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/mckernelarguments"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/nicdriver"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/nodekernels"
 	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/nodemcname"
+	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/nodeos"
 	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/nodetainted"
 	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/podnodename"
 	"github.com/test-network-function/test-network-function/pkg/tnf/handlers/readbootconfig"
@@ -152,8 +157,11 @@ var _ = ginkgo.Describe(common.PlatformAlterationTestKey, func() {
 			testHugepages(env)
 			testBootParams(env)
 			testSysctlConfigs(env)
+			testNICDrivers(env)
+			testClockSkew(env)
 		}
 		testIsRedHatRelease(env)
+		testNodeKernels(env)
 	}
 })
 
@@ -248,6 +256,15 @@ func getMcName(context *interactive.Context, nodeName string) string {
 	return mcNameTester.GetMcName()
 }
 
+// getNodeOS identifies the operating system and the kernel of a node from its debug pod.
+func getNodeOS(nodeOc *interactive.Oc) nodeos.OSDescriptor {
+	tester := nodeos.NewNodeOS(common.DefaultTimeout)
+	test, err := tnf.NewTest(nodeOc.GetExpecter(), tester, []reel.Handler{tester}, nodeOc.GetErrorChannel())
+	gomega.Expect(err).To(gomega.BeNil())
+	test.RunAndValidate()
+	return tester.GetOSDescriptor()
+}
+
 func getPodNodeName(context *interactive.Context, podName, podNamespace string) string {
 	podNameTester := podnodename.NewPodNodeName(common.DefaultTimeout, podName, podNamespace)
 	test, err := tnf.NewTest(context.GetExpecter(), podNameTester, []reel.Handler{podNameTester}, context.GetErrorChannel())
@@ -334,11 +351,16 @@ func testBootParams(env *config.TestEnvironment) {
 func testBootParamsHelper(context *interactive.Context, podName, podNamespace string, targetContainerOc *interactive.Oc) {
 	ginkgo.By(fmt.Sprintf("Testing boot params for the pod's node %s/%s", podNamespace, podName))
 	nodeName := getPodNodeName(context, podName, podNamespace)
+	env := config.GetTestEnvironment()
+	nodeOC := env.NodesUnderTest[nodeName].Oc
+	// The kernel arguments of the MachineConfigs and the ostree boot entries only apply to RHCOS nodes.
+	if nodeOS := getNodeOS(nodeOC); !nodeOS.IsRHCOS() {
+		tnf.ClaimFilePrintf("Skipping the boot params of node %s, which runs %s rather than RHCOS", nodeName, nodeOS.PrettyName)
+		return
+	}
 	mcName := getMcName(context, nodeName)
 	mcKernelArgumentsMap := getMcKernelArguments(context, mcName)
 	currentKernelArgsMap := getCurrentKernelCmdlineArgs(targetContainerOc)
-	env := config.GetTestEnvironment()
-	nodeOC := env.NodesUnderTest[nodeName].Oc
 	grubKernelConfigMap := getGrubKernelArgs(nodeOC)
 
 	for key, mcVal := range mcKernelArgumentsMap {
@@ -377,6 +399,137 @@ func testSysctlConfigsHelper(podName, podNamespace string) {
 	}
 }
 
+// testNICDrivers checks the driver and firmware versions of the configured interfaces of every worker node against the
+// supported versions of the config.
+func testNICDrivers(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestNICDriverVersionsIdentifier)
+	ginkgo.It(testID, func() {
+		nics := env.Config.NICs
+		if len(nics.Interfaces) == 0 {
+			ginkgo.Skip("No NIC interface is configured")
+		}
+		supported := getSupportedNICDrivers(nics)
+		tested := false
+		var failedNodes []string
+		for name, node := range env.NodesUnderTest {
+			if !node.IsWorker() || !node.HasDebugPod() {
+				continue
+			}
+			tested = true
+			ginkgo.By(fmt.Sprintf("Checking the NIC drivers of node %s", name))
+			tester := nicdriver.NewNICDriver(common.DefaultTimeout, nics.Interfaces, supported)
+			test, err := tnf.NewTest(node.Oc.GetExpecter(), tester, []reel.Handler{tester}, node.Oc.GetErrorChannel())
+			gomega.Expect(err).To(gomega.BeNil())
+			result, err := test.Run()
+			gomega.Expect(err).To(gomega.BeNil())
+			gomega.Expect(result).ToNot(gomega.Equal(tnf.ERROR))
+			for iface, info := range tester.GetDrivers() {
+				tnf.ClaimFilePrintf("Node %s interface %s: driver %s %s, firmware %s", name, iface, info.Driver, info.Version,
+					info.FirmwareVersion)
+			}
+			if unsupported := tester.GetUnsupported(); len(unsupported) > 0 {
+				tnf.ClaimFilePrintf("Node %s has unsupported NICs: %v", name, unsupported)
+				failedNodes = append(failedNodes, name)
+			}
+		}
+		if !tested {
+			ginkgo.Skip("No worker node has a debug pod")
+		}
+		if n := len(failedNodes); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d nodes with unsupported NIC drivers or firmware: %v", n, failedNodes))
+		}
+	})
+}
+
+// getSupportedNICDrivers returns the supported versions of the NIC drivers of the config, keyed by driver name.
+func getSupportedNICDrivers(nics configsections.NICConfig) map[string]nicdriver.SupportedVersions {
+	supported := map[string]nicdriver.SupportedVersions{}
+	for _, driver := range nics.SupportedDrivers {
+		supported[driver.Name] = nicdriver.SupportedVersions{
+			DriverVersions:   driver.Versions,
+			FirmwareVersions: driver.FirmwareVersions,
+		}
+	}
+	return supported
+}
+
+// testNodeKernels checks that every node runs a kernel release within the allowed range of the config, and that the
+// workers all run the same one.
+func testNodeKernels(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestNodeKernelVersionsIdentifier)
+	ginkgo.It(testID, func() {
+		allowed := env.Config.AllowedKernelVersions
+		nodes := runNodeKernels("", allowed.Min, allowed.Max)
+		gomega.Expect(nodes).ToNot(gomega.BeNil())
+		for release, names := range nodes.GetKernels() {
+			tnf.ClaimFilePrintf("Kernel %s runs on nodes %v", release, names)
+		}
+		violations := nodes.GetViolations()
+		if workers := runNodeKernels(configsections.WorkerLabel, "", ""); workers != nil && len(workers.GetKernels()) > 1 {
+			releases := make([]string, 0, len(workers.GetKernels()))
+			for release := range workers.GetKernels() {
+				releases = append(releases, release)
+			}
+			sort.Strings(releases)
+			violations = append(violations, fmt.Sprintf("worker nodes run %d different kernels: %v", len(releases), releases))
+		}
+		if n := len(violations); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d node kernel violations: %v", n, violations))
+		}
+	})
+}
+
+// runNodeKernels gets the kernel releases of the nodes matching selector, checking them against the minVersion and
+// maxVersion range.  It returns nil when no node matches the selector.
+func runNodeKernels(selector, minVersion, maxVersion string) *nodekernels.NodeKernels {
+	context := common.GetContext()
+	tester := nodekernels.NewNodeKernels(common.DefaultTimeout, selector, minVersion, maxVersion)
+	test, err := tnf.NewTest(context.GetExpecter(), tester, []reel.Handler{tester}, context.GetErrorChannel())
+	gomega.Expect(err).To(gomega.BeNil())
+	result, err := test.Run()
+	gomega.Expect(err).To(gomega.BeNil())
+	if result == tnf.ERROR {
+		return nil
+	}
+	return tester
+}
+
+// testClockSkew checks that the clock of every node is within the maxClockSkewMillis bound of the config from the test
+// machine clock.
+func testClockSkew(env *config.TestEnvironment) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifiers.TestNodeClockSkewIdentifier)
+	ginkgo.It(testID, func() {
+		if env.Config.MaxClockSkewMillis <= 0 {
+			ginkgo.Skip("maxClockSkewMillis is not set")
+		}
+		maxSkew := time.Duration(env.Config.MaxClockSkewMillis) * time.Millisecond
+		tested := false
+		var skewedNodes []string
+		for name, node := range env.NodesUnderTest {
+			if !node.HasDebugPod() {
+				continue
+			}
+			tested = true
+			tester := clockskew.NewClockSkew(common.DefaultTimeout, maxSkew)
+			test, err := tnf.NewTest(node.Oc.GetExpecter(), tester, []reel.Handler{tester}, node.Oc.GetErrorChannel())
+			gomega.Expect(err).To(gomega.BeNil())
+			result, err := test.Run()
+			gomega.Expect(err).To(gomega.BeNil())
+			gomega.Expect(result).ToNot(gomega.Equal(tnf.ERROR))
+			tnf.ClaimFilePrintf("Node %s clock offset %v (+/- %v)", name, tester.GetOffset(), tester.GetUncertainty())
+			if result == tnf.FAILURE {
+				skewedNodes = append(skewedNodes, name)
+			}
+		}
+		if !tested {
+			ginkgo.Skip("No node has a debug pod")
+		}
+		if n := len(skewedNodes); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d nodes whose clock is more than %v off: %v", n, maxSkew, skewedNodes))
+		}
+	})
+}
+
 func printTainted(bitmap uint64) string {
 	values := getTaintedBitValues()
 	var out string
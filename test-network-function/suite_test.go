@@ -46,6 +46,7 @@ import (
 	_ "github.com/test-network-function/test-network-function/test-network-function/observability"
 	_ "github.com/test-network-function/test-network-function/test-network-function/operator"
 	_ "github.com/test-network-function/test-network-function/test-network-function/platform"
+	_ "github.com/test-network-function/test-network-function/test-network-function/topology"
 )
 
 const (
@@ -63,6 +64,16 @@ const (
 	// dateTimeFormatDirective is the directive used to format date/time according to ISO 8601.
 	dateTimeFormatDirective = "2006-01-02T15:04:05+00:00"
 	extraInfoKey            = "testsExtraInfo"
+	// cnfConfigurationKey records the CNF under test when the config file declares several of them.
+	cnfConfigurationKey = "cnf"
+	// clusterConfigurationKey records the kubeconfig context and the API server of the cluster under test.
+	clusterConfigurationKey = "cluster"
+	// namespacesUnderTestConfigurationKey records the namespaces the target namespaces resolved to.
+	namespacesUnderTestConfigurationKey = "namespacesUnderTest"
+	// configSourcesConfigurationKey records the config files loaded and the checksums of their contents.
+	configSourcesConfigurationKey = "configSources"
+	// clusterPlatformConfigurationKey records the platform, network and node inventory of the cluster under test.
+	clusterPlatformConfigurationKey = "clusterPlatform"
 )
 
 var (
@@ -151,6 +162,10 @@ func TestTest(t *testing.T) {
 	claimData.Configurations = make(map[string]interface{})
 	claimData.Nodes = make(map[string]interface{})
 
+	// Select the cluster under test before any oc command is run.
+	config.GetTestEnvironment().LoadConfig()
+	defer config.GetTestEnvironment().Close()
+
 	// run the test suite
 	ginkgo.RunSpecs(t, CnfCertificationTestSuiteName)
 	endTime := time.Now()
@@ -160,6 +175,7 @@ func TestTest(t *testing.T) {
 	// messages.
 	junitMap := make(map[string]interface{})
 	cnfCertificationJUnitFilename := filepath.Join(*junitPath, TNFJunitXMLFileName)
+	addPartnerMetadata(cnfCertificationJUnitFilename)
 	loadJUnitXMLIntoMap(junitMap, cnfCertificationJUnitFilename, TNFReportKey)
 	appendCNFFeatureValidationReportResults(junitPath, junitMap)
 	junitMap[extraInfoKey] = tnf.TestsExtraInfo
@@ -170,6 +186,18 @@ func TestTest(t *testing.T) {
 	configurations := marshalConfigurations()
 	claimData.Nodes = generateNodes()
 	unmarshalConfigurations(configurations, claimData.Configurations)
+	config.RedactSecrets(claimData.Configurations)
+	env := config.GetTestEnvironment()
+	if env.CNFName != "" {
+		claimData.Configurations[cnfConfigurationKey] = env.CNFName
+	}
+	claimData.Configurations[clusterConfigurationKey] = map[string]string{
+		"kubeconfigContext": env.KubeconfigContext,
+		"apiServer":         env.APIServer,
+	}
+	claimData.Configurations[namespacesUnderTestConfigurationKey] = env.NameSpacesUnderTest
+	claimData.Configurations[configSourcesConfigurationKey] = env.ConfigSources
+	claimData.Configurations[clusterPlatformConfigurationKey] = diagnostic.GetClusterPlatform()
 	claimData.Metadata.EndTime = endTime.UTC().Format(dateTimeFormatDirective)
 
 	// marshal the claim and output to file
@@ -189,6 +217,15 @@ func incorporateVersions(claimData *claim.Claim) {
 	}
 }
 
+// addPartnerMetadata adds the partner metadata of the test configuration to the properties of the JUnit report.  In
+// the event of an error, this method fatally fails.
+func addPartnerMetadata(junitFilename string) {
+	properties := config.GetTestEnvironment().Config.PartnerMetadata.JUnitProperties()
+	if err := junit.AddProperties(junitFilename, properties); err != nil {
+		log.Fatalf("error adding the partner metadata to the JUnit report: %v", err)
+	}
+}
+
 // appendCNFFeatureValidationReportResults is a helper method to add the results of running the cnf-features-deploy
 // test suite to the claim file.
 func appendCNFFeatureValidationReportResults(junitPath *string, junitMap map[string]interface{}) {
@@ -241,11 +278,13 @@ func generateNodes() map[string]interface{} {
 		cniPluginsField  = "cniPlugins"
 		nodesHwInfo      = "nodesHwInfo"
 		csiDriverInfo    = "csiDriver"
+		envHealth        = "environmentHealth"
 	)
 	nodes := map[string]interface{}{}
 	nodes[nodeSummaryField] = diagnostic.GetNodeSummary()
 	nodes[cniPluginsField] = diagnostic.GetCniPlugins()
 	nodes[nodesHwInfo] = diagnostic.GetNodesHwInfo()
 	nodes[csiDriverInfo] = diagnostic.GetCsiDriverInfo()
+	nodes[envHealth] = diagnostic.GetEnvironmentHealth()
 	return nodes
 }
@@ -0,0 +1,286 @@
+// Copyright (C) 2020-2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package topology
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/onsi/ginkgo"
+	log "github.com/sirupsen/logrus"
+	"github.com/test-network-function/test-network-function-claim/pkg/claim"
+	"github.com/test-network-function/test-network-function/pkg/config"
+	"github.com/test-network-function/test-network-function/pkg/config/autodiscover"
+	"github.com/test-network-function/test-network-function/pkg/config/configsections"
+	"github.com/test-network-function/test-network-function/pkg/tnf"
+	"github.com/test-network-function/test-network-function/pkg/tnf/testcases"
+	"github.com/test-network-function/test-network-function/test-network-function/common"
+	"github.com/test-network-function/test-network-function/test-network-function/identifiers"
+	"github.com/test-network-function/test-network-function/test-network-function/results"
+)
+
+const (
+	podPhaseRunning = "Running"
+)
+
+var (
+	// retrieve the singleton instance of test environment
+	env *config.TestEnvironment = config.GetTestEnvironment()
+
+	// topologyChecks are the checks of the expected topology of a workload.
+	topologyChecks = []topologyCheck{checkPodCount, checkNodePlacement, checkNetworkAttachments}
+)
+
+// workloadPod is the placement and the network attachments of a pod of a workload.
+type workloadPod struct {
+	name     string
+	node     string
+	running  bool
+	networks []string
+}
+
+// topologyCheck returns the mismatches between the expected topology of a workload and its pods.
+type topologyCheck func(workload *configsections.WorkloadTopology, pods []workloadPod) []string
+
+func init() {
+	common.RegisterPreflightCheck(checkTopology)
+}
+
+var _ = ginkgo.Describe(common.TopologyTestKey, func() {
+	conf, _ := ginkgo.GinkgoConfiguration()
+
+	if testcases.IsInFocus(conf.FocusStrings, common.TopologyTestKey) {
+		ginkgo.BeforeEach(func() {
+			env.LoadConfig()
+		})
+		ginkgo.ReportAfterEach(results.RecordResult)
+		testTopology(identifiers.TestTopologyPodCountIdentifier, checkPodCount)
+		testTopology(identifiers.TestTopologyNodePlacementIdentifier, checkNodePlacement)
+		testTopology(identifiers.TestTopologyNetworkAttachmentsIdentifier, checkNetworkAttachments)
+	}
+})
+
+// checkTopology aborts the suite when the workloads under test do not match the expected topology of the config, so a
+// CNF which is not deployed as intended is not reported as failing its functional tests.
+func checkTopology() {
+	if mismatches := getTopologyMismatches(); len(mismatches) > 0 {
+		log.Errorf("Topology mismatches: %v", mismatches)
+		ginkgo.Fail(fmt.Sprintf("The CNF tests were not run as %d mismatches with the expected topology were found: %v. "+
+			"Set TNF_SKIP_PREFLIGHT=true to run them anyway.", len(mismatches), mismatches))
+	}
+}
+
+// getTopologyMismatches returns the differences between the expected topology of the config and the workloads running
+// in the cluster.  The results of the other tests are not meaningful while there is any.
+func getTopologyMismatches() []string {
+	var mismatches []string
+	workloads := env.Config.ExpectedTopology
+	for i := range workloads {
+		for _, check := range topologyChecks {
+			mismatches = append(mismatches, checkWorkload(&workloads[i], check)...)
+		}
+	}
+	return mismatches
+}
+
+// testTopology runs a check of the expected topology against each of the workloads declaring one.
+func testTopology(identifier claim.Identifier, check topologyCheck) {
+	testID := identifiers.XformToGinkgoItIdentifier(identifier)
+	ginkgo.It(testID, func() {
+		workloads := env.Config.ExpectedTopology
+		if len(workloads) == 0 {
+			ginkgo.Skip("No expected topology is declared in the config")
+		}
+		var mismatches []string
+		for i := range workloads {
+			ginkgo.By(fmt.Sprintf("Checking the topology of workload %s", workloads[i].Name))
+			mismatches = append(mismatches, checkWorkload(&workloads[i], check)...)
+		}
+		for _, mismatch := range mismatches {
+			tnf.ClaimFilePrintf("Topology mismatch: %s", mismatch)
+		}
+		if n := len(mismatches); n > 0 {
+			ginkgo.Fail(fmt.Sprintf("Found %d mismatches with the expected topology: %v", n, mismatches))
+		}
+	})
+}
+
+// describeWorkload identifies a workload in the mismatches.
+func describeWorkload(workload *configsections.WorkloadTopology) string {
+	return fmt.Sprintf("workload %s (%s in namespace %s)", workload.Name, workload.PodSelector, workload.Namespace)
+}
+
+// getWorkloadPods returns the pods of a workload, with the nodes they run on and their network attachments.
+func getWorkloadPods(workload *configsections.WorkloadTopology) ([]workloadPod, error) {
+	resources, err := autodiscover.GetWorkloadPods(workload.PodSelector, workload.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	var pods []workloadPod
+	for _, resource := range resources {
+		networks, err := resource.GetNetworkAttachments()
+		if err != nil {
+			return nil, err
+		}
+		pods = append(pods, workloadPod{
+			name:     resource.Metadata.Name,
+			node:     resource.Spec.NodeName,
+			running:  resource.Status.Phase == podPhaseRunning,
+			networks: networks,
+		})
+	}
+	return pods, nil
+}
+
+// checkWorkload runs a check against the pods of a workload.  Failing to get them is a mismatch.
+func checkWorkload(workload *configsections.WorkloadTopology, check topologyCheck) []string {
+	pods, err := getWorkloadPods(workload)
+	if err != nil {
+		return []string{fmt.Sprintf("%s: failed to get its pods: %v", describeWorkload(workload), err)}
+	}
+	return check(workload, pods)
+}
+
+// checkPodCount checks a workload runs the expected number of pods, when declared.
+func checkPodCount(workload *configsections.WorkloadTopology, pods []workloadPod) []string {
+	if workload.Pods == 0 {
+		return nil
+	}
+	running := 0
+	var notRunning []string
+	for _, pod := range pods {
+		if pod.running {
+			running++
+		} else {
+			notRunning = append(notRunning, pod.name)
+		}
+	}
+	if running == workload.Pods {
+		return nil
+	}
+	mismatch := fmt.Sprintf("%s: %d pods are running, expected %d", describeWorkload(workload), running, workload.Pods)
+	if len(notRunning) > 0 {
+		mismatch += fmt.Sprintf(", pods not running: %v", notRunning)
+	}
+	return []string{mismatch}
+}
+
+// getAllowedNodes returns the nodes the pods of a workload may run on, or nil when any node is accepted.  When both
+// nodes and a node selector are declared, a node must satisfy both.
+func getAllowedNodes(workload *configsections.WorkloadTopology) (map[string]bool, error) {
+	if len(workload.Nodes) == 0 && workload.NodeSelector == "" {
+		return nil, nil
+	}
+	allowed := map[string]bool{}
+	for _, node := range workload.Nodes {
+		allowed[node] = true
+	}
+	if workload.NodeSelector == "" {
+		return allowed, nil
+	}
+	selected, err := autodiscover.GetNodeNamesBySelector(workload.NodeSelector)
+	if err != nil {
+		return nil, err
+	}
+	selectedAllowed := map[string]bool{}
+	for _, node := range selected {
+		if len(workload.Nodes) == 0 || allowed[node] {
+			selectedAllowed[node] = true
+		}
+	}
+	return selectedAllowed, nil
+}
+
+// checkNodePlacement checks the pods of a workload run on the allowed nodes, and on distinct nodes when required.
+func checkNodePlacement(workload *configsections.WorkloadTopology, pods []workloadPod) []string {
+	allowed, err := getAllowedNodes(workload)
+	if err != nil {
+		return []string{fmt.Sprintf("%s: failed to get the nodes matching %q: %v", describeWorkload(workload), workload.NodeSelector, err)}
+	}
+	return checkPlacement(workload, pods, allowed)
+}
+
+// checkPlacement checks the pods of a workload run on the allowed nodes, any node when nil, and on distinct nodes when
+// required.  The pods not scheduled yet are left to the pod count check.
+func checkPlacement(workload *configsections.WorkloadTopology, pods []workloadPod, allowed map[string]bool) (mismatches []string) {
+	var allowedNodes []string
+	for node := range allowed {
+		allowedNodes = append(allowedNodes, node)
+	}
+	sort.Strings(allowedNodes)
+	var nodes []string
+	podsByNode := map[string][]string{}
+	for _, pod := range pods {
+		if pod.node == "" {
+			continue
+		}
+		if allowed != nil && !allowed[pod.node] {
+			mismatches = append(mismatches, fmt.Sprintf("%s: pod %s runs on node %s, expected one of %v", describeWorkload(workload),
+				pod.name, pod.node, allowedNodes))
+		}
+		if _, found := podsByNode[pod.node]; !found {
+			nodes = append(nodes, pod.node)
+		}
+		podsByNode[pod.node] = append(podsByNode[pod.node], pod.name)
+	}
+	if !workload.DistinctNodes {
+		return mismatches
+	}
+	for _, node := range nodes {
+		if len(podsByNode[node]) > 1 {
+			mismatches = append(mismatches, fmt.Sprintf("%s: pods %v share node %s, expected distinct nodes", describeWorkload(workload),
+				podsByNode[node], node))
+		}
+	}
+	return mismatches
+}
+
+// expectedNetworkName returns the "<namespace>/<name>" of a network attachment declared by a workload.
+func expectedNetworkName(workload *configsections.WorkloadTopology, network string) string {
+	if strings.Contains(network, "/") {
+		return network
+	}
+	return workload.Namespace + "/" + network
+}
+
+// checkNetworkAttachments checks every running pod of a workload is attached to the expected networks.
+func checkNetworkAttachments(workload *configsections.WorkloadTopology, pods []workloadPod) (mismatches []string) {
+	if len(workload.Networks) == 0 {
+		return nil
+	}
+	for _, pod := range pods {
+		if !pod.running {
+			continue
+		}
+		attached := map[string]bool{}
+		for _, network := range pod.networks {
+			attached[network] = true
+		}
+		var missing []string
+		for _, network := range workload.Networks {
+			if name := expectedNetworkName(workload, network); !attached[name] {
+				missing = append(missing, name)
+			}
+		}
+		if len(missing) > 0 {
+			mismatches = append(mismatches, fmt.Sprintf("%s: pod %s is not attached to networks %v, only to %v", describeWorkload(workload),
+				pod.name, missing, pod.networks))
+		}
+	}
+	return mismatches
+}
@@ -0,0 +1,70 @@
+// Copyright (C) 2020-2021 Red Hat, Inc.
+//
+// This program is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 2 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program; if not, write to the Free Software Foundation, Inc.,
+// 51 Franklin Street, Fifth Floor, Boston, MA 02110-1301 USA.
+
+package topology
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/test-network-function/test-network-function/pkg/config/configsections"
+)
+
+var testPods = []workloadPod{
+	{name: "front-1", node: "worker-0", running: true, networks: []string{"tnf/macvlan1", "tnf/sriov1"}},
+	{name: "front-2", node: "worker-1", running: true, networks: []string{"tnf/macvlan1"}},
+	{name: "front-3", node: "worker-1", running: true, networks: []string{"tnf/macvlan1", "tnf/sriov1"}},
+	{name: "front-4"},
+}
+
+func TestCheckPodCount(t *testing.T) {
+	workload := &configsections.WorkloadTopology{Name: "front", Namespace: "tnf", PodSelector: "app=front"}
+	assert.Nil(t, checkPodCount(workload, testPods))
+
+	workload.Pods = 3
+	assert.Nil(t, checkPodCount(workload, testPods))
+
+	workload.Pods = 4
+	assert.Equal(t, []string{"workload front (app=front in namespace tnf): 3 pods are running, expected 4, pods not running: [front-4]"},
+		checkPodCount(workload, testPods))
+}
+
+func TestCheckPlacement(t *testing.T) {
+	workload := &configsections.WorkloadTopology{Name: "front", Namespace: "tnf", PodSelector: "app=front"}
+	assert.Nil(t, checkPlacement(workload, testPods, nil))
+	assert.Nil(t, checkPlacement(workload, testPods, map[string]bool{"worker-0": true, "worker-1": true}))
+
+	assert.Equal(t, []string{
+		"workload front (app=front in namespace tnf): pod front-2 runs on node worker-1, expected one of [worker-0 worker-2]",
+		"workload front (app=front in namespace tnf): pod front-3 runs on node worker-1, expected one of [worker-0 worker-2]",
+	}, checkPlacement(workload, testPods, map[string]bool{"worker-0": true, "worker-2": true}))
+
+	workload.DistinctNodes = true
+	assert.Equal(t, []string{"workload front (app=front in namespace tnf): pods [front-2 front-3] share node worker-1, expected distinct nodes"},
+		checkPlacement(workload, testPods, nil))
+}
+
+func TestCheckNetworkAttachments(t *testing.T) {
+	workload := &configsections.WorkloadTopology{Name: "front", Namespace: "tnf", PodSelector: "app=front"}
+	assert.Nil(t, checkNetworkAttachments(workload, testPods))
+
+	workload.Networks = []string{"macvlan1", "tnf/sriov1"}
+	assert.Equal(t, []string{"workload front (app=front in namespace tnf): pod front-2 is not attached to networks [tnf/sriov1], only to [tnf/macvlan1]"},
+		checkNetworkAttachments(workload, testPods))
+
+	workload.Networks = []string{"other/macvlan1"}
+	assert.Equal(t, 3, len(checkNetworkAttachments(workload, testPods)))
+}